@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conventionalcommits validates commit messages and pull request titles against
+// the Conventional Commits specification (https://www.conventionalcommits.org), or a
+// stricter, configurable subset of it.
+package conventionalcommits
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+var (
+	// ErrInvalidFormat is returned if the subject doesn't follow the
+	// "<type>[(<scope>)][!]: <description>" format.
+	ErrInvalidFormat = errors.New("subject doesn't follow the <type>[(<scope>)][!]: <description> format")
+	// ErrTypeNotAllowed is returned if the type isn't part of Convention.Types.
+	ErrTypeNotAllowed = errors.New("type is not allowed")
+	// ErrScopeRequired is returned if Convention.RequireScope is set, but no scope was given.
+	ErrScopeRequired = errors.New("scope is required")
+	// ErrScopeNotAllowed is returned if the scope isn't part of Convention.Scopes.
+	ErrScopeNotAllowed = errors.New("scope is not allowed")
+	// ErrSubjectTooLong is returned if the subject is longer than Convention.MaxSubjectLength.
+	ErrSubjectTooLong = errors.New("subject is too long")
+	// ErrBreakingChangeNotAllowed is returned if a breaking change is marked, but
+	// Convention.DisallowBreakingChanges is set.
+	ErrBreakingChangeNotAllowed = errors.New("breaking changes are not allowed")
+)
+
+const (
+	// breakingChangeFooter marks a breaking change in the body of a commit message.
+	breakingChangeFooter = "BREAKING CHANGE:"
+	// breakingChangeFooterAlt is the alternative, hyphenated, breaking change footer.
+	breakingChangeFooterAlt = "BREAKING-CHANGE:"
+)
+
+// subjectRegexp matches "<type>[(<scope>)][!]: <description>".
+//
+//nolint:gochecknoglobals
+var subjectRegexp = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^()\s]+)\))?(!)?: (\S.*)$`)
+
+// DefaultTypes are the commit types allowed by Default().
+//
+//nolint:gochecknoglobals
+var DefaultTypes = []string{"build", "chore", "ci", "docs", "feat", "fix", "perf", "refactor", "revert", "style", "test"}
+
+// Message is a parsed Conventional Commits message.
+type Message struct {
+	// Type is the type of change, e.g. "feat" or "fix".
+	Type string
+	// Scope is the optional scope of the change, e.g. "gitlab".
+	Scope string
+	// Breaking is true if the subject is marked with "!", or the body contains a
+	// "BREAKING CHANGE:" footer.
+	Breaking bool
+	// Description is the description in the subject, after the colon.
+	Description string
+	// Body is everything after the first line, with surrounding whitespace trimmed.
+	Body string
+}
+
+// Parse parses a commit message or pull request title. Only the first line is
+// treated as the subject; the rest is the body.
+//
+// ErrInvalidFormat is returned if the subject doesn't follow the specification.
+func Parse(msg string) (*Message, error) {
+	subject, body, _ := strings.Cut(msg, "\n")
+	subject = strings.TrimSpace(subject)
+
+	matches := subjectRegexp.FindStringSubmatch(subject)
+	if matches == nil {
+		return nil, fmt.Errorf("%q: %w", subject, ErrInvalidFormat)
+	}
+
+	body = strings.TrimSpace(body)
+	return &Message{
+		Type:        matches[1],
+		Scope:       matches[2],
+		Breaking:    matches[3] == "!" || hasBreakingChangeFooter(body),
+		Description: matches[4],
+		Body:        body,
+	}, nil
+}
+
+func hasBreakingChangeFooter(body string) bool {
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, breakingChangeFooter) || strings.HasPrefix(line, breakingChangeFooterAlt) {
+			return true
+		}
+	}
+	return false
+}
+
+// Convention describes the rules a commit message or pull request title must follow.
+// The zero value only enforces the Conventional Commits format.
+type Convention struct {
+	// Types lists the allowed types. If empty, any type is allowed.
+	Types []string
+	// Scopes lists the allowed scopes. If empty, any scope is allowed.
+	Scopes []string
+	// RequireScope makes the scope mandatory.
+	RequireScope bool
+	// MaxSubjectLength is the maximum length of the subject line. Zero means unlimited.
+	MaxSubjectLength int
+	// DisallowBreakingChanges rejects messages marked as breaking changes.
+	DisallowBreakingChanges bool
+}
+
+// Default returns a Convention allowing the DefaultTypes, with a subject of at most 72 characters.
+// The Types are a copy of DefaultTypes, so they can be changed without affecting other callers.
+func Default() Convention {
+	return Convention{
+		Types:            slices.Clone(DefaultTypes),
+		MaxSubjectLength: 72,
+	}
+}
+
+// Validate validates msg against the convention. All violations are returned at once in a
+// *validation.MultiError, so that they can be checked for using errors.Is, e.g.
+// errors.Is(err, ErrTypeNotAllowed).
+func (c Convention) Validate(msg string) error {
+	m, err := Parse(msg)
+	if err != nil {
+		return validation.NewMultiError(err)
+	}
+
+	errs := []error{}
+	if len(c.Types) != 0 && !slices.Contains(c.Types, m.Type) {
+		errs = append(errs, fmt.Errorf("%q, expected one of %v: %w", m.Type, c.Types, ErrTypeNotAllowed))
+	}
+	if m.Scope == "" && c.RequireScope {
+		errs = append(errs, ErrScopeRequired)
+	}
+	if m.Scope != "" && len(c.Scopes) != 0 && !slices.Contains(c.Scopes, m.Scope) {
+		errs = append(errs, fmt.Errorf("%q, expected one of %v: %w", m.Scope, c.Scopes, ErrScopeNotAllowed))
+	}
+	subject, _, _ := strings.Cut(msg, "\n")
+	subject = strings.TrimSpace(subject)
+	if c.MaxSubjectLength > 0 && len([]rune(subject)) > c.MaxSubjectLength {
+		errs = append(errs, fmt.Errorf("%d characters, maximum is %d: %w", len([]rune(subject)), c.MaxSubjectLength, ErrSubjectTooLong))
+	}
+	if m.Breaking && c.DisallowBreakingChanges {
+		errs = append(errs, ErrBreakingChangeNotAllowed)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return validation.NewMultiError(errs...)
+}
+
+// ValidatePullRequest validates the title of the given pull request against the convention.
+// This can be used to block merging non-conforming pull requests.
+func (c Convention) ValidatePullRequest(pr gitprovider.PullRequest) error {
+	return c.Validate(pr.Get().Title)
+}
+
+// Annotate returns a human-readable, Markdown-formatted explanation of the violations in err,
+// as returned from Validate. This can be used to annotate non-conforming pull requests,
+// e.g. in a comment. An empty string is returned if err is nil.
+func Annotate(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("This title doesn't follow the commit message convention:\n")
+
+	multiErr := &validation.MultiError{}
+	if !errors.As(err, &multiErr) {
+		multiErr = validation.NewMultiError(err)
+	}
+	for _, e := range multiErr.Errors {
+		sb.WriteString(fmt.Sprintf("\n- %s", e.Error()))
+	}
+	return sb.String()
+}
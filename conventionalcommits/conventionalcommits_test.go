@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conventionalcommits
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     string
+		want    *Message
+		wantErr bool
+	}{
+		{
+			name: "type and description",
+			msg:  "fix: handle empty responses",
+			want: &Message{Type: "fix", Description: "handle empty responses"},
+		},
+		{
+			name: "scope and breaking marker",
+			msg:  "feat(gitlab)!: drop v3 API support",
+			want: &Message{Type: "feat", Scope: "gitlab", Breaking: true, Description: "drop v3 API support"},
+		},
+		{
+			name: "breaking change footer",
+			msg:  "refactor: rename client options\n\nBREAKING CHANGE: WithFoo is now WithBar",
+			want: &Message{Type: "refactor", Breaking: true, Description: "rename client options", Body: "BREAKING CHANGE: WithFoo is now WithBar"},
+		},
+		{
+			name:    "missing type",
+			msg:     "handle empty responses",
+			wantErr: true,
+		},
+		{
+			name:    "missing space after colon",
+			msg:     "fix:handle empty responses",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				validation.TestExpectErrors(t, "Parse", err, ErrInvalidFormat)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvention_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		convention   Convention
+		msg          string
+		expectedErrs []error
+	}{
+		{
+			name:       "zero value accepts any type",
+			convention: Convention{},
+			msg:        "wip: something",
+		},
+		{
+			name:       "default accepts known type",
+			convention: Default(),
+			msg:        "docs(readme): fix typo",
+		},
+		{
+			name:         "invalid format",
+			convention:   Default(),
+			msg:          "Fix typo",
+			expectedErrs: []error{ErrInvalidFormat},
+		},
+		{
+			name:         "unknown type",
+			convention:   Default(),
+			msg:          "wip: something",
+			expectedErrs: []error{ErrTypeNotAllowed},
+		},
+		{
+			name:         "scope required",
+			convention:   Convention{RequireScope: true},
+			msg:          "fix: something",
+			expectedErrs: []error{ErrScopeRequired},
+		},
+		{
+			name:         "scope not allowed",
+			convention:   Convention{Scopes: []string{"github", "gitlab"}},
+			msg:          "fix(gitea): something",
+			expectedErrs: []error{ErrScopeNotAllowed},
+		},
+		{
+			name:         "multiple violations",
+			convention:   Convention{Types: []string{"fix"}, MaxSubjectLength: 10, DisallowBreakingChanges: true},
+			msg:          "feat!: something",
+			expectedErrs: []error{ErrTypeNotAllowed, ErrSubjectTooLong, ErrBreakingChangeNotAllowed},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convention.Validate(tt.msg)
+			if (err != nil) != (len(tt.expectedErrs) != 0) {
+				t.Fatalf("Convention.Validate() error = %v, expectedErrs %v", err, tt.expectedErrs)
+			}
+			validation.TestExpectErrors(t, "Convention.Validate", err, tt.expectedErrs...)
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	want := slices.Clone(DefaultTypes)
+	conv := Default()
+	conv.Types[0] = "changed"
+	conv.Types = append(conv.Types, "added")
+	if !reflect.DeepEqual(DefaultTypes, want) {
+		t.Errorf("changing Default().Types changed DefaultTypes to %v, want %v", DefaultTypes, want)
+	}
+	if got := Default().Types; !reflect.DeepEqual(got, want) {
+		t.Errorf("Default().Types = %v, want %v", got, want)
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	if got := Annotate(nil); got != "" {
+		t.Errorf("Annotate(nil) = %q, want empty", got)
+	}
+	err := Convention{RequireScope: true, DisallowBreakingChanges: true}.Validate("fix!: something")
+	got := Annotate(err)
+	for _, want := range []string{ErrScopeRequired.Error(), ErrBreakingChangeNotAllowed.Error()} {
+		if !strings.Contains(got, "\n- "+want) {
+			t.Errorf("Annotate() = %q, want it to contain %q", got, want)
+		}
+	}
+}
@@ -19,9 +19,10 @@ package gitea
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"code.gitea.io/sdk/gitea"
+	"github.com/go-logr/logr"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
@@ -35,6 +36,9 @@ const (
 // NewClient creates a new gitprovider.Client instance for Gitea API endpoints.
 //
 // Gitea Selfhosted can be used if you specify the domain using WithDomain.
+// To authenticate with tokens which change over time, pass an empty token together with
+// gitprovider.WithTokenSource. For read-only access to public repositories, pass an empty token
+// together with gitprovider.WithAnonymous.
 func NewClient(token string, optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
 	// Complete the options struct
 	opts, err := gitprovider.MakeClientOptions(optFns...)
@@ -42,6 +46,14 @@ func NewClient(token string, optFns ...gitprovider.ClientOption) (gitprovider.Cl
 		return nil, err
 	}
 
+	if len(opts.RequiredScopes()) != 0 {
+		// The scopes of a token are only listed for basic authentication with a password
+		return nil, fmt.Errorf("token scopes can't be checked: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if opts.Anonymous() && token != "" {
+		return nil, fmt.Errorf("anonymous client can't be given a token: %w", gitprovider.ErrInvalidClientOptions)
+	}
+
 	// Create a *http.Client using the transport chain
 	httpClient, err := gitprovider.BuildClientFromTransportChain(opts.GetTransportChain())
 	if err != nil {
@@ -52,9 +64,13 @@ func NewClient(token string, optFns ...gitprovider.ClientOption) (gitprovider.Cl
 	if opts.Domain != nil {
 		domain = *opts.Domain
 	}
-	baseURL := domain
-	if !strings.Contains(domain, "://") {
-		baseURL = fmt.Sprintf("https://%s/", domain)
+	baseURL := fmt.Sprintf("https://%s/", domain)
+	if opts.Domain != nil {
+		domainURL, err := opts.DomainURL()
+		if err != nil {
+			return nil, err
+		}
+		baseURL = domainURL + "/"
 	}
 
 	gt, err := gitea.NewClient(baseURL, gitea.SetHTTPClient(httpClient), gitea.SetToken(token))
@@ -66,12 +82,17 @@ func NewClient(token string, optFns ...gitprovider.ClientOption) (gitprovider.Cl
 	if opts.EnableDestructiveAPICalls != nil {
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
+	followRedirects := opts.FollowRedirects == nil || *opts.FollowRedirects
 
-	return newClient(gt, domain, destructiveActions), nil
+	c := newClient(gt, domain, destructiveActions, followRedirects, opts.GetLogger())
+	if opts.CommitSizeLimits != nil {
+		c.commitSizeLimits = *opts.CommitSizeLimits
+	}
+	return c, nil
 }
 
-func newClient(c *gitea.Client, domain string, destructiveActions bool) *Client {
-	ctx := &clientContext{c, domain, destructiveActions}
+func newClient(c *gitea.Client, domain string, destructiveActions, followRedirects bool, log logr.Logger) *Client {
+	ctx := &clientContext{c, domain, destructiveActions, followRedirects, log, defaultCommitSizeLimits}
 	return &Client{
 		clientContext: ctx,
 		orgs: &OrganizationsClient{
@@ -83,6 +104,15 @@ func newClient(c *gitea.Client, domain string, destructiveActions bool) *Client
 		userRepos: &UserRepositoriesClient{
 			clientContext: ctx,
 		},
+		gpgKeys: &GPGKeyClient{
+			clientContext: ctx,
+		},
+		sshKeys: &SSHKeyClient{
+			clientContext: ctx,
+		},
+		instance: &InstanceClient{
+			clientContext: ctx,
+		},
 	}
 }
 
@@ -90,6 +120,10 @@ type clientContext struct {
 	c                  *gitea.Client
 	domain             string
 	destructiveActions bool
+	followRedirects    bool
+	log                logr.Logger
+	// commitSizeLimits are the limits commits are checked against before they're sent.
+	commitSizeLimits gitprovider.SizeLimits
 }
 
 // Client implements the gitprovider.Client interface.
@@ -102,6 +136,9 @@ type Client struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+	gpgKeys   *GPGKeyClient
+	sshKeys   *SSHKeyClient
+	instance  *InstanceClient
 }
 
 // SupportedDomain returns the domain endpoint for this client, e.g. "gitea.com", "gitea.dev.com" or
@@ -139,6 +176,58 @@ func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
 	return c.userRepos
 }
 
+// GPGKeys returns the GPGKeyClient handling the GPG keys of the authenticated user.
+func (c *Client) GPGKeys() (gitprovider.GPGKeyClient, error) {
+	return c.gpgKeys, nil
+}
+
+// SSHKeys returns the SSHKeyClient handling the SSH keys of the authenticated user.
+func (c *Client) SSHKeys() (gitprovider.SSHKeyClient, error) {
+	return c.sshKeys, nil
+}
+
+// Instance returns the InstanceClient handling the administration of the instance.
+func (c *Client) Instance() gitprovider.InstanceClient {
+	return c.instance
+}
+
+// Runners returns ErrNoProviderSupport, as the Gitea SDK doesn't cover Actions runners.
+func (c *Client) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// CurrentUser returns the identity the client is authenticated as. Gitea doesn't report the
+// scopes or expiry of tokens.
+func (c *Client) CurrentUser(ctx context.Context) (gitprovider.CurrentUserInfo, error) {
+	// GET /user
+	user, res, err := c.c.GetMyUserInfo()
+	if err != nil {
+		return gitprovider.CurrentUserInfo{}, handleHTTPError(res, err)
+	}
+	info := gitprovider.CurrentUserInfo{
+		ID:       user.ID,
+		Username: user.UserName,
+	}
+	if user.FullName != "" {
+		info.Name = &user.FullName
+	}
+	if user.Email != "" {
+		info.Email = &user.Email
+	}
+	return info, nil
+}
+
+// CheckCapability returns nil, the scopes of Gitea tokens are only checked by the operations
+// themselves.
+func (c *Client) CheckCapability(_ context.Context, _ gitprovider.Capability) error {
+	return nil
+}
+
+// SSHHostKeys returns ErrNoProviderSupport, Gitea doesn't publish its SSH host keys through the API.
+func (c *Client) SSHHostKeys(_ context.Context) ([]gitprovider.SSHHostKey, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
 // HasTokenPermission returns true if the given token has the given permissions.
 func (c *Client) HasTokenPermission(ctx context.Context, permission gitprovider.TokenPermission) (bool, error) {
 	return false, gitprovider.ErrNoProviderSupport
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestNewClient_InsecureHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"1.21.0"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		opts    []gitprovider.ClientOption
+		wantErr error
+	}{
+		{
+			name:    "http:// domain isn't allowed by default",
+			opts:    []gitprovider.ClientOption{gitprovider.WithDomain(server.URL)},
+			wantErr: gitprovider.ErrInvalidClientOptions,
+		},
+		{
+			name: "http:// domain with WithAllowInsecureHTTP(true)",
+			opts: []gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient("", tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewClient() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && c.SupportedDomain() != server.URL {
+				t.Errorf("SupportedDomain() = %q, want %q", c.SupportedDomain(), server.URL)
+			}
+		})
+	}
+}
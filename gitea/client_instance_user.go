@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AdminUserClient implements the gitprovider.AdminUserClient interface.
+var _ gitprovider.AdminUserClient = &AdminUserClient{}
+
+// AdminUserClient manages the user accounts of a Gitea instance, which requires an
+// administrator token.
+type AdminUserClient struct {
+	*clientContext
+}
+
+// Get returns the user with the given username.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Get(ctx context.Context, username string) (*gitprovider.UserInfo, error) {
+	apiObj, err := c.getUser(username)
+	if err != nil {
+		return nil, err
+	}
+	return userFromAPI(apiObj), nil
+}
+
+// Create creates a user, which doesn't have to change its password when signing in first.
+// Gitea requires a password. Administrators are promoted with an additional request, as they
+// can't be created directly.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *AdminUserClient) Create(ctx context.Context, req gitprovider.UserInfo) (*gitprovider.UserInfo, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if req.Password == nil {
+		return nil, fmt.Errorf("password is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	// POST /admin/users
+	apiObj, res, err := c.c.AdminCreateUser(gitea.CreateUserOption{
+		Username:           req.Username,
+		FullName:           *req.Name,
+		Email:              req.Email,
+		Password:           *req.Password,
+		MustChangePassword: gitea.OptionalBool(false),
+	})
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if *req.Admin {
+		if err := c.editUser(apiObj, gitea.EditUserOption{Admin: gitea.OptionalBool(true)}); err != nil {
+			return nil, err
+		}
+		apiObj.IsAdmin = true
+	}
+	return userFromAPI(apiObj), nil
+}
+
+// Block prohibits the user from signing in.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Block(ctx context.Context, username string) error {
+	apiObj, err := c.getUser(username)
+	if err != nil {
+		return err
+	}
+	return c.editUser(apiObj, gitea.EditUserOption{ProhibitLogin: gitea.OptionalBool(true)})
+}
+
+// Unblock allows the user to sign in again.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Unblock(ctx context.Context, username string) error {
+	apiObj, err := c.getUser(username)
+	if err != nil {
+		return err
+	}
+	return c.editUser(apiObj, gitea.EditUserOption{ProhibitLogin: gitea.OptionalBool(false)})
+}
+
+// Delete deletes the user. Gitea refuses to delete users who still own repositories or are
+// the last owner of an organization.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Delete(ctx context.Context, username string) error {
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete user: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /admin/users/{username}
+	res, err := c.c.AdminDeleteUser(username)
+	return handleHTTPError(res, err)
+}
+
+// CreateImpersonationToken returns ErrNoProviderSupport, as Gitea only creates access tokens
+// for requests authenticated with the password of the user.
+func (c *AdminUserClient) CreateImpersonationToken(_ context.Context, _ string, _ gitprovider.ImpersonationTokenInfo) (*gitprovider.ImpersonationTokenInfo, error) {
+	return nil, fmt.Errorf("impersonation tokens: %w", gitprovider.ErrNoProviderSupport)
+}
+
+func (c *AdminUserClient) getUser(username string) (*gitea.User, error) {
+	// GET /users/{username}
+	apiObj, res, err := c.c.GetUserInfo(username)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return apiObj, nil
+}
+
+// editUser changes the given user. Older Gitea versions require the login name and
+// authentication source, which are copied from the user.
+func (c *AdminUserClient) editUser(apiObj *gitea.User, opts gitea.EditUserOption) error {
+	opts.SourceID = apiObj.SourceID
+	opts.LoginName = apiObj.LoginName
+	if opts.LoginName == "" {
+		opts.LoginName = apiObj.UserName
+	}
+	// PATCH /admin/users/{username}
+	res, err := c.c.AdminEditUser(apiObj.UserName, opts)
+	return handleHTTPError(res, err)
+}
+
+// userFromAPI converts a user as seen by an administrator.
+func userFromAPI(apiObj *gitea.User) *gitprovider.UserInfo {
+	return &gitprovider.UserInfo{
+		ID:       apiObj.ID,
+		Username: apiObj.UserName,
+		Name:     gitprovider.StringVar(apiObj.FullName),
+		Email:    apiObj.Email,
+		Admin:    gitprovider.BoolVar(apiObj.IsAdmin),
+		Blocked:  apiObj.ProhibitLogin,
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ownersTeamName is the name of the team Gitea creates for the owners of each organization.
+const ownersTeamName = "Owners"
+
+// OrganizationMemberClient implements the gitprovider.OrganizationMemberClient interface.
+var _ gitprovider.OrganizationMemberClient = &OrganizationMemberClient{}
+
+// OrganizationMemberClient operates on the members of a specific organization.
+// Gitea users are members of an organization through its teams, the members of
+// the Owners team are the owners of the organization.
+type OrganizationMemberClient struct {
+	*clientContext
+	ref   gitprovider.OrganizationRef
+	teams *TeamsClient
+}
+
+// List lists the members of the organization, including their role.
+//
+// List returns all members, using multiple paginated requests if needed.
+func (c *OrganizationMemberClient) List(ctx context.Context) ([]gitprovider.OrganizationMemberInfo, error) {
+	opts := gitea.ListOrgMembershipOption{}
+	apiObjs := []*gitea.User{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /orgs/{org}/members
+		pageObjs, resp, listErr := c.c.ListOrgMembership(c.ref.Organization, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := c.owners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isOwner := make(map[string]bool, len(owners))
+	for _, owner := range owners {
+		isOwner[owner.UserName] = true
+	}
+
+	members := make([]gitprovider.OrganizationMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		role := gitprovider.OrganizationRoleMember
+		if isOwner[apiObj.UserName] {
+			role = gitprovider.OrganizationRoleOwner
+		}
+		members = append(members, gitprovider.OrganizationMemberInfo{
+			Username: apiObj.UserName,
+			Role:     gitprovider.OrganizationRoleVar(role),
+		})
+	}
+	return gitprovider.LimitItems(ctx, members), nil
+}
+
+// ListIter returns an iterator over the members List returns, fetching them a page at a time.
+// The owners are looked up along with the first page.
+func (c *OrganizationMemberClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.OrganizationMemberInfo] {
+	var isOwner map[string]bool
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrganizationMemberInfo, gitprovider.PageInfo, error) {
+		if isOwner == nil {
+			owners, err := c.owners(gitprovider.WithoutListOptions(ctx))
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			isOwner = make(map[string]bool, len(owners))
+			for _, owner := range owners {
+				isOwner[owner.UserName] = true
+			}
+		}
+		opts := gitea.ListOrgMembershipOption{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /orgs/{org}/members
+		apiObjs, res, err := c.c.ListOrgMembership(c.ref.Organization, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		members := make([]gitprovider.OrganizationMemberInfo, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			role := gitprovider.OrganizationRoleMember
+			if isOwner[apiObj.UserName] {
+				role = gitprovider.OrganizationRoleOwner
+			}
+			members = append(members, gitprovider.OrganizationMemberInfo{
+				Username: apiObj.UserName,
+				Role:     gitprovider.OrganizationRoleVar(role),
+			})
+		}
+		return members, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// Invite adds the user to the Owners team for the owner role, or removes an existing
+// owner from it for the member role. As Gitea users only join an organization through
+// its teams, ErrNoProviderSupport is returned for the member role if the user isn't a
+// member yet; add the user to a team instead. Gitea has no billing role.
+func (c *OrganizationMemberClient) Invite(ctx context.Context, req gitprovider.OrganizationMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+
+	ownersTeam, err := c.teams.getOrgTeam(ctx, c.ref.Organization, ownersTeamName)
+	if err != nil {
+		return err
+	}
+
+	switch *req.Role {
+	case gitprovider.OrganizationRoleOwner:
+		// PUT /teams/{id}/members/{username}
+		res, err := c.c.AddTeamMember(ownersTeam.ID, req.Username)
+		return handleHTTPError(res, err)
+	case gitprovider.OrganizationRoleMember:
+		// GET /orgs/{org}/members/{username}
+		isMember, res, err := c.c.CheckOrgMembership(c.ref.Organization, req.Username)
+		if err != nil {
+			return handleHTTPError(res, err)
+		}
+		if !isMember {
+			return fmt.Errorf("cannot add %s to organization %s without a team: %w", req.Username, c.ref.Organization, gitprovider.ErrNoProviderSupport)
+		}
+		owners, err := c.owners(ctx)
+		if err != nil {
+			return err
+		}
+		for _, owner := range owners {
+			if owner.UserName == req.Username {
+				// DELETE /teams/{id}/members/{username}
+				res, err := c.c.RemoveTeamMember(ownersTeam.ID, req.Username)
+				return handleHTTPError(res, err)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("organization role %q: %w", *req.Role, gitprovider.ErrNoProviderSupport)
+	}
+}
+
+// Remove removes the user from the organization and all of its teams.
+//
+// ErrNotFound is returned if the user isn't a member of the organization.
+func (c *OrganizationMemberClient) Remove(ctx context.Context, username string) error {
+	// GET /orgs/{org}/members/{username}
+	isMember, res, err := c.c.CheckOrgMembership(c.ref.Organization, username)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	if !isMember {
+		return gitprovider.ErrNotFound
+	}
+	// DELETE /orgs/{org}/members/{username}
+	res, err = c.c.DeleteOrgMembership(c.ref.Organization, username)
+	return handleHTTPError(res, err)
+}
+
+// owners returns the members of the Owners team of the organization.
+func (c *OrganizationMemberClient) owners(ctx context.Context) ([]*gitea.User, error) {
+	ownersTeam, err := c.teams.getOrgTeam(ctx, c.ref.Organization, ownersTeamName)
+	if err != nil {
+		return nil, err
+	}
+	return c.teams.listTeamMembers(gitprovider.WithoutListOptions(ctx), ownersTeam.ID)
+}
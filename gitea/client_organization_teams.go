@@ -18,6 +18,8 @@ package gitea
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -39,27 +41,19 @@ type TeamsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
-	// GET /orgs/{org}/teams/{team_slug}/members
-	apiObjs, err := c.listOrgTeamMembers(c.ref.Organization, teamName)
+	return c.get(ctx, teamName)
+}
+
+func (c *TeamsClient) get(ctx context.Context, teamName string) (*team, error) {
+	apiObj, err := c.getOrgTeam(ctx, c.ref.Organization, teamName)
 	if err != nil {
 		return nil, err
 	}
-
-	// Collect a list of the members' names.ÒÒ Login is validated to be non-nil in ListOrgTeamMembers.
-	logins := make([]string, 0, len(apiObjs))
-	for _, apiObj := range apiObjs {
-		// Login is validated to be non-nil in ListOrgTeamMembers
-		logins = append(logins, apiObj.UserName)
+	apiObjs, err := c.listTeamMembers(gitprovider.WithoutListOptions(ctx), apiObj.ID)
+	if err != nil {
+		return nil, err
 	}
-
-	return &team{
-		users: apiObjs,
-		info: gitprovider.TeamInfo{
-			Name:    teamName,
-			Members: logins,
-		},
-		ref: c.ref,
-	}, nil
+	return newTeam(c, apiObj, apiObjs), nil
 }
 
 // List all teams (recursively, in terms of subgroups) within the specific organization.
@@ -67,61 +61,156 @@ func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Tea
 // List returns all available organizations, using multiple paginated requests if needed.
 func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 	// GET /orgs/{org}/teams
-	apiObjs, err := c.listOrgTeams(c.ref.Organization)
+	apiObjs, err := c.listOrgTeams(ctx, c.ref.Organization)
 	if err != nil {
 		return nil, err
 	}
 
-	// Use .Get() to get detailed information about each member
+	// List the members of each team
 	teams := make([]gitprovider.Team, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
-		// Get detailed information about individual teams (including members).
-		// Slug is validated to be non-nil in ListOrgTeams.
-		team, err := c.Get(ctx, apiObj.Name)
+		users, err := c.listTeamMembers(gitprovider.WithoutListOptions(ctx), apiObj.ID)
 		if err != nil {
 			return nil, err
 		}
 
-		teams = append(teams, team)
+		teams = append(teams, newTeam(c, apiObj, users))
+	}
+
+	return gitprovider.LimitItems(ctx, teams), nil
+}
+
+// ListIter returns an iterator over the teams List returns, fetching them a page at a time,
+// and the members of each team of a page along with it.
+func (c *TeamsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Team] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Team, gitprovider.PageInfo, error) {
+		opts := gitea.ListTeamsOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /orgs/{org}/teams
+		apiObjs, res, err := c.c.ListOrgTeams(c.ref.Organization, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		teams := make([]gitprovider.Team, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			users, err := c.listTeamMembers(gitprovider.WithoutListOptions(ctx), apiObj.ID)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			teams = append(teams, newTeam(c, apiObj, users))
+		}
+		return teams, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// Create a team within the specific organization. The team is granted read access to
+// the code, issues, pull requests, releases and wiki of the repositories it is added to.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	opts := gitea.CreateTeamOption{
+		Name:       req.Name,
+		Permission: gitea.AccessModeRead,
+		Units: []gitea.RepoUnitType{
+			gitea.RepoUnitCode,
+			gitea.RepoUnitIssues,
+			gitea.RepoUnitPulls,
+			gitea.RepoUnitReleases,
+			gitea.RepoUnitWiki,
+		},
+	}
+	if req.Description != nil {
+		opts.Description = *req.Description
+	}
+	// POST /orgs/{org}/teams
+	apiObj, res, err := c.c.CreateTeam(c.ref.Organization, opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newTeam(c, apiObj, nil), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team, as it doesn't exist", "organization", c.ref.String(), "team", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team is up to date", "organization", c.ref.String(), "team", req.Name)
+		return actual, false, nil
 	}
 
-	return teams, nil
+	c.log.V(1).Info("updating team to the desired state", "organization", c.ref.String(), "team", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
 }
 
-// listOrgTeamMembers returns all of current team members of the given team.
-func (c *TeamsClient) listOrgTeamMembers(orgName, teamName string) ([]*gitea.User, error) {
-	teams, err := c.listOrgTeams(orgName)
+// getOrgTeam returns the team with the given name in the given organization.
+func (c *TeamsClient) getOrgTeam(ctx context.Context, orgName, teamName string) (*gitea.Team, error) {
+	teams, err := c.listOrgTeams(gitprovider.WithoutListOptions(ctx), orgName)
 	if err != nil {
 		return nil, err
 	}
-	apiObjs := []*gitea.User{}
-	opts := gitea.ListTeamMembersOptions{}
 	for _, team := range teams {
 		if team.Name == teamName {
-			err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
-				pageObjs, resp, listErr := c.c.ListTeamMembers(team.ID, gitea.ListTeamMembersOptions{})
-				if len(pageObjs) > 0 {
-					apiObjs = append(apiObjs, pageObjs...)
-					return resp, listErr
-				}
-				return nil, nil
-			})
-			if err != nil {
-				return nil, err
-			}
-			return apiObjs, nil
+			return team, nil
 		}
 	}
-
 	return nil, gitprovider.ErrNotFound
 }
 
+// listTeamMembers returns all of current team members of the given team.
+func (c *TeamsClient) listTeamMembers(ctx context.Context, teamID int64) ([]*gitea.User, error) {
+	apiObjs := []*gitea.User{}
+	opts := gitea.ListTeamMembersOptions{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /teams/{id}/members
+		pageObjs, resp, listErr := c.c.ListTeamMembers(teamID, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
 // listOrgTeams returns all teams of the given organization the user has access to.
-func (c *TeamsClient) listOrgTeams(orgName string) ([]*gitea.Team, error) {
+func (c *TeamsClient) listOrgTeams(ctx context.Context, orgName string) ([]*gitea.Team, error) {
 	opts := gitea.ListTeamsOptions{}
 	apiObjs := []*gitea.Team{}
 
-	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
 		// GET /orgs/{org}/teams"
 		pageObjs, resp, listErr := c.c.ListOrgTeams(orgName, opts)
 		if len(pageObjs) > 0 {
@@ -136,22 +225,152 @@ func (c *TeamsClient) listOrgTeams(orgName string) ([]*gitea.Team, error) {
 	return apiObjs, nil
 }
 
+func newTeam(c *TeamsClient, apiObj *gitea.Team, users []*gitea.User) *team {
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		logins = append(logins, user.UserName)
+	}
+
+	return &team{
+		t:     *apiObj,
+		users: users,
+		info: gitprovider.TeamInfo{
+			Name:        apiObj.Name,
+			Description: gitprovider.StringVar(apiObj.Description),
+			Members:     logins,
+		},
+		c: c,
+	}
+}
+
 var _ gitprovider.Team = &team{}
 
 type team struct {
+	t     gitea.Team
 	users []*gitea.User
 	info  gitprovider.TeamInfo
-	ref   gitprovider.OrganizationRef
+	c     *TeamsClient
 }
 
 func (t *team) Get() gitprovider.TeamInfo {
 	return t.info
 }
 
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	t.info = info
+	return nil
+}
+
 func (t *team) APIObject() interface{} {
 	return t.users
 }
 
 func (t *team) Organization() gitprovider.OrganizationRef {
-	return t.ref
+	return t.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function. Changing the name renames the team.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (t *team) Update(ctx context.Context) error {
+	opts := gitea.EditTeamOption{
+		Name:                    t.info.Name,
+		Description:             t.info.Description,
+		Permission:              t.t.Permission,
+		CanCreateOrgRepo:        &t.t.CanCreateOrgRepo,
+		IncludesAllRepositories: &t.t.IncludesAllRepositories,
+		Units:                   t.t.Units,
+	}
+	// PATCH /teams/{id}
+	res, err := t.c.c.EditTeam(t.t.ID, opts)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	// GET /teams/{id}
+	apiObj, res, err := t.c.c.GetTeam(t.t.ID)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	*t = *newTeam(t.c, apiObj, t.users)
+	return nil
+}
+
+// Delete deletes the team from the organization, revoking the repository access
+// granted through it.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(ctx context.Context) error {
+	// Don't allow deleting teams if the user didn't explicitly allow dangerous API calls.
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /teams/{id}
+	res, err := t.c.c.DeleteTeam(t.t.ID)
+	return handleHTTPError(res, err)
+}
+
+// ListMembers lists the members of the team. Gitea teams don't have roles,
+// all members are reported with the member role.
+//
+// ListMembers returns all members, using multiple paginated requests if needed.
+func (t *team) ListMembers(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	apiObjs, err := t.c.listTeamMembers(ctx, t.t.ID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]gitprovider.TeamMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		members = append(members, gitprovider.TeamMemberInfo{
+			Username: apiObj.UserName,
+			Role:     gitprovider.TeamRoleVar(gitprovider.TeamRoleMember),
+		})
+	}
+	return members, nil
+}
+
+// AddMember adds the user to the team.
+// As Gitea teams don't have roles, ErrNoProviderSupport is returned for the maintainer role.
+func (t *team) AddMember(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	if *req.Role != gitprovider.TeamRoleMember {
+		return fmt.Errorf("team role %q: %w", *req.Role, gitprovider.ErrNoProviderSupport)
+	}
+	// PUT /teams/{id}/members/{username}
+	res, err := t.c.c.AddTeamMember(t.t.ID, req.Username)
+	return handleHTTPError(res, err)
+}
+
+// RemoveMember removes the user from the team.
+//
+// ErrNotFound is returned if the user isn't a member of the team.
+func (t *team) RemoveMember(ctx context.Context, username string) error {
+	// DELETE /teams/{id}/members/{username}
+	res, err := t.c.c.RemoveTeamMember(t.t.ID, username)
+	return handleHTTPError(res, err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	resp, actionTaken, err := t.c.Reconcile(ctx, t.info)
+	if err != nil {
+		return actionTaken, err
+	}
+	*t = *resp.(*team)
+	return actionTaken, nil
 }
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestTeamsClient_GetWithListOptions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/orgs/fluxcd/teams", func(w http.ResponseWriter, r *http.Request) {
+		// The team is on the second page
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"id":2,"name":"maintainers"}]`))
+			return
+		}
+		w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+		_, _ = w.Write([]byte(`[{"id":1,"name":"Owners"}]`))
+	})
+	mux.HandleFunc("GET /api/v1/teams/2/members", func(w http.ResponseWriter, r *http.Request) {
+		// So are the members of the team
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"id":2,"login":"bob"}]`))
+			return
+		}
+		w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+		_, _ = w.Write([]byte(`[{"id":1,"login":"alice"}]`))
+	})
+	c := newTestClient(t, mux)
+	teams := &TeamsClient{
+		clientContext: c.clientContext,
+		ref:           gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+	}
+
+	// The list options of the caller don't limit the lookup of the team and its members
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallListOptions(gitprovider.ListOptions{PerPage: 1, MaxItems: 1}))
+	team, err := teams.Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got, want := team.Get().Members, []string{"alice", "bob"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get() members = %v, want %v", got, want)
+	}
+}
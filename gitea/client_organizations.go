@@ -18,6 +18,8 @@ package gitea
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -55,7 +57,7 @@ func (c *OrganizationsClient) Get(ctx context.Context, ref gitprovider.Organizat
 // List returns all available organizations, using multiple paginated requests if needed.
 func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organization, error) {
 	// GET /user/orgs
-	apiObjs, err := c.listOrgs()
+	apiObjs, err := c.listOrgs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +71,31 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 		}))
 	}
 
-	return orgs, nil
+	return gitprovider.LimitItems(ctx, orgs), nil
+}
+
+// ListIter returns an iterator over the organizations List returns, fetching them a page at a time.
+func (c *OrganizationsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Organization] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Organization, gitprovider.PageInfo, error) {
+		opts := gitea.ListOrgsOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /user/orgs
+		apiObjs, res, err := c.c.ListMyOrgs(opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		orgs := make([]gitprovider.Organization, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateOrganizationAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			orgs = append(orgs, newOrganization(c.clientContext, apiObj, gitprovider.OrganizationRef{
+				Domain:       c.domain,
+				Organization: apiObj.UserName,
+			}))
+		}
+		return orgs, pageInfo(res, len(apiObjs)), nil
+	})
 }
 
 // getOrg returns a specific organization the user has access to.
@@ -86,11 +112,11 @@ func (c *OrganizationsClient) getOrg(orgName string) (*gitea.Organization, error
 }
 
 // listOrgs returns all of current user's organizations.
-func (c *OrganizationsClient) listOrgs() ([]*gitea.Organization, error) {
+func (c *OrganizationsClient) listOrgs(ctx context.Context) ([]*gitea.Organization, error) {
 	opts := gitea.ListOrgsOptions{}
 	apiObjs := []*gitea.Organization{}
 
-	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
 		// GET /user/orgs"
 		pageObjs, resp, listErr := c.c.ListMyOrgs(opts)
 		if len(pageObjs) > 0 {
@@ -121,3 +147,101 @@ func (c *OrganizationsClient) listOrgs() ([]*gitea.Organization, error) {
 func (c *OrganizationsClient) Children(_ context.Context, _ gitprovider.OrganizationRef) ([]gitprovider.Organization, error) {
 	return nil, gitprovider.ErrNoProviderSupport
 }
+
+// Create creates an organization named ref.Organization. In Gitea, the name of an
+// organization is its login, so req.Name must either be unset or equal ref.Organization.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.Name != nil && *req.Name != ref.Organization {
+		return nil, fmt.Errorf("organization name %q doesn't match %q: %w", *req.Name, ref.Organization, gitprovider.ErrInvalidArgument)
+	}
+	if req.DefaultRepositoryPermission != nil || req.RequireTwoFactor != nil {
+		return nil, fmt.Errorf("cannot create organization %q: %w", ref.Organization, gitprovider.ErrNoProviderSupport)
+	}
+
+	opts := gitea.CreateOrgOption{
+		Name: ref.Organization,
+	}
+	if req.Description != nil {
+		opts.Description = *req.Description
+	}
+	if req.Visibility != nil {
+		opts.Visibility = visibilityToAPI(*req.Visibility)
+	}
+	// The Gitea SDK doesn't take a context per request, so stop here if the call was cancelled
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// POST /orgs
+	apiObj, res, err := c.c.CreateOrg(opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating organization, as it doesn't exist", "organization", ref.String())
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("organization is up to date", "organization", ref.String())
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating organization to the desired state", "organization", ref.String())
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// Delete deletes an organization. Gitea refuses to delete organizations which still own
+// repositories.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// Don't allow deleting organizations if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete organization: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// DELETE /orgs/{org}
+	res, err := c.c.DeleteOrg(ref.Organization)
+	return handleHTTPError(res, err)
+}
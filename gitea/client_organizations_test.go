@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestOrganizationsClient_Create(t *testing.T) {
+	var got *gitea.CreateOrgOption
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/orgs", func(w http.ResponseWriter, r *http.Request) {
+		got = &gitea.CreateOrgOption{}
+		_ = json.NewDecoder(r.Body).Decode(got)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":42,"username":"my-org","description":"My organization","visibility":"private"}`))
+	})
+	c := newTestClient(t, mux)
+
+	ref := gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "my-org"}
+	info := gitprovider.OrganizationInfo{
+		Description: gitprovider.StringVar("My organization"),
+		Visibility:  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate),
+	}
+	org, err := c.Organizations().Create(context.Background(), ref, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &gitea.CreateOrgOption{
+		Name:        "my-org",
+		Description: "My organization",
+		Visibility:  gitea.VisibleTypePrivate,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Create() request mismatch (-want +got):\n%s", diff)
+	}
+	wantInfo := info
+	wantInfo.Name = gitprovider.StringVar("my-org")
+	if diff := cmp.Diff(wantInfo, org.Get()); diff != "" {
+		t.Errorf("Create() organization mismatch (-want +got):\n%s", diff)
+	}
+
+	// A cancelled call doesn't reach the server
+	got = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.Organizations().Create(ctx, ref, info); !errors.Is(err, context.Canceled) {
+		t.Errorf("Create() error = %v, want %v", err, context.Canceled)
+	}
+	if got != nil {
+		t.Error("Create() sent a request after the context was cancelled")
+	}
+}
+
+func TestOrganizationsClient_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		destructive bool
+		expectedErr error
+		wantDeleted bool
+	}{
+		{
+			name:        "destructive calls are on",
+			destructive: true,
+			wantDeleted: true,
+		},
+		{
+			name:        "destructive calls are off",
+			expectedErr: gitprovider.ErrDestructiveCallDisallowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("DELETE /api/v1/orgs/my-org", func(w http.ResponseWriter, r *http.Request) {
+				deleted = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+			c := newTestClient(t, mux, gitprovider.WithDestructiveAPICalls(tt.destructive))
+
+			ref := gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "my-org"}
+			if err := c.Organizations().Delete(context.Background(), ref); !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.expectedErr)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("Delete() sent a delete request = %t, want %t", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"code.gitea.io/sdk/gitea"
+	"github.com/go-logr/logr"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -53,7 +54,25 @@ func (c *OrgRepositoriesClient) Get(ctx context.Context, ref gitprovider.OrgRepo
 	if err != nil {
 		return nil, err
 	}
-	return newOrgRepository(c.clientContext, apiObj, ref), nil
+	// Gitea redirects requests for renamed or transferred repositories
+	moved, isMoved, err := repositoryMoved(c.clientContext, ref, apiObj)
+	if err != nil {
+		return nil, err
+	}
+	if isMoved {
+		if !gitprovider.CallOptionsFromContext(ctx).ShouldFollowRedirects(c.followRedirects) {
+			return nil, &gitprovider.RepositoryMovedError{Ref: ref, CanonicalRef: moved.Repository()}
+		}
+		// A repository transferred to a user keeps the requested reference
+		if canonicalRef, isOrg := moved.Repository().(gitprovider.OrgRepositoryRef); isOrg {
+			ref = canonicalRef
+		}
+	}
+	orgRepo := newOrgRepository(c.clientContext, apiObj, ref)
+	if err := orgRepo.loadOptionalInfo(ctx); err != nil {
+		return nil, err
+	}
+	return orgRepo, nil
 }
 
 // List all repositories in the given organization.
@@ -65,21 +84,121 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 		return nil, err
 	}
 
-	// GET /orgs/{org}/repos
-	apiObjs, err := c.listOrgRepos(ref.Organization)
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	includeArchived := callOpts.ShouldIncludeArchived()
+	filter := callOpts.RepositoryFilter()
+	sort, err := callOpts.RepositorySort()
+	if err != nil {
+		return nil, err
+	}
+
+	var apiObjs []*gitea.Repository
+	if filter.IsZero() && sort == nil {
+		// GET /orgs/{org}/repos
+		apiObjs, err = c.listOrgRepos(ctx, ref.Organization)
+	} else {
+		// Only the search endpoint filters and sorts
+		apiObjs, err = c.searchOrgRepos(ctx, ref.Organization, filter, includeArchived, sort)
+		// Topics are only filtered on the server side, as the repository objects don't include them
+		filter.Topic = ""
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Traverse the list, and return a list of OrgRepository objects
+	return gitprovider.LimitItems(ctx, c.orgRepositories(ref, apiObjs, includeArchived, filter)), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+//
+// Filtered or sorted repositories are searched for, and all fetched by the first page.
+func (c *OrgRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.OrganizationRef) *gitprovider.Iterator[gitprovider.OrgRepository] {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	if !callOpts.RepositoryFilter().IsZero() || callOpts.Sort != nil {
+		return gitprovider.ListIterator(ctx, func(ctx context.Context) ([]gitprovider.OrgRepository, error) {
+			return c.List(ctx, ref)
+		})
+	}
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrgRepository, gitprovider.PageInfo, error) {
+		// Make sure the OrganizationRef is valid
+		if err := validateOrganizationRef(ref, c.domain); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		opts := gitea.ListOrgReposOptions{ListOptions: gitea.ListOptions{Page: page, PageSize: iteratorPageSize(ctx)}}
+		// GET /orgs/{org}/repos
+		apiObjs, res, err := c.c.ListOrgRepos(ref.Organization, opts)
+		apiObjs, info, err := repositoryPage(apiObjs, res, err)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		includeArchived := gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived()
+		return c.orgRepositories(ref, apiObjs, includeArchived, gitprovider.RepositoryFilter{}), info, nil
+	})
+}
+
+// orgRepositories returns the OrgRepository objects for the repositories of the organization
+// that are not archived unless includeArchived is set, and match the filter.
+func (c *OrgRepositoriesClient) orgRepositories(ref gitprovider.OrganizationRef, apiObjs []*gitea.Repository, includeArchived bool, filter gitprovider.RepositoryFilter) []gitprovider.OrgRepository {
 	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
+		if !includeArchived && apiObj.Archived {
+			continue
+		}
+		if !filter.Matches(apiObj.Name, repositoryFromAPI(apiObj), apiObj.Updated) {
+			continue
+		}
 		// apiObj is already validated at ListOrgRepos
 		repos = append(repos, newOrgRepository(c.clientContext, apiObj, gitprovider.OrgRepositoryRef{
 			OrganizationRef: ref,
 			RepositoryName:  apiObj.Name,
 		}))
 	}
+	return repos
+}
+
+// Search searches the repositories of all organizations for repositories whose name contains query.
+//
+// Gitea can't search by name and topic at once, so filtering by topic isn't supported.
+func (c *OrgRepositoriesClient) Search(ctx context.Context, query string) ([]gitprovider.OrgRepository, error) {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	includeArchived := callOpts.ShouldIncludeArchived()
+	filter := callOpts.RepositoryFilter()
+	if filter.Topic != "" {
+		return nil, fmt.Errorf("searching repositories by topic: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	opts := searchRepoOptions(filter, includeArchived)
+	opts.Keyword = query
+	// GET /repos/search
+	apiObjs, err := c.searchRepos(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The owner of a repository doesn't tell whether it's an organization, so look it up
+	isOrg := map[string]bool{}
+	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if !filter.Matches(apiObj.Name, repositoryFromAPI(apiObj), apiObj.Updated) || apiObj.Owner == nil {
+			continue
+		}
+		owner := apiObj.Owner.UserName
+		if _, ok := isOrg[owner]; !ok {
+			if isOrg[owner], err = isOrganization(c.c, owner); err != nil {
+				return nil, err
+			}
+		}
+		if !isOrg[owner] {
+			continue
+		}
+		repos = append(repos, newOrgRepository(c.clientContext, apiObj, gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{
+				Domain:       c.domain,
+				Organization: owner,
+			},
+			RepositoryName: apiObj.Name,
+		}))
+	}
 	return repos, nil
 }
 
@@ -115,6 +234,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -123,7 +243,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 		return nil, false, err
 	}
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
+	actionTaken, err := reconcileRepository(ctx, c.log, actual, req)
 	return actual, actionTaken, err
 }
 
@@ -134,11 +254,11 @@ func getRepo(c *gitea.Client, owner, repo string) (*gitea.Repository, error) {
 }
 
 // listOrgRepos returns all repositories of the given organization the user has access to.
-func (c *OrgRepositoriesClient) listOrgRepos(org string) ([]*gitea.Repository, error) {
+func (c *OrgRepositoriesClient) listOrgRepos(ctx context.Context, org string) ([]*gitea.Repository, error) {
 	opts := gitea.ListOrgReposOptions{}
 	apiObjs := []*gitea.Repository{}
 
-	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
 		// GET /orgs/{org}/repos
 		pageObjs, resp, listErr := c.c.ListOrgRepos(org, opts)
 		if len(pageObjs) > 0 {
@@ -153,12 +273,91 @@ func (c *OrgRepositoriesClient) listOrgRepos(org string) ([]*gitea.Repository, e
 	return validateRepositoryObjects(apiObjs)
 }
 
+// searchOrgRepos returns the repositories of the given organization matching as much of the
+// filter as Gitea supports, in the given order if set. The keyword is either the topic or
+// the name.
+func (c *OrgRepositoriesClient) searchOrgRepos(ctx context.Context, org string, filter gitprovider.RepositoryFilter, includeArchived bool, sort *gitprovider.RepositorySort) ([]*gitea.Repository, error) {
+	// GET /orgs/{org}
+	apiOrg, res, err := c.c.GetOrg(org)
+	if err = handleHTTPError(res, err); err != nil {
+		return nil, err
+	}
+	opts := searchRepoOptions(filter, includeArchived)
+	opts.OwnerID = apiOrg.ID
+	if filter.Topic != "" {
+		opts.Keyword = filter.Topic
+		opts.KeywordIsTopic = true
+	} else {
+		opts.Keyword = filter.Name
+	}
+	if sort != nil {
+		opts.Sort = repositorySortToAPI(sort.By)
+		opts.Order = "asc"
+		if sort.Descending {
+			opts.Order = "desc"
+		}
+	}
+	// GET /repos/search
+	return c.searchRepos(ctx, opts)
+}
+
+// searchRepos returns all repositories matching the search options.
+func (c *OrgRepositoriesClient) searchRepos(ctx context.Context, opts gitea.SearchRepoOptions) ([]*gitea.Repository, error) {
+	apiObjs := []*gitea.Repository{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/search
+		pageObjs, resp, listErr := c.c.SearchRepos(opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return validateRepositoryObjects(apiObjs)
+}
+
+// searchRepoOptions returns the search options for the visibility of the filter, and whether
+// archived repositories are included.
+func searchRepoOptions(filter gitprovider.RepositoryFilter, includeArchived bool) gitea.SearchRepoOptions {
+	opts := gitea.SearchRepoOptions{}
+	if filter.Visibility != nil {
+		opts.IsPrivate = gitea.OptionalBool(*filter.Visibility != gitprovider.RepositoryVisibilityPublic)
+	}
+	if !includeArchived {
+		opts.IsArchived = gitea.OptionalBool(false)
+	}
+	return opts
+}
+
+// repositorySortToAPI returns the field searched repositories are sorted by for the given
+// sort field. Gitea doesn't track pushes, so the time of the last update is used instead.
+func repositorySortToAPI(field gitprovider.RepositorySortField) string {
+	switch field {
+	case gitprovider.RepositorySortFieldCreated:
+		return "created"
+	case gitprovider.RepositorySortFieldPushed:
+		return "updated"
+	default:
+		return "alpha"
+	}
+}
+
 func createRepository(ctx context.Context, c *gitea.Client, ref gitprovider.RepositoryRef, orgName string, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (*gitea.Repository, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
 		return nil, err
 	}
+	if req.Topics != nil {
+		return nil, fmt.Errorf("repository topics: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// LFS is configured for the whole Gitea instance
+	if req.LFSEnabled != nil {
+		return nil, fmt.Errorf("repository LFS settings: %w", gitprovider.ErrNoProviderSupport)
+	}
 
 	// Assemble the options struct based on the given options
 	o, err := gitprovider.MakeRepositoryCreateOptions(opts...)
@@ -168,14 +367,52 @@ func createRepository(ctx context.Context, c *gitea.Client, ref gitprovider.Repo
 
 	// Convert to the API object and apply the options
 	apiOpts := repositoryToAPI(&req, ref)
-	if o.AutoInit != nil {
-		apiOpts.AutoInit = *o.AutoInit
+	var apiObj *gitea.Repository
+	if o.Template != nil {
+		apiObj, err = createRepositoryFromTemplate(c, ref, apiOpts, o)
+	} else {
+		if o.AutoInit != nil {
+			apiOpts.AutoInit = *o.AutoInit
+		}
+		if o.LicenseTemplate != nil {
+			apiOpts.License = knownLicenseTemplateMap[string(*o.LicenseTemplate)]
+		}
+		apiObj, err = createRepo(c, orgName, apiOpts)
 	}
-	if o.LicenseTemplate != nil {
-		apiOpts.License = knownLicenseTemplateMap[string(*o.LicenseTemplate)]
+	if err != nil {
+		return nil, err
+	}
+
+	// The wiki can only be configured once the repository exists
+	if req.WikiEnabled != nil && *req.WikiEnabled != apiObj.HasWiki {
+		return updateRepo(c, ref.GetIdentity(), apiObj.Name, &gitea.EditRepoOption{
+			HasWiki: req.WikiEnabled,
+		})
 	}
+	return apiObj, nil
+}
 
-	return createRepo(c, orgName, apiOpts)
+// createRepositoryFromTemplate generates the repository from the template given in opts, including
+// the contents of its default branch and its labels. Only the name, description and visibility
+// of apiOpts can be set when generating a repository.
+func createRepositoryFromTemplate(c *gitea.Client, ref gitprovider.RepositoryRef, apiOpts gitea.CreateRepoOption, opts gitprovider.RepositoryCreateOptions) (*gitea.Repository, error) {
+	if opts.TemplateIncludeAllBranches != nil {
+		return nil, fmt.Errorf("including all branches of templates: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if err := validateIdentityFields(opts.Template, ref.GetDomain()); err != nil {
+		return nil, err
+	}
+	templateOpts := gitea.CreateRepoFromTemplateOption{
+		Owner:       ref.GetIdentity(),
+		Name:        apiOpts.Name,
+		Description: apiOpts.Description,
+		Private:     apiOpts.Private,
+		GitContent:  true,
+		Labels:      true,
+	}
+	// POST /repos/{template_owner}/{template_repo}/generate
+	apiObj, res, err := c.CreateRepoFromTemplate(opts.Template.GetIdentity(), opts.Template.GetRepository(), templateOpts)
+	return validateRepositoryAPIResp(apiObj, res, err)
 }
 
 func createRepo(c *gitea.Client, orgName string, apiOpts gitea.CreateRepoOption) (*gitea.Repository, error) {
@@ -203,11 +440,13 @@ func deleteRepo(c *gitea.Client, owner, repo string, destructiveActions bool) er
 	return handleHTTPError(resp, err)
 }
 
-func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
+func reconcileRepository(ctx context.Context, log logr.Logger, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		log.V(1).Info("repository is up to date", "repository", actual.Repository().String())
 		return false, nil
 	}
+	log.V(1).Info("updating repository to the desired state", "repository", actual.Repository().String())
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return false, err
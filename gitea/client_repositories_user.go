@@ -46,7 +46,30 @@ func (c *UserRepositoriesClient) Get(ctx context.Context, ref gitprovider.UserRe
 	if err != nil {
 		return nil, err
 	}
-	return newUserRepository(c.clientContext, apiObj, ref), nil
+	// Gitea redirects requests for renamed or transferred repositories
+	moved, isMoved, err := repositoryMoved(c.clientContext, ref, apiObj)
+	if err != nil {
+		return nil, err
+	}
+	if isMoved {
+		if !gitprovider.CallOptionsFromContext(ctx).ShouldFollowRedirects(c.followRedirects) {
+			return nil, &gitprovider.RepositoryMovedError{Ref: ref, CanonicalRef: moved.Repository()}
+		}
+		// The repository may have been transferred to an organization
+		if orgRef, isOrg := moved.Repository().(gitprovider.OrgRepositoryRef); isOrg {
+			orgRepo := newOrgRepository(c.clientContext, apiObj, orgRef)
+			if err := orgRepo.loadOptionalInfo(ctx); err != nil {
+				return nil, err
+			}
+			return orgRepo, nil
+		}
+		ref = moved.Repository().(gitprovider.UserRepositoryRef)
+	}
+	userRepo := newUserRepository(c.clientContext, apiObj, ref)
+	if err := userRepo.loadOptionalInfo(ctx); err != nil {
+		return nil, err
+	}
+	return userRepo, nil
 }
 
 // List all repositories in the given organization.
@@ -59,28 +82,55 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 	}
 
 	// GET /users/{username}/repos
-	apiObjs, err := c.listUserRepos(ref.UserLogin)
+	apiObjs, err := c.listUserRepos(ctx, ref.UserLogin)
 	if err != nil {
 		return nil, err
 	}
 
-	// Traverse the list, and return a list of UserRepository objects
+	repos := c.userRepositories(ref, apiObjs, gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived())
+	return gitprovider.LimitItems(ctx, repos), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+func (c *UserRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.UserRef) *gitprovider.Iterator[gitprovider.UserRepository] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.UserRepository, gitprovider.PageInfo, error) {
+		// Make sure the UserRef is valid
+		if err := validateUserRef(ref, c.domain); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		opts := gitea.ListReposOptions{ListOptions: gitea.ListOptions{Page: page, PageSize: iteratorPageSize(ctx)}}
+		// GET /users/{username}/repos
+		apiObjs, res, err := c.c.ListUserRepos(ref.UserLogin, opts)
+		apiObjs, info, err := repositoryPage(apiObjs, res, err)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.userRepositories(ref, apiObjs, gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived()), info, nil
+	})
+}
+
+// userRepositories returns the UserRepository objects for the repositories of the user
+// that are not archived unless includeArchived is set.
+func (c *UserRepositoriesClient) userRepositories(ref gitprovider.UserRef, apiObjs []*gitea.Repository, includeArchived bool) []gitprovider.UserRepository {
 	repos := make([]gitprovider.UserRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
+		if !includeArchived && apiObj.Archived {
+			continue
+		}
 		// apiObj is already validated at ListUserRepos
 		repos = append(repos, newUserRepository(c.clientContext, apiObj, gitprovider.UserRepositoryRef{
 			UserRef:        ref,
 			RepositoryName: apiObj.Name,
 		}))
 	}
-	return repos, nil
+	return repos
 }
 
-func (c *UserRepositoriesClient) listUserRepos(username string) ([]*gitea.Repository, error) {
+func (c *UserRepositoriesClient) listUserRepos(ctx context.Context, username string) ([]*gitea.Repository, error) {
 	opts := gitea.ListReposOptions{}
 	apiObjs := []*gitea.Repository{}
 
-	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
 		// GET /users/{username}/repos
 		pageObjs, resp, listErr := c.c.ListUserRepos(username, opts)
 		if len(pageObjs) > 0 {
@@ -128,7 +178,7 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 		return nil, fmt.Errorf("unable to get owner from API")
 	}
 
-	if ref.GetIdentity() != idRef.GetIdentity() {
+	if !gitprovider.NamesEqual(ref.GetIdentity(), idRef.GetIdentity(), caseSensitiveNames) {
 		return nil, gitprovider.NewErrIncorrectUser(ref.GetIdentity())
 	}
 
@@ -161,6 +211,7 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -170,6 +221,6 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 	}
 
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
+	actionTaken, err := reconcileRepository(ctx, c.log, actual, req)
 	return actual, actionTaken, err
 }
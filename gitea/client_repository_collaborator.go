@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the collaborators of a specific repository.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the collaborator with the given username.
+//
+// ErrNotFound is returned if the user isn't a collaborator.
+func (c *CollaboratorClient) Get(ctx context.Context, username string) (gitprovider.Collaborator, error) {
+	// GET /repos/{owner}/{repo}/collaborators/{collaborator}
+	ok, res, err := c.c.IsCollaborator(c.ref.GetIdentity(), c.ref.GetRepository(), username)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("collaborator %q: %w", username, gitprovider.ErrNotFound)
+	}
+	return c.get(ctx, username)
+}
+
+func (c *CollaboratorClient) get(_ context.Context, username string) (*collaborator, error) {
+	// GET /repos/{owner}/{repo}/collaborators/{collaborator}/permission
+	apiObj, res, err := c.c.CollaboratorPermission(c.ref.GetIdentity(), c.ref.GetRepository(), username)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newCollaborator(c, apiObj, gitprovider.CollaboratorInfo{
+		Username:   username,
+		Permission: getProviderPermission(apiObj.Permission),
+	}), nil
+}
+
+// List lists the collaborators of the repository.
+//
+// List returns all available collaborators, using multiple paginated requests if needed.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	opts := gitea.ListCollaboratorsOptions{}
+	users := []*gitea.User{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/collaborators
+		pageObjs, resp, listErr := c.c.ListCollaborators(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			users = append(users, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]gitprovider.Collaborator, 0, len(users))
+	for _, user := range users {
+		// The list doesn't contain the permission level of the users
+		collaborator, err := c.get(ctx, user.UserName)
+		if err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+	return gitprovider.LimitItems(ctx, collaborators), nil
+}
+
+// ListIter returns an iterator over the collaborators List returns, fetching them a page at a time.
+func (c *CollaboratorClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Collaborator] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Collaborator, gitprovider.PageInfo, error) {
+		opts := gitea.ListCollaboratorsOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/collaborators
+		apiObjs, res, err := c.c.ListCollaborators(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		collaborators := make([]gitprovider.Collaborator, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			// The list doesn't contain the permission level of the users
+			collaborator, err := c.get(ctx, apiObj.UserName)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			collaborators = append(collaborators, collaborator)
+		}
+		return collaborators, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// ListInvitations always returns an empty list, as Gitea adds collaborators without an invitation.
+func (c *CollaboratorClient) ListInvitations(_ context.Context) ([]gitprovider.Collaborator, error) {
+	return []gitprovider.Collaborator{}, nil
+}
+
+// Create adds the given user as a collaborator of the repository.
+//
+// ErrAlreadyExists will be returned if the user already is a collaborator.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.Get(ctx, req.Username); err == nil {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Username, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	if err := c.add(ctx, req.Username, *req.Permission); err != nil {
+		return nil, err
+	}
+	return c.get(ctx, req.Username)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Username)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating collaborator, as it doesn't exist", "repository", c.ref.String(), "collaborator", req.Username)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("collaborator is up to date", "repository", c.ref.String(), "collaborator", req.Username)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating collaborator to the desired state", "repository", c.ref.String(), "collaborator", req.Username)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+// add adds the given user, or changes the permission of an existing collaborator.
+func (c *CollaboratorClient) add(_ context.Context, username string, permission gitprovider.RepositoryPermission) error {
+	accessMode := getGiteaPermission(permission)
+	// PUT /repos/{owner}/{repo}/collaborators/{collaborator}
+	res, err := c.c.AddCollaborator(c.ref.GetIdentity(), c.ref.GetRepository(), username, gitea.AddCollaboratorOption{
+		Permission: &accessMode,
+	})
+	return handleHTTPError(res, err)
+}
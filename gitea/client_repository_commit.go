@@ -18,6 +18,7 @@ package gitea
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 
 	"code.gitea.io/sdk/gitea"
@@ -27,6 +28,15 @@ import (
 // CommitClient implements the gitprovider.CommitClient interface.
 var _ gitprovider.CommitClient = &CommitClient{}
 
+// defaultCommitSizeLimits mirror the default maximum upload size of Gitea. Only a single file
+// can be committed at a time, so the push size isn't limited separately. Instances with a raised
+// limit can be configured with gitprovider.WithCommitSizeLimits.
+//
+//nolint:gochecknoglobals
+var defaultCommitSizeLimits = gitprovider.SizeLimits{
+	MaxFileSize: 50 << 20,
+}
+
 // CommitClient operates on the commits for a specific repository.
 type CommitClient struct {
 	*clientContext
@@ -49,9 +59,26 @@ func (c *CommitClient) ListPage(ctx context.Context, branch string, perPage, pag
 	return commits, nil
 }
 
+// ListIter returns an iterator over the commits of the given branch, fetching them a page at a
+// time with ListPage. A page holding fewer commits than the page size is the last one.
+func (c *CommitClient) ListIter(ctx context.Context, branch string) *gitprovider.Iterator[gitprovider.Commit] {
+	perPage := iteratorPageSize(ctx)
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Commit, gitprovider.PageInfo, error) {
+		commits, err := c.ListPage(ctx, branch, perPage, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		var info gitprovider.PageInfo
+		if len(commits) == perPage {
+			info.NextPage = page + 1
+		}
+		return commits, info, nil
+	})
+}
+
 func (c *CommitClient) listPage(ctx context.Context, branch string, perPage, page int) ([]*commitType, error) {
 	// GET /repos/{owner}/{repo}/commits
-	apiObjs, err := c.listCommits(c.ref.GetIdentity(), c.ref.GetRepository(), branch, perPage, page)
+	apiObjs, err := c.listCommits(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, perPage, page)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +100,34 @@ func (c *CommitClient) Create(ctx context.Context, branch string, message string
 		return nil, fmt.Errorf("no files added")
 	}
 
+	files, err := gitprovider.NormalizeCommitFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	// Gitea assumes base64 content if no encoding is set, so the size check
+	// has to measure the decoded bytes for those files too
+	for i := range files {
+		if files[i].Encoding == nil {
+			files[i].Encoding = gitprovider.CommitFileEncodingVar(gitprovider.CommitFileEncodingBase64)
+		}
+	}
+
+	if err := gitprovider.CheckCommitSize(files, gitprovider.CallOptionsFromContext(ctx).SizeLimits(c.commitSizeLimits)); err != nil {
+		return nil, err
+	}
+
 	if len(files) > 1 {
 		return nil, fmt.Errorf("creating commits with multiple files is not supported")
 	}
 
+	// Gitea expects base64 encoded content, which is also assumed if no encoding is set
+	content := *files[0].Content
+	if enc := files[0].Encoding; enc != nil && *enc == gitprovider.CommitFileEncodingText {
+		content = base64.StdEncoding.EncodeToString([]byte(content))
+	}
+
 	resp, err := c.createCommits(c.ref.GetIdentity(), c.ref.GetRepository(), *files[0].Path, &gitea.CreateFileOptions{
-		Content: *files[0].Content,
+		Content: content,
 		FileOptions: gitea.FileOptions{
 			Message:    message,
 			BranchName: branch,
@@ -107,7 +156,7 @@ func (c *CommitClient) Create(ctx context.Context, branch string, message string
 
 // listCommits lists all repository commits of the given branch.
 // It accepts a page size and page number to support pagination.
-func (c *CommitClient) listCommits(owner, repo, branch string, perPage int, page int) ([]*gitea.Commit, error) {
+func (c *CommitClient) listCommits(ctx context.Context, owner, repo, branch string, perPage int, page int) ([]*gitea.Commit, error) {
 	opts := gitea.ListCommitOptions{
 		ListOptions: gitea.ListOptions{
 			PageSize: perPage,
@@ -116,7 +165,7 @@ func (c *CommitClient) listCommits(owner, repo, branch string, perPage int, page
 		SHA: branch,
 	}
 	apiObjs := []*gitea.Commit{}
-	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
 		pageObjs, resp, listErr := c.c.ListRepoCommits(owner, repo, opts)
 		if len(pageObjs) > 0 {
 			apiObjs = append(apiObjs, pageObjs...)
@@ -140,3 +189,35 @@ func (c *CommitClient) createCommits(owner, repo string, path string, req *gitea
 	}
 	return apiObj, nil
 }
+
+// GetSignature returns the signature verification of the commit with the given sha.
+func (c *CommitClient) GetSignature(_ context.Context, sha string) (*gitprovider.SignatureVerification, error) {
+	// GET /repos/{owner}/{repo}/git/commits/{sha}
+	apiObj, res, err := c.c.GetSingleCommit(c.ref.GetIdentity(), c.ref.GetRepository(), sha)
+	if err := handleHTTPError(res, err); err != nil {
+		return nil, err
+	}
+	if apiObj.RepoCommit == nil {
+		return signatureFromAPI(nil, nil), nil
+	}
+	return signatureFromAPI(apiObj.RepoCommit.Verification, apiObj.RepoCommit.Committer), nil
+}
+
+// GetTagSignature returns the signature verification of the tag with the given name.
+func (c *CommitClient) GetTagSignature(_ context.Context, tag string) (*gitprovider.SignatureVerification, error) {
+	// GET /repos/{owner}/{repo}/tags/{tag}
+	apiTag, res, err := c.c.GetTag(c.ref.GetIdentity(), c.ref.GetRepository(), tag)
+	if err := handleHTTPError(res, err); err != nil {
+		return nil, err
+	}
+	// The ID of lightweight tags is the commit they point to
+	if apiTag.Commit == nil || apiTag.ID == apiTag.Commit.SHA {
+		return signatureFromAPI(nil, nil), nil
+	}
+	// GET /repos/{owner}/{repo}/git/tags/{sha}
+	apiObj, res, err := c.c.GetAnnotatedTag(c.ref.GetIdentity(), c.ref.GetRepository(), apiTag.ID)
+	if err := handleHTTPError(res, err); err != nil {
+		return nil, err
+	}
+	return signatureFromAPI(apiObj.Verification, apiObj.Tagger), nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestCommitClient_Create_Size(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		encoding   *gitprovider.CommitFileEncoding
+		callLimits *gitprovider.SizeLimits
+		tooLarge   bool
+	}{
+		{
+			name:    "no encoding, decoded size within the limit",
+			content: "aGk=",
+		},
+		{
+			name:     "no encoding, decoded size above the limit",
+			content:  "aGVsbG8=",
+			tooLarge: true,
+		},
+		{
+			name:     "text encoding",
+			content:  "aGk=",
+			encoding: gitprovider.CommitFileEncodingVar(gitprovider.CommitFileEncodingText),
+			tooLarge: true,
+		},
+		{
+			name:       "limit raised for the call",
+			content:    "aGVsbG8=",
+			callLimits: &gitprovider.SizeLimits{MaxFileSize: 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &CommitClient{clientContext: &clientContext{commitSizeLimits: gitprovider.SizeLimits{MaxFileSize: 3}}}
+			ctx := context.Background()
+			if tt.callLimits != nil {
+				ctx = gitprovider.WithCallOption(ctx, gitprovider.CallCommitSizeLimits(*tt.callLimits))
+			}
+			// A second file stops Create right after the size check, before any request is made
+			files := []gitprovider.CommitFile{
+				{Path: gitprovider.StringVar("a"), Content: &tt.content, Encoding: tt.encoding},
+				{Path: gitprovider.StringVar("b"), Content: gitprovider.StringVar("")},
+			}
+			_, err := c.Create(ctx, "main", "message", files)
+			var sizeErr *gitprovider.CommitTooLargeError
+			if got := errors.As(err, &sizeErr); got != tt.tooLarge {
+				t.Errorf("Create() error = %v, want CommitTooLargeError %v", err, tt.tooLarge)
+			}
+			if tt.encoding == nil && files[0].Encoding != nil {
+				t.Errorf("Create() modified the encoding of the given files")
+			}
+		})
+	}
+}
+
+func TestCommitClient_Create_UnknownEncoding(t *testing.T) {
+	c := &CommitClient{clientContext: &clientContext{}}
+	_, err := c.Create(context.Background(), "main", "message", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("a"), Content: gitprovider.StringVar("aGk="), Encoding: gitprovider.CommitFileEncodingVar("base32")},
+	})
+	if !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("Create() error = %v, want %v", err, gitprovider.ErrInvalidArgument)
+	}
+}
@@ -42,7 +42,7 @@ func (c *DeployKeyClient) Get(ctx context.Context, name string) (gitprovider.Dep
 }
 
 func (c *DeployKeyClient) get(ctx context.Context, name string) (*deployKey, error) {
-	deployKeys, err := c.list(ctx)
+	deployKeys, err := c.list(gitprovider.WithoutListOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +69,33 @@ func (c *DeployKeyClient) List(ctx context.Context) ([]gitprovider.DeployKey, er
 	for _, dk := range dks {
 		keys = append(keys, dk)
 	}
-	return keys, nil
+	return gitprovider.LimitItems(ctx, keys), nil
+}
+
+// ListIter returns an iterator over the deploy keys List returns, fetching them a page at a time.
+func (c *DeployKeyClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.DeployKey] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.DeployKey, gitprovider.PageInfo, error) {
+		opts := gitea.ListDeployKeysOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/keys
+		apiObjs, res, err := c.c.ListDeployKeys(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		keys := make([]gitprovider.DeployKey, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateDeployKeyAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			keys = append(keys, newDeployKey(c, apiObj))
+		}
+		return keys, pageInfo(res, len(apiObjs)), nil
+	})
 }
 
 func (c *DeployKeyClient) list(ctx context.Context) ([]*deployKey, error) {
 	// GET /repos/{owner}/{repo}/keys
-	apiObjs, err := c.listKeys(c.ref.GetIdentity(), c.ref.GetRepository())
+	apiObjs, err := c.listKeys(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +138,7 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating deploy key, as it doesn't exist", "repository", c.ref.String(), "deployKey", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -127,9 +149,11 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("deploy key is up to date", "repository", c.ref.String(), "deployKey", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating deploy key to the desired state", "repository", c.ref.String(), "deployKey", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
@@ -139,11 +163,11 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 }
 
 // listKeys returns all deploy keys of the given repository.
-func (c *DeployKeyClient) listKeys(owner, repo string) ([]*gitea.DeployKey, error) {
+func (c *DeployKeyClient) listKeys(ctx context.Context, owner, repo string) ([]*gitea.DeployKey, error) {
 	opts := gitea.ListDeployKeysOptions{}
 	apiObjs := []*gitea.DeployKey{}
 
-	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
 		// GET /repos/{owner}/{repo}/keys"
 		pageObjs, resp, listErr := c.c.ListDeployKeys(owner, repo, opts)
 		if len(pageObjs) > 0 {
@@ -36,6 +36,10 @@ type FileClient struct {
 // If a file path is given, the contents of the file are returned
 // If a directory path is given, the contents of the files in the path's root are returned
 func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...gitprovider.FilesGetOption) ([]*gitprovider.CommitFile, error) {
+	path, err := gitprovider.NormalizePath(path)
+	if err != nil {
+		return nil, err
+	}
 	fileOpts := gitprovider.FilesGetOptions{}
 	for _, opt := range optFns {
 		opt.ApplyFilesGetOptions(&fileOpts)
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific repository.
+// Gitea references labels by ID, so the labels of an issue must exist in the repository.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *IssueClient) Get(_ context.Context, number int) (gitprovider.Issue, error) {
+	// GET /repos/{owner}/{repo}/issues/{index}
+	apiObj, res, err := c.c.GetIssue(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number))
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if apiObj.PullRequest != nil {
+		return nil, gitprovider.ErrNotFound
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// List lists all open issues of the repository.
+//
+// List returns all available issues, using multiple paginated requests if needed.
+func (c *IssueClient) List(ctx context.Context) ([]gitprovider.Issue, error) {
+	opts := gitea.ListIssueOption{
+		State: gitea.StateOpen,
+		Type:  gitea.IssueTypeIssue,
+	}
+	issues := []gitprovider.Issue{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/issues
+		pageObjs, resp, listErr := c.c.ListRepoIssues(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				issues = append(issues, newIssue(c, apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, issues), nil
+}
+
+// ListIter returns an iterator over the issues List returns, fetching them a page at a time.
+func (c *IssueClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Issue] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Issue, gitprovider.PageInfo, error) {
+		opts := gitea.ListIssueOption{
+			ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page},
+			State:       gitea.StateOpen,
+			Type:        gitea.IssueTypeIssue,
+		}
+		// GET /repos/{owner}/{repo}/issues
+		apiObjs, res, err := c.c.ListRepoIssues(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		issues := make([]gitprovider.Issue, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			issues = append(issues, newIssue(c, apiObj))
+		}
+		return issues, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// Create creates an issue with the given specifications.
+func (c *IssueClient) Create(ctx context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	opts := gitea.CreateIssueOption{
+		Title:     req.Title,
+		Body:      req.Description,
+		Assignees: req.Assignees,
+		Closed:    req.State == gitprovider.IssueStateClosed,
+	}
+	if len(req.Labels) != 0 {
+		ids, err := c.labelIDs(gitprovider.WithoutListOptions(ctx), req.Labels)
+		if err != nil {
+			return nil, err
+		}
+		opts.Labels = ids
+	}
+	// POST /repos/{owner}/{repo}/issues
+	apiObj, res, err := c.c.CreateIssue(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// labelIDs looks up the IDs of the repository labels with the given names.
+func (c *IssueClient) labelIDs(ctx context.Context, names []string) ([]int64, error) {
+	opts := gitea.ListLabelsOptions{}
+	known := map[string]int64{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/labels
+		pageObjs, resp, listErr := c.c.ListRepoLabels(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			for _, label := range pageObjs {
+				known[label.Name] = label.ID
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("label %q: %w", name, gitprovider.ErrNotFound)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
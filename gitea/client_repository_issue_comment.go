@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommentClient implements the gitprovider.CommentClient interface.
+var _ gitprovider.CommentClient = &CommentClient{}
+
+// CommentClient operates on the comments of a specific issue.
+type CommentClient struct {
+	*clientContext
+	ref   gitprovider.RepositoryRef
+	index int64
+}
+
+// List lists all comments of the issue, oldest first.
+//
+// List returns all available comments, using multiple paginated requests if needed.
+func (c *CommentClient) List(ctx context.Context) ([]gitprovider.Comment, error) {
+	opts := gitea.ListIssueCommentOptions{}
+	comments := []gitprovider.Comment{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/issues/{index}/comments
+		pageObjs, resp, listErr := c.c.ListIssueComments(c.ref.GetIdentity(), c.ref.GetRepository(), c.index, opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				comments = append(comments, newComment(c, apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, comments), nil
+}
+
+// ListIter returns an iterator over the comments List returns, fetching them a page at a time.
+func (c *CommentClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Comment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Comment, gitprovider.PageInfo, error) {
+		opts := gitea.ListIssueCommentOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/issues/{index}/comments
+		apiObjs, res, err := c.c.ListIssueComments(c.ref.GetIdentity(), c.ref.GetRepository(), c.index, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		comments := make([]gitprovider.Comment, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			comments = append(comments, newComment(c, apiObj))
+		}
+		return comments, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// Create creates a comment with the given specifications.
+func (c *CommentClient) Create(_ context.Context, req gitprovider.CommentInfo) (gitprovider.Comment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/issues/{index}/comments
+	apiObj, res, err := c.c.CreateIssueComment(c.ref.GetIdentity(), c.ref.GetRepository(), c.index, gitea.CreateIssueCommentOption{
+		Body: req.Body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newComment(c, apiObj), nil
+}
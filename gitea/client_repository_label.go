@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the issue labels of a specific repository.
+type LabelClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the label with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *LabelClient) Get(ctx context.Context, name string) (gitprovider.Label, error) {
+	return c.get(ctx, name)
+}
+
+func (c *LabelClient) get(ctx context.Context, name string) (*label, error) {
+	// Gitea can only get labels by their ID
+	labels, err := c.list(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range labels {
+		if l.l.Name == name {
+			return l, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all labels of the repository.
+//
+// List returns all available labels, using multiple paginated requests if needed.
+func (c *LabelClient) List(ctx context.Context) ([]gitprovider.Label, error) {
+	ls, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.Label
+	labels := make([]gitprovider.Label, 0, len(ls))
+	for _, l := range ls {
+		labels = append(labels, l)
+	}
+	return gitprovider.LimitItems(ctx, labels), nil
+}
+
+// ListIter returns an iterator over the labels List returns, fetching them a page at a time.
+func (c *LabelClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Label] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Label, gitprovider.PageInfo, error) {
+		opts := gitea.ListLabelsOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/labels
+		apiObjs, res, err := c.c.ListRepoLabels(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		labels := make([]gitprovider.Label, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			labels = append(labels, newLabel(c, apiObj))
+		}
+		return labels, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+func (c *LabelClient) list(ctx context.Context) ([]*label, error) {
+	opts := gitea.ListLabelsOptions{}
+	labels := []*label{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/labels
+		pageObjs, resp, listErr := c.c.ListRepoLabels(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				labels = append(labels, newLabel(c, apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// Create creates a label with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *LabelClient) Create(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	return c.create(ctx, req)
+}
+
+func (c *LabelClient) create(ctx context.Context, req gitprovider.LabelInfo) (*label, error) {
+	// Gitea allows several labels with the same name, which would break Get
+	if _, err := c.get(ctx, req.Name); err == nil {
+		return nil, gitprovider.ErrAlreadyExists
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/labels
+	apiObj, res, err := c.c.CreateLabel(c.ref.GetIdentity(), c.ref.GetRepository(), gitea.CreateLabelOption{
+		Name:        req.Name,
+		Color:       *req.Color,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newLabel(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *LabelClient) Reconcile(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating label, as it doesn't exist", "repository", c.ref.String(), "label", req.Name)
+			resp, err := c.create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("label is up to date", "repository", c.ref.String(), "label", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating label to the desired state", "repository", c.ref.String(), "label", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// MilestoneClient implements the gitprovider.MilestoneClient interface.
+var _ gitprovider.MilestoneClient = &MilestoneClient{}
+
+// MilestoneClient operates on the milestones of a specific repository.
+type MilestoneClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the milestone with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *MilestoneClient) Get(_ context.Context, id int) (gitprovider.Milestone, error) {
+	// GET /repos/{owner}/{repo}/milestones/{id}
+	apiObj, res, err := c.c.GetMilestone(c.ref.GetIdentity(), c.ref.GetRepository(), int64(id))
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newMilestone(c, apiObj), nil
+}
+
+// List lists all open and closed milestones of the repository.
+//
+// List returns all available milestones, using multiple paginated requests if needed.
+func (c *MilestoneClient) List(ctx context.Context) ([]gitprovider.Milestone, error) {
+	opts := gitea.ListMilestoneOption{State: gitea.StateAll}
+	milestones := []gitprovider.Milestone{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/milestones
+		pageObjs, resp, listErr := c.c.ListRepoMilestones(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				milestones = append(milestones, newMilestone(c, apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, milestones), nil
+}
+
+// ListIter returns an iterator over the milestones List returns, fetching them a page at a time.
+func (c *MilestoneClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Milestone] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Milestone, gitprovider.PageInfo, error) {
+		opts := gitea.ListMilestoneOption{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}, State: gitea.StateAll}
+		// GET /repos/{owner}/{repo}/milestones
+		apiObjs, res, err := c.c.ListRepoMilestones(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		milestones := make([]gitprovider.Milestone, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			milestones = append(milestones, newMilestone(c, apiObj))
+		}
+		return milestones, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// Create creates a milestone with the given specifications.
+func (c *MilestoneClient) Create(_ context.Context, req gitprovider.MilestoneInfo) (gitprovider.Milestone, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/milestones
+	apiObj, res, err := c.c.CreateMilestone(c.ref.GetIdentity(), c.ref.GetRepository(), gitea.CreateMilestoneOption{
+		Title:       req.Title,
+		Description: req.Description,
+		State:       gitea.StateType(req.State),
+		Deadline:    req.DueDate,
+	})
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newMilestone(c, apiObj), nil
+}
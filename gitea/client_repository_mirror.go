@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// pullMirrorID is the ID of the pull mirror of a repository, as there can only be one.
+const pullMirrorID = "pull"
+
+// MirrorClient implements the gitprovider.MirrorClient interface.
+var _ gitprovider.MirrorClient = &MirrorClient{}
+
+// MirrorClient operates on the mirrors of a specific repository.
+// A repository is a pull mirror if it was migrated as a mirror, in which case only its sync
+// interval can be changed. Push mirrors can be created, but the Gitea SDK can't list, update or
+// delete them, so they aren't returned by Get and List, and can't be reconciled.
+type MirrorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the mirror with the given ID, which has to be "pull" for the pull mirror.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *MirrorClient) Get(ctx context.Context, id string) (gitprovider.Mirror, error) {
+	return c.get(ctx, id)
+}
+
+func (c *MirrorClient) get(_ context.Context, id string) (*mirror, error) {
+	if id != pullMirrorID {
+		return nil, fmt.Errorf("getting push mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// GET /repos/{owner}/{repo}
+	apiObj, err := getRepo(c.c, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	if !apiObj.Mirror {
+		return nil, gitprovider.ErrNotFound
+	}
+	return newPullMirror(c, apiObj), nil
+}
+
+// List returns the pull mirror of the repository, if it is one.
+// Push mirrors can't be listed.
+func (c *MirrorClient) List(ctx context.Context) ([]gitprovider.Mirror, error) {
+	m, err := c.get(ctx, pullMirrorID)
+	if errors.Is(err, gitprovider.ErrNotFound) {
+		return []gitprovider.Mirror{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []gitprovider.Mirror{m}, nil
+}
+
+// ListIter returns an iterator over the mirrors List returns. The pull mirror is a setting of the
+// repository rather than a paginated list, so it's fetched by a single call to List.
+func (c *MirrorClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Mirror] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
+
+// Create creates a push mirror with the given specifications. Pull mirrors can only be
+// set up when migrating a repository.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *MirrorClient) Create(_ context.Context, req gitprovider.MirrorInfo) (gitprovider.Mirror, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if req.Direction == gitprovider.MirrorDirectionPull {
+		return nil, fmt.Errorf("creating pull mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if err := validateMirrorInfo(req); err != nil {
+		return nil, err
+	}
+
+	opts := gitea.CreatePushMirrorOption{
+		RemoteAddress: req.URL,
+	}
+	if req.Username != nil {
+		opts.RemoteUsername = *req.Username
+	}
+	if req.Password != nil {
+		opts.RemotePassword = *req.Password
+	}
+	if req.Interval != nil {
+		opts.Interval = req.Interval.String()
+	}
+	// POST /repos/{owner}/{repo}/push_mirrors
+	apiObj, res, err := c.c.PushMirrors(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newPushMirror(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) of the pull mirror becomes the actual
+// state in the backing Git provider. Push mirrors can't be reconciled.
+//
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *MirrorClient) Reconcile(ctx context.Context, req gitprovider.MirrorInfo) (gitprovider.Mirror, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	if req.Direction == gitprovider.MirrorDirectionPush {
+		return nil, false, fmt.Errorf("reconciling push mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	actual, err := c.get(ctx, pullMirrorID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("mirror is up to date", "repository", c.ref.String(), "direction", req.Direction)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating mirror to the desired state", "repository", c.ref.String(), "direction", req.Direction)
+	// Populate the desired state to the current-actual object
+	req.ID = actual.info.ID
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// validateMirrorInfo makes sure req only uses fields Gitea supports.
+func validateMirrorInfo(req gitprovider.MirrorInfo) error {
+	if req.Enabled != nil {
+		return fmt.Errorf("disabling mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.OnlyProtectedBranches != nil {
+		return fmt.Errorf("mirroring only protected branches: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
+
+// parseMirrorInterval parses an interval returned by Gitea, e.g. "8h0m0s".
+// An empty or invalid interval is returned as nil.
+func parseMirrorInterval(interval string) *time.Duration {
+	d, err := time.ParseDuration(interval)
+	if err != nil || d <= 0 {
+		return nil
+	}
+	return &d
+}
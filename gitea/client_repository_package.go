@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PackageClient implements the gitprovider.PackageClient interface.
+var _ gitprovider.PackageClient = &PackageClient{}
+
+// PackageClient operates on the packages linked to a specific repository. Gitea packages
+// belong to the owner of the repository, and can be linked to at most one repository.
+type PackageClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all packages of the owner that are linked to the repository. Gitea returns
+// every package version separately; they are grouped by package type and name.
+//
+// List returns all available packages, using multiple paginated requests if needed.
+func (c *PackageClient) List(ctx context.Context) ([]gitprovider.Package, error) {
+	packages := []gitprovider.Package{}
+	grouped := map[gitprovider.PackageInfo]*pkg{}
+	opts := gitea.ListPackagesOptions{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /packages/{owner}
+		pageObjs, resp, listErr := c.c.ListPackages(c.ref.GetIdentity(), opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				if apiObj.Repository == nil || !strings.EqualFold(apiObj.Repository.Name, c.ref.GetRepository()) {
+					continue
+				}
+				key := gitprovider.PackageInfo{Name: apiObj.Name, Type: gitprovider.PackageType(apiObj.Type)}
+				p, ok := grouped[key]
+				if !ok {
+					p = &pkg{info: key, c: c}
+					grouped[key] = p
+					packages = append(packages, p)
+				}
+				p.p = append(p.p, apiObj)
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, packages), nil
+}
+
+// ListIter returns an iterator over the packages List returns. Gitea lists every version of a
+// package of the owner on its own, and the versions of a package may be spread over several pages,
+// so they're all fetched and grouped by a single call to List.
+func (c *PackageClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Package] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
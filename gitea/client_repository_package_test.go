@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestPackageClient_List(t *testing.T) {
+	r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /api/v1/packages/fluxcd" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id":1,"name":"flux2","version":"v2.0.0","type":"container","repository":{"name":"flux2"},"created_at":"2026-01-01T00:00:00Z"},
+			{"id":2,"name":"source-controller","version":"v1.0.0","type":"container","repository":{"name":"source-controller"}},
+			{"id":3,"name":"flux-js","version":"1.0.0","type":"npm","repository":{"name":"Flux2"}},
+			{"id":4,"name":"flux2","version":"v2.1.0","type":"container","repository":{"name":"flux2"},"created_at":"2026-01-02T00:00:00Z"},
+			{"id":5,"name":"unlinked","version":"1.0.0","type":"npm"}
+		]`))
+	}))
+	packageClient, err := r.Packages()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	packages, err := packageClient.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	got := make([]gitprovider.PackageInfo, 0, len(packages))
+	for _, p := range packages {
+		got = append(got, p.Get())
+	}
+	// Only the packages linked to the repository are listed, the versions are grouped by type and name
+	want := []gitprovider.PackageInfo{
+		{Name: "flux2", Type: gitprovider.PackageTypeContainer},
+		{Name: "flux-js", Type: gitprovider.PackageTypeNPM},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("List() mismatch (-want +got):\n%s", diff)
+	}
+
+	versions, err := packages[0].Versions(context.Background())
+	if err != nil {
+		t.Fatalf("Versions() error = %v", err)
+	}
+	var gotVersions []string
+	for _, v := range versions {
+		gotVersions = append(gotVersions, v.Get().Version)
+	}
+	if diff := cmp.Diff([]string{"v2.0.0", "v2.1.0"}, gotVersions); diff != "" {
+		t.Errorf("Versions() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -47,7 +47,26 @@ func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest
 		requests[idx] = newPullRequest(c.clientContext, pr)
 	}
 
-	return requests, nil
+	return gitprovider.LimitItems(ctx, requests), nil
+}
+
+// ListIter returns an iterator over the pull requests of the repository, fetching them a page at
+// a time.
+func (c *PullRequestClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.PullRequest] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.PullRequest, gitprovider.PageInfo, error) {
+		opts := gitea.ListPullRequestsOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/pulls
+		prs, res, err := c.c.ListRepoPullRequests(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		requests := make([]gitprovider.PullRequest, 0, len(prs))
+		for _, pr := range prs {
+			requests = append(requests, newPullRequest(c.clientContext, pr))
+		}
+		return requests, pageInfo(res, len(prs)), nil
+	})
 }
 
 // Create creates a pull request with the given specifications.
@@ -110,3 +129,19 @@ func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod g
 
 	return nil
 }
+
+// EnableAutoMerge schedules the pull request to be merged when all checks succeed.
+func (c *PullRequestClient) EnableAutoMerge(_ context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	mergeOpts := gitea.MergePullRequestOption{
+		Style:                  gitea.MergeStyle(mergeMethod),
+		MergeWhenChecksSucceed: true,
+	}
+	// POST /repos/{owner}/{repo}/pulls/{index}/merge
+	_, resp, err := c.c.MergePullRequest(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number), mergeOpts)
+	return handleHTTPError(resp, err)
+}
+
+// DisableAutoMerge returns ErrNoProviderSupport, as the Gitea SDK can't cancel a scheduled merge.
+func (c *PullRequestClient) DisableAutoMerge(_ context.Context, _ int) error {
+	return gitprovider.ErrNoProviderSupport
+}
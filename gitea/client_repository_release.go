@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+// ReleaseClient implements the experimental.ReleaseClient interface.
+var _ experimental.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific repository.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ExperimentalAPI implements experimental.API.
+func (c *ReleaseClient) ExperimentalAPI() {}
+
+// Get returns the release for the given tag.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ReleaseClient) Get(_ context.Context, tag string) (experimental.Release, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	apiObj, res, err := c.c.GetReleaseByTag(c.ref.GetIdentity(), c.ref.GetRepository(), tag)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// List lists all releases of the repository, newest first.
+//
+// List returns all available releases, using multiple paginated requests if needed.
+func (c *ReleaseClient) List(ctx context.Context) ([]experimental.Release, error) {
+	opts := gitea.ListReleasesOptions{}
+	releases := []experimental.Release{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/releases
+		pageObjs, resp, listErr := c.c.ListReleases(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				releases = append(releases, newRelease(c, apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, releases), nil
+}
+
+// ListIter returns an iterator over the releases List returns, fetching them a page at a time.
+func (c *ReleaseClient) ListIter(ctx context.Context) *gitprovider.Iterator[experimental.Release] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]experimental.Release, gitprovider.PageInfo, error) {
+		opts := gitea.ListReleasesOptions{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/releases
+		apiObjs, res, err := c.c.ListReleases(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		releases := make([]experimental.Release, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			releases = append(releases, newRelease(c, apiObj))
+		}
+		return releases, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+// Create creates a release with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *ReleaseClient) Create(_ context.Context, req experimental.ReleaseInfo) (experimental.Release, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	opts := gitea.CreateReleaseOption{
+		TagName:      req.TagName,
+		Title:        req.Name,
+		Note:         req.Description,
+		IsDraft:      req.Draft,
+		IsPrerelease: req.Prerelease,
+	}
+	if req.Target != nil {
+		opts.Target = *req.Target
+	}
+	// POST /repos/{owner}/{repo}/releases
+	apiObj, res, err := c.c.CreateRelease(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newRelease(c, apiObj), nil
+}
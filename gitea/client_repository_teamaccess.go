@@ -75,7 +75,13 @@ func (c *TeamAccessClient) List(ctx context.Context) ([]gitprovider.TeamAccess,
 		teamAccess = append(teamAccess, ta)
 	}
 
-	return teamAccess, nil
+	return gitprovider.LimitItems(ctx, teamAccess), nil
+}
+
+// ListIter returns an iterator over the team accesses List returns. Gitea doesn't paginate the
+// teams of a repository, so they're all fetched by a single call to List.
+func (c *TeamAccessClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.TeamAccess] {
+	return gitprovider.ListIterator(ctx, c.List)
 }
 
 // Create adds a given team to the repo's team access control list.
@@ -114,6 +120,7 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team access, as it doesn't exist", "repository", c.ref.String(), "team", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -124,9 +131,11 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team access is up to date", "repository", c.ref.String(), "team", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating team access to the desired state", "repository", c.ref.String(), "team", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
@@ -66,6 +66,10 @@ func (c *TreeClient) Get(ctx context.Context, sha string, recursive bool) (*gitp
 
 // List files (blob) in a tree, sha is represented by the branch name
 func (c *TreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
+	path, err := gitprovider.NormalizePath(path)
+	if err != nil {
+		return nil, err
+	}
 	treeInfo, err := c.Get(ctx, sha, recursive)
 	if err != nil {
 		return nil, err
@@ -86,5 +90,14 @@ func (c *TreeClient) List(ctx context.Context, sha string, path string, recursiv
 		}
 	}
 
-	return treeEntries, nil
+	return gitprovider.LimitItems(ctx, treeEntries), nil
+}
+
+// ListIter returns an iterator over the tree entries List returns. The Gitea SDK fetches the
+// tree in a single response rather than a page at a time, so the entries are all fetched by a
+// single call to List.
+func (c *TreeClient) ListIter(ctx context.Context, sha string, path string, recursive bool) *gitprovider.Iterator[*gitprovider.TreeEntry] {
+	return gitprovider.ListIterator(ctx, func(ctx context.Context) ([]*gitprovider.TreeEntry, error) {
+		return c.List(ctx, sha, path, recursive)
+	})
 }
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// VariableClient implements the gitprovider.VariableClient interface.
+var _ gitprovider.VariableClient = &VariableClient{}
+
+// VariableClient operates on the Gitea Actions secrets of a specific repository.
+// Secrets are write-only, so their values are never returned by the API.
+type VariableClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the secret with the given name. The value is always empty.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *VariableClient) Get(ctx context.Context, key string) (gitprovider.Variable, error) {
+	return c.get(ctx, key)
+}
+
+func (c *VariableClient) get(ctx context.Context, key string) (*variable, error) {
+	variables, err := c.list(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// Loop through secrets once we find one with the right name
+	for _, v := range variables {
+		if v.s.Name == key {
+			return v, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all secrets of the repository.
+//
+// List returns all available secrets, using multiple paginated requests if needed.
+func (c *VariableClient) List(ctx context.Context) ([]gitprovider.Variable, error) {
+	vs, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.Variable
+	variables := make([]gitprovider.Variable, 0, len(vs))
+	for _, v := range vs {
+		variables = append(variables, v)
+	}
+	return gitprovider.LimitItems(ctx, variables), nil
+}
+
+// ListIter returns an iterator over the variables List returns, fetching them a page at a time.
+func (c *VariableClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Variable] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Variable, gitprovider.PageInfo, error) {
+		opts := gitea.ListRepoActionSecretOption{ListOptions: gitea.ListOptions{PageSize: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/actions/secrets
+		apiObjs, res, err := c.c.ListRepoActionSecret(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+		}
+
+		variables := make([]gitprovider.Variable, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateVariableAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			variables = append(variables, newVariable(c, apiObj))
+		}
+		return variables, pageInfo(res, len(apiObjs)), nil
+	})
+}
+
+func (c *VariableClient) list(ctx context.Context) ([]*variable, error) {
+	// GET /repos/{owner}/{repo}/actions/secrets
+	apiObjs, err := c.listSecrets(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our variable type
+	variables := make([]*variable, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at listSecrets
+		variables = append(variables, newVariable(c, apiObj))
+	}
+
+	return variables, nil
+}
+
+// Create creates a secret with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *VariableClient) Create(ctx context.Context, req gitprovider.VariableInfo) (gitprovider.Variable, error) {
+	// PUT would silently overwrite an existing secret, so check for it first
+	if _, err := c.get(ctx, req.Key); err == nil {
+		return nil, gitprovider.ErrAlreadyExists
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := c.createVariable(req)
+	if err != nil {
+		return nil, err
+	}
+	return newVariable(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// As the value of an existing secret can't be read back, it is always overwritten (actionTaken == true).
+func (c *VariableClient) Reconcile(ctx context.Context, req gitprovider.VariableInfo) (gitprovider.Variable, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	c.log.V(1).Info("updating variable to the desired state", "repository", c.ref.String(), "variable", req.Key)
+	// PUT /repos/{owner}/{repo}/actions/secrets/{secretname} both creates and updates
+	apiObj, err := c.createVariable(req)
+	if err != nil {
+		return nil, false, err
+	}
+	return newVariable(c, apiObj), true, nil
+}
+
+func (c *VariableClient) createVariable(req gitprovider.VariableInfo) (*gitea.Secret, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// Gitea has no notion of protected or environment-scoped secrets
+	if *req.Protected {
+		return nil, fmt.Errorf("protected secrets: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.Environment != "" {
+		return nil, fmt.Errorf("environment-scoped secrets: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// PUT /repos/{owner}/{repo}/actions/secrets/{secretname}
+	return c.createSecret(c.ref.GetIdentity(), c.ref.GetRepository(), variableToAPI(&req))
+}
+
+// listSecrets returns all Actions secrets of the given repository.
+func (c *VariableClient) listSecrets(ctx context.Context, owner, repo string) ([]*gitea.Secret, error) {
+	opts := gitea.ListRepoActionSecretOption{}
+	apiObjs := []*gitea.Secret{}
+
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/actions/secrets
+		pageObjs, resp, listErr := c.c.ListRepoActionSecret(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateVariableAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+// createSecret creates or updates an Actions secret of the given repository.
+func (c *VariableClient) createSecret(owner, repo string, req *gitea.Secret) (*gitea.Secret, error) {
+	opts := gitea.CreateSecretOption{Name: req.Name, Data: req.Data}
+	resp, err := c.c.CreateRepoActionSecret(owner, repo, opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	// The API doesn't return the secret, and never exposes its data
+	return &gitea.Secret{Name: req.Name}, nil
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package gitea
 
 import (
+	"errors"
+	"net/http"
 	"net/url"
 	"os"
 	"regexp"
@@ -62,7 +64,7 @@ func Test_DomainVariations(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c1, err := NewClient(token, tt.opts)
+			c1, err := NewClient(token, tt.opts, gitprovider.WithAllowInsecureHTTP(true))
 			if err != nil {
 				if tt.expectedErrPattern == "" {
 					t.Fatalf("unexpected error: %s", err)
@@ -89,3 +91,10 @@ func assertEqual(t *testing.T, a interface{}, b interface{}) {
 		t.Fatalf("%s != %s", a, b)
 	}
 }
+
+func TestClient_Runners(t *testing.T) {
+	c := newTestClient(t, http.NotFoundHandler())
+	if _, err := c.Runners(); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Runners() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+}
@@ -166,6 +166,7 @@ var _ = Describe("Gitea Provider", func() {
 			giteaToken,
 			gitprovider.WithDomain(giteaBaseUrl),
 			gitprovider.WithDestructiveAPICalls(true),
+			gitprovider.WithAllowInsecureHTTP(true),
 			gitprovider.WithConditionalRequests(true),
 			gitprovider.WithPreChainTransportHook(customTransportFactory),
 		)
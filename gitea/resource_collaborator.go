@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, apiObj *gitea.CollaboratorPermissionResult, info gitprovider.CollaboratorInfo) *collaborator {
+	return &collaborator{
+		info:   info,
+		apiObj: apiObj,
+		c:      c,
+	}
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	info   gitprovider.CollaboratorInfo
+	apiObj *gitea.CollaboratorPermissionResult
+	c      *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	c.info = info
+	return nil
+}
+
+func (c *collaborator) APIObject() interface{} {
+	return c.apiObj
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Update changes the permission of the collaborator.
+func (c *collaborator) Update(ctx context.Context) error {
+	// Adding an existing collaborator again updates the permission
+	if err := c.c.add(ctx, c.info.Username, *c.info.Permission); err != nil {
+		return err
+	}
+	actual, err := c.c.get(ctx, c.info.Username)
+	if err != nil {
+		return err
+	}
+	c.info = actual.info
+	c.apiObj = actual.apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.Get(ctx, req.Username)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			return true, c.Set(resp.Get())
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+
+	return true, c.Update(ctx)
+}
+
+// Delete removes the user from the repository's collaborators.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(_ context.Context) error {
+	// DELETE /repos/{owner}/{repo}/collaborators/{collaborator}
+	res, err := c.c.c.DeleteCollaborator(c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.info.Username)
+	return handleHTTPError(res, err)
+}
@@ -59,6 +59,27 @@ func commitFromAPI(apiObj *gitea.Commit) gitprovider.CommitInfo {
 			info.TreeSha = apiObj.RepoCommit.Tree.SHA
 		}
 		info.Message = apiObj.RepoCommit.Message
+		if apiObj.RepoCommit.Verification != nil {
+			info.Signature = signatureFromAPI(apiObj.RepoCommit.Verification, apiObj.RepoCommit.Committer)
+		}
 	}
 	return info
 }
+
+// signatureFromAPI returns the verification of a commit or tag, which Gitea only verifies for
+// the committer or tagger, the signer. A nil verification means the object isn't signed.
+func signatureFromAPI(apiObj *gitea.PayloadCommitVerification, signer *gitea.CommitUser) *gitprovider.SignatureVerification {
+	if apiObj == nil || apiObj.Signature == "" {
+		return &gitprovider.SignatureVerification{Reason: gitprovider.SignatureVerificationReasonUnsigned}
+	}
+	sig := &gitprovider.SignatureVerification{
+		Verified: apiObj.Verified,
+		Reason:   apiObj.Reason,
+		Type:     gitprovider.DetectSignatureType(apiObj.Signature),
+	}
+	if signer != nil {
+		sig.SignerName = signer.Name
+		sig.SignerEmail = signer.Email
+	}
+	return sig
+}
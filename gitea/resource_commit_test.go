@@ -66,6 +66,73 @@ func Test_CommitFromAPI(t *testing.T) {
 				TreeSha:   "treesha",
 			},
 		},
+		{
+			name: "signed",
+			apiObj: &gitea.Commit{
+				CommitMeta: &gitea.CommitMeta{
+					SHA: "sha",
+					URL: "commitURL",
+				},
+				Author: &gitea.User{
+					UserName: "username",
+					Created:  genTime,
+				},
+				RepoCommit: &gitea.RepoCommit{
+					Message: "message",
+					Committer: &gitea.CommitUser{
+						Identity: gitea.Identity{Name: "name", Email: "name@example.com"},
+					},
+					Verification: &gitea.PayloadCommitVerification{
+						Verified:  true,
+						Reason:    "name / key",
+						Signature: "-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----\n",
+					},
+				},
+			},
+			want: gitprovider.CommitInfo{
+				Sha:       "sha",
+				Author:    "username",
+				CreatedAt: genTime,
+				URL:       "commitURL",
+				Message:   "message",
+				Signature: &gitprovider.SignatureVerification{
+					Verified:    true,
+					Reason:      "name / key",
+					Type:        gitprovider.SignatureTypeVar(gitprovider.SignatureTypeSSH),
+					SignerName:  "name",
+					SignerEmail: "name@example.com",
+				},
+			},
+		},
+		{
+			name: "unsigned",
+			apiObj: &gitea.Commit{
+				CommitMeta: &gitea.CommitMeta{
+					SHA: "sha",
+					URL: "commitURL",
+				},
+				Author: &gitea.User{
+					UserName: "username",
+					Created:  genTime,
+				},
+				RepoCommit: &gitea.RepoCommit{
+					Message: "message",
+					Verification: &gitea.PayloadCommitVerification{
+						Reason: "gpg.error.not_signed_commit",
+					},
+				},
+			},
+			want: gitprovider.CommitInfo{
+				Sha:       "sha",
+				Author:    "username",
+				CreatedAt: genTime,
+				URL:       "commitURL",
+				Message:   "message",
+				Signature: &gitprovider.SignatureVerification{
+					Reason: gitprovider.SignatureVerificationReasonUnsigned,
+				},
+			},
+		},
 		{
 			name: "nil repo commit",
 			apiObj: &gitea.Commit{
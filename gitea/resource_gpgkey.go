@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newGPGKey(c *GPGKeyClient, apiObj *gitea.GPGKey) *gpgKey {
+	return &gpgKey{
+		k: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.GPGKey = &gpgKey{}
+
+type gpgKey struct {
+	k gitea.GPGKey
+	c *GPGKeyClient
+}
+
+// Get returns high-level information about the GPG key. Gitea doesn't return the armored key.
+func (k *gpgKey) Get() gitprovider.GPGKeyInfo {
+	info := gitprovider.GPGKeyInfo{
+		KeyID: k.k.KeyID,
+	}
+	for _, email := range k.k.Emails {
+		info.Emails = append(info.Emails, email.Email)
+	}
+	if !k.k.Expires.IsZero() {
+		info.ExpiresAt = &k.k.Expires
+	}
+	return info
+}
+
+// APIObject returns the underlying API object.
+func (k *gpgKey) APIObject() interface{} {
+	return &k.k
+}
+
+// Delete removes the GPG key from the authenticated user.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (k *gpgKey) Delete(_ context.Context) error {
+	// DELETE /user/gpg_keys/{id}
+	res, err := k.c.c.DeleteGPGKey(k.k.ID)
+	return handleHTTPError(res, err)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, apiObj *gitea.Issue) *issue {
+	return &issue{
+		i:    *apiObj,
+		info: issueFromAPI(apiObj),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i    gitea.Issue
+	info gitprovider.IssueInfo
+	c    *IssueClient
+}
+
+func (i *issue) Get() gitprovider.IssueInfo {
+	return i.info
+}
+
+func (i *issue) Set(info gitprovider.IssueInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	i.info = info
+	return nil
+}
+
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+func (i *issue) Repository() gitprovider.RepositoryRef {
+	return i.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (i *issue) Update(ctx context.Context) error {
+	owner, repo := i.c.ref.GetIdentity(), i.c.ref.GetRepository()
+	// Labels are replaced through a separate endpoint
+	if i.info.Labels != nil {
+		ids, err := i.c.labelIDs(gitprovider.WithoutListOptions(ctx), i.info.Labels)
+		if err != nil {
+			return err
+		}
+		// PUT /repos/{owner}/{repo}/issues/{index}/labels
+		_, res, err := i.c.c.ReplaceIssueLabels(owner, repo, i.i.Index, gitea.IssueLabelsOption{Labels: ids})
+		if err != nil {
+			return handleHTTPError(res, err)
+		}
+	}
+
+	opts := gitea.EditIssueOption{
+		Title:     i.info.Title,
+		Body:      &i.info.Description,
+		Assignees: i.info.Assignees,
+	}
+	if i.info.State != "" {
+		state := gitea.StateType(i.info.State)
+		opts.State = &state
+	}
+	return i.edit(opts)
+}
+
+// Close closes the issue.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (i *issue) Close(_ context.Context) error {
+	state := gitea.StateClosed
+	return i.edit(gitea.EditIssueOption{
+		// The title is always sent, so keep it
+		Title: i.i.Title,
+		State: &state,
+	})
+}
+
+func (i *issue) edit(opts gitea.EditIssueOption) error {
+	// PATCH /repos/{owner}/{repo}/issues/{index}
+	apiObj, res, err := i.c.c.EditIssue(i.c.ref.GetIdentity(), i.c.ref.GetRepository(), i.i.Index, opts)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	*i = *newIssue(i.c, apiObj)
+	return nil
+}
+
+// Comments gives access to the comments of this issue.
+func (i *issue) Comments() gitprovider.CommentClient {
+	return &CommentClient{
+		clientContext: i.c.clientContext,
+		ref:           i.c.ref,
+		index:         i.i.Index,
+	}
+}
+
+func issueFromAPI(apiObj *gitea.Issue) gitprovider.IssueInfo {
+	info := gitprovider.IssueInfo{
+		Number:      int(apiObj.Index),
+		Title:       apiObj.Title,
+		Description: apiObj.Body,
+		Labels:      make([]string, 0, len(apiObj.Labels)),
+		Assignees:   make([]string, 0, len(apiObj.Assignees)),
+		State:       gitprovider.IssueState(apiObj.State),
+		WebURL:      apiObj.HTMLURL,
+	}
+	for _, label := range apiObj.Labels {
+		info.Labels = append(info.Labels, label.Name)
+	}
+	for _, assignee := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, assignee.UserName)
+	}
+	return info
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newComment(c *CommentClient, apiObj *gitea.Comment) *comment {
+	info := gitprovider.CommentInfo{
+		ID:   apiObj.ID,
+		Body: apiObj.Body,
+	}
+	if apiObj.Poster != nil {
+		info.Author = apiObj.Poster.UserName
+	}
+	return &comment{
+		cm:   *apiObj,
+		info: info,
+		c:    c,
+	}
+}
+
+var _ gitprovider.Comment = &comment{}
+
+type comment struct {
+	cm   gitea.Comment
+	info gitprovider.CommentInfo
+	c    *CommentClient
+}
+
+func (cm *comment) Get() gitprovider.CommentInfo {
+	return cm.info
+}
+
+func (cm *comment) Set(info gitprovider.CommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	cm.info = info
+	return nil
+}
+
+func (cm *comment) APIObject() interface{} {
+	return &cm.cm
+}
+
+// Update will apply the desired state in this object to the server.
+// Only the body of a comment can be changed.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (cm *comment) Update(_ context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/comments/{id}
+	apiObj, res, err := cm.c.c.EditIssueComment(cm.c.ref.GetIdentity(), cm.c.ref.GetRepository(), cm.cm.ID, gitea.EditIssueCommentOption{
+		Body: cm.info.Body,
+	})
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	*cm = *newComment(cm.c, apiObj)
+	return nil
+}
+
+// Delete deletes the comment.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (cm *comment) Delete(_ context.Context) error {
+	// DELETE /repos/{owner}/{repo}/issues/comments/{id}
+	res, err := cm.c.c.DeleteIssueComment(cm.c.ref.GetIdentity(), cm.c.ref.GetRepository(), cm.cm.ID)
+	return handleHTTPError(res, err)
+}
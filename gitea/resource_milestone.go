@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newMilestone(c *MilestoneClient, apiObj *gitea.Milestone) *milestone {
+	return &milestone{
+		m: *apiObj,
+		info: gitprovider.MilestoneInfo{
+			ID:          int(apiObj.ID),
+			Title:       apiObj.Title,
+			Description: apiObj.Description,
+			DueDate:     apiObj.Deadline,
+			State:       gitprovider.MilestoneState(apiObj.State),
+		},
+		c: c,
+	}
+}
+
+var _ gitprovider.Milestone = &milestone{}
+
+type milestone struct {
+	m    gitea.Milestone
+	info gitprovider.MilestoneInfo
+	c    *MilestoneClient
+}
+
+func (m *milestone) Get() gitprovider.MilestoneInfo {
+	return m.info
+}
+
+func (m *milestone) Set(info gitprovider.MilestoneInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	m.info = info
+	return nil
+}
+
+func (m *milestone) APIObject() interface{} {
+	return &m.m
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *milestone) Update(_ context.Context) error {
+	opts := gitea.EditMilestoneOption{
+		Title:       m.info.Title,
+		Description: &m.info.Description,
+		Deadline:    m.info.DueDate,
+	}
+	if m.info.State != "" {
+		state := gitea.StateType(m.info.State)
+		opts.State = &state
+	}
+	return m.edit(opts)
+}
+
+// Close closes the milestone.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *milestone) Close(_ context.Context) error {
+	state := gitea.StateClosed
+	return m.edit(gitea.EditMilestoneOption{
+		Title: m.m.Title,
+		State: &state,
+	})
+}
+
+func (m *milestone) edit(opts gitea.EditMilestoneOption) error {
+	// PATCH /repos/{owner}/{repo}/milestones/{id}
+	apiObj, res, err := m.c.c.EditMilestone(m.c.ref.GetIdentity(), m.c.ref.GetRepository(), m.m.ID, opts)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	*m = *newMilestone(m.c, apiObj)
+	return nil
+}
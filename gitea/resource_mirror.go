@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Mirror = &mirror{}
+
+type mirror struct {
+	// apiObj is a *gitea.PushMirrorResponse for push mirrors, and the *gitea.Repository for the pull mirror
+	apiObj interface{}
+	info   gitprovider.MirrorInfo
+	c      *MirrorClient
+}
+
+func newPushMirror(c *MirrorClient, apiObj *gitea.PushMirrorResponse) *mirror {
+	return &mirror{
+		apiObj: apiObj,
+		info: gitprovider.MirrorInfo{
+			ID:        apiObj.RemoteName,
+			Direction: gitprovider.MirrorDirectionPush,
+			URL:       apiObj.RemoteAddress,
+			Interval:  parseMirrorInterval(apiObj.Interval),
+		},
+		c: c,
+	}
+}
+
+func newPullMirror(c *MirrorClient, apiObj *gitea.Repository) *mirror {
+	return &mirror{
+		apiObj: apiObj,
+		info: gitprovider.MirrorInfo{
+			ID:        pullMirrorID,
+			Direction: gitprovider.MirrorDirectionPull,
+			URL:       apiObj.OriginalURL,
+			Interval:  parseMirrorInterval(apiObj.MirrorInterval),
+		},
+		c: c,
+	}
+}
+
+// Get returns the mirror information.
+func (m *mirror) Get() gitprovider.MirrorInfo {
+	return m.info
+}
+
+// Set sets the mirror information.
+func (m *mirror) Set(info gitprovider.MirrorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	m.info = info
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (m *mirror) APIObject() interface{} {
+	return m.apiObj
+}
+
+// Repository returns the repository reference.
+func (m *mirror) Repository() gitprovider.RepositoryRef {
+	return m.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only the sync interval of the pull mirror can be updated.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *mirror) Update(_ context.Context) error {
+	repo, ok := m.apiObj.(*gitea.Repository)
+	if !ok {
+		return fmt.Errorf("updating push mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if err := validateMirrorInfo(m.info); err != nil {
+		return err
+	}
+	if m.info.URL != repo.OriginalURL || m.info.Username != nil {
+		return fmt.Errorf("changing the remote of pull mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if m.info.Interval == nil {
+		return nil
+	}
+
+	opts := &gitea.EditRepoOption{
+		MirrorInterval: gitea.OptionalString(m.info.Interval.String()),
+	}
+	// PATCH /repos/{owner}/{repo}
+	apiObj, err := updateRepo(m.c.c, m.c.ref.GetIdentity(), m.c.ref.GetRepository(), opts)
+	if err != nil {
+		return err
+	}
+	*m = *newPullMirror(m.c, apiObj)
+	return nil
+}
+
+// Delete returns ErrNoProviderSupport, as the Gitea SDK can't delete push mirrors, nor
+// convert pull mirrors into regular repositories.
+func (m *mirror) Delete(_ context.Context) error {
+	return fmt.Errorf("deleting mirrors: %w", gitprovider.ErrNoProviderSupport)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider. Only the pull mirror can be reconciled.
+//
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (m *mirror) Reconcile(ctx context.Context) (bool, error) {
+	if m.info.Direction == gitprovider.MirrorDirectionPush {
+		return false, fmt.Errorf("reconciling push mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	actual, err := m.c.get(ctx, pullMirrorID)
+	if err != nil {
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if m.info.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	m.apiObj = actual.apiObj
+	m.info.ID = actual.info.ID
+	return true, m.Update(ctx)
+}
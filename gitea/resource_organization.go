@@ -17,6 +17,9 @@ limitations under the License.
 package gitea
 
 import (
+	"context"
+	"fmt"
+
 	"code.gitea.io/sdk/gitea"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -24,13 +27,19 @@ import (
 )
 
 func newOrganization(ctx *clientContext, apiObj *gitea.Organization, ref gitprovider.OrganizationRef) *organization {
+	teams := &TeamsClient{
+		clientContext: ctx,
+		ref:           ref,
+	}
 	return &organization{
 		clientContext: ctx,
 		o:             *apiObj,
 		ref:           ref,
-		teams: &TeamsClient{
+		teams:         teams,
+		members: &OrganizationMemberClient{
 			clientContext: ctx,
 			ref:           ref,
+			teams:         teams,
 		},
 	}
 }
@@ -43,7 +52,8 @@ type organization struct {
 	o   gitea.Organization
 	ref gitprovider.OrganizationRef
 
-	teams *TeamsClient
+	teams   *TeamsClient
+	members *OrganizationMemberClient
 }
 
 // Get returns the organization information.
@@ -66,10 +76,170 @@ func (o *organization) Teams() gitprovider.TeamsClient {
 	return o.teams
 }
 
+// Runners returns ErrNoProviderSupport, as the Gitea SDK can't create runner registration tokens.
+func (o *organization) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Bots returns ErrNoProviderSupport, as Gitea has no bot users.
+func (o *organization) Bots() (gitprovider.BotClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AccessTokens returns ErrNoProviderSupport, as Gitea only has personal access tokens.
+func (o *organization) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Labels returns ErrNoProviderSupport, as the Gitea SDK can't manage organization labels.
+func (o *organization) Labels() (gitprovider.LabelClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Milestones returns ErrNoProviderSupport, as Gitea only has milestones per repository.
+func (o *organization) Milestones() (gitprovider.MilestoneClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Members returns the organization members client.
+func (o *organization) Members() (gitprovider.OrganizationMemberClient, error) {
+	return o.members, nil
+}
+
+// Variables returns ErrNoProviderSupport, as the Gitea SDK can't read back or delete
+// organization secrets.
+func (o *organization) Variables() (gitprovider.OrganizationVariableClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AuditLog returns ErrNoProviderSupport, as Gitea has no audit log.
+func (o *organization) AuditLog() (gitprovider.AuditLogClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// SCIM returns ErrNoProviderSupport, as Gitea doesn't support SCIM.
+func (o *organization) SCIM() (gitprovider.SCIMClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// SSHCertificateAuthorities returns ErrNoProviderSupport, as Gitea only trusts SSH
+// certificate authorities configured instance-wide.
+func (o *organization) SSHCertificateAuthorities() (gitprovider.SSHCertificateAuthorityClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// IPAllowList returns ErrNoProviderSupport, as Gitea has no IP allow list per organization.
+func (o *organization) IPAllowList() (gitprovider.IPAllowListClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Set sets the desired state of the organization. In order to apply these changes, run
+// .Update() or .Reconcile().
+//
+// ErrNoProviderSupport is returned when trying to rename the organization, or to set its
+// default repository permission or two-factor authentication requirement.
+func (o *organization) Set(info gitprovider.OrganizationInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Name != nil && *info.Name != o.o.UserName {
+		return fmt.Errorf("cannot rename organization %q: %w", o.o.UserName, gitprovider.ErrNoProviderSupport)
+	}
+	if info.DefaultRepositoryPermission != nil {
+		return fmt.Errorf("organizations have no default repository permission: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.RequireTwoFactor != nil {
+		return fmt.Errorf("organizations can't require two-factor authentication: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.Description != nil {
+		o.o.Description = *info.Description
+	}
+	if info.Visibility != nil {
+		o.o.Visibility = string(visibilityToAPI(*info.Visibility))
+	}
+	return nil
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a *gitea.Organization and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (o *organization) Update(_ context.Context) error {
+	// EditOrg overwrites all fields, so send the unmanaged ones back as they are
+	opts := gitea.EditOrgOption{
+		FullName:    o.o.FullName,
+		Description: o.o.Description,
+		Website:     o.o.Website,
+		Location:    o.o.Location,
+		Visibility:  gitea.VisibleType(o.o.Visibility),
+	}
+	// PATCH /orgs/{org}
+	res, err := o.c.EditOrg(o.o.UserName, opts)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	// GET /orgs/{org}
+	apiObj, res, err := o.c.GetOrg(o.o.UserName)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	o.o = *apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (o *organization) Reconcile(ctx context.Context) (bool, error) {
+	orgs := &OrganizationsClient{clientContext: o.clientContext}
+	resp, actionTaken, err := orgs.Reconcile(ctx, o.ref, o.Get())
+	if err != nil {
+		return actionTaken, err
+	}
+	*o = *resp.(*organization)
+	return actionTaken, nil
+}
+
 func organizationFromAPI(apiObj *gitea.Organization) gitprovider.OrganizationInfo {
 	return gitprovider.OrganizationInfo{
 		Name:        &apiObj.UserName,
 		Description: &apiObj.Description,
+		Visibility:  visibilityFromAPI(gitea.VisibleType(apiObj.Visibility)),
+	}
+}
+
+// visibilityFromAPI maps the visibility of an organization to a RepositoryVisibility.
+// Limited organizations are visible to all signed-in users, like internal repositories.
+func visibilityFromAPI(visibility gitea.VisibleType) *gitprovider.RepositoryVisibility {
+	switch visibility {
+	case gitea.VisibleTypePublic:
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic)
+	case gitea.VisibleTypeLimited:
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityInternal)
+	case gitea.VisibleTypePrivate:
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate)
+	default:
+		return nil
+	}
+}
+
+// visibilityToAPI maps a RepositoryVisibility to the visibility of an organization.
+func visibilityToAPI(visibility gitprovider.RepositoryVisibility) gitea.VisibleType {
+	switch visibility {
+	case gitprovider.RepositoryVisibilityInternal:
+		return gitea.VisibleTypeLimited
+	case gitprovider.RepositoryVisibilityPrivate:
+		return gitea.VisibleTypePrivate
+	default:
+		return gitea.VisibleTypePublic
 	}
 }
 
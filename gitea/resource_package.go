@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Package = &pkg{}
+
+// pkg is a package of the repository owner. Its versions are returned
+// along with the package list, so they are kept as the API object.
+type pkg struct {
+	info gitprovider.PackageInfo
+	p    []*gitea.Package
+	c    *PackageClient
+}
+
+func (p *pkg) Get() gitprovider.PackageInfo {
+	return p.info
+}
+
+func (p *pkg) APIObject() interface{} {
+	return p.p
+}
+
+func (p *pkg) Repository() gitprovider.RepositoryRef {
+	return p.c.ref
+}
+
+// Versions returns the versions of the package, as fetched by PackageClient.List.
+func (p *pkg) Versions(_ context.Context) ([]gitprovider.PackageVersion, error) {
+	versions := make([]gitprovider.PackageVersion, 0, len(p.p))
+	for _, apiObj := range p.p {
+		versions = append(versions, &packageVersion{v: *apiObj, c: p.c})
+	}
+	return versions, nil
+}
+
+var _ gitprovider.PackageVersion = &packageVersion{}
+
+type packageVersion struct {
+	v gitea.Package
+	c *PackageClient
+}
+
+func (v *packageVersion) Get() gitprovider.PackageVersionInfo {
+	info := gitprovider.PackageVersionInfo{
+		Version:   v.v.Version,
+		CreatedAt: &v.v.CreatedAt,
+	}
+	if v.v.Type == string(gitprovider.PackageTypeContainer) {
+		info.Tags = []string{v.v.Version}
+	}
+	return info
+}
+
+func (v *packageVersion) APIObject() interface{} {
+	return &v.v
+}
+
+// Delete removes the package version, including all of its files.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *packageVersion) Delete(_ context.Context) error {
+	// DELETE /packages/{owner}/{type}/{name}/{version}
+	res, err := v.c.c.DeletePackage(v.c.ref.GetIdentity(), v.v.Type, v.v.Name, v.v.Version)
+	return handleHTTPError(res, err)
+}
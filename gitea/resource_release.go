@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+func newRelease(c *ReleaseClient, apiObj *gitea.Release) *release {
+	return &release{
+		r: *apiObj,
+		c: c,
+	}
+}
+
+var _ experimental.Release = &release{}
+
+type release struct {
+	r gitea.Release
+	c *ReleaseClient
+}
+
+func (r *release) Get() experimental.ReleaseInfo {
+	info := experimental.ReleaseInfo{
+		TagName:     r.r.TagName,
+		Name:        r.r.Title,
+		Description: r.r.Note,
+		Draft:       r.r.IsDraft,
+		Prerelease:  r.r.IsPrerelease,
+		WebURL:      r.r.HTMLURL,
+	}
+	if r.r.Target != "" {
+		info.Target = gitprovider.StringVar(r.r.Target)
+	}
+	return info
+}
+
+func (r *release) APIObject() interface{} {
+	return &r.r
+}
+
+func (r *release) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Delete deletes the release. The tag is kept.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (r *release) Delete(_ context.Context) error {
+	// DELETE /repos/{owner}/{repo}/releases/{id}
+	res, err := r.c.c.DeleteRelease(r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.ID)
+	return handleHTTPError(res, err)
+}
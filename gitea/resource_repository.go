@@ -19,11 +19,14 @@ package gitea
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"strconv"
 
 	"code.gitea.io/sdk/gitea"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
 	"github.com/fluxcd/go-git-providers/validation"
 )
 
@@ -36,6 +39,34 @@ func newUserRepository(ctx *clientContext, apiObj *gitea.Repository, ref gitprov
 			clientContext: ctx,
 			ref:           ref,
 		},
+		variables: &VariableClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		packages: &PackageClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		mirrors: &MirrorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		milestones: &MilestoneClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		commits: &CommitClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -62,18 +93,31 @@ type userRepository struct {
 
 	r   gitea.Repository // gitea
 	ref gitprovider.RepositoryRef
-
-	deployKeys   *DeployKeyClient
-	commits      *CommitClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	files        *FileClient
-	trees        *TreeClient
+	// statistics and metadata are only fetched if requested through the call options
+	statistics *gitprovider.RepositoryStatistics
+	metadata   *gitprovider.RepositoryMetadata
+
+	deployKeys    *DeployKeyClient
+	variables     *VariableClient
+	packages      *PackageClient
+	mirrors       *MirrorClient
+	issues        *IssueClient
+	labels        *LabelClient
+	milestones    *MilestoneClient
+	collaborators *CollaboratorClient
+	commits       *CommitClient
+	branches      *BranchClient
+	pullRequests  *PullRequestClient
+	files         *FileClient
+	trees         *TreeClient
 }
 
 // Get returns the repository information.
 func (r *userRepository) Get() gitprovider.RepositoryInfo {
-	return repositoryFromAPI(&r.r)
+	info := repositoryFromAPI(&r.r)
+	info.Statistics = r.statistics
+	info.Metadata = r.metadata
+	return info
 }
 
 // Set sets the repository information.
@@ -81,6 +125,12 @@ func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
 	if err := info.ValidateInfo(); err != nil {
 		return err
 	}
+	if info.Topics != nil {
+		return fmt.Errorf("repository topics: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.LFSEnabled != nil {
+		return fmt.Errorf("repository LFS settings: %w", gitprovider.ErrNoProviderSupport)
+	}
 	repositoryInfoToAPIObj(&info, &r.r)
 	return nil
 }
@@ -90,6 +140,15 @@ func (r *userRepository) APIObject() interface{} {
 	return &r.r
 }
 
+// SSHEndpoint returns the SSH endpoint reported by Gitea, which reflects the SSH_DOMAIN, SSH_PORT
+// and SSH user of the instance. Gitea doesn't report one if SSH is disabled.
+func (r *userRepository) SSHEndpoint() (gitprovider.SSHEndpoint, error) {
+	if r.r.SSHURL == "" {
+		return gitprovider.SSHEndpoint{}, fmt.Errorf("SSH is disabled: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return gitprovider.ParseSSHEndpoint(r.r.SSHURL)
+}
+
 // Repository returns the repository reference.
 func (r *userRepository) Repository() gitprovider.RepositoryRef {
 	return r.ref
@@ -101,11 +160,243 @@ func (r *userRepository) DeployKeys() gitprovider.DeployKeyClient {
 }
 
 // DeployTokens returns the deploy token client.
-// ErrNoProviderSupport is returned as the provider does not support deploy tokens.
+// ErrNoProviderSupport is returned as Gitea has no repository-scoped deploy tokens; its
+// access tokens are bound to a user and grant access to all of that user's repositories.
+// Use DeployKeys for read-only, repository-scoped access instead.
 func (r *userRepository) DeployTokens() (gitprovider.DeployTokenClient, error) {
 	return nil, gitprovider.ErrNoProviderSupport
 }
 
+// Variables returns the variable client, operating on the Gitea Actions secrets
+// of the repository.
+func (r *userRepository) Variables() (gitprovider.VariableClient, error) {
+	return r.variables, nil
+}
+
+// Artifacts returns ErrNoProviderSupport, as artifact storage isn't implemented for Gitea.
+func (r *userRepository) Artifacts() (gitprovider.ArtifactClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Packages returns the package client, operating on the packages of the repository owner
+// that are linked to the repository.
+func (r *userRepository) Packages() (gitprovider.PackageClient, error) {
+	return r.packages, nil
+}
+
+// Environments returns ErrNoProviderSupport, as Gitea has no deployment environments.
+func (r *userRepository) Environments() (gitprovider.EnvironmentClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Deployments returns ErrNoProviderSupport, as Gitea has no deployments API.
+func (r *userRepository) Deployments() (gitprovider.DeploymentClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Mirrors returns the mirror client.
+func (r *userRepository) Mirrors() (gitprovider.MirrorClient, error) {
+	return r.mirrors, nil
+}
+
+// Runners returns ErrNoProviderSupport, as the Gitea SDK can't create runner registration tokens.
+func (r *userRepository) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Issues() (gitprovider.IssueClient, error) {
+	return r.issues, nil
+}
+
+func (r *userRepository) Labels() (gitprovider.LabelClient, error) {
+	return r.labels, nil
+}
+
+func (r *userRepository) Milestones() (gitprovider.MilestoneClient, error) {
+	return r.milestones, nil
+}
+
+// Autolinks returns ErrNoProviderSupport. Gitea's external issue tracker replaces the
+// built-in issues instead of linking references in addition to them.
+func (r *userRepository) Autolinks() (gitprovider.AutolinkClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Collaborators() (gitprovider.CollaboratorClient, error) {
+	return r.collaborators, nil
+}
+
+// Releases returns the experimental ReleaseClient of the repository.
+// Use experimental.Releases to access it through the gitprovider interfaces.
+func (r *userRepository) Releases() (experimental.ReleaseClient, error) {
+	return &ReleaseClient{
+		clientContext: r.clientContext,
+		ref:           r.ref,
+	}, nil
+}
+
+func (r *userRepository) Bots() (gitprovider.BotClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AccessTokens returns ErrNoProviderSupport, as Gitea only has personal access tokens.
+func (r *userRepository) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Schedules() (gitprovider.ScheduleClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Pipelines returns ErrNoProviderSupport, as the Gitea SDK has no API for Gitea Actions runs.
+func (r *userRepository) Pipelines() (gitprovider.PipelineClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// LFSUsage returns ErrNoProviderSupport, as Gitea doesn't report the LFS storage per repository.
+func (r *userRepository) LFSUsage(_ context.Context) (int64, error) {
+	return 0, gitprovider.ErrNoProviderSupport
+}
+
+// Languages returns the share of each language in the code of the repository, in percent.
+func (r *userRepository) Languages(_ context.Context) (map[string]float64, error) {
+	// GET /repos/{owner}/{repo}/languages
+	apiObj, res, err := r.c.GetRepoLanguages(r.ref.GetIdentity(), r.ref.GetRepository())
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return gitprovider.LanguagePercentages(apiObj), nil
+}
+
+// GetUserPermission returns the permission level of the given user on the repository.
+func (r *userRepository) GetUserPermission(_ context.Context, username string) (*gitprovider.RepositoryPermission, error) {
+	// GET /repos/{owner}/{repo}/collaborators/{collaborator}/permission
+	apiObj, res, err := r.c.CollaboratorPermission(r.ref.GetIdentity(), r.ref.GetRepository(), username)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if apiObj.Permission == gitea.AccessModeNone {
+		return nil, nil
+	}
+	return getProviderPermission(apiObj.Permission), nil
+}
+
+// loadOptionalInfo fetches the statistics and metadata of the repository, if requested through
+// the call options.
+func (r *userRepository) loadOptionalInfo(ctx context.Context) error {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	if callOpts.ShouldIncludeStatistics() {
+		if err := r.loadStatistics(ctx); err != nil {
+			return err
+		}
+	}
+	if callOpts.ShouldIncludeMetadata() {
+		return r.loadMetadata(ctx)
+	}
+	return nil
+}
+
+// loadStatistics fetches the statistics of the repository.
+func (r *userRepository) loadStatistics(ctx context.Context) error {
+	languages, err := r.Languages(ctx)
+	if err != nil {
+		return err
+	}
+
+	var commitCount *int
+	if r.r.Empty {
+		commitCount = gitprovider.IntVar(0)
+	} else {
+		// Gitea reports the total number of commits when listing them
+		// GET /repos/{owner}/{repo}/commits
+		_, res, err := r.c.ListRepoCommits(r.ref.GetIdentity(), r.ref.GetRepository(), gitea.ListCommitOptions{
+			ListOptions: gitea.ListOptions{Page: 1, PageSize: 1},
+			SHA:         r.r.DefaultBranch,
+		})
+		if err != nil {
+			return handleHTTPError(res, err)
+		}
+		// Older Gitea versions don't report the total
+		if total, err := strconv.Atoi(res.Header.Get("X-Total-Count")); err == nil {
+			commitCount = &total
+		}
+	}
+
+	r.statistics = &gitprovider.RepositoryStatistics{
+		Size:             int64(r.r.Size) * 1024,
+		Languages:        languages,
+		CommitCount:      commitCount,
+		OpenIssues:       gitprovider.IntVar(r.r.OpenIssues),
+		OpenPullRequests: gitprovider.IntVar(r.r.OpenPulls),
+	}
+	return nil
+}
+
+// loadMetadata detects the README of the repository. Gitea doesn't report the license.
+func (r *userRepository) loadMetadata(ctx context.Context) error {
+	readme, err := gitprovider.DetectReadme(ctx, r.listDir)
+	if err != nil {
+		return err
+	}
+	r.metadata = &gitprovider.RepositoryMetadata{
+		ReadmePath: readme,
+	}
+	return nil
+}
+
+// DetectCI detects the CI/CD systems configured on the default branch of the repository.
+// GetWatchLevel returns whether the authenticated user watches the repository.
+func (r *userRepository) GetWatchLevel(_ context.Context) (gitprovider.WatchLevel, error) {
+	// GET /repos/{owner}/{repo}/subscription
+	watching, res, err := r.c.CheckRepoWatch(r.ref.GetIdentity(), r.ref.GetRepository())
+	if err != nil {
+		return "", handleHTTPError(res, err)
+	}
+	if watching {
+		return gitprovider.WatchLevelWatching, nil
+	}
+	return gitprovider.WatchLevelParticipating, nil
+}
+
+// SetWatchLevel watches or unwatches the repository. Gitea can't ignore a repository,
+// so ErrNoProviderSupport is returned for WatchLevelIgnoring.
+func (r *userRepository) SetWatchLevel(_ context.Context, level gitprovider.WatchLevel) error {
+	if err := gitprovider.ValidateWatchLevel(level); err != nil {
+		return fmt.Errorf("watch level %q: %w", level, err)
+	}
+	var res *gitea.Response
+	var err error
+	switch level {
+	case gitprovider.WatchLevelWatching:
+		// PUT /repos/{owner}/{repo}/subscription
+		res, err = r.c.WatchRepo(r.ref.GetIdentity(), r.ref.GetRepository())
+	case gitprovider.WatchLevelParticipating:
+		// DELETE /repos/{owner}/{repo}/subscription
+		res, err = r.c.UnWatchRepo(r.ref.GetIdentity(), r.ref.GetRepository())
+	default:
+		return fmt.Errorf("watch level %q: %w", level, gitprovider.ErrNoProviderSupport)
+	}
+	return handleHTTPError(res, err)
+}
+
+func (r *userRepository) DetectCI(ctx context.Context) (*gitprovider.CIInfo, error) {
+	return gitprovider.DetectCI(ctx, r.listDir)
+}
+
+// listDir lists the paths of the entries of dir on the default branch.
+func (r *userRepository) listDir(_ context.Context, dir string) ([]string, error) {
+	// GET /repos/{owner}/{repo}/contents/{filepath}
+	apiObjs, res, err := r.c.ListContents(r.ref.GetIdentity(), r.ref.GetRepository(), "", dir)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	paths := make([]string, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		paths = append(paths, apiObj.Path)
+	}
+	return paths, nil
+}
+
 // Commits returns the commit client.
 func (r *userRepository) Commits() gitprovider.CommitClient {
 	return r.commits
@@ -222,6 +513,131 @@ func (r *userRepository) Reconcile(ctx context.Context) (bool, error) {
 	return true, r.Update(ctx)
 }
 
+// Fork forks the repository into the given user account or organization. Gitea can only fork
+// into the account of the authenticated user, or an organization.
+func (r *userRepository) Fork(_ context.Context, target gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	opts := gitea.CreateForkOption{}
+	if target != nil {
+		if err := validateIdentityFields(target, r.domain); err != nil {
+			return nil, err
+		}
+		if target.GetType() == gitprovider.IdentityTypeOrganization {
+			org := target.GetIdentity()
+			opts.Organization = &org
+		} else {
+			// GET /user
+			user, res, err := r.c.GetMyUserInfo()
+			if err != nil {
+				return nil, handleHTTPError(res, err)
+			}
+			if !gitprovider.NamesEqual(user.UserName, target.GetIdentity(), caseSensitiveNames) {
+				return nil, gitprovider.NewErrIncorrectUser(target.GetIdentity())
+			}
+		}
+	}
+
+	// POST /repos/{owner}/{repo}/forks
+	apiObj, res, err := r.c.CreateFork(r.ref.GetIdentity(), r.ref.GetRepository(), opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if err := validateForkAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newRepositoryFromAPI(r.clientContext, apiObj, opts.Organization != nil), nil
+}
+
+// ListForks lists the forks of the repository.
+//
+// ListForks returns all available forks, using multiple paginated requests if needed.
+func (r *userRepository) ListForks(ctx context.Context) ([]gitprovider.UserRepository, error) {
+	opts := gitea.ListForksOptions{}
+	apiObjs := []*gitea.Repository{}
+	err := allPages(ctx, &opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/forks
+		pageObjs, resp, listErr := r.c.ListForks(r.ref.GetIdentity(), r.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The owner of a repository doesn't tell whether it's an organization, so look it up
+	isOrg := map[string]bool{}
+	forks := make([]gitprovider.UserRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateForkAPI(apiObj); err != nil {
+			return nil, err
+		}
+		owner := apiObj.Owner.UserName
+		if _, ok := isOrg[owner]; !ok {
+			if isOrg[owner], err = isOrganization(r.c, owner); err != nil {
+				return nil, err
+			}
+		}
+		forks = append(forks, newRepositoryFromAPI(r.clientContext, apiObj, isOrg[owner]))
+	}
+	return forks, nil
+}
+
+// Archive archives the repository, after which it's read-only.
+func (r *userRepository) Archive(_ context.Context) error {
+	return r.setArchived(true)
+}
+
+// Unarchive unarchives the repository.
+func (r *userRepository) Unarchive(_ context.Context) error {
+	return r.setArchived(false)
+}
+
+func (r *userRepository) setArchived(archived bool) error {
+	// PATCH /repos/{owner}/{repo}
+	apiObj, err := updateRepo(r.c, r.ref.GetIdentity(), r.ref.GetRepository(), &gitea.EditRepoOption{
+		Archived: &archived,
+	})
+	if err != nil {
+		return err
+	}
+	r.r = *apiObj
+	return nil
+}
+
+// Transfer transfers the repository to the given user account or organization. Transfers to
+// another user account have to be accepted by that user, in which case ErrTransferPending is returned.
+func (r *userRepository) Transfer(_ context.Context, newOwner gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	// Don't allow transferring repositories if the user didn't explicitly allow dangerous API calls.
+	if !r.destructiveActions {
+		return nil, fmt.Errorf("cannot transfer repository: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	if newOwner == nil {
+		return nil, fmt.Errorf("new owner is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	if err := validateIdentityFields(newOwner, r.domain); err != nil {
+		return nil, err
+	}
+
+	// POST /repos/{owner}/{repo}/transfer
+	apiObj, res, err := r.c.TransferRepo(r.ref.GetIdentity(), r.ref.GetRepository(), gitea.TransferRepoOption{
+		NewOwner: newOwner.GetIdentity(),
+	})
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if err := validateForkAPI(apiObj); err != nil {
+		return nil, err
+	}
+	// The original owner is returned until the new owner accepts the transfer
+	if !gitprovider.NamesEqual(apiObj.Owner.UserName, newOwner.GetIdentity(), caseSensitiveNames) {
+		return nil, fmt.Errorf("transfer of %s to %q: %w", r.ref, newOwner.GetIdentity(), gitprovider.ErrTransferPending)
+	}
+	isOrg := newOwner.GetType() == gitprovider.IdentityTypeOrganization
+	return newRepositoryFromAPI(r.clientContext, apiObj, isOrg), nil
+}
+
 // Delete deletes the current resource irreversibly.
 //
 // ErrNotFound is returned if the resource doesn't exist anymore.
@@ -239,6 +655,65 @@ func newOrgRepository(ctx *clientContext, apiObj *gitea.Repository, ref gitprovi
 	}
 }
 
+// validateForkAPI validates a forked repository, which also needs to have its owner set.
+func validateForkAPI(apiObj *gitea.Repository) error {
+	if err := validateRepositoryAPI(apiObj); err != nil {
+		return err
+	}
+	return validateAPIObject("Gitea.Repository", func(validator validation.Validator) {
+		if apiObj.Owner == nil {
+			validator.Required("Owner")
+		}
+	})
+}
+
+// newRepositoryFromAPI wraps a repository that may be owned by any user or organization, e.g. a fork.
+func newRepositoryFromAPI(ctx *clientContext, apiObj *gitea.Repository, isOrg bool) gitprovider.UserRepository {
+	if isOrg {
+		return newOrgRepository(ctx, apiObj, gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{
+				Domain:       ctx.domain,
+				Organization: apiObj.Owner.UserName,
+			},
+			RepositoryName: apiObj.Name,
+		})
+	}
+	return newUserRepository(ctx, apiObj, gitprovider.UserRepositoryRef{
+		UserRef: gitprovider.UserRef{
+			Domain:    ctx.domain,
+			UserLogin: apiObj.Owner.UserName,
+		},
+		RepositoryName: apiObj.Name,
+	})
+}
+
+// isOrganization returns true if the given owner is an organization, and false if it's a user.
+func isOrganization(c *gitea.Client, owner string) (bool, error) {
+	// GET /orgs/{org}
+	_, res, err := c.GetOrg(owner)
+	if err = handleHTTPError(res, err); err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+		return false, err
+	}
+	return err == nil, nil
+}
+
+// repositoryMoved returns the repository at its canonical location, and true, if apiObj, as
+// returned when getting ref, shows that the repository has been renamed or transferred.
+func repositoryMoved(ctx *clientContext, ref gitprovider.RepositoryRef, apiObj *gitea.Repository) (gitprovider.UserRepository, bool, error) {
+	if apiObj.Owner == nil || apiObj.Owner.UserName == "" {
+		return nil, false, nil
+	}
+	if gitprovider.NamesEqual(ref.GetIdentity(), apiObj.Owner.UserName, caseSensitiveNames) &&
+		gitprovider.NamesEqual(ref.GetRepository(), apiObj.Name, caseSensitiveNames) {
+		return nil, false, nil
+	}
+	isOrg, err := isOrganization(ctx.c, apiObj.Owner.UserName)
+	if err != nil {
+		return nil, false, err
+	}
+	return newRepositoryFromAPI(ctx, apiObj, isOrg), true, nil
+}
+
 var _ gitprovider.OrgRepository = &orgRepository{}
 
 type orgRepository struct {
@@ -281,6 +756,8 @@ func repositoryFromAPI(apiObj *gitea.Repository) gitprovider.RepositoryInfo {
 	} else {
 		repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility("private"))
 	}
+	repo.Archived = gitprovider.BoolVar(apiObj.Archived)
+	repo.WikiEnabled = gitprovider.BoolVar(apiObj.HasWiki)
 	return repo
 }
 
@@ -314,6 +791,12 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *gitea.Repo
 	if repo.Visibility != nil {
 		apiObj.Private = *gitprovider.BoolVar(string(*repo.Visibility) == "private")
 	}
+	if repo.Archived != nil {
+		apiObj.Archived = *repo.Archived
+	}
+	if repo.WikiEnabled != nil {
+		apiObj.HasWiki = *repo.WikiEnabled
+	}
 }
 
 // This function copies over the fields that are part of create/update requests of a repository
@@ -334,6 +817,7 @@ func newGiteaRepositorySpec(repo *gitea.Repository) *giteaRepositorySpec {
 
 			// Update-specific parameters
 			DefaultBranch: repo.DefaultBranch,
+			Archived:      repo.Archived,
 
 			// Create-specific parameters
 
@@ -351,5 +835,10 @@ type giteaRepositorySpec struct {
 
 // Equals compares two giteaRepositorySpec objects for equality.
 func (s *giteaRepositorySpec) Equals(other *giteaRepositorySpec) bool {
-	return reflect.DeepEqual(s, other)
+	// Names only differing in case refer to the same repository
+	desired := *s.Repository
+	if gitprovider.NamesEqual(desired.Name, other.Name, caseSensitiveNames) {
+		desired.Name = other.Name
+	}
+	return reflect.DeepEqual(&desired, other.Repository)
 }
@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// newTestClient returns a client talking to a Gitea server with the given handler.
+func newTestClient(t *testing.T, handler http.Handler, opts ...gitprovider.ClientOption) *Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"1.21.0"}`))
+	})
+	mux.Handle("/", handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("token", append([]gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.(*Client)
+}
+
+// newTestRepository returns the fluxcd/flux2 repository of a client talking to a server with the given handler.
+func newTestRepository(t *testing.T, handler http.Handler, opts ...gitprovider.ClientOption) *userRepository {
+	t.Helper()
+	c := newTestClient(t, handler, opts...)
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	return newUserRepository(c.clientContext, &gitea.Repository{Name: "flux2"}, ref)
+}
+
+// repositoryRef returns the identity and name of the repository, and whether it's owned by an organization.
+func repositoryRef(repo gitprovider.UserRepository) (string, bool) {
+	_, isOrg := repo.(gitprovider.OrgRepository)
+	return repo.Repository().GetIdentity() + "/" + repo.Repository().GetRepository(), isOrg
+}
+
+func Test_userRepository_Fork(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        func(domain string) gitprovider.IdentityRef
+		wantOrg       string
+		wantRef       string
+		incorrectUser bool
+	}{
+		{
+			name:    "into the authenticated user",
+			target:  func(string) gitprovider.IdentityRef { return nil },
+			wantRef: "alice/flux2",
+		},
+		{
+			name: "into the given user",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.UserRef{Domain: domain, UserLogin: "alice"}
+			},
+			wantRef: "alice/flux2",
+		},
+		{
+			name: "into an organization",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.OrganizationRef{Domain: domain, Organization: "my-org"}
+			},
+			wantOrg: "my-org",
+			wantRef: "my-org/flux2",
+		},
+		{
+			name: "into another user",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.UserRef{Domain: domain, UserLogin: "bob"}
+			},
+			incorrectUser: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forkReq *gitea.CreateForkOption
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method + " " + r.URL.Path {
+				case "GET /api/v1/user":
+					_, _ = w.Write([]byte(`{"login":"alice"}`))
+				case "POST /api/v1/repos/fluxcd/flux2/forks":
+					forkReq = &gitea.CreateForkOption{}
+					_ = json.NewDecoder(r.Body).Decode(forkReq)
+					owner := "alice"
+					if forkReq.Organization != nil {
+						owner = *forkReq.Organization
+					}
+					w.WriteHeader(http.StatusAccepted)
+					_, _ = w.Write([]byte(`{"name":"flux2","fork":true,"owner":{"login":"` + owner + `"},"parent":{"name":"flux2","owner":{"login":"fluxcd"}}}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			fork, err := r.Fork(context.Background(), tt.target(r.domain))
+			if tt.incorrectUser {
+				incorrectUser := &gitprovider.ErrIncorrectUser{}
+				if !errors.As(err, &incorrectUser) {
+					t.Fatalf("Fork() error = %v, want %T", err, incorrectUser)
+				}
+				if forkReq != nil {
+					t.Error("Fork() sent a fork request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fork() error = %v", err)
+			}
+			if forkReq == nil {
+				t.Fatal("Fork() didn't send a fork request")
+			}
+			got := ""
+			if forkReq.Organization != nil {
+				got = *forkReq.Organization
+			}
+			if got != tt.wantOrg {
+				t.Errorf("fork request organization = %q, want %q", got, tt.wantOrg)
+			}
+			if ref, isOrg := repositoryRef(fork); ref != tt.wantRef || isOrg != (tt.wantOrg != "") {
+				t.Errorf("Fork() = %s (organization %t), want %s (organization %t)", ref, isOrg, tt.wantRef, tt.wantOrg != "")
+			}
+		})
+	}
+}
+
+func Test_userRepository_ListForks(t *testing.T) {
+	r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/repos/fluxcd/flux2/forks":
+			if r.URL.Query().Get("page") != "1" {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[
+				{"name":"flux2","fork":true,"owner":{"login":"alice"}},
+				{"name":"flux2-fork","fork":true,"owner":{"login":"my-org"}}
+			]`))
+		case "GET /api/v1/orgs/my-org":
+			_, _ = w.Write([]byte(`{"username":"my-org"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	forks, err := r.ListForks(context.Background())
+	if err != nil {
+		t.Fatalf("ListForks() error = %v", err)
+	}
+	want := []struct {
+		ref   string
+		isOrg bool
+	}{
+		{"alice/flux2", false},
+		{"my-org/flux2-fork", true},
+	}
+	if len(forks) != len(want) {
+		t.Fatalf("ListForks() returned %d forks, want %d", len(forks), len(want))
+	}
+	for i, w := range want {
+		if ref, isOrg := repositoryRef(forks[i]); ref != w.ref || isOrg != w.isOrg {
+			t.Errorf("ListForks()[%d] = %s (organization %t), want %s (organization %t)", i, ref, isOrg, w.ref, w.isOrg)
+		}
+	}
+}
+
+func Test_userRepository_Transfer(t *testing.T) {
+	tests := []struct {
+		name         string
+		destructive  bool
+		acceptedBy   string
+		wantRef      string
+		expectedErr  error
+		wantTransfer bool
+	}{
+		{
+			name:         "to an organization",
+			destructive:  true,
+			acceptedBy:   "my-org",
+			wantRef:      "my-org/flux2",
+			wantTransfer: true,
+		},
+		{
+			name:         "not accepted yet",
+			destructive:  true,
+			acceptedBy:   "fluxcd",
+			expectedErr:  gitprovider.ErrTransferPending,
+			wantTransfer: true,
+		},
+		{
+			name:        "destructive calls are off",
+			expectedErr: gitprovider.ErrDestructiveCallDisallowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var transferReq *gitea.TransferRepoOption
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.Path != "POST /api/v1/repos/fluxcd/flux2/transfer" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				transferReq = &gitea.TransferRepoOption{}
+				_ = json.NewDecoder(r.Body).Decode(transferReq)
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"name":"flux2","owner":{"login":"` + tt.acceptedBy + `"}}`))
+			}), gitprovider.WithDestructiveAPICalls(tt.destructive))
+
+			newOwner := gitprovider.OrganizationRef{Domain: r.domain, Organization: "my-org"}
+			repo, err := r.Transfer(context.Background(), newOwner)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Transfer() error = %v, want %v", err, tt.expectedErr)
+			}
+			if (transferReq != nil) != tt.wantTransfer {
+				t.Fatalf("Transfer() sent a transfer request = %t, want %t", transferReq != nil, tt.wantTransfer)
+			}
+			if transferReq != nil && transferReq.NewOwner != "my-org" {
+				t.Errorf("transfer request new owner = %q, want %q", transferReq.NewOwner, "my-org")
+			}
+			if err != nil {
+				return
+			}
+			if ref, isOrg := repositoryRef(repo); ref != tt.wantRef || !isOrg {
+				t.Errorf("Transfer() = %s (organization %t), want %s (organization true)", ref, isOrg, tt.wantRef)
+			}
+		})
+	}
+}
+
+func Test_userRepository_GetUserPermission(t *testing.T) {
+	tests := []struct {
+		permission string
+		want       *gitprovider.RepositoryPermission
+	}{
+		{permission: "owner", want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin)},
+		{permission: "admin", want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin)},
+		{permission: "write", want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush)},
+		{permission: "read", want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull)},
+		// Users who aren't collaborators have no access
+		{permission: "none"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.permission, func(t *testing.T) {
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.Path != "GET /api/v1/repos/fluxcd/flux2/collaborators/alice/permission" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"permission":"` + tt.permission + `","role_name":"` + tt.permission + `"}`))
+			}))
+
+			got, err := r.GetUserPermission(context.Background(), "alice")
+			if err != nil {
+				t.Fatalf("GetUserPermission() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetUserPermission() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_userRepository_GetWatchLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   gitprovider.WatchLevel
+	}{
+		{name: "watching", status: http.StatusOK, want: gitprovider.WatchLevelWatching},
+		{name: "not watching", status: http.StatusNotFound, want: gitprovider.WatchLevelParticipating},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v1/repos/fluxcd/flux2/subscription", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{}`))
+			})
+			r := newTestRepository(t, mux)
+
+			got, err := r.GetWatchLevel(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("GetWatchLevel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userRepository_SetWatchLevel(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       gitprovider.WatchLevel
+		wantRequest string
+		expectedErr error
+	}{
+		{name: "watch", level: gitprovider.WatchLevelWatching, wantRequest: "PUT"},
+		{name: "unwatch", level: gitprovider.WatchLevelParticipating, wantRequest: "DELETE"},
+		{name: "ignore", level: gitprovider.WatchLevelIgnoring, expectedErr: gitprovider.ErrNoProviderSupport},
+		{name: "unknown level", level: "bogus", expectedErr: validation.ErrFieldEnumInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotRequest string
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/repos/fluxcd/flux2/subscription" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				gotRequest = r.Method
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				_, _ = w.Write([]byte(`{"subscribed":true}`))
+			}))
+
+			err := r.SetWatchLevel(context.Background(), tt.level)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("SetWatchLevel() error = %v, want %v", err, tt.expectedErr)
+			}
+			if gotRequest != tt.wantRequest {
+				t.Errorf("SetWatchLevel() sent %q, want %q", gotRequest, tt.wantRequest)
+			}
+		})
+	}
+}
@@ -123,3 +123,14 @@ func getProviderPermission(accessMode gitea.AccessMode) (permission *gitprovider
 	}
 	return permission
 }
+
+func getGiteaPermission(permission gitprovider.RepositoryPermission) gitea.AccessMode {
+	switch permission {
+	case gitprovider.RepositoryPermissionAdmin:
+		return gitea.AccessModeAdmin
+	case gitprovider.RepositoryPermissionPush, gitprovider.RepositoryPermissionMaintain:
+		return gitea.AccessModeWrite
+	default:
+		return gitea.AccessModeRead
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newVariable(c *VariableClient, s *gitea.Secret) *variable {
+	return &variable{
+		s: *s,
+		c: c,
+	}
+}
+
+var _ gitprovider.Variable = &variable{}
+
+type variable struct {
+	s gitea.Secret
+	c *VariableClient
+}
+
+// Get returns the secret information. The value is only known if it was set through Set.
+func (v *variable) Get() gitprovider.VariableInfo {
+	return variableFromAPI(&v.s)
+}
+
+// Set sets the secret information.
+func (v *variable) Set(info gitprovider.VariableInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	variableInfoToAPIObj(&info, &v.s)
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (v *variable) APIObject() interface{} {
+	return &v.s
+}
+
+// Repository returns the repository that this secret belongs to.
+func (v *variable) Repository() gitprovider.RepositoryRef {
+	return v.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// The internal API object will be overridden with the received server data.
+func (v *variable) Update(_ context.Context) error {
+	// PUT /repos/{owner}/{repo}/actions/secrets/{secretname}
+	apiObj, err := v.c.createSecret(v.c.ref.GetIdentity(), v.c.ref.GetRepository(), &v.s)
+	if err != nil {
+		return err
+	}
+	v.s = *apiObj
+	return nil
+}
+
+// Delete isn't supported, as the Gitea SDK doesn't expose the endpoint for deleting
+// repository secrets.
+func (v *variable) Delete(_ context.Context) error {
+	return fmt.Errorf("cannot delete secret %q: %w", v.s.Name, gitprovider.ErrNoProviderSupport)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// The value of a secret cannot be retrieved from Gitea,
+// consequently we have to write the secret in every reconcile call.
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (v *variable) Reconcile(ctx context.Context) (bool, error) {
+	return true, v.Update(ctx)
+}
+
+func validateVariableAPI(apiObj *gitea.Secret) error {
+	return validateAPIObject("Gitea.Secret", func(validator validation.Validator) {
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+	})
+}
+
+func variableFromAPI(apiObj *gitea.Secret) gitprovider.VariableInfo {
+	return gitprovider.VariableInfo{
+		Key:   apiObj.Name,
+		Value: apiObj.Data,
+		// Secrets are never shown in logs, and can't be limited to protected branches
+		Masked:    gitprovider.BoolVar(true),
+		Protected: gitprovider.BoolVar(false),
+	}
+}
+
+func variableToAPI(info *gitprovider.VariableInfo) *gitea.Secret {
+	s := &gitea.Secret{}
+	variableInfoToAPIObj(info, s)
+	return s
+}
+
+func variableInfoToAPIObj(info *gitprovider.VariableInfo, apiObj *gitea.Secret) {
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.Name = info.Key
+	apiObj.Data = info.Value
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package gitea
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"code.gitea.io/sdk/gitea"
 
@@ -26,6 +28,19 @@ import (
 	"github.com/fluxcd/go-git-providers/validation"
 )
 
+// caseSensitiveNames is false, as Gitea stores lowercase copies of repository and
+// organization names, and looks them up case-insensitively.
+const caseSensitiveNames = false
+
+const (
+	// defaultPageSize is the number of items Gitea returns per page by default, its
+	// DEFAULT_PAGING_NUM setting.
+	defaultPageSize = 30
+	// listPageSize is the number of items requested per page by the List iterators. Gitea caps
+	// it to its MAX_RESPONSE_ITEMS setting, 50 by default.
+	listPageSize = 50
+)
+
 // validateUserRepositoryRef makes sure the UserRepositoryRef is valid for Gitea's usage.
 func validateUserRepositoryRef(ref gitprovider.UserRepositoryRef, expectedDomain string) error {
 	// Make sure the RepositoryRef fields are valid
@@ -69,7 +84,7 @@ func validateUserRef(ref gitprovider.UserRef, expectedDomain string) error {
 // validateIdentityFields makes sure the type of the IdentityRef is supported, and the domain is as expected.
 func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string) error {
 	// Make sure the expected domain is used
-	if ref.GetDomain() != expectedDomain {
+	if !gitprovider.DomainsEqual(ref.GetDomain(), expectedDomain) {
 		return fmt.Errorf("domain %q not supported by this client: %w", ref.GetDomain(), gitprovider.ErrDomainUnsupported)
 	}
 	// Make sure the right type of identityref is used
@@ -127,21 +142,70 @@ func handleHTTPError(res *gitea.Response, err error) error {
 // // allPages runs fn for each page, expecting a HTTP request to be made and returned during that call.
 // // allPages expects that the data is saved in fn to an outer variable.
 // // allPages calls fn as many times as needed to get all pages, and modifies opts for each call.
+// // The page size, first page and number of pages honor the ListOptions of ctx.
 // // There is no need to wrap the resulting error in handleHTTPError(err), as that's already done.
-func allPages(opts *gitea.ListOptions, fn func() (*gitea.Response, error)) error {
-	opts.Page = 1
-	for {
+func allPages(ctx context.Context, opts *gitea.ListOptions, fn func() (*gitea.Response, error)) error {
+	ctx, cancel := gitprovider.CallContext(ctx)
+	defer cancel()
+	listOpts := gitprovider.CallOptionsFromContext(ctx).ListOptions()
+	if listOpts.PerPage > 0 {
+		opts.PageSize = listOpts.PageSize(defaultPageSize, listPageSize)
+	}
+	pageSize := opts.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	opts.Page = listOpts.FirstPage()
+	for fetched := pageSize; ; fetched += pageSize {
+		// The Gitea SDK doesn't take a context per request, so this is the only place a
+		// cancelled call or its deadline stops the listing.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		resp, err := fn()
 		if err != nil {
 			return handleHTTPError(resp, err)
 		}
-		if resp == nil || resp.Header.Get("Link") == "" {
+		if resp == nil || resp.Header.Get("Link") == "" || listOpts.Reached(fetched) {
 			return nil
 		}
 		opts.Page += 1
 	}
 }
 
+// iteratorPageSize returns the number of items the List iterators request per page.
+func iteratorPageSize(ctx context.Context) int {
+	return gitprovider.CallOptionsFromContext(ctx).ListOptions().PageSize(listPageSize, listPageSize)
+}
+
+// repositoryPage validates a page of repositories, and describes it using the pagination
+// headers of res.
+func repositoryPage(apiObjs []*gitea.Repository, res *gitea.Response, err error) ([]*gitea.Repository, gitprovider.PageInfo, error) {
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, handleHTTPError(res, err)
+	}
+	if _, err := validateRepositoryObjects(apiObjs); err != nil {
+		return nil, gitprovider.PageInfo{}, err
+	}
+	return apiObjs, pageInfo(res, len(apiObjs)), nil
+}
+
+// pageInfo describes a page of n items using the pagination headers of res. An empty page is
+// the last one, as Gitea keeps linking to the next page of some lists.
+func pageInfo(res *gitea.Response, n int) gitprovider.PageInfo {
+	var info gitprovider.PageInfo
+	if res == nil {
+		return info
+	}
+	if n > 0 {
+		info.NextPage = res.NextPage
+	}
+	if total, err := strconv.Atoi(res.Header.Get("X-Total-Count")); err == nil {
+		info.Total = &total
+	}
+	return info
+}
+
 // validateAPIObject creates a Validatior with the specified name, gives it to fn, and
 // depending on if any error was registered with it; either returns nil, or a MultiError
 // with both the validation error and ErrInvalidServerData, to mark that the server data
@@ -17,8 +17,13 @@ limitations under the License.
 package gitea
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"testing"
 
+	"code.gitea.io/sdk/gitea"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/fluxcd/go-git-providers/validation"
 )
@@ -52,6 +57,23 @@ func Test_validateAPIObject(t *testing.T) {
 	}
 }
 
+func Test_allPagesCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := allPages(ctx, &gitea.ListOptions{}, func() (*gitea.Response, error) {
+		calls++
+		// Cancel the call while listing the first page, as a deadline passing would.
+		cancel()
+		return &gitea.Response{Response: &http.Response{Header: http.Header{"Link": []string{`<next>; rel="next"`}}}}, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("allPages() error = %v, want %v", err, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("allPages() calls = %d, want 1", calls)
+	}
+}
+
 // func Test_allPages(t *testing.T) {
 // 	tests := []struct {
 // 		name          string
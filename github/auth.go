@@ -17,7 +17,10 @@ limitations under the License.
 package github
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/google/go-github/v66/github"
 
@@ -34,14 +37,20 @@ const (
 
 // NewClient creates a new gitprovider.Client instance for GitHub API endpoints.
 //
-// Using WithOAuth2Token you can specify authentication
-// credentials, passing no such ClientOption will allow public read access only.
+// Using WithOAuth2Token or WithTokenSource you can specify authentication
+// credentials, passing no such ClientOption, or gitprovider.WithAnonymous to make that explicit,
+// will allow public read access only.
+// The user access tokens of GitHub OAuth apps expire, use WithOAuth2TokenSource with the token
+// source of an oauth2.Config to refresh them automatically.
 //
 // Password-based authentication is not supported because it is deprecated by GitHub, see
 // https://developer.github.com/changes/2020-02-14-deprecating-password-auth/
 //
 // GitHub Enterprise can be used if you specify the domain using WithDomain.
 //
+// With WithScopeCheck, the scopes of classic personal access tokens and OAuth app tokens are
+// verified by requesting the authenticated user.
+//
 // You can customize low-level HTTP Transport functionality by using the With{Pre,Post}ChainTransportHook options.
 // You can also use conditional requests (and an in-memory cache) using WithConditionalRequests.
 //
@@ -60,23 +69,12 @@ func NewClient(optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
 		return nil, err
 	}
 
-	// Create the GitHub client either for the default github.com domain, or
-	// a custom enterprise domain if opts.Domain is set to something other than
-	// the default.
-	var gh *github.Client
-	var domain string
-
-	if opts.Domain == nil || *opts.Domain == DefaultDomain {
-		// No domain or the default github.com used
-		domain = DefaultDomain
-		gh = github.NewClient(httpClient)
-	} else {
-		// GitHub Enterprise is used
-		domain = *opts.Domain
-		baseURL := fmt.Sprintf("https://%s/api/v3/", domain)
-		uploadURL := fmt.Sprintf("https://%s/api/uploads/", domain)
-
-		if gh, err = github.NewEnterpriseClient(baseURL, uploadURL, httpClient); err != nil {
+	gh, domain, err := newGitHubClient(opts, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	if scopes := opts.RequiredScopes(); len(scopes) != 0 {
+		if err := checkScopes(context.Background(), gh, scopes); err != nil {
 			return nil, err
 		}
 	}
@@ -85,6 +83,93 @@ func NewClient(optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
 	if opts.EnableDestructiveAPICalls != nil {
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
+	followRedirects := opts.FollowRedirects == nil || *opts.FollowRedirects
+
+	c := newClient(gh, domain, destructiveActions, followRedirects, opts.TokenSource(), opts.GetLogger())
+	if opts.CommitSizeLimits != nil {
+		c.commitSizeLimits = *opts.CommitSizeLimits
+	}
+	if post := opts.PostChainTransport(); post != nil {
+		c.downloadClient, err = gitprovider.BuildClientFromTransportChain([]gitprovider.ChainableRoundTripperFunc{post})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
 
-	return newClient(gh, domain, destructiveActions), nil
+// impliedScopes maps the OAuth scopes of GitHub to the scopes they include, see
+// https://docs.github.com/en/apps/oauth-apps/building-oauth-apps/scopes-for-oauth-apps
+//
+//nolint:gochecknoglobals
+var impliedScopes = map[string][]string{
+	"repo":                  {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events"},
+	"admin:repo_hook":       {"write:repo_hook"},
+	"write:repo_hook":       {"read:repo_hook"},
+	"admin:org":             {"write:org", "manage_runners:org"},
+	"write:org":             {"read:org"},
+	"admin:public_key":      {"write:public_key"},
+	"write:public_key":      {"read:public_key"},
+	"user":                  {"read:user", "user:email", "user:follow"},
+	"project":               {"read:project"},
+	"write:packages":        {"read:packages"},
+	"admin:gpg_key":         {"write:gpg_key"},
+	"write:gpg_key":         {"read:gpg_key"},
+	"admin:ssh_signing_key": {"write:ssh_signing_key"},
+	"write:ssh_signing_key": {"read:ssh_signing_key"},
+	"admin:enterprise":      {"manage_runners:enterprise", "manage_billing:enterprise", "read:enterprise"},
+}
+
+// checkScopes verifies that the token has the required scopes, as reported by the X-OAuth-Scopes
+// header. Fine-grained personal access tokens and GitHub App tokens don't have scopes, but
+// permissions, which can't be introspected.
+func checkScopes(ctx context.Context, gh *github.Client, required []string) error {
+	_, res, err := gh.Users.Get(ctx, "")
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	granted, ok := oauthScopes(res.Header)
+	if !ok {
+		return fmt.Errorf("the token has no OAuth scopes to check: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return gitprovider.CheckScopes(granted, required, impliedScopes)
+}
+
+// oauthScopes returns the scopes in the X-OAuth-Scopes header, and whether the header is set.
+func oauthScopes(header http.Header) ([]string, bool) {
+	values, ok := header["X-Oauth-Scopes"]
+	if !ok {
+		return nil, false
+	}
+	scopes := []string{}
+	for _, scope := range strings.Split(strings.Join(values, ","), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, true
+}
+
+// newGitHubClient creates the GitHub client using httpClient either for the default github.com
+// domain, or a custom enterprise domain if opts.Domain is set to something other than the
+// default. The domain of the client is returned along with it.
+func newGitHubClient(opts *gitprovider.ClientOptions, httpClient *http.Client) (*github.Client, string, error) {
+	if opts.Domain == nil || *opts.Domain == DefaultDomain {
+		// No domain or the default github.com used
+		return github.NewClient(httpClient), DefaultDomain, nil
+	}
+
+	// GitHub Enterprise is used
+	domainURL, err := opts.DomainURL()
+	if err != nil {
+		return nil, "", err
+	}
+	baseURL := fmt.Sprintf("%s/api/v3/", domainURL)
+	uploadURL := fmt.Sprintf("%s/api/uploads/", domainURL)
+
+	gh, err := github.NewEnterpriseClient(baseURL, uploadURL, httpClient)
+	if err != nil {
+		return nil, "", err
+	}
+	return gh, *opts.Domain, nil
 }
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// appJWTLifetime is how long the JWTs authenticating as the GitHub App are valid. GitHub
+	// allows at most 10 minutes.
+	appJWTLifetime = 9 * time.Minute
+	// appJWTClockSkew is how far the JWTs are backdated, to allow for clock drift.
+	appJWTClockSkew = time.Minute
+	// installationTokenEarlyExpiry is how long before their expiry installation tokens are
+	// refreshed, so that a token doesn't expire while a request is in flight.
+	installationTokenEarlyExpiry = 5 * time.Minute
+)
+
+// NewAppClient creates a new gitprovider.Client instance for GitHub API endpoints, which
+// authenticates as the installation of a GitHub App.
+//
+// privateKey is the PEM-encoded private key of the GitHub App, as downloaded from its settings.
+// The client mints installation tokens with it, and transparently refreshes them before they
+// expire, so no long-lived personal access token is needed. The tokens have the permissions of
+// the installation.
+//
+// All options of NewClient are supported, except for WithOAuth2Token, WithOAuth2TokenSource and
+// WithTokenSource, as the client authenticates itself.
+func NewAppClient(appID, installationID int64, privateKey []byte, optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
+	key, err := parseAppPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := gitprovider.MakeClientOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Installation tokens are minted through a separate client authenticating as the App,
+	// which shares the transport talking to the API, but not the cache.
+	chain := []gitprovider.ChainableRoundTripperFunc{}
+	if post := opts.PostChainTransport(); post != nil {
+		chain = append(chain, post)
+	}
+	chain = append(chain, func(in http.RoundTripper) http.RoundTripper {
+		return &appTransport{appID: appID, key: key, base: in}
+	})
+	httpClient, err := gitprovider.BuildClientFromTransportChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	appClient, _, err := newGitHubClient(opts, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &installationTokenSource{client: appClient, installationID: installationID}
+	return NewClient(append(optFns, gitprovider.WithTokenSource(ts.Token))...)
+}
+
+// parseAppPrivateKey parses the PEM-encoded RSA private key of a GitHub App, in either the
+// PKCS #1 format GitHub uses or PKCS #8.
+func parseAppPrivateKey(privateKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, fmt.Errorf("privateKey is not PEM-encoded: %w", gitprovider.ErrInvalidClientOptions)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("privateKey is invalid: %v: %w", err, gitprovider.ErrInvalidClientOptions)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("privateKey is not an RSA key: %w", gitprovider.ErrInvalidClientOptions)
+	}
+	return rsaKey, nil
+}
+
+// appTransport authenticates requests as the GitHub App, with a new JWT for every request.
+type appTransport struct {
+	appID int64
+	key   *rsa.PrivateKey
+	base  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *appTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := signAppJWT(t.appID, t.key, time.Now())
+	if err != nil {
+		// A RoundTripper must always close the body
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	// A RoundTripper must not modify the given request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// signAppJWT returns a JWT, signed with RS256, which authenticates as the GitHub App with the
+// given ID.
+func signAppJWT(appID int64, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-appJWTClockSkew).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// installationTokenSource returns the token of the installation of a GitHub App, and mints a
+// new one with the context of the request once it's about to expire.
+type installationTokenSource struct {
+	client         *github.Client
+	installationID int64
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// Token implements gitprovider.TokenSource.
+func (s *installationTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && time.Now().Add(installationTokenEarlyExpiry).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	// POST /app/installations/{installation_id}/access_tokens
+	apiObj, _, err := s.client.Apps.CreateInstallationToken(ctx, s.installationID, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create installation token: %w", handleHTTPError(err))
+	}
+	s.token, s.expiry = apiObj.GetToken(), apiObj.GetExpiresAt().Time
+	return s.token, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_signAppJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	jwt, err := signAppJWT(123, key, now)
+	if err != nil {
+		t.Fatalf("signAppJWT() error = %v", err)
+	}
+
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("signAppJWT() = %q, want three parts", jwt)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("signAppJWT() signature is invalid: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims struct {
+		IssuedAt  int64  `json:"iat"`
+		ExpiresAt int64  `json:"exp"`
+		Issuer    string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims.Issuer != "123" || claims.IssuedAt != now.Unix()-60 || claims.ExpiresAt != now.Unix()+540 {
+		t.Errorf("signAppJWT() claims = %+v", claims)
+	}
+}
+
+func Test_parseAppPrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		pem     []byte
+		wantErr bool
+	}{
+		{
+			name: "PKCS #1",
+			pem:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		},
+		{
+			name: "PKCS #8",
+			pem:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+		},
+		{
+			name:    "not PEM",
+			pem:     []byte("not a key"),
+			wantErr: true,
+		},
+		{
+			name:    "garbage",
+			pem:     pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAppPrivateKey(tt.pem)
+			if tt.wantErr {
+				if !errors.Is(err, gitprovider.ErrInvalidClientOptions) {
+					t.Errorf("parseAppPrivateKey() error = %v, want ErrInvalidClientOptions", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAppPrivateKey() error = %v", err)
+			}
+			if !got.Equal(key) {
+				t.Error("parseAppPrivateKey() returned another key")
+			}
+		})
+	}
+}
+
+func TestNewAppClient(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var minted atomic.Int32
+	var expiresIn atomic.Int64
+	expiresIn.Store(int64(time.Hour))
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v3/app/installations/42/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); !strings.HasPrefix(got, "Bearer ") || strings.Count(got, ".") != 2 {
+			t.Errorf("minting the token authenticated with %q, want a JWT", got)
+		}
+		minted.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"token":      "ghs_installation",
+			"expires_at": time.Now().Add(time.Duration(expiresIn.Load())).Format(time.RFC3339),
+		})
+	})
+	mux.HandleFunc("GET /api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer ghs_installation" {
+			t.Errorf("request authenticated with %q, want the installation token", got)
+		}
+		_, _ = w.Write([]byte(`{"login":"app[bot]"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewAppClient(1, 42, privateKey, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatalf("NewAppClient() error = %v", err)
+	}
+	gh := c.Raw().(*github.Client)
+	for i := 0; i < 2; i++ {
+		if _, _, err := gh.Users.Get(context.Background(), ""); err != nil {
+			t.Fatalf("Users.Get() error = %v", err)
+		}
+	}
+	if got := minted.Load(); got != 1 {
+		t.Errorf("minted %d installation tokens, want the token to be reused", got)
+	}
+
+	// A token expiring within the early expiry is minted again on every request
+	expiresIn.Store(int64(installationTokenEarlyExpiry / 2))
+	c, err = NewAppClient(1, 42, privateKey, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatalf("NewAppClient() error = %v", err)
+	}
+	minted.Store(0)
+	gh = c.Raw().(*github.Client)
+	for i := 0; i < 2; i++ {
+		if _, _, err := gh.Users.Get(context.Background(), ""); err != nil {
+			t.Fatalf("Users.Get() error = %v", err)
+		}
+	}
+	if got := minted.Load(); got != 2 {
+		t.Errorf("minted %d installation tokens, want one per request for an expiring token", got)
+	}
+}
@@ -17,17 +17,27 @@ limitations under the License.
 package github
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/google/go-github/v66/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
 )
 
 func Test_DomainVariations(t *testing.T) {
 	tests := []struct {
-		name         string
-		opts         gitprovider.ClientOption
-		want         string
-		expectedErrs []error
+		name              string
+		opts              gitprovider.ClientOption
+		allowInsecureHTTP bool
+		want              string
+		expectedErrs      []error
 	}{
 		{
 			name: "github.com domain",
@@ -45,17 +55,28 @@ func Test_DomainVariations(t *testing.T) {
 			want: "https://my-github.dev.com",
 		},
 		{
-			name: "custom domain with http protocol",
-			opts: gitprovider.WithDomain("http://my-github.dev.com"),
-			want: "http://my-github.dev.com",
+			name:              "custom domain with http protocol",
+			opts:              gitprovider.WithDomain("http://my-github.dev.com"),
+			allowInsecureHTTP: true,
+			want:              "http://my-github.dev.com",
+		},
+		{
+			name:         "custom domain with http protocol, insecure HTTP not allowed",
+			opts:         gitprovider.WithDomain("http://my-github.dev.com"),
+			expectedErrs: []error{gitprovider.ErrInvalidClientOptions},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c1, _ := NewClient(tt.opts)
+			c1, err := NewClient(tt.opts, gitprovider.WithAllowInsecureHTTP(tt.allowInsecureHTTP))
+			validation.TestExpectErrors(t, "NewClient", err, tt.expectedErrs...)
+			if err != nil {
+				return
+			}
 			assertEqual(t, tt.want, c1.SupportedDomain())
 
-			c2, _ := NewClient(tt.opts)
+			c2, err := NewClient(tt.opts, gitprovider.WithAllowInsecureHTTP(tt.allowInsecureHTTP))
+			validation.TestExpectErrors(t, "NewClient", err, tt.expectedErrs...)
 			assertEqual(t, tt.want, c2.SupportedDomain())
 		})
 	}
@@ -66,3 +87,216 @@ func assertEqual(t *testing.T, a interface{}, b interface{}) {
 		t.Fatalf("%s != %s", a, b)
 	}
 }
+
+func TestNewClientScopeCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		header       []string
+		required     []string
+		wantMissing  []string
+		expectedErrs []error
+	}{
+		{
+			name:     "granted",
+			header:   []string{"repo, read:org"},
+			required: []string{"repo", "read:org"},
+		},
+		{
+			name:     "implied",
+			header:   []string{"repo, admin:org"},
+			required: []string{"public_repo", "read:org"},
+		},
+		{
+			name:         "missing",
+			header:       []string{"public_repo"},
+			required:     []string{"repo", "workflow", "public_repo"},
+			wantMissing:  []string{"repo", "workflow"},
+			expectedErrs: []error{gitprovider.ErrMissingScopes},
+		},
+		{
+			name:         "fine-grained token",
+			required:     []string{"repo"},
+			expectedErrs: []error{gitprovider.ErrNoProviderSupport},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/user" {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+				if tt.header != nil {
+					w.Header()["X-OAuth-Scopes"] = tt.header
+				}
+				_, _ = w.Write([]byte(`{"login":"octocat"}`))
+			}))
+			defer server.Close()
+
+			_, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true),
+				gitprovider.WithOAuth2Token("token"), gitprovider.WithScopeCheck(tt.required...))
+			validation.TestExpectErrors(t, "NewClient", err, tt.expectedErrs...)
+			var scopesErr *gitprovider.MissingScopesError
+			if errors.As(err, &scopesErr) && !reflect.DeepEqual(scopesErr.Missing, tt.wantMissing) {
+				t.Errorf("NewClient() missing scopes = %v, want %v", scopesErr.Missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestClientCurrentUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header()["X-OAuth-Scopes"] = []string{"repo, read:org"}
+		w.Header().Set("GitHub-Authentication-Token-Expiration", "2026-11-01 12:00:00 UTC")
+		_, _ = w.Write([]byte(`{"id":1,"login":"octocat","name":"The Octocat","type":"User"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true), gitprovider.WithOAuth2Token("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.CurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentUser() error = %v", err)
+	}
+	name := "The Octocat"
+	expiry := time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC)
+	want := gitprovider.CurrentUserInfo{ID: 1, Username: "octocat", Name: &name, Scopes: []string{"repo", "read:org"}, TokenExpiresAt: &expiry}
+	if info.TokenExpiresAt == nil || !info.TokenExpiresAt.Equal(expiry) {
+		t.Errorf("CurrentUser() token expiry = %v, want %v", info.TokenExpiresAt, expiry)
+	}
+	info.TokenExpiresAt = want.TokenExpiresAt
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("CurrentUser() = %+v, want %+v", info, want)
+	}
+}
+
+func TestClientCheckCapability(t *testing.T) {
+	tests := []struct {
+		name         string
+		token        string
+		capability   gitprovider.Capability
+		expectedErrs []error
+	}{
+		{
+			name:       "classic token",
+			token:      "ghp_classic",
+			capability: gitprovider.CapabilityPackages,
+		},
+		{
+			name:         "fine-grained token",
+			token:        "github_pat_finegrained",
+			capability:   gitprovider.CapabilityPackages,
+			expectedErrs: []error{gitprovider.ErrNoProviderSupport},
+		},
+		{
+			name:         "fine-grained token audit log",
+			token:        "github_pat_finegrained",
+			capability:   gitprovider.CapabilityAuditLog,
+			expectedErrs: []error{gitprovider.ErrNoProviderSupport},
+		},
+		{
+			name:       "fine-grained token ssh keys",
+			token:      "github_pat_finegrained",
+			capability: gitprovider.CapabilitySSHKeys,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient(gitprovider.WithOAuth2Token(tt.token))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = c.CheckCapability(context.Background(), tt.capability)
+			validation.TestExpectErrors(t, "CheckCapability", err, tt.expectedErrs...)
+			var capErr *gitprovider.CapabilityError
+			if err != nil && (!errors.As(err, &capErr) || capErr.Capability != tt.capability) {
+				t.Errorf("CheckCapability() error = %v, want a *CapabilityError for %s", err, tt.capability)
+			}
+		})
+	}
+
+	// Anonymous clients don't have a token to tell
+	c, err := NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CheckCapability(context.Background(), gitprovider.CapabilityPackages); err != nil {
+		t.Errorf("CheckCapability() error = %v", err)
+	}
+}
+
+func TestCapabilityCheckedOnUse(t *testing.T) {
+	requested := 0
+	c, err := NewClient(gitprovider.WithTokenSource(func(context.Context) (string, error) {
+		requested++
+		return "github_pat_finegrained", nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := c.(*Client).clientContext
+	org := newOrganization(cc, &github.Organization{}, gitprovider.OrganizationRef{Domain: "github.com", Organization: "fluxcd"})
+	repo := newUserRepository(cc, &github.Repository{}, gitprovider.UserRepositoryRef{
+		UserRef:        gitprovider.UserRef{Domain: "github.com", UserLogin: "fluxcd"},
+		RepositoryName: "flux2",
+	})
+
+	// The accessors don't request the token
+	auditLog, err := org.AuditLog()
+	if err != nil {
+		t.Fatalf("AuditLog() error = %v", err)
+	}
+	scim, err := org.SCIM()
+	if err != nil {
+		t.Fatalf("SCIM() error = %v", err)
+	}
+	packages, err := repo.Packages()
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if requested != 0 {
+		t.Errorf("the accessors requested the token %d times, want none", requested)
+	}
+
+	// Using the clients does, and reports that fine-grained tokens can't access them
+	ctx := context.Background()
+	var capErr *gitprovider.CapabilityError
+	if err := auditLog.Stream(ctx, gitprovider.AuditLogOptions{}, func(gitprovider.AuditEventInfo) error { return nil }); !errors.As(err, &capErr) {
+		t.Errorf("Stream() error = %v, want a *CapabilityError", err)
+	}
+	if _, err := scim.List(ctx); !errors.As(err, &capErr) {
+		t.Errorf("SCIM List() error = %v, want a *CapabilityError", err)
+	}
+	if _, err := packages.List(ctx); !errors.As(err, &capErr) {
+		t.Errorf("Packages List() error = %v, want a *CapabilityError", err)
+	}
+}
+
+func TestClientSSHHostKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/meta" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ssh_keys":["ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := c.SSHHostKeys(context.Background())
+	if err != nil {
+		t.Fatalf("SSHHostKeys() error = %v", err)
+	}
+	want := []gitprovider.SSHHostKey{{
+		Host:        "127.0.0.1",
+		Key:         "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl",
+		Fingerprint: "SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU",
+	}}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("SSHHostKeys() = %+v, want %+v", keys, want)
+	}
+}
@@ -18,8 +18,13 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-github/v66/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -28,9 +33,9 @@ import (
 // ProviderID is the provider ID for GitHub.
 const ProviderID = gitprovider.ProviderID("github")
 
-func newClient(c *github.Client, domain string, destructiveActions bool) *Client {
+func newClient(c *github.Client, domain string, destructiveActions, followRedirects bool, tokenSource gitprovider.TokenSource, log logr.Logger) *Client {
 	ghClient := &githubClientImpl{c, destructiveActions}
-	ctx := &clientContext{ghClient, domain, destructiveActions}
+	ctx := &clientContext{ghClient, domain, destructiveActions, followRedirects, tokenSource, log, defaultCommitSizeLimits, http.DefaultClient}
 	return &Client{
 		clientContext: ctx,
 		orgs: &OrganizationsClient{
@@ -42,6 +47,15 @@ func newClient(c *github.Client, domain string, destructiveActions bool) *Client
 		userRepos: &UserRepositoriesClient{
 			clientContext: ctx,
 		},
+		gpgKeys: &GPGKeyClient{
+			clientContext: ctx,
+		},
+		sshKeys: &SSHKeyClient{
+			clientContext: ctx,
+		},
+		instance: &InstanceClient{
+			clientContext: ctx,
+		},
 	}
 }
 
@@ -49,6 +63,45 @@ type clientContext struct {
 	c                  githubClient
 	domain             string
 	destructiveActions bool
+	followRedirects    bool
+	// tokenSource returns the token of the client, if known, to tell fine-grained personal
+	// access tokens apart.
+	tokenSource gitprovider.TokenSource
+	log         logr.Logger
+	// commitSizeLimits are the limits commits are checked against before they're sent.
+	commitSizeLimits gitprovider.SizeLimits
+	// downloadClient follows the redirects of downloads to storage hosts. It shares the
+	// transport talking to the API, but not the authentication of the API client.
+	downloadClient *http.Client
+}
+
+// fineGrainedTokenPrefix is the prefix of fine-grained personal access tokens, see
+// https://github.blog/engineering/platform-security/behind-githubs-new-authentication-token-formats/
+const fineGrainedTokenPrefix = "github_pat_"
+
+// checkCapability returns a *gitprovider.CapabilityError for the capabilities fine-grained
+// personal access tokens can't access: GitHub Packages, the organization audit log and SCIM
+// only accept classic personal access tokens, and respond with 403 Forbidden otherwise.
+func (c *clientContext) checkCapability(ctx context.Context, capability gitprovider.Capability) error {
+	switch capability {
+	case gitprovider.CapabilityPackages, gitprovider.CapabilityAuditLog, gitprovider.CapabilitySCIM:
+	default:
+		return nil
+	}
+	if c.tokenSource == nil {
+		return nil
+	}
+	token, err := c.tokenSource(ctx)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(token, fineGrainedTokenPrefix) {
+		return &gitprovider.CapabilityError{
+			Capability: capability,
+			Reason:     "fine-grained personal access tokens can't access it, use a classic personal access token",
+		}
+	}
+	return nil
 }
 
 // Client implements the gitprovider.Client interface.
@@ -61,6 +114,9 @@ type Client struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+	gpgKeys   *GPGKeyClient
+	sshKeys   *SSHKeyClient
+	instance  *InstanceClient
 }
 
 // SupportedDomain returns the domain endpoint for this client, e.g. "github.com", "enterprise.github.com" or
@@ -98,6 +154,84 @@ func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
 	return c.userRepos
 }
 
+// GPGKeys returns the GPGKeyClient handling the GPG keys of the authenticated user.
+func (c *Client) GPGKeys() (gitprovider.GPGKeyClient, error) {
+	return c.gpgKeys, nil
+}
+
+// SSHKeys returns the SSHKeyClient handling the SSH keys of the authenticated user.
+func (c *Client) SSHKeys() (gitprovider.SSHKeyClient, error) {
+	return c.sshKeys, nil
+}
+
+// Instance returns the InstanceClient handling the administration of the instance.
+func (c *Client) Instance() gitprovider.InstanceClient {
+	return c.instance
+}
+
+// Runners returns ErrNoProviderSupport, as GitHub runners are registered with an
+// enterprise, organization or repository, and not with the instance.
+func (c *Client) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// CheckCapability returns a *gitprovider.CapabilityError for the capabilities fine-grained
+// personal access tokens can't access, which are detected by their "github_pat_" prefix.
+func (c *Client) CheckCapability(ctx context.Context, capability gitprovider.Capability) error {
+	return c.checkCapability(ctx, capability)
+}
+
+// SSHHostKeys returns the SSH host keys published in the API meta information of GitHub, or of
+// the GitHub Enterprise Server instance, whose SSH endpoint listens on the default port.
+func (c *Client) SSHHostKeys(ctx context.Context) ([]gitprovider.SSHHostKey, error) {
+	// GET /meta
+	meta, _, err := c.c.Client().Meta.Get(ctx)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	u, err := url.Parse(gitprovider.GetDomainURL(c.domain))
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]gitprovider.SSHHostKey, 0, len(meta.SSHKeys))
+	for _, key := range meta.SSHKeys {
+		hostKey, err := gitprovider.NewSSHHostKey(u.Hostname(), 0, key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SSH host key: %w", err)
+		}
+		keys = append(keys, hostKey)
+	}
+	return keys, nil
+}
+
+// CurrentUser returns the identity the client is authenticated as. The scopes are only known for
+// classic personal access tokens and OAuth app tokens, the expiry only for expiring personal
+// access tokens.
+func (c *Client) CurrentUser(ctx context.Context) (gitprovider.CurrentUserInfo, error) {
+	// GET /user
+	user, res, err := c.c.Client().Users.Get(ctx, "")
+	if err != nil {
+		return gitprovider.CurrentUserInfo{}, handleHTTPError(err)
+	}
+	info := gitprovider.CurrentUserInfo{
+		ID:       user.GetID(),
+		Username: user.GetLogin(),
+		Name:     user.Name,
+		Email:    user.Email,
+		Bot:      user.GetType() == "Bot",
+	}
+	info.Scopes, _ = oauthScopes(res.Header)
+	if expiry := res.Header.Get("GitHub-Authentication-Token-Expiration"); expiry != "" {
+		for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+			if t, err := time.Parse(layout, expiry); err == nil {
+				info.TokenExpiresAt = &t
+				break
+			}
+		}
+	}
+	return info, nil
+}
+
 //nolint:gochecknoglobals
 var permissionScopes = map[gitprovider.TokenPermission]string{
 	gitprovider.TokenPermissionRWRepository: "repo",
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// InstanceClient implements the gitprovider.InstanceClient interface.
+var _ gitprovider.InstanceClient = &InstanceClient{}
+
+// InstanceClient operates on the GitHub instance as a whole.
+type InstanceClient struct {
+	*clientContext
+}
+
+// Users returns ErrNoProviderSupport, as user accounts are managed through SCIM in GitHub
+// Enterprise, see Organization.SCIM().
+func (c *InstanceClient) Users() (gitprovider.AdminUserClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AuditLogClient implements the gitprovider.AuditLogClient interface.
+var _ gitprovider.AuditLogClient = &AuditLogClient{}
+
+// AuditLogClient reads the audit log of a specific organization. The audit log API is only
+// available to owners of organizations on the GitHub Enterprise Cloud plan.
+type AuditLogClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Stream calls fn for each web event of the audit log within the time range given by opts,
+// newest first, following the cursors of the audit log API.
+func (c *AuditLogClient) Stream(ctx context.Context, opts gitprovider.AuditLogOptions, fn func(gitprovider.AuditEventInfo) error) error {
+	if err := opts.ValidateOptions(); err != nil {
+		return err
+	}
+	if err := c.checkCapability(ctx, gitprovider.CapabilityAuditLog); err != nil {
+		return err
+	}
+
+	apiOpts := &github.GetAuditLogOptions{
+		ListCursorOptions: github.ListCursorOptions{PerPage: 100},
+	}
+	if phrase := auditLogPhrase(opts); phrase != "" {
+		apiOpts.Phrase = &phrase
+	}
+	for {
+		// GET /orgs/{org}/audit-log
+		apiObjs, resp, err := c.c.Client().Organizations.GetAuditLog(ctx, c.ref.Organization, apiOpts)
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		for _, apiObj := range apiObjs {
+			if err := fn(auditEventFromAPI(apiObj)); err != nil {
+				return err
+			}
+		}
+		if resp.After == "" {
+			return nil
+		}
+		apiOpts.After = resp.After
+	}
+}
+
+// auditLogPhrase returns the search phrase selecting the time range of opts.
+func auditLogPhrase(opts gitprovider.AuditLogOptions) string {
+	qualifiers := []string{}
+	if opts.Since != nil {
+		qualifiers = append(qualifiers, fmt.Sprintf("created:>=%s", opts.Since.UTC().Format(time.RFC3339)))
+	}
+	if opts.Until != nil {
+		qualifiers = append(qualifiers, fmt.Sprintf("created:<%s", opts.Until.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(qualifiers, " ")
+}
+
+// auditEventFromAPI converts an audit log entry. The target is the affected user, or else the
+// affected repository or team, which are only available as additional fields.
+func auditEventFromAPI(apiObj *github.AuditEntry) gitprovider.AuditEventInfo {
+	target := apiObj.GetUser()
+	for _, field := range []string{"repo", "team"} {
+		if value, ok := apiObj.AdditionalFields[field].(string); ok && target == "" {
+			target = value
+		}
+	}
+	return gitprovider.AuditEventInfo{
+		ID:        apiObj.GetDocumentID(),
+		Action:    apiObj.GetAction(),
+		Actor:     apiObj.GetActor(),
+		Target:    target,
+		CreatedAt: apiObj.GetCreatedAt().Time,
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_auditLogPhrase(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 1, 31, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+	tests := []struct {
+		name string
+		opts gitprovider.AuditLogOptions
+		want string
+	}{
+		{
+			name: "no time range",
+			want: "",
+		},
+		{
+			name: "since",
+			opts: gitprovider.AuditLogOptions{Since: &since},
+			want: "created:>=2024-01-01T00:00:00Z",
+		},
+		{
+			name: "since and until",
+			opts: gitprovider.AuditLogOptions{Since: &since, Until: &until},
+			want: "created:>=2024-01-01T00:00:00Z created:<2024-01-31T11:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := auditLogPhrase(tt.opts); got != tt.want {
+				t.Errorf("auditLogPhrase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_auditEventFromAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want gitprovider.AuditEventInfo
+	}{
+		{
+			name: "user target",
+			data: `{"_document_id":"a1","action":"org.add_member","actor":"octocat","user":"hubot","created_at":1704067200000}`,
+			want: gitprovider.AuditEventInfo{ID: "a1", Action: "org.add_member", Actor: "octocat", Target: "hubot", CreatedAt: time.Unix(1704067200, 0)},
+		},
+		{
+			name: "repository target",
+			data: `{"_document_id":"a2","action":"repo.create","actor":"octocat","repo":"fluxcd/flux2","created_at":1704067200000}`,
+			want: gitprovider.AuditEventInfo{ID: "a2", Action: "repo.create", Actor: "octocat", Target: "fluxcd/flux2", CreatedAt: time.Unix(1704067200, 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiObj := &github.AuditEntry{}
+			if err := json.Unmarshal([]byte(tt.data), apiObj); err != nil {
+				t.Fatal(err)
+			}
+			got := auditEventFromAPI(apiObj)
+			if got.ID != tt.want.ID || got.Action != tt.want.Action || got.Actor != tt.want.Actor ||
+				got.Target != tt.want.Target || !got.CreatedAt.Equal(tt.want.CreatedAt) {
+				t.Errorf("auditEventFromAPI() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
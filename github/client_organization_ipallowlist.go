@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IPAllowListClient implements the gitprovider.IPAllowListClient interface.
+var _ gitprovider.IPAllowListClient = &IPAllowListClient{}
+
+// IPAllowListClient operates on the IP allow list of a specific organization, which needs
+// GitHub Enterprise Cloud. The allow list is only available through the GraphQL API.
+type IPAllowListClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// ipAllowListEntry is an IpAllowListEntry object of the GraphQL API.
+type ipAllowListEntry struct {
+	ID             string  `json:"id"`
+	AllowListValue string  `json:"allowListValue"`
+	Name           *string `json:"name"`
+	IsActive       bool    `json:"isActive"`
+}
+
+// ipAllowListEntryFields are the fields of an IpAllowListEntry decoded into ipAllowListEntry.
+const ipAllowListEntryFields = `id allowListValue name isActive`
+
+// List lists the entries of the IP allow list.
+//
+// List returns all entries, using multiple paginated requests if needed.
+func (c *IPAllowListClient) List(ctx context.Context) ([]gitprovider.IPAllowListEntryInfo, error) {
+	entries := []gitprovider.IPAllowListEntryInfo{}
+	var cursor *string
+	for {
+		page, next, err := c.listPage(ctx, listPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page...)
+		if next == nil {
+			return gitprovider.LimitItems(ctx, entries), nil
+		}
+		cursor = next
+	}
+}
+
+// ListIter returns an iterator over the allow list entries List returns, fetching them a page at a
+// time. The GraphQL API pages by cursor, so the pages are fetched from the first one, in order.
+func (c *IPAllowListClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.IPAllowListEntryInfo] {
+	var cursor *string
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.IPAllowListEntryInfo, gitprovider.PageInfo, error) {
+		entries, next, err := c.listPage(ctx, iteratorPageSize(ctx), cursor)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		var info gitprovider.PageInfo
+		if next != nil {
+			info.NextPage = page + 1
+			cursor = next
+		}
+		return entries, info, nil
+	})
+}
+
+// listPage returns up to first entries following cursor, and the cursor of the next page, or nil
+// if this is the last page.
+func (c *IPAllowListClient) listPage(ctx context.Context, first int, cursor *string) ([]gitprovider.IPAllowListEntryInfo, *string, error) {
+	var data struct {
+		Organization *struct {
+			IPAllowListEntries struct {
+				Nodes    []ipAllowListEntry `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"ipAllowListEntries"`
+		} `json:"organization"`
+	}
+	err := graphQL(ctx, c.c.Client(), `query($login: String!, $first: Int!, $cursor: String) {
+  organization(login: $login) {
+    ipAllowListEntries(first: $first, after: $cursor) {
+      nodes { `+ipAllowListEntryFields+` }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`, map[string]interface{}{
+		"login":  c.ref.Organization,
+		"first":  first,
+		"cursor": cursor,
+	}, &data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if data.Organization == nil {
+		return nil, nil, gitprovider.ErrNotFound
+	}
+	page := data.Organization.IPAllowListEntries
+	entries := make([]gitprovider.IPAllowListEntryInfo, 0, len(page.Nodes))
+	for _, node := range page.Nodes {
+		entries = append(entries, ipAllowListEntryFromAPI(node))
+	}
+	if !page.PageInfo.HasNextPage {
+		return entries, nil, nil
+	}
+	return entries, &page.PageInfo.EndCursor, nil
+}
+
+// Create adds an entry to the IP allow list.
+//
+// ErrAlreadyExists is returned if an entry with the same value exists.
+func (c *IPAllowListClient) Create(ctx context.Context, req gitprovider.IPAllowListEntryInfo) (*gitprovider.IPAllowListEntryInfo, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.get(ctx, req.Value); err == nil {
+		return nil, fmt.Errorf("IP allow list entry %q: %w", req.Value, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	// GET /orgs/{org}
+	org, _, err := c.c.Client().Organizations.Get(ctx, c.ref.Organization)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	var data struct {
+		CreateIPAllowListEntry struct {
+			IPAllowListEntry ipAllowListEntry `json:"ipAllowListEntry"`
+		} `json:"createIpAllowListEntry"`
+	}
+	err = graphQL(ctx, c.c.Client(), `mutation($owner: ID!, $value: String!, $name: String, $active: Boolean!) {
+  createIpAllowListEntry(input: {ownerId: $owner, allowListValue: $value, name: $name, isActive: $active}) {
+    ipAllowListEntry { `+ipAllowListEntryFields+` }
+  }
+}`, map[string]interface{}{
+		"owner":  org.GetNodeID(),
+		"value":  req.Value,
+		"name":   req.Name,
+		"active": *req.Active,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+	entry := ipAllowListEntryFromAPI(data.CreateIPAllowListEntry.IPAllowListEntry)
+	return &entry, nil
+}
+
+// Delete removes the entry with the given GraphQL node ID from the IP allow list.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *IPAllowListClient) Delete(ctx context.Context, id string) error {
+	return graphQL(ctx, c.c.Client(), `mutation($id: ID!) {
+  deleteIpAllowListEntry(input: {ipAllowListEntryId: $id}) { clientMutationId }
+}`, map[string]interface{}{
+		"id": id,
+	}, nil)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If an entry with the same value doesn't exist, it is created.
+// If the entry exists but differs from the desired state, it is updated.
+// If the actual state equals the desired state, no action is taken.
+func (c *IPAllowListClient) Reconcile(ctx context.Context, req gitprovider.IPAllowListEntryInfo) (*gitprovider.IPAllowListEntryInfo, bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.get(ctx, req.Value)
+	if errors.Is(err, gitprovider.ErrNotFound) {
+		c.log.V(1).Info("creating IP allow list entry, as it doesn't exist", "organization", c.ref.String(), "value", req.Value)
+		resp, err := c.Create(ctx, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(*actual) {
+		c.log.V(1).Info("IP allow list entry is up to date", "organization", c.ref.String(), "value", req.Value)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating IP allow list entry to the desired state", "organization", c.ref.String(), "value", req.Value)
+	var data struct {
+		UpdateIPAllowListEntry struct {
+			IPAllowListEntry ipAllowListEntry `json:"ipAllowListEntry"`
+		} `json:"updateIpAllowListEntry"`
+	}
+	err = graphQL(ctx, c.c.Client(), `mutation($id: ID!, $value: String!, $name: String, $active: Boolean!) {
+  updateIpAllowListEntry(input: {ipAllowListEntryId: $id, allowListValue: $value, name: $name, isActive: $active}) {
+    ipAllowListEntry { `+ipAllowListEntryFields+` }
+  }
+}`, map[string]interface{}{
+		"id":     actual.ID,
+		"value":  req.Value,
+		"name":   req.Name,
+		"active": *req.Active,
+	}, &data)
+	if err != nil {
+		return nil, false, err
+	}
+	entry := ipAllowListEntryFromAPI(data.UpdateIPAllowListEntry.IPAllowListEntry)
+	return &entry, true, nil
+}
+
+// get returns the entry with the given value.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *IPAllowListClient) get(ctx context.Context, value string) (*gitprovider.IPAllowListEntryInfo, error) {
+	entries, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if entries[i].Value == value {
+			return &entries[i], nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+func ipAllowListEntryFromAPI(apiObj ipAllowListEntry) gitprovider.IPAllowListEntryInfo {
+	return gitprovider.IPAllowListEntryInfo{
+		ID:     apiObj.ID,
+		Value:  apiObj.AllowListValue,
+		Name:   apiObj.Name,
+		Active: gitprovider.BoolVar(apiObj.IsActive),
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// orgMembershipRoleAdmin is the role of organization owners, both for members and invitations.
+	orgMembershipRoleAdmin = "admin"
+	// orgMembershipRoleMember is the role of regular organization members.
+	orgMembershipRoleMember = "member"
+	// orgInvitationRoleBillingManager is the role of invited billing managers.
+	orgInvitationRoleBillingManager = "billing_manager"
+)
+
+// OrganizationMemberClient implements the gitprovider.OrganizationMemberClient interface.
+var _ gitprovider.OrganizationMemberClient = &OrganizationMemberClient{}
+
+// OrganizationMemberClient operates on the members of a specific organization.
+type OrganizationMemberClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// List lists the owners and members of the organization, followed by the pending invitations
+// of users. Invitations by email address only are skipped, as they don't have a username.
+// GitHub has no API to list billing managers who accepted their invitation.
+//
+// List returns all members, using multiple paginated requests if needed.
+func (c *OrganizationMemberClient) List(ctx context.Context) ([]gitprovider.OrganizationMemberInfo, error) {
+	members := []gitprovider.OrganizationMemberInfo{}
+	for _, role := range []string{orgMembershipRoleAdmin, orgMembershipRoleMember} {
+		opts := &github.ListMembersOptions{Role: role}
+		err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+			// GET /orgs/{org}/members
+			pageObjs, resp, listErr := c.c.Client().Organizations.ListMembers(ctx, c.ref.Organization, opts)
+			for _, apiObj := range pageObjs {
+				members = append(members, gitprovider.OrganizationMemberInfo{
+					Username: apiObj.GetLogin(),
+					Role:     gitprovider.OrganizationRoleVar(organizationRoleFromAPI(role)),
+				})
+			}
+			return resp, listErr
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	invitations, err := c.listInvitations(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range invitations {
+		if apiObj.GetLogin() == "" {
+			continue
+		}
+		members = append(members, gitprovider.OrganizationMemberInfo{
+			Username: apiObj.GetLogin(),
+			Role:     gitprovider.OrganizationRoleVar(organizationRoleFromAPI(apiObj.GetRole())),
+			Pending:  true,
+		})
+	}
+	return gitprovider.LimitItems(ctx, members), nil
+}
+
+// ListIter returns an iterator over the members List returns, fetching them a page at a time:
+// the admins first, then the other members, then the pending invitations.
+func (c *OrganizationMemberClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.OrganizationMemberInfo] {
+	return gitprovider.NewIterator(ctx, gitprovider.ConcatPages(
+		c.membersPage(orgMembershipRoleAdmin),
+		c.membersPage(orgMembershipRoleMember),
+		c.invitationsPage,
+	))
+}
+
+// membersPage returns a PageFunc listing the members with the given role.
+func (c *OrganizationMemberClient) membersPage(role string) gitprovider.PageFunc[gitprovider.OrganizationMemberInfo] {
+	return func(ctx context.Context, page int) ([]gitprovider.OrganizationMemberInfo, gitprovider.PageInfo, error) {
+		opts := &github.ListMembersOptions{Role: role, ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /orgs/{org}/members
+		apiObjs, resp, err := c.c.Client().Organizations.ListMembers(ctx, c.ref.Organization, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+		members := make([]gitprovider.OrganizationMemberInfo, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			members = append(members, gitprovider.OrganizationMemberInfo{
+				Username: apiObj.GetLogin(),
+				Role:     gitprovider.OrganizationRoleVar(organizationRoleFromAPI(role)),
+			})
+		}
+		return members, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	}
+}
+
+// invitationsPage lists a page of the pending invitations of users with a GitHub account.
+func (c *OrganizationMemberClient) invitationsPage(ctx context.Context, page int) ([]gitprovider.OrganizationMemberInfo, gitprovider.PageInfo, error) {
+	opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+	// GET /orgs/{org}/invitations
+	apiObjs, resp, err := c.c.Client().Organizations.ListPendingOrgInvitations(ctx, c.ref.Organization, opts)
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+	}
+	members := make([]gitprovider.OrganizationMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if apiObj.GetLogin() == "" {
+			continue
+		}
+		members = append(members, gitprovider.OrganizationMemberInfo{
+			Username: apiObj.GetLogin(),
+			Role:     gitprovider.OrganizationRoleVar(organizationRoleFromAPI(apiObj.GetRole())),
+			Pending:  true,
+		})
+	}
+	return members, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+}
+
+// Invite invites the user to the organization with the given role, or changes the role of
+// an existing member. The user becomes a member once the invitation is accepted.
+func (c *OrganizationMemberClient) Invite(ctx context.Context, req gitprovider.OrganizationMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+
+	if *req.Role == gitprovider.OrganizationRoleBilling {
+		// Billing managers can only be invited, which requires the ID of the user.
+		// GET /users/{username}
+		user, _, err := c.c.Client().Users.Get(ctx, req.Username)
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		opts := &github.CreateOrgInvitationOptions{
+			InviteeID: user.ID,
+			Role:      github.String(orgInvitationRoleBillingManager),
+		}
+		// POST /orgs/{org}/invitations
+		_, _, err = c.c.Client().Organizations.CreateOrgInvitation(ctx, c.ref.Organization, opts)
+		return handleHTTPError(err)
+	}
+
+	role := orgMembershipRoleMember
+	if *req.Role == gitprovider.OrganizationRoleOwner {
+		role = orgMembershipRoleAdmin
+	}
+	// PUT /orgs/{org}/memberships/{username}
+	_, _, err := c.c.Client().Organizations.EditOrgMembership(ctx, req.Username, c.ref.Organization, &github.Membership{Role: github.String(role)})
+	return handleHTTPError(err)
+}
+
+// Remove removes the user from the organization, or cancels the pending invitation of the user.
+//
+// ErrNotFound is returned if the user isn't a member of the organization.
+func (c *OrganizationMemberClient) Remove(ctx context.Context, username string) error {
+	invitations, err := c.listInvitations(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return err
+	}
+	for _, apiObj := range invitations {
+		if apiObj.GetLogin() == username {
+			// DELETE /orgs/{org}/invitations/{invitation_id}
+			_, err := c.c.Client().Organizations.CancelInvite(ctx, c.ref.Organization, apiObj.GetID())
+			return handleHTTPError(err)
+		}
+	}
+
+	// DELETE /orgs/{org}/memberships/{username}
+	_, err = c.c.Client().Organizations.RemoveOrgMembership(ctx, username, c.ref.Organization)
+	return handleHTTPError(err)
+}
+
+// listInvitations lists the pending invitations of the organization.
+func (c *OrganizationMemberClient) listInvitations(ctx context.Context) ([]*github.Invitation, error) {
+	apiObjs := []*github.Invitation{}
+	opts := &github.ListOptions{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /orgs/{org}/invitations
+		pageObjs, resp, listErr := c.c.Client().Organizations.ListPendingOrgInvitations(ctx, c.ref.Organization, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+// organizationRoleFromAPI maps the role of a membership or invitation to an OrganizationRole.
+func organizationRoleFromAPI(role string) gitprovider.OrganizationRole {
+	switch role {
+	case orgMembershipRoleAdmin:
+		return gitprovider.OrganizationRoleOwner
+	case orgInvitationRoleBillingManager:
+		return gitprovider.OrganizationRoleBilling
+	default:
+		return gitprovider.OrganizationRoleMember
+	}
+}
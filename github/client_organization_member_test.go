@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_organizationRoleFromAPI(t *testing.T) {
+	tests := []struct {
+		role string
+		want gitprovider.OrganizationRole
+	}{
+		{"admin", gitprovider.OrganizationRoleOwner},
+		{"member", gitprovider.OrganizationRoleMember},
+		{"direct_member", gitprovider.OrganizationRoleMember},
+		{"billing_manager", gitprovider.OrganizationRoleBilling},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			if got := organizationRoleFromAPI(tt.role); got != tt.want {
+				t.Errorf("organizationRoleFromAPI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
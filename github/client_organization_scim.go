@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// scimPageSize is the number of provisioned identities requested per page.
+const scimPageSize = 100
+
+// SCIMClient implements the gitprovider.SCIMClient interface.
+var _ gitprovider.SCIMClient = &SCIMClient{}
+
+// SCIMClient provisions the users of a specific organization through SCIM, which needs GitHub
+// Enterprise Cloud with SAML single sign-on enabled for the organization.
+type SCIMClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Get returns the provisioned user with the given SCIM identity ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *SCIMClient) Get(ctx context.Context, id string) (*gitprovider.SCIMUserInfo, error) {
+	if err := c.checkCapability(ctx, gitprovider.CapabilitySCIM); err != nil {
+		return nil, err
+	}
+	// GET /scim/v2/organizations/{org}/Users/{scim_user_id}
+	apiObj, _, err := c.c.Client().SCIM.GetSCIMProvisioningInfoForUser(ctx, c.ref.Organization, id)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return scimUserFromAPI(apiObj), nil
+}
+
+// List lists the users provisioned for the organization.
+//
+// List returns all provisioned users, using multiple paginated requests if needed.
+func (c *SCIMClient) List(ctx context.Context) ([]gitprovider.SCIMUserInfo, error) {
+	if err := c.checkCapability(ctx, gitprovider.CapabilitySCIM); err != nil {
+		return nil, err
+	}
+	users := []gitprovider.SCIMUserInfo{}
+	// SCIM pagination is based on the 1-based index of the first result
+	opts := &github.ListSCIMProvisionedIdentitiesOptions{
+		StartIndex: github.Int(1),
+		Count:      github.Int(scimPageSize),
+	}
+	for {
+		// GET /scim/v2/organizations/{org}/Users
+		page, _, err := c.c.Client().SCIM.ListSCIMProvisionedIdentities(ctx, c.ref.Organization, opts)
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		for _, apiObj := range page.Resources {
+			users = append(users, *scimUserFromAPI(apiObj))
+		}
+		if len(page.Resources) == 0 || len(users) >= page.GetTotalResults() {
+			return gitprovider.LimitItems(ctx, users), nil
+		}
+		opts.StartIndex = github.Int(*opts.StartIndex + len(page.Resources))
+	}
+}
+
+// ListIter returns an iterator over the provisioned users List returns, fetching them a page at a time.
+func (c *SCIMClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.SCIMUserInfo] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.SCIMUserInfo, gitprovider.PageInfo, error) {
+		if err := c.checkCapability(ctx, gitprovider.CapabilitySCIM); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		perPage := iteratorPageSize(ctx)
+		opts := &github.ListSCIMProvisionedIdentitiesOptions{
+			StartIndex: github.Int((page-1)*perPage + 1),
+			Count:      github.Int(perPage),
+		}
+		// GET /scim/v2/organizations/{org}/Users
+		apiObj, _, err := c.c.Client().SCIM.ListSCIMProvisionedIdentities(ctx, c.ref.Organization, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+		users := make([]gitprovider.SCIMUserInfo, 0, len(apiObj.Resources))
+		for _, resource := range apiObj.Resources {
+			users = append(users, *scimUserFromAPI(resource))
+		}
+		info := gitprovider.PageInfo{Total: github.Int(apiObj.GetTotalResults())}
+		if len(users) != 0 && (page-1)*perPage+len(users) < apiObj.GetTotalResults() {
+			info.NextPage = page + 1
+		}
+		return users, info, nil
+	})
+}
+
+// Provision creates a SCIM identity for the user, and sends an invitation to the organization
+// to the primary email address. GitHub requires both the given and family name, and at least
+// one email address.
+//
+// ErrAlreadyExists is returned if the user is already provisioned.
+func (c *SCIMClient) Provision(ctx context.Context, req gitprovider.SCIMUserInfo) (*gitprovider.SCIMUserInfo, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if err := c.checkCapability(ctx, gitprovider.CapabilitySCIM); err != nil {
+		return nil, err
+	}
+	// POST /scim/v2/organizations/{org}/Users
+	apiObj, _, err := c.c.Client().SCIM.ProvisionAndInviteSCIMUser(ctx, c.ref.Organization, scimUserToAPI(req))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return scimUserFromAPI(apiObj), nil
+}
+
+// Deprovision deletes the SCIM identity, and removes the user from the organization.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *SCIMClient) Deprovision(ctx context.Context, id string) error {
+	if err := c.checkCapability(ctx, gitprovider.CapabilitySCIM); err != nil {
+		return err
+	}
+	// DELETE /scim/v2/organizations/{org}/Users/{scim_user_id}
+	_, err := c.c.Client().SCIM.DeleteSCIMUserFromOrg(ctx, c.ref.Organization, id)
+	return handleHTTPError(err)
+}
+
+// scimUserToAPI converts a provisioning request to the SCIM attributes of the user. The first
+// email address is marked as the primary one.
+func scimUserToAPI(info gitprovider.SCIMUserInfo) *github.SCIMUserAttributes {
+	apiObj := &github.SCIMUserAttributes{
+		UserName:   info.UserName,
+		ExternalID: info.ExternalID,
+		Emails:     make([]*github.SCIMUserEmail, 0, len(info.Emails)),
+	}
+	if info.GivenName != nil {
+		apiObj.Name.GivenName = *info.GivenName
+	}
+	if info.FamilyName != nil {
+		apiObj.Name.FamilyName = *info.FamilyName
+	}
+	for i, email := range info.Emails {
+		apiObj.Emails = append(apiObj.Emails, &github.SCIMUserEmail{
+			Value:   email,
+			Primary: github.Bool(i == 0),
+		})
+	}
+	return apiObj
+}
+
+// scimUserFromAPI converts the SCIM attributes of a user, listing the primary email address
+// first.
+func scimUserFromAPI(apiObj *github.SCIMUserAttributes) *gitprovider.SCIMUserInfo {
+	info := &gitprovider.SCIMUserInfo{
+		ID:         apiObj.GetID(),
+		ExternalID: apiObj.ExternalID,
+		UserName:   apiObj.UserName,
+		Active:     apiObj.Active,
+	}
+	if apiObj.Name.GivenName != "" {
+		info.GivenName = gitprovider.StringVar(apiObj.Name.GivenName)
+	}
+	if apiObj.Name.FamilyName != "" {
+		info.FamilyName = gitprovider.StringVar(apiObj.Name.FamilyName)
+	}
+	for _, email := range apiObj.Emails {
+		if email.GetPrimary() {
+			info.Emails = append([]string{email.Value}, info.Emails...)
+		} else {
+			info.Emails = append(info.Emails, email.Value)
+		}
+	}
+	return info
+}
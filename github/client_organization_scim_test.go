@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_scimUserToAPI(t *testing.T) {
+	info := gitprovider.SCIMUserInfo{
+		ExternalID: gitprovider.StringVar("00u1dhhb1fkIGP7RL1d8"),
+		UserName:   "jane@example.com",
+		GivenName:  gitprovider.StringVar("Jane"),
+		FamilyName: gitprovider.StringVar("Doe"),
+		Emails:     []string{"jane@example.com", "jane.doe@example.com"},
+	}
+	apiObj := scimUserToAPI(info)
+	if apiObj.Name.GivenName != "Jane" || apiObj.Name.FamilyName != "Doe" {
+		t.Errorf("scimUserToAPI() name = %+v, want Jane Doe", apiObj.Name)
+	}
+	if len(apiObj.Emails) != 2 || !apiObj.Emails[0].GetPrimary() || apiObj.Emails[1].GetPrimary() {
+		t.Errorf("scimUserToAPI() must only mark the first email as primary")
+	}
+	if got := scimUserFromAPI(apiObj); !reflect.DeepEqual(*got, info) {
+		t.Errorf("scimUserFromAPI(scimUserToAPI()) = %+v, want %+v", *got, info)
+	}
+}
+
+func Test_scimUserFromAPI_primaryEmailFirst(t *testing.T) {
+	apiObj := &github.SCIMUserAttributes{
+		ID:       github.String("5fc0c238-1112-11e8-8e45-920c87bdbd75"),
+		UserName: "jane@example.com",
+		Emails: []*github.SCIMUserEmail{
+			{Value: "jane.doe@example.com"},
+			{Value: "jane@example.com", Primary: github.Bool(true)},
+		},
+		Active: github.Bool(true),
+	}
+	got := scimUserFromAPI(apiObj)
+	if want := []string{"jane@example.com", "jane.doe@example.com"}; !reflect.DeepEqual(got.Emails, want) {
+		t.Errorf("scimUserFromAPI() emails = %v, want %v", got.Emails, want)
+	}
+	if got.ID != apiObj.GetID() || got.GivenName != nil || !*got.Active {
+		t.Errorf("scimUserFromAPI() = %+v", *got)
+	}
+}
@@ -18,6 +18,8 @@ package github
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/google/go-github/v66/github"
 
@@ -40,27 +42,23 @@ type TeamsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
-	// GET /orgs/{org}/teams/{team_slug}/members
-	apiObjs, err := c.c.ListOrgTeamMembers(ctx, c.ref.Organization, teamName)
+	return c.get(ctx, teamName)
+}
+
+func (c *TeamsClient) get(ctx context.Context, teamName string) (*team, error) {
+	// GET /orgs/{org}/teams/{team_slug}
+	apiObj, _, err := c.c.Client().Teams.GetTeamBySlug(ctx, c.ref.Organization, teamName)
 	if err != nil {
-		return nil, err
+		return nil, handleHTTPError(err)
 	}
 
-	// Collect a list of the members' names. Login is validated to be non-nil in ListOrgTeamMembers.
-	logins := make([]string, 0, len(apiObjs))
-	for _, apiObj := range apiObjs {
-		// Login is validated to be non-nil in ListOrgTeamMembers
-		logins = append(logins, *apiObj.Login)
+	// GET /orgs/{org}/teams/{team_slug}/members
+	apiObjs, err := c.c.ListOrgTeamMembers(gitprovider.WithoutListOptions(ctx), c.ref.Organization, teamName)
+	if err != nil {
+		return nil, err
 	}
 
-	return &team{
-		users: apiObjs,
-		info: gitprovider.TeamInfo{
-			Name:    teamName,
-			Members: logins,
-		},
-		ref: c.ref,
-	}, nil
+	return newTeam(c, apiObj, apiObjs), nil
 }
 
 // List all teams (recursively, in terms of subgroups) within the specific organization.
@@ -86,25 +84,233 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 		teams = append(teams, team)
 	}
 
-	return teams, nil
+	return gitprovider.LimitItems(ctx, teams), nil
+}
+
+// ListIter returns an iterator over the teams List returns, fetching them a page at a time,
+// and the detailed information about each team of a page along with it.
+func (c *TeamsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Team] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Team, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /orgs/{org}/teams
+		apiObjs, resp, err := c.c.Client().Teams.ListTeams(ctx, c.ref.Organization, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		teams := make([]gitprovider.Team, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			team, err := c.Get(ctx, apiObj.GetSlug())
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			teams = append(teams, team)
+		}
+		return teams, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create a team within the specific organization.
+// The team slug, which identifies the team in Get, is derived from req.Name by GitHub.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /orgs/{org}/teams
+	apiObj, _, err := c.c.Client().Teams.CreateTeam(ctx, c.ref.Organization, teamToAPI(req))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newTeam(c, apiObj, nil), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team, as it doesn't exist", "organization", c.ref.String(), "team", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team is up to date", "organization", c.ref.String(), "team", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating team to the desired state", "organization", c.ref.String(), "team", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+func newTeam(c *TeamsClient, apiObj *github.Team, users []*github.User) *team {
+	// Login is validated to be non-nil in ListOrgTeamMembers
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		logins = append(logins, user.GetLogin())
+	}
+
+	return &team{
+		t:     *apiObj,
+		users: users,
+		info: gitprovider.TeamInfo{
+			Name:        apiObj.GetSlug(),
+			Description: apiObj.Description,
+			Members:     logins,
+		},
+		c: c,
+	}
+}
+
+func teamToAPI(info gitprovider.TeamInfo) github.NewTeam {
+	return github.NewTeam{
+		Name:        info.Name,
+		Description: info.Description,
+	}
 }
 
 var _ gitprovider.Team = &team{}
 
 type team struct {
+	t     github.Team
 	users []*github.User
 	info  gitprovider.TeamInfo
-	ref   gitprovider.OrganizationRef
+	c     *TeamsClient
 }
 
 func (t *team) Get() gitprovider.TeamInfo {
 	return t.info
 }
 
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	t.info = info
+	return nil
+}
+
 func (t *team) APIObject() interface{} {
 	return t.users
 }
 
 func (t *team) Organization() gitprovider.OrganizationRef {
-	return t.ref
+	return t.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function. Changing the name renames the team, which also changes its slug.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (t *team) Update(ctx context.Context) error {
+	// PATCH /orgs/{org}/teams/{team_slug}
+	apiObj, _, err := t.c.c.Client().Teams.EditTeamBySlug(ctx, t.c.ref.Organization, t.t.GetSlug(), teamToAPI(t.info), false)
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*t = *newTeam(t.c, apiObj, t.users)
+	return nil
+}
+
+// Delete deletes the team from the organization, revoking the repository access
+// granted through it.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(ctx context.Context) error {
+	// Don't allow deleting teams if the user didn't explicitly allow dangerous API calls.
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /orgs/{org}/teams/{team_slug}
+	_, err := t.c.c.Client().Teams.DeleteTeamBySlug(ctx, t.c.ref.Organization, t.t.GetSlug())
+	return handleHTTPError(err)
+}
+
+// ListMembers lists the members of the team, including their role.
+//
+// ListMembers returns all members, using multiple paginated requests if needed.
+func (t *team) ListMembers(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	members := []gitprovider.TeamMemberInfo{}
+	for _, role := range []gitprovider.TeamRole{gitprovider.TeamRoleMaintainer, gitprovider.TeamRoleMember} {
+		opts := &github.TeamListTeamMembersOptions{Role: string(role)}
+		err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+			// GET /orgs/{org}/teams/{team_slug}/members
+			pageObjs, resp, listErr := t.c.c.Client().Teams.ListTeamMembersBySlug(ctx, t.c.ref.Organization, t.t.GetSlug(), opts)
+			for _, apiObj := range pageObjs {
+				members = append(members, gitprovider.TeamMemberInfo{
+					Username: apiObj.GetLogin(),
+					Role:     gitprovider.TeamRoleVar(role),
+				})
+			}
+			return resp, listErr
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return members, nil
+}
+
+// AddMember adds the user to the team with the given role, or changes the role
+// if the user is already a member. Users who aren't part of the organization yet
+// are invited to it.
+func (t *team) AddMember(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	opts := &github.TeamAddTeamMembershipOptions{Role: string(*req.Role)}
+	// PUT /orgs/{org}/teams/{team_slug}/memberships/{username}
+	_, _, err := t.c.c.Client().Teams.AddTeamMembershipBySlug(ctx, t.c.ref.Organization, t.t.GetSlug(), req.Username, opts)
+	return handleHTTPError(err)
+}
+
+// RemoveMember removes the user from the team.
+//
+// ErrNotFound is returned if the user isn't a member of the team.
+func (t *team) RemoveMember(ctx context.Context, username string) error {
+	// DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}
+	_, err := t.c.c.Client().Teams.RemoveTeamMembershipBySlug(ctx, t.c.ref.Organization, t.t.GetSlug(), username)
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	resp, actionTaken, err := t.c.Reconcile(ctx, t.info)
+	if err != nil {
+		return actionTaken, err
+	}
+	*t = *resp.(*team)
+	return actionTaken, nil
 }
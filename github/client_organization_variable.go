@@ -0,0 +1,364 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrganizationVariableClient implements the gitprovider.OrganizationVariableClient interface.
+var _ gitprovider.OrganizationVariableClient = &OrganizationVariableClient{}
+
+// OrganizationVariableClient operates on the GitHub Actions variables and secrets of a specific
+// organization. Like for repositories, masked variables are stored as sealed Actions secrets,
+// and unmasked variables as plain Actions variables.
+type OrganizationVariableClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Get returns the variable or secret with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrganizationVariableClient) Get(ctx context.Context, key string) (gitprovider.OrganizationVariable, error) {
+	return c.get(ctx, key)
+}
+
+func (c *OrganizationVariableClient) get(ctx context.Context, key string) (*organizationVariable, error) {
+	// Plain variables can be read back, so look for one of those first
+	// GET /orgs/{org}/actions/variables/{name}
+	apiObj, _, err := c.c.Client().Actions.GetOrgVariable(ctx, c.ref.Organization, key)
+	if err == nil {
+		return c.newVariable(ctx, apiObj)
+	}
+	if err = handleHTTPError(err); !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	// GET /orgs/{org}/actions/secrets/{secret_name}
+	secret, _, err := c.c.Client().Actions.GetOrgSecret(ctx, c.ref.Organization, key)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return c.newSecret(ctx, secret)
+}
+
+// List lists all variables and secrets of the organization.
+//
+// List returns all available variables, using multiple paginated requests if needed.
+func (c *OrganizationVariableClient) List(ctx context.Context) ([]gitprovider.OrganizationVariable, error) {
+	apiObjs := []*github.ActionsVariable{}
+	opts := &github.ListOptions{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /orgs/{org}/actions/variables
+		page, resp, listErr := c.c.Client().Actions.ListOrgVariables(ctx, c.ref.Organization, opts)
+		if page != nil {
+			apiObjs = append(apiObjs, page.Variables...)
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []*github.Secret{}
+	opts = &github.ListOptions{}
+	err = allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /orgs/{org}/actions/secrets
+		page, resp, listErr := c.c.Client().Actions.ListOrgSecrets(ctx, c.ref.Organization, opts)
+		if page != nil {
+			secrets = append(secrets, page.Secrets...)
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]gitprovider.OrganizationVariable, 0, len(apiObjs)+len(secrets))
+	for _, apiObj := range apiObjs {
+		v, err := c.newVariable(ctx, apiObj)
+		if err != nil {
+			return nil, err
+		}
+		variables = append(variables, v)
+	}
+	for _, secret := range secrets {
+		v, err := c.newSecret(ctx, secret)
+		if err != nil {
+			return nil, err
+		}
+		variables = append(variables, v)
+	}
+	return gitprovider.LimitItems(ctx, variables), nil
+}
+
+// ListIter returns an iterator over the variables List returns, fetching them a page at a time:
+// the variables first, then the secrets.
+func (c *OrganizationVariableClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.OrganizationVariable] {
+	return gitprovider.NewIterator(ctx, gitprovider.ConcatPages(c.variablesPage, c.secretsPage))
+}
+
+// variablesPage lists a page of the variables of the organization.
+func (c *OrganizationVariableClient) variablesPage(ctx context.Context, page int) ([]gitprovider.OrganizationVariable, gitprovider.PageInfo, error) {
+	opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+	// GET /orgs/{org}/actions/variables
+	apiObj, resp, err := c.c.Client().Actions.ListOrgVariables(ctx, c.ref.Organization, opts)
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+	}
+	variables := make([]gitprovider.OrganizationVariable, 0, len(apiObj.Variables))
+	for _, variable := range apiObj.Variables {
+		v, err := c.newVariable(ctx, variable)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		variables = append(variables, v)
+	}
+	return variables, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+}
+
+// secretsPage lists a page of the secrets of the organization.
+func (c *OrganizationVariableClient) secretsPage(ctx context.Context, page int) ([]gitprovider.OrganizationVariable, gitprovider.PageInfo, error) {
+	opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+	// GET /orgs/{org}/actions/secrets
+	apiObj, resp, err := c.c.Client().Actions.ListOrgSecrets(ctx, c.ref.Organization, opts)
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+	}
+	variables := make([]gitprovider.OrganizationVariable, 0, len(apiObj.Secrets))
+	for _, secret := range apiObj.Secrets {
+		v, err := c.newSecret(ctx, secret)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		variables = append(variables, v)
+	}
+	return variables, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+}
+
+// Create creates a variable, or a secret if req is masked.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationVariableClient) Create(ctx context.Context, req gitprovider.OrganizationVariableInfo) (gitprovider.OrganizationVariable, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// Secrets are created and updated using the same call, so check for existence first
+	if _, err := c.get(ctx, req.Key); err == nil {
+		return nil, gitprovider.ErrAlreadyExists
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := c.createOrUpdate(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	return newOrganizationVariable(c, req, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// As the value of a secret can't be read back, masked variables are always updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationVariableClient) Reconcile(ctx context.Context, req gitprovider.OrganizationVariableInfo) (gitprovider.OrganizationVariable, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Key)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating variable, as it doesn't exist", "organization", c.ref.String(), "variable", req.Key)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("variable is up to date", "organization", c.ref.String(), "variable", req.Key)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating variable to the desired state", "organization", c.ref.String(), "variable", req.Key)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// newVariable returns the plain variable apiObj, including its selected repositories.
+func (c *OrganizationVariableClient) newVariable(ctx context.Context, apiObj *github.ActionsVariable) (*organizationVariable, error) {
+	var repositories []string
+	if apiObj.GetVisibility() == string(gitprovider.VariableVisibilitySelected) {
+		var err error
+		repositories, err = c.listSelectedRepositories(gitprovider.WithoutListOptions(ctx), apiObj.Name, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newOrganizationVariable(c, organizationVariableFromAPI(apiObj, repositories), apiObj), nil
+}
+
+// newSecret returns the secret apiObj, including its selected repositories.
+func (c *OrganizationVariableClient) newSecret(ctx context.Context, apiObj *github.Secret) (*organizationVariable, error) {
+	var repositories []string
+	if apiObj.Visibility == string(gitprovider.VariableVisibilitySelected) {
+		var err error
+		repositories, err = c.listSelectedRepositories(gitprovider.WithoutListOptions(ctx), apiObj.Name, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newOrganizationVariable(c, organizationSecretFromAPI(apiObj, repositories), apiObj), nil
+}
+
+// createOrUpdate writes req as a plain variable, or as a sealed secret if req is masked.
+// The returned API object is either a *github.ActionsVariable or a *github.Secret.
+func (c *OrganizationVariableClient) createOrUpdate(ctx context.Context, req gitprovider.OrganizationVariableInfo, exists bool) (interface{}, error) {
+	// GitHub has no notion of protected variables; use a protected environment instead
+	if req.Protected != nil && *req.Protected {
+		return nil, fmt.Errorf("protected variables: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.Environment != "" {
+		return nil, fmt.Errorf("organization variables for an environment: %w", gitprovider.ErrNoProviderSupport)
+	}
+	visibility := string(*req.Visibility)
+	var repoIDs github.SelectedRepoIDs
+	if *req.Visibility == gitprovider.VariableVisibilitySelected {
+		var err error
+		repoIDs, err = c.repositoryIDs(ctx, req.Repositories)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Masked != nil && *req.Masked {
+		// GET /orgs/{org}/actions/secrets/public-key
+		publicKey, _, err := c.c.Client().Actions.GetOrgPublicKey(ctx, c.ref.Organization)
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		encryptedValue, err := sealSecret(publicKey.GetKey(), req.Value)
+		if err != nil {
+			return nil, err
+		}
+		encryptedSecret := &github.EncryptedSecret{
+			Name:                  req.Key,
+			KeyID:                 publicKey.GetKeyID(),
+			EncryptedValue:        encryptedValue,
+			Visibility:            visibility,
+			SelectedRepositoryIDs: repoIDs,
+		}
+		// PUT /orgs/{org}/actions/secrets/{secret_name}
+		if _, err := c.c.Client().Actions.CreateOrUpdateOrgSecret(ctx, c.ref.Organization, encryptedSecret); err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return &github.Secret{Name: req.Key, Visibility: visibility}, nil
+	}
+
+	apiObj := &github.ActionsVariable{Name: req.Key, Value: req.Value, Visibility: &visibility}
+	if repoIDs != nil {
+		apiObj.SelectedRepositoryIDs = &repoIDs
+	}
+	var err error
+	if exists {
+		// PATCH /orgs/{org}/actions/variables/{name}
+		_, err = c.c.Client().Actions.UpdateOrgVariable(ctx, c.ref.Organization, apiObj)
+	} else {
+		// POST /orgs/{org}/actions/variables
+		_, err = c.c.Client().Actions.CreateOrgVariable(ctx, c.ref.Organization, apiObj)
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+// delete deletes the variable, or the secret if masked is true.
+func (c *OrganizationVariableClient) delete(ctx context.Context, key string, masked bool) error {
+	var err error
+	if masked {
+		// DELETE /orgs/{org}/actions/secrets/{secret_name}
+		_, err = c.c.Client().Actions.DeleteOrgSecret(ctx, c.ref.Organization, key)
+	} else {
+		// DELETE /orgs/{org}/actions/variables/{name}
+		_, err = c.c.Client().Actions.DeleteOrgVariable(ctx, c.ref.Organization, key)
+	}
+	return handleHTTPError(err)
+}
+
+// listSelectedRepositories returns the names of the repositories selected for the variable,
+// or for the secret if secret is true.
+func (c *OrganizationVariableClient) listSelectedRepositories(ctx context.Context, key string, secret bool) ([]string, error) {
+	opts := &github.ListOptions{}
+	names := []string{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		var page *github.SelectedReposList
+		var resp *github.Response
+		var listErr error
+		if secret {
+			// GET /orgs/{org}/actions/secrets/{secret_name}/repositories
+			page, resp, listErr = c.c.Client().Actions.ListSelectedReposForOrgSecret(ctx, c.ref.Organization, key, opts)
+		} else {
+			// GET /orgs/{org}/actions/variables/{name}/repositories
+			page, resp, listErr = c.c.Client().Actions.ListSelectedReposForOrgVariable(ctx, c.ref.Organization, key, opts)
+		}
+		if page != nil {
+			for _, repo := range page.Repositories {
+				names = append(names, repo.GetName())
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// repositoryIDs looks up the IDs of the given repositories of the organization.
+func (c *OrganizationVariableClient) repositoryIDs(ctx context.Context, names []string) (github.SelectedRepoIDs, error) {
+	ids := make(github.SelectedRepoIDs, 0, len(names))
+	for _, name := range names {
+		// GET /repos/{owner}/{repo}
+		repo, err := c.c.GetRepo(ctx, c.ref.Organization, name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, repo.GetID())
+	}
+	return ids, nil
+}
@@ -19,6 +19,8 @@ package github
 import (
 	"context"
 
+	"github.com/google/go-github/v66/github"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
@@ -68,7 +70,31 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 		}))
 	}
 
-	return orgs, nil
+	return gitprovider.LimitItems(ctx, orgs), nil
+}
+
+// ListIter returns an iterator over the organizations List returns, fetching them a page at a time.
+func (c *OrganizationsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Organization] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Organization, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /user/orgs
+		apiObjs, resp, err := c.c.Client().Organizations.List(ctx, "", opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		orgs := make([]gitprovider.Organization, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateOrganizationAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			orgs = append(orgs, newOrganization(c.clientContext, apiObj, gitprovider.OrganizationRef{
+				Domain:       c.domain,
+				Organization: *apiObj.Login,
+			}))
+		}
+		return orgs, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
 }
 
 // Children returns the immediate child-organizations for the specific OrganizationRef o.
@@ -80,3 +106,46 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 func (c *OrganizationsClient) Children(_ context.Context, _ gitprovider.OrganizationRef) ([]gitprovider.Organization, error) {
 	return nil, gitprovider.ErrNoProviderSupport
 }
+
+// Create returns ErrNoProviderSupport, as organizations can't be managed through the GitHub API.
+func (c *OrganizationsClient) Create(_ context.Context, _ gitprovider.OrganizationRef, _ gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// Organizations can't be created through the GitHub API, so ErrNotFound is returned if the
+// organization doesn't exist.
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("organization is up to date", "organization", ref.String())
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating organization to the desired state", "organization", ref.String())
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// Delete returns ErrNoProviderSupport, as organizations can't be managed through the GitHub API.
+func (c *OrganizationsClient) Delete(_ context.Context, _ gitprovider.OrganizationRef) error {
+	return gitprovider.ErrNoProviderSupport
+}
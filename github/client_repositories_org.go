@@ -19,7 +19,10 @@ package github
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-github/v66/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -46,7 +49,21 @@ func (c *OrgRepositoriesClient) Get(ctx context.Context, ref gitprovider.OrgRepo
 	if err != nil {
 		return nil, err
 	}
-	return newOrgRepository(c.clientContext, apiObj, ref), nil
+	// GitHub redirects requests for renamed or transferred repositories
+	if moved, isMoved := repositoryMoved(c.clientContext, ref, apiObj); isMoved {
+		if !gitprovider.CallOptionsFromContext(ctx).ShouldFollowRedirects(c.followRedirects) {
+			return nil, &gitprovider.RepositoryMovedError{Ref: ref, CanonicalRef: moved.Repository()}
+		}
+		// A repository transferred to a user keeps the requested reference
+		if canonicalRef, isOrg := moved.Repository().(gitprovider.OrgRepositoryRef); isOrg {
+			ref = canonicalRef
+		}
+	}
+	repo := newOrgRepository(c.clientContext, apiObj, ref)
+	if err := repo.loadOptionalInfo(ctx); err != nil {
+		return nil, err
+	}
+	return repo, nil
 }
 
 // List all repositories in the given organization.
@@ -58,24 +75,203 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 		return nil, err
 	}
 
-	// GET /orgs/{org}/repos
-	apiObjs, err := c.c.ListOrgRepos(ctx, ref.Organization)
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	includeArchived := callOpts.ShouldIncludeArchived()
+	filter := callOpts.RepositoryFilter()
+	sort, err := callOpts.RepositorySort()
+	if err != nil {
+		return nil, err
+	}
+
+	var apiObjs []*github.Repository
+	if opts, ok := listByOrgOptions(filter, sort); ok {
+		// GET /orgs/{org}/repos
+		apiObjs, err = c.c.ListOrgRepos(ctx, ref.Organization, opts)
+	} else {
+		// GET /search/repositories
+		apiObjs, err = c.c.SearchRepos(ctx, searchQuery("org:"+ref.Organization, filter, includeArchived))
+		if err == nil && sort != nil {
+			// Search can't sort by name or time of creation
+			sortRepositories(apiObjs, *sort)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
+	return gitprovider.LimitItems(ctx, c.orgRepositories(ref, apiObjs, includeArchived, filter)), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+//
+// When the repositories are both filtered and sorted, GitHub can't sort the search results, so
+// they're all fetched by the first page.
+func (c *OrgRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.OrganizationRef) *gitprovider.Iterator[gitprovider.OrgRepository] {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	includeArchived := callOpts.ShouldIncludeArchived()
+	filter := callOpts.RepositoryFilter()
+	sort, sortErr := callOpts.RepositorySort()
+	opts, listByOrg := listByOrgOptions(filter, sort)
+	if sortErr == nil && !listByOrg && sort != nil {
+		return gitprovider.ListIterator(ctx, func(ctx context.Context) ([]gitprovider.OrgRepository, error) {
+			return c.List(ctx, ref)
+		})
+	}
+
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrgRepository, gitprovider.PageInfo, error) {
+		// Make sure the OrganizationRef is valid
+		if err := validateOrganizationRef(ref, c.domain); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		if sortErr != nil {
+			return nil, gitprovider.PageInfo{}, sortErr
+		}
+		var apiObjs []*github.Repository
+		var info gitprovider.PageInfo
+		var err error
+		if listByOrg {
+			// GET /orgs/{org}/repos
+			apiObjs, info, err = c.c.ListOrgReposPage(ctx, ref.Organization, opts, page)
+		} else {
+			// GET /search/repositories
+			apiObjs, info, err = c.c.SearchReposPage(ctx, searchQuery("org:"+ref.Organization, filter, includeArchived), page)
+		}
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.orgRepositories(ref, apiObjs, includeArchived, filter), info, nil
+	})
+}
 
-	// Traverse the list, and return a list of OrgRepository objects
+// orgRepositories returns the OrgRepository objects for the repositories of the organization
+// that are not archived unless includeArchived is set, and match the filter.
+func (c *OrgRepositoriesClient) orgRepositories(ref gitprovider.OrganizationRef, apiObjs []*github.Repository, includeArchived bool, filter gitprovider.RepositoryFilter) []gitprovider.OrgRepository {
 	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
+		if !includeArchived && apiObj.GetArchived() {
+			continue
+		}
+		// The search qualifiers are coarser than the filter, e.g. GitHub only matches whole words
+		if !filter.Matches(apiObj.GetName(), repositoryFromAPI(apiObj), apiObj.GetPushedAt().Time) {
+			continue
+		}
 		// apiObj is already validated at ListOrgRepos
 		repos = append(repos, newOrgRepository(c.clientContext, apiObj, gitprovider.OrgRepositoryRef{
 			OrganizationRef: ref,
 			RepositoryName:  *apiObj.Name,
 		}))
 	}
+	return repos
+}
+
+// Search searches the repositories of all organizations for repositories whose name contains query.
+//
+// GitHub only matches whole words of the name, and returns at most 1000 repositories.
+func (c *OrgRepositoriesClient) Search(ctx context.Context, query string) ([]gitprovider.OrgRepository, error) {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	includeArchived := callOpts.ShouldIncludeArchived()
+	filter := callOpts.RepositoryFilter()
+
+	// GET /search/repositories
+	apiObjs, err := c.c.SearchRepos(ctx, searchQuery(query+" in:name", filter, includeArchived))
+	if err != nil {
+		return nil, err
+	}
+
+	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if !includeArchived && apiObj.GetArchived() {
+			continue
+		}
+		if !filter.Matches(apiObj.GetName(), repositoryFromAPI(apiObj), apiObj.GetPushedAt().Time) {
+			continue
+		}
+		// Search returns the repositories of users too
+		if repo, isOrg := newRepositoryFromAPI(c.clientContext, apiObj).(gitprovider.OrgRepository); isOrg {
+			repos = append(repos, repo)
+		}
+	}
 	return repos, nil
 }
 
+// listByOrgOptions returns the options to list the repositories of an organization with, if
+// the filter can be applied by listing them, i.e. it at most filters by a visibility the list
+// endpoint supports.
+func listByOrgOptions(filter gitprovider.RepositoryFilter, sort *gitprovider.RepositorySort) (*github.RepositoryListByOrgOptions, bool) {
+	opts := &github.RepositoryListByOrgOptions{}
+	if visibility := filter.Visibility; visibility != nil {
+		filter.Visibility = nil
+		if *visibility == gitprovider.RepositoryVisibilityInternal {
+			return nil, false
+		}
+		opts.Type = string(*visibility)
+	}
+	if !filter.IsZero() {
+		return nil, false
+	}
+	if sort != nil {
+		opts.Sort = repositorySortToAPI(sort.By)
+		opts.Direction = "asc"
+		if sort.Descending {
+			opts.Direction = "desc"
+		}
+	}
+	return opts, true
+}
+
+// repositorySortToAPI returns the field the repositories of an organization are sorted by
+// for the given sort field.
+func repositorySortToAPI(field gitprovider.RepositorySortField) string {
+	switch field {
+	case gitprovider.RepositorySortFieldCreated:
+		return "created"
+	case gitprovider.RepositorySortFieldPushed:
+		return "pushed"
+	default:
+		return "full_name"
+	}
+}
+
+// sortRepositories sorts the repositories in place.
+func sortRepositories(apiObjs []*github.Repository, sort gitprovider.RepositorySort) {
+	slices.SortStableFunc(apiObjs, func(a, b *github.Repository) int {
+		var cmp int
+		switch sort.By {
+		case gitprovider.RepositorySortFieldCreated:
+			cmp = a.GetCreatedAt().Compare(b.GetCreatedAt().Time)
+		case gitprovider.RepositorySortFieldPushed:
+			cmp = a.GetPushedAt().Compare(b.GetPushedAt().Time)
+		default:
+			cmp = strings.Compare(strings.ToLower(a.GetName()), strings.ToLower(b.GetName()))
+		}
+		if sort.Descending {
+			return -cmp
+		}
+		return cmp
+	})
+}
+
+// searchQuery returns the repository search query for the given base query, extended with
+// qualifiers for the filter.
+func searchQuery(base string, filter gitprovider.RepositoryFilter, includeArchived bool) string {
+	qualifiers := []string{base}
+	if filter.Name != "" {
+		qualifiers = append(qualifiers, filter.Name+" in:name")
+	}
+	if filter.Visibility != nil {
+		qualifiers = append(qualifiers, "is:"+string(*filter.Visibility))
+	}
+	if filter.Topic != "" {
+		qualifiers = append(qualifiers, "topic:"+filter.Topic)
+	}
+	if filter.ActiveSince != nil {
+		qualifiers = append(qualifiers, "pushed:>="+filter.ActiveSince.UTC().Format("2006-01-02"))
+	}
+	if !includeArchived {
+		qualifiers = append(qualifiers, "archived:false")
+	}
+	return strings.Join(qualifiers, " ")
+}
+
 // Create creates a repository for the given organization, with the data and options.
 //
 // ErrAlreadyExists will be returned if the resource already exists.
@@ -108,6 +304,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -116,7 +313,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 		return nil, false, err
 	}
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
+	actionTaken, err := reconcileRepository(ctx, c.log, actual, req)
 	return actual, actionTaken, err
 }
 
@@ -137,14 +334,52 @@ func createRepository(ctx context.Context, c githubClient, ref gitprovider.Repos
 	data := repositoryToAPI(&req, ref)
 	applyRepoCreateOptions(&data, o)
 
-	return c.CreateRepo(ctx, orgName, &data)
+	var apiObj *github.Repository
+	if o.Template != nil {
+		apiObj, err = createRepositoryFromTemplate(ctx, c, ref, &data, o)
+	} else {
+		apiObj, err = c.CreateRepo(ctx, orgName, &data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Topics can't be set when creating the repository
+	if len(req.Topics) != 0 {
+		if apiObj.Topics, err = c.ReplaceTopics(ctx, apiObj.GetOwner().GetLogin(), apiObj.GetName(), req.Topics); err != nil {
+			return nil, err
+		}
+	}
+	if req.LFSEnabled != nil {
+		if err := setLFS(ctx, c, apiObj.GetOwner().GetLogin(), apiObj.GetName(), *req.LFSEnabled); err != nil {
+			return nil, err
+		}
+	}
+	return apiObj, nil
+}
+
+// createRepositoryFromTemplate generates the repository from the template given in opts. Only the
+// name, description and visibility of data can be set when generating a repository.
+func createRepositoryFromTemplate(ctx context.Context, c githubClient, ref gitprovider.RepositoryRef, data *github.Repository, opts gitprovider.RepositoryCreateOptions) (*github.Repository, error) {
+	if err := validateIdentityFields(opts.Template, ref.GetDomain()); err != nil {
+		return nil, err
+	}
+	req := &github.TemplateRepoRequest{
+		Name:               data.Name,
+		Owner:              github.String(ref.GetIdentity()),
+		Description:        data.Description,
+		IncludeAllBranches: opts.TemplateIncludeAllBranches,
+		Private:            github.Bool(data.GetVisibility() != string(gitprovider.RepositoryVisibilityPublic)),
+	}
+	return c.CreateRepoFromTemplate(ctx, opts.Template.GetIdentity(), opts.Template.GetRepository(), req)
 }
 
-func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
+func reconcileRepository(ctx context.Context, log logr.Logger, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		log.V(1).Info("repository is up to date", "repository", actual.Repository().String())
 		return false, nil
 	}
+	log.V(1).Info("updating repository to the desired state", "repository", actual.Repository().String())
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return false, err
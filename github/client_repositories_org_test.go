@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_listByOrgOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter gitprovider.RepositoryFilter
+		sort   *gitprovider.RepositorySort
+		want   *github.RepositoryListByOrgOptions
+	}{
+		{
+			name: "no filter",
+			want: &github.RepositoryListByOrgOptions{},
+		},
+		{
+			name:   "private, most recently pushed first",
+			filter: gitprovider.RepositoryFilter{Visibility: gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate)},
+			sort:   &gitprovider.RepositorySort{By: gitprovider.RepositorySortFieldPushed, Descending: true},
+			want:   &github.RepositoryListByOrgOptions{Type: "private", Sort: "pushed", Direction: "desc"},
+		},
+		{
+			name: "by name",
+			sort: &gitprovider.RepositorySort{By: gitprovider.RepositorySortFieldName},
+			want: &github.RepositoryListByOrgOptions{Sort: "full_name", Direction: "asc"},
+		},
+		{
+			name:   "internal needs search",
+			filter: gitprovider.RepositoryFilter{Visibility: gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityInternal)},
+		},
+		{
+			name:   "topic needs search",
+			filter: gitprovider.RepositoryFilter{Topic: "gitops"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := listByOrgOptions(tt.filter, tt.sort)
+			if ok != (tt.want != nil) {
+				t.Fatalf("listByOrgOptions() ok = %t, want %t", ok, tt.want != nil)
+			}
+			if ok && *got != *tt.want {
+				t.Errorf("listByOrgOptions() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func Test_sortRepositories(t *testing.T) {
+	now := time.Now()
+	repos := []*github.Repository{
+		{Name: github.String("flux2"), CreatedAt: &github.Timestamp{Time: now}, PushedAt: &github.Timestamp{Time: now.Add(-time.Hour)}},
+		{Name: github.String("Agent"), CreatedAt: &github.Timestamp{Time: now.Add(-time.Hour)}, PushedAt: &github.Timestamp{Time: now}},
+	}
+	sortRepositories(repos, gitprovider.RepositorySort{By: gitprovider.RepositorySortFieldName})
+	if repos[0].GetName() != "Agent" {
+		t.Errorf("sortRepositories() by name = %s first, want Agent", repos[0].GetName())
+	}
+	sortRepositories(repos, gitprovider.RepositorySort{By: gitprovider.RepositorySortFieldCreated, Descending: true})
+	if repos[0].GetName() != "flux2" {
+		t.Errorf("sortRepositories() by creation, descending = %s first, want flux2", repos[0].GetName())
+	}
+	sortRepositories(repos, gitprovider.RepositorySort{By: gitprovider.RepositorySortFieldPushed, Descending: true})
+	if repos[0].GetName() != "Agent" {
+		t.Errorf("sortRepositories() by push, descending = %s first, want Agent", repos[0].GetName())
+	}
+}
+
+func TestOrgRepositoriesClient_ListIter(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/orgs/fluxcd/repos" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "", "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s%s?page=2>; rel="next"`, "http://"+r.Host, r.URL.Path))
+			_, _ = w.Write([]byte(`[{"name":"flux2"},{"name":"old","archived":true}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"name":"source-controller"}]`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallIncludeArchived(false))
+	it := c.OrgRepositories().ListIter(ctx, gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"})
+
+	var names []string
+	for it.Next(context.Background()) {
+		names = append(names, it.Value().Repository().GetRepository())
+		if len(names) == 1 && len(pages) != 1 {
+			t.Errorf("fetched %d pages before returning the first repository", len(pages))
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListIter() error = %v", err)
+	}
+	if want := []string{"flux2", "source-controller"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListIter() = %v, want %v", names, want)
+	}
+	if it.Page() != 2 {
+		t.Errorf("Page() = %d, want 2", it.Page())
+	}
+}
+
+func TestOrgRepositoriesClient_ListOptions(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Link", fmt.Sprintf(`<%s%s?page=9>; rel="next"`, "http://"+r.Host, r.URL.Path))
+		_, _ = w.Write([]byte(`[{"name":"a"},{"name":"b"}]`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallListOptions(gitprovider.ListOptions{PerPage: 2, Page: 3, MaxItems: 3}))
+	repos, err := c.OrgRepositories().List(ctx, gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(repos) != 3 {
+		t.Errorf("List() returned %d repositories, want 3", len(repos))
+	}
+	if want := []string{"page=3&per_page=2", "page=9&per_page=2"}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("List() queries = %v, want %v", queries, want)
+	}
+}
+
+func TestOrgRepositoriesClient_GetMoved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/fluxcd/old":
+			_, _ = w.Write([]byte(`{"name":"new","owner":{"login":"fluxcd","type":"Organization"}}`))
+		case "/api/v3/repos/fluxcd/given":
+			_, _ = w.Write([]byte(`{"name":"given","owner":{"login":"octocat","type":"User"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orgRef := gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"}
+
+	repo, err := c.OrgRepositories().Get(context.Background(), gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "old"})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if want := (gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "new"}); !reflect.DeepEqual(repo.Repository(), want) {
+		t.Errorf("Get() of a renamed repository = %v, want %v", repo.Repository(), want)
+	}
+
+	// A repository transferred to a user can't be returned under its canonical reference
+	ref := gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "given"}
+	repo, err = c.OrgRepositories().Get(context.Background(), ref)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !reflect.DeepEqual(repo.Repository(), ref) {
+		t.Errorf("Get() of a transferred repository = %v, want %v", repo.Repository(), ref)
+	}
+
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallFollowRedirects(false))
+	_, err = c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "old"})
+	var movedErr *gitprovider.RepositoryMovedError
+	if !errors.Is(err, gitprovider.ErrRepositoryMoved) || !errors.As(err, &movedErr) {
+		t.Fatalf("Get() without following redirects error = %v, want a *RepositoryMovedError", err)
+	}
+	if movedErr.CanonicalRef.GetRepository() != "new" {
+		t.Errorf("CanonicalRef = %v, want the new name", movedErr.CanonicalRef)
+	}
+}
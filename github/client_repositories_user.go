@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/go-github/v66/github"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
@@ -58,7 +60,26 @@ func (c *UserRepositoriesClient) Get(ctx context.Context, ref gitprovider.UserRe
 	if err != nil {
 		return nil, err
 	}
-	return newUserRepository(c.clientContext, apiObj, ref), nil
+	// GitHub redirects requests for renamed or transferred repositories
+	if moved, isMoved := repositoryMoved(c.clientContext, ref, apiObj); isMoved {
+		if !gitprovider.CallOptionsFromContext(ctx).ShouldFollowRedirects(c.followRedirects) {
+			return nil, &gitprovider.RepositoryMovedError{Ref: ref, CanonicalRef: moved.Repository()}
+		}
+		// The repository may have been transferred to an organization
+		if orgRef, isOrg := moved.Repository().(gitprovider.OrgRepositoryRef); isOrg {
+			repo := newOrgRepository(c.clientContext, apiObj, orgRef)
+			if err := repo.loadOptionalInfo(ctx); err != nil {
+				return nil, err
+			}
+			return repo, nil
+		}
+		ref = moved.Repository().(gitprovider.UserRepositoryRef)
+	}
+	repo := newUserRepository(c.clientContext, apiObj, ref)
+	if err := repo.loadOptionalInfo(ctx); err != nil {
+		return nil, err
+	}
+	return repo, nil
 }
 
 // List all repositories in the given organization.
@@ -75,17 +96,41 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 	if err != nil {
 		return nil, err
 	}
+	repos := c.userRepositories(ref, apiObjs, gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived())
+	return gitprovider.LimitItems(ctx, repos), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+func (c *UserRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.UserRef) *gitprovider.Iterator[gitprovider.UserRepository] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.UserRepository, gitprovider.PageInfo, error) {
+		// Make sure the UserRef is valid
+		if err := validateUserRef(ref, c.domain); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		// GET /users/{username}/repos
+		apiObjs, info, err := c.c.ListUserReposPage(ctx, ref.UserLogin, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.userRepositories(ref, apiObjs, gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived()), info, nil
+	})
+}
 
-	// Traverse the list, and return a list of UserRepository objects
+// userRepositories returns the UserRepository objects for the repositories of the user
+// that are not archived unless includeArchived is set.
+func (c *UserRepositoriesClient) userRepositories(ref gitprovider.UserRef, apiObjs []*github.Repository, includeArchived bool) []gitprovider.UserRepository {
 	repos := make([]gitprovider.UserRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
+		if !includeArchived && apiObj.GetArchived() {
+			continue
+		}
 		// apiObj is already validated at ListUserRepos
 		repos = append(repos, newUserRepository(c.clientContext, apiObj, gitprovider.UserRepositoryRef{
 			UserRef:        ref,
 			RepositoryName: *apiObj.Name,
 		}))
 	}
-	return repos, nil
+	return repos
 }
 
 // Create creates a repository for the given organization, with the data and options
@@ -108,7 +153,7 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 		return nil, fmt.Errorf("unable to get owner from API")
 	}
 
-	if ref.GetIdentity() != idRef.GetIdentity() {
+	if !gitprovider.NamesEqual(ref.GetIdentity(), idRef.GetIdentity(), caseSensitiveNames) {
 		return nil, gitprovider.NewErrIncorrectUser(ref.GetIdentity())
 	}
 
@@ -142,6 +187,7 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -151,6 +197,6 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 	}
 
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
+	actionTaken, err := reconcileRepository(ctx, c.log, actual, req)
 	return actual, actionTaken, err
 }
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// defaultArtifactMediaType is used for artifacts with an unknown file extension.
+const defaultArtifactMediaType = "application/octet-stream"
+
+// ArtifactClient implements the gitprovider.ArtifactClient interface.
+var _ gitprovider.ArtifactClient = &ArtifactClient{}
+
+// ArtifactClient operates on the release assets of a specific repository.
+// The artifact version is the tag of the release the asset is attached to;
+// the release has to exist before artifacts can be uploaded to it.
+type ArtifactClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Upload uploads content as a release asset with the given name, to the release tagged with version.
+// uses https://docs.github.com/en/rest/releases/assets#upload-a-release-asset
+//
+// The upload API needs the size of the asset up front. It's taken from content if it has a Len
+// method (e.g. *bytes.Reader), is an *os.File or an io.Seeker; any other reader is first copied to
+// a temporary file, so that the asset is never held in memory.
+//
+// ErrNotFound is returned if there is no release for the given version.
+func (c *ArtifactClient) Upload(ctx context.Context, version, name string, content io.Reader) (*gitprovider.ArtifactInfo, error) {
+	release, err := c.getRelease(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	body, size, cleanup, err := sizedArtifactContent(content)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	mediaType := mime.TypeByExtension(filepath.Ext(name))
+	if mediaType == "" {
+		mediaType = defaultArtifactMediaType
+	}
+
+	// POST /repos/{owner}/{repo}/releases/{release_id}/assets
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", c.ref.GetIdentity(), c.ref.GetRepository(), release.GetID(), url.QueryEscape(name))
+	req, err := c.c.Client().NewUploadRequest(u, body, size, mediaType)
+	if err != nil {
+		return nil, err
+	}
+	asset := &github.ReleaseAsset{}
+	if _, err := c.c.Client().Do(ctx, req, asset); err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return artifactFromAPI(asset, version), nil
+}
+
+// sizedArtifactContent returns content together with the number of bytes left to read from it.
+// If the size can't be determined from content itself, content is spooled to a temporary file,
+// which the returned cleanup function removes.
+func sizedArtifactContent(content io.Reader) (io.Reader, int64, func(), error) {
+	noop := func() {}
+	switch r := content.(type) {
+	case interface{ Len() int }:
+		return content, int64(r.Len()), noop, nil
+	case *os.File:
+		if fi, err := r.Stat(); err == nil && fi.Mode().IsRegular() {
+			offset, err := r.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, 0, noop, err
+			}
+			return content, fi.Size() - offset, noop, nil
+		}
+	case io.Seeker:
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, 0, noop, err
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, 0, noop, err
+		}
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return nil, 0, noop, err
+		}
+		return content, end - offset, noop, nil
+	}
+
+	f, err := os.CreateTemp("", "artifact-")
+	if err != nil {
+		return nil, 0, noop, err
+	}
+	cleanup := func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}
+	size, err := io.Copy(f, content)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		cleanup()
+		return nil, 0, noop, err
+	}
+	return f, size, cleanup, nil
+}
+
+// Download returns the content of the release asset with the given name, attached to the release tagged with version.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ArtifactClient) Download(ctx context.Context, version, name string) (io.ReadCloser, error) {
+	assets, err := c.listAssets(gitprovider.WithoutListOptions(ctx), version)
+	if err != nil {
+		return nil, err
+	}
+	for _, asset := range assets {
+		if asset.GetName() != name {
+			continue
+		}
+		// GET /repos/{owner}/{repo}/releases/assets/{asset_id}
+		// The API redirects to the storage host of the asset. The redirect is followed with a
+		// client without authentication, so that the credentials of the API client aren't sent
+		// to that host.
+		rc, _, err := c.c.Client().Repositories.DownloadReleaseAsset(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), asset.GetID(), c.downloadClient)
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return rc, nil
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all assets of the release tagged with version.
+//
+// ErrNotFound is returned if there is no release for the given version.
+func (c *ArtifactClient) List(ctx context.Context, version string) ([]*gitprovider.ArtifactInfo, error) {
+	assets, err := c.listAssets(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	artifacts := make([]*gitprovider.ArtifactInfo, 0, len(assets))
+	for _, asset := range assets {
+		artifacts = append(artifacts, artifactFromAPI(asset, version))
+	}
+	return gitprovider.LimitItems(ctx, artifacts), nil
+}
+
+// ListIter returns an iterator over the artifacts List returns, fetching them a page at a time.
+func (c *ArtifactClient) ListIter(ctx context.Context, version string) *gitprovider.Iterator[*gitprovider.ArtifactInfo] {
+	var releaseID int64
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]*gitprovider.ArtifactInfo, gitprovider.PageInfo, error) {
+		// Look the release up once, along with the first page
+		if releaseID == 0 {
+			release, err := c.getRelease(ctx, version)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			releaseID = release.GetID()
+		}
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /repos/{owner}/{repo}/releases/{release_id}/assets
+		apiObjs, resp, err := c.c.Client().Repositories.ListReleaseAssets(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), releaseID, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		artifacts := make([]*gitprovider.ArtifactInfo, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			artifacts = append(artifacts, artifactFromAPI(apiObj, version))
+		}
+		return artifacts, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// getRelease returns the release tagged with version.
+func (c *ArtifactClient) getRelease(ctx context.Context, version string) (*github.RepositoryRelease, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	release, _, err := c.c.Client().Repositories.GetReleaseByTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), version)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return release, nil
+}
+
+// listAssets returns all assets of the release tagged with version.
+func (c *ArtifactClient) listAssets(ctx context.Context, version string) ([]*github.ReleaseAsset, error) {
+	release, err := c.getRelease(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &github.ListOptions{}
+	apiObjs := []*github.ReleaseAsset{}
+	err = allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/releases/{release_id}/assets
+		pageObjs, resp, listErr := c.c.Client().Repositories.ListReleaseAssets(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), release.GetID(), opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func artifactFromAPI(apiObj *github.ReleaseAsset, version string) *gitprovider.ArtifactInfo {
+	return &gitprovider.ArtifactInfo{
+		Name:        apiObj.GetName(),
+		Version:     version,
+		Size:        int64(apiObj.GetSize()),
+		DownloadURL: apiObj.GetBrowserDownloadURL(),
+	}
+}
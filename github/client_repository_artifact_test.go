@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTestArtifactClient(t *testing.T, handler http.Handler, optFns ...gitprovider.ClientOption) gitprovider.ArtifactClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v3/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"flux2"}`))
+	})
+	mux.HandleFunc("GET /api/v3/repos/fluxcd/flux2/releases/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"tag_name":"v1.0.0"}`))
+	})
+	mux.Handle("/", handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	optFns = append([]gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true), gitprovider.WithOAuth2Token("token")}, optFns...)
+	c, err := NewClient(optFns...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	repo, err := c.OrgRepositories().Get(context.Background(), ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	artifacts, err := repo.Artifacts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return artifacts
+}
+
+func TestArtifactClient_Upload(t *testing.T) {
+	tests := []struct {
+		name    string
+		content io.Reader
+	}{
+		{
+			name:    "reader with length",
+			content: bytes.NewReader([]byte("artifact")),
+		},
+		{
+			name:    "seeker",
+			content: io.NewSectionReader(strings.NewReader("artifact"), 0, 8),
+		},
+		{
+			name:    "reader of unknown size",
+			content: io.MultiReader(strings.NewReader("arti"), strings.NewReader("fact")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var contentLength int64
+			var body, contentType string
+			artifacts := newTestArtifactClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.Path != "POST /api/uploads/repos/fluxcd/flux2/releases/1/assets" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if got := r.URL.Query().Get("name"); got != "manifests.tar.gz" {
+					t.Errorf("upload name = %q, want %q", got, "manifests.tar.gz")
+				}
+				contentLength = r.ContentLength
+				contentType = r.Header.Get("Content-Type")
+				data, _ := io.ReadAll(r.Body)
+				body = string(data)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id":2,"name":"manifests.tar.gz","size":8,"browser_download_url":"https://example.com/manifests.tar.gz"}`))
+			}))
+
+			info, err := artifacts.Upload(context.Background(), "v1.0.0", "manifests.tar.gz", tt.content)
+			if err != nil {
+				t.Fatalf("Upload() error = %v", err)
+			}
+			if body != "artifact" || contentLength != int64(len("artifact")) {
+				t.Errorf("uploaded %q with Content-Length %d, want %q with Content-Length %d", body, contentLength, "artifact", len("artifact"))
+			}
+			if contentType != "application/gzip" && contentType != defaultArtifactMediaType {
+				t.Errorf("Content-Type = %q", contentType)
+			}
+			want := gitprovider.ArtifactInfo{Name: "manifests.tar.gz", Version: "v1.0.0", Size: 8, DownloadURL: "https://example.com/manifests.tar.gz"}
+			if *info != want {
+				t.Errorf("Upload() = %+v, want %+v", *info, want)
+			}
+		})
+	}
+}
+
+func TestArtifactClient_List(t *testing.T) {
+	artifacts := newTestArtifactClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /api/v3/repos/fluxcd/flux2/releases/1/assets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":2,"name":"manifests.tar.gz","size":8},{"id":3,"name":"checksums.txt","size":64}]`))
+	}))
+
+	got, err := artifacts.List(context.Background(), "v1.0.0")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []gitprovider.ArtifactInfo{
+		{Name: "manifests.tar.gz", Version: "v1.0.0", Size: 8},
+		{Name: "checksums.txt", Version: "v1.0.0", Size: 64},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("List() returned %d artifacts, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if *got[i] != want[i] {
+			t.Errorf("List()[%d] = %+v, want %+v", i, *got[i], want[i])
+		}
+	}
+
+	if _, err := artifacts.List(context.Background(), "v2.0.0"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("List() for a missing release error = %v, want %v", err, gitprovider.ErrNotFound)
+	}
+}
+
+func TestArtifactClient_Download(t *testing.T) {
+	var storageAuth string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("redirected artifact"))
+	}))
+	defer storage.Close()
+
+	artifacts := newTestArtifactClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v3/repos/fluxcd/flux2/releases/1/assets":
+			_, _ = w.Write([]byte(`[{"id":2,"name":"manifests.tar.gz"},{"id":3,"name":"checksums.txt"}]`))
+		case "GET /api/v3/repos/fluxcd/flux2/releases/assets/2":
+			_, _ = w.Write([]byte("artifact"))
+		case "GET /api/v3/repos/fluxcd/flux2/releases/assets/3":
+			http.Redirect(w, r, storage.URL+"/checksums.txt", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr error
+	}{
+		{name: "manifests.tar.gz", want: "artifact"},
+		{name: "checksums.txt", want: "redirected artifact"},
+		{name: "missing.txt", wantErr: gitprovider.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc, err := artifacts.Download(context.Background(), "v1.0.0", tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Download() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer rc.Close()
+			data, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Download() = %q, want %q", data, tt.want)
+			}
+		})
+	}
+	if storageAuth != "" {
+		t.Errorf("the redirect to the storage host sent Authorization %q, want none", storageAuth)
+	}
+}
+
+func TestArtifactClient_DownloadWithCABundle(t *testing.T) {
+	storage := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("redirected artifact"))
+	}))
+	defer storage.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: storage.Certificate().Raw})
+
+	// The redirect to the storage host must trust the CA bundle of the client.
+	artifacts := newTestArtifactClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v3/repos/fluxcd/flux2/releases/1/assets":
+			_, _ = w.Write([]byte(`[{"id":3,"name":"checksums.txt"}]`))
+		case "GET /api/v3/repos/fluxcd/flux2/releases/assets/3":
+			http.Redirect(w, r, storage.URL+"/checksums.txt", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), gitprovider.WithCABundle(caBundle))
+
+	rc, err := artifacts.Download(context.Background(), "v1.0.0", "checksums.txt")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "redirected artifact" {
+		t.Errorf("Download() = %q, want %q", data, "redirected artifact")
+	}
+}
+
+func TestArtifactClient_DownloadWithListOptions(t *testing.T) {
+	artifacts := newTestArtifactClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v3/repos/fluxcd/flux2/releases/1/assets":
+			// The asset is on the second page
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`[{"id":3,"name":"checksums.txt"}]`))
+				return
+			}
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`[{"id":2,"name":"manifests.tar.gz"}]`))
+		case "GET /api/v3/repos/fluxcd/flux2/releases/assets/3":
+			_, _ = w.Write([]byte("checksums"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	// The list options of the caller don't limit the lookup of the asset
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallListOptions(gitprovider.ListOptions{PerPage: 1, MaxItems: 1}))
+	rc, err := artifacts.Download(ctx, "v1.0.0", "checksums.txt")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "checksums" {
+		t.Errorf("Download() = %q, want %q", data, "checksums")
+	}
+}
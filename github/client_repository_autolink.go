@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AutolinkClient implements the gitprovider.AutolinkClient interface.
+var _ gitprovider.AutolinkClient = &AutolinkClient{}
+
+// AutolinkClient operates on the autolink references of a specific repository.
+type AutolinkClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all autolinks of the repository.
+func (c *AutolinkClient) List(ctx context.Context) ([]gitprovider.Autolink, error) {
+	opts := &github.ListOptions{}
+	autolinks := []gitprovider.Autolink{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/autolinks
+		pageObjs, resp, listErr := c.c.Client().Repositories.ListAutolinks(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			autolinks = append(autolinks, newAutolink(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, autolinks), nil
+}
+
+// ListIter returns an iterator over the autolinks List returns, fetching them a page at a time.
+func (c *AutolinkClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Autolink] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Autolink, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /repos/{owner}/{repo}/autolinks
+		apiObjs, resp, err := c.c.Client().Repositories.ListAutolinks(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		autolinks := make([]gitprovider.Autolink, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			autolinks = append(autolinks, newAutolink(c, apiObj))
+		}
+		return autolinks, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates an autolink with the given specifications.
+//
+// ErrAlreadyExists will be returned if an autolink with the same key prefix exists.
+func (c *AutolinkClient) Create(ctx context.Context, req gitprovider.AutolinkInfo) (gitprovider.Autolink, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	autolinks, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, autolink := range autolinks {
+		if autolink.Get().KeyPrefix == req.KeyPrefix {
+			return nil, fmt.Errorf("autolink with key prefix %q: %w", req.KeyPrefix, gitprovider.ErrAlreadyExists)
+		}
+	}
+
+	// POST /repos/{owner}/{repo}/autolinks
+	apiObj, _, err := c.c.Client().Repositories.AddAutolink(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), &github.AutolinkOptions{
+		KeyPrefix:      &req.KeyPrefix,
+		URLTemplate:    &req.URLTemplate,
+		IsAlphanumeric: req.IsAlphanumeric,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newAutolink(c, apiObj), nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the outside collaborators of a specific repository.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the collaborator or pending invitation of the given user.
+//
+// ErrNotFound is returned if the user is neither a collaborator nor invited.
+func (c *CollaboratorClient) Get(ctx context.Context, username string) (gitprovider.Collaborator, error) {
+	collaborators, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := c.ListInvitations(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, collaborator := range append(collaborators, invitations...) {
+		if strings.EqualFold(collaborator.Get().Username, username) {
+			return collaborator, nil
+		}
+	}
+	return nil, fmt.Errorf("collaborator %q: %w", username, gitprovider.ErrNotFound)
+}
+
+// List lists the users that are direct collaborators of the repository.
+//
+// List returns all available collaborators, using multiple paginated requests if needed.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	opts := &github.ListCollaboratorsOptions{Affiliation: "direct"}
+	collaborators := []gitprovider.Collaborator{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/collaborators
+		pageObjs, resp, listErr := c.c.Client().Repositories.ListCollaborators(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			collaborators = append(collaborators, newCollaborator(c, apiObj, gitprovider.CollaboratorInfo{
+				Username:   apiObj.GetLogin(),
+				Permission: getPermissionFromMap(apiObj.Permissions),
+			}))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, collaborators), nil
+}
+
+// ListIter returns an iterator over the collaborators List returns, fetching them a page at a time.
+func (c *CollaboratorClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Collaborator] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Collaborator, gitprovider.PageInfo, error) {
+		opts := &github.ListCollaboratorsOptions{Affiliation: "direct", ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/collaborators
+		apiObjs, resp, err := c.c.Client().Repositories.ListCollaborators(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		collaborators := make([]gitprovider.Collaborator, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			collaborators = append(collaborators, newCollaborator(c, apiObj, gitprovider.CollaboratorInfo{
+				Username:   apiObj.GetLogin(),
+				Permission: getPermissionFromMap(apiObj.Permissions),
+			}))
+		}
+		return collaborators, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// ListInvitations lists the users that have been invited, but haven't accepted yet.
+//
+// ListInvitations returns all available invitations, using multiple paginated requests if needed.
+func (c *CollaboratorClient) ListInvitations(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	opts := &github.ListOptions{}
+	invitations := []gitprovider.Collaborator{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/invitations
+		pageObjs, resp, listErr := c.c.Client().Repositories.ListInvitations(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			invitations = append(invitations, newCollaborator(c, apiObj, invitationFromAPI(apiObj.GetInvitee(), apiObj.GetPermissions())))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// Create invites the given user to the repository. Users that already have access through
+// the organization are added right away, without an invitation.
+//
+// ErrAlreadyExists will be returned if the user already is a collaborator or invited.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.Get(ctx, req.Username); err == nil {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Username, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	return c.add(ctx, req)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Username)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating collaborator, as it doesn't exist", "repository", c.ref.String(), "collaborator", req.Username)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("collaborator is up to date", "repository", c.ref.String(), "collaborator", req.Username)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating collaborator to the desired state", "repository", c.ref.String(), "collaborator", req.Username)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+// add adds the given user, or changes the permission of an existing collaborator.
+func (c *CollaboratorClient) add(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	// PUT /repos/{owner}/{repo}/collaborators/{username}
+	invitation, _, err := c.c.Client().Repositories.AddCollaborator(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), req.Username, &github.RepositoryAddCollaboratorOptions{
+		Permission: string(*req.Permission),
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// No invitation is returned if the user got access right away
+	if invitation == nil {
+		return newCollaborator(c, nil, req), nil
+	}
+	return newCollaborator(c, invitation, invitationFromAPI(invitation.GetInvitee(), invitation.GetPermissions())), nil
+}
+
+func invitationFromAPI(invitee *github.User, permissions string) gitprovider.CollaboratorInfo {
+	// Invitations use the legacy names of the pull and push permissions
+	permission := gitprovider.RepositoryPermission(permissions)
+	switch permissions {
+	case "read":
+		permission = gitprovider.RepositoryPermissionPull
+	case "write":
+		permission = gitprovider.RepositoryPermissionPush
+	}
+	return gitprovider.CollaboratorInfo{
+		Username:   invitee.GetLogin(),
+		Permission: &permission,
+		Pending:    true,
+	}
+}
+
+func invitationPermissionToAPI(permission gitprovider.RepositoryPermission) string {
+	switch permission {
+	case gitprovider.RepositoryPermissionPull:
+		return "read"
+	case gitprovider.RepositoryPermissionPush:
+		return "write"
+	default:
+		return string(permission)
+	}
+}
@@ -27,6 +27,16 @@ import (
 var githubNewFileMode = "100644"
 var githubBlobTypeFile = "blob"
 
+// defaultCommitSizeLimits are the limits of the GitHub API: blobs larger than 100 MiB are
+// rejected, and so are pushes larger than 2 GiB. They can be overridden with
+// gitprovider.WithCommitSizeLimits.
+//
+//nolint:gochecknoglobals
+var defaultCommitSizeLimits = gitprovider.SizeLimits{
+	MaxFileSize: 100 << 20,
+	MaxPushSize: 2 << 30,
+}
+
 // CommitClient implements the gitprovider.CommitClient interface.
 var _ gitprovider.CommitClient = &CommitClient{}
 
@@ -52,6 +62,23 @@ func (c *CommitClient) ListPage(ctx context.Context, branch string, perPage, pag
 	return commits, nil
 }
 
+// ListIter returns an iterator over the commits of the given branch, fetching them a page at a
+// time with ListPage. A page holding fewer commits than the page size is the last one.
+func (c *CommitClient) ListIter(ctx context.Context, branch string) *gitprovider.Iterator[gitprovider.Commit] {
+	perPage := iteratorPageSize(ctx)
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Commit, gitprovider.PageInfo, error) {
+		commits, err := c.ListPage(ctx, branch, perPage, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		var info gitprovider.PageInfo
+		if len(commits) == perPage {
+			info.NextPage = page + 1
+		}
+		return commits, info, nil
+	})
+}
+
 func (c *CommitClient) listPage(ctx context.Context, branch string, perPage, page int) ([]*commitType, error) {
 	// GET /repos/{owner}/{repo}/commits
 	apiObjs, err := c.c.ListCommitsPage(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, perPage, page)
@@ -75,14 +102,36 @@ func (c *CommitClient) Create(ctx context.Context, branch string, message string
 		return nil, fmt.Errorf("no files added")
 	}
 
+	files, err := gitprovider.NormalizeCommitFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gitprovider.CheckCommitSize(files, gitprovider.CallOptionsFromContext(ctx).SizeLimits(c.commitSizeLimits)); err != nil {
+		return nil, err
+	}
+
 	treeEntries := make([]*github.TreeEntry, 0)
 	for _, file := range files {
-		treeEntries = append(treeEntries, &github.TreeEntry{
+		entry := &github.TreeEntry{
 			Path:    file.Path,
 			Mode:    &githubNewFileMode,
 			Type:    &githubBlobTypeFile,
 			Content: file.Content,
-		})
+		}
+		// Inline tree content must be UTF-8, so binary files are uploaded as blobs first
+		if file.Content != nil && file.IsBase64() {
+			blob, _, err := c.c.Client().Git.CreateBlob(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), &github.Blob{
+				Content:  file.Content,
+				Encoding: github.String(string(gitprovider.CommitFileEncodingBase64)),
+			})
+			if err != nil {
+				return nil, handleHTTPError(err)
+			}
+			entry.Content = nil
+			entry.SHA = blob.SHA
+		}
+		treeEntries = append(treeEntries, entry)
 	}
 
 	commits, err := c.ListPage(ctx, branch, 1, 0)
@@ -125,3 +174,32 @@ func (c *CommitClient) Create(ctx context.Context, branch string, message string
 
 	return newCommit(c, nCommit), nil
 }
+
+// GetSignature returns the signature verification of the commit with the given sha.
+func (c *CommitClient) GetSignature(ctx context.Context, sha string) (*gitprovider.SignatureVerification, error) {
+	// GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+	apiObj, _, err := c.c.Client().Git.GetCommit(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), sha)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return signatureFromAPI(apiObj.Verification, apiObj.Committer), nil
+}
+
+// GetTagSignature returns the signature verification of the tag with the given name.
+func (c *CommitClient) GetTagSignature(ctx context.Context, tag string) (*gitprovider.SignatureVerification, error) {
+	// GET /repos/{owner}/{repo}/git/ref/tags/{tag}
+	ref, _, err := c.c.Client().Git.GetRef(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), "tags/"+tag)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Lightweight tags point to the commit directly
+	if ref.GetObject().GetType() != "tag" {
+		return signatureFromAPI(nil, nil), nil
+	}
+	// GET /repos/{owner}/{repo}/git/tags/{tag_sha}
+	apiObj, _, err := c.c.Client().Git.GetTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), ref.GetObject().GetSHA())
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return signatureFromAPI(apiObj.Verification, apiObj.Tagger), nil
+}
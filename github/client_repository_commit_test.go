@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestCommitClient_Create_BlobError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v3/repos/fluxcd/flux2":
+			_, _ = w.Write([]byte(`{"name":"flux2"}`))
+		case "POST /api/v3/repos/fluxcd/flux2/git/blobs":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Bad credentials"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	repo, err := c.OrgRepositories().Get(ctx, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = repo.Commits().Create(ctx, "main", "add binary file", []gitprovider.CommitFile{
+		gitprovider.NewBinaryCommitFile("data.bin", []byte{0xff, 0x00}),
+	})
+	var credsErr *gitprovider.InvalidCredentialsError
+	if !errors.As(err, &credsErr) {
+		t.Errorf("Create() error = %v, want InvalidCredentialsError", err)
+	}
+}
+
+func TestCommitClient_Create_SizeLimits(t *testing.T) {
+	c, err := NewClient(gitprovider.WithCommitSizeLimits(gitprovider.SizeLimits{MaxFileSize: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := gitprovider.UserRepositoryRef{
+		UserRef:        gitprovider.UserRef{Domain: c.SupportedDomain(), UserLogin: "fluxcd"},
+		RepositoryName: "flux2",
+	}
+	// The commit is checked before any request is made
+	repo := newUserRepository(c.(*Client).clientContext, &github.Repository{}, ref)
+
+	_, err = repo.Commits().Create(context.Background(), "main", "add file", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("README.md"), Content: gitprovider.StringVar("ab")},
+	})
+	var sizeErr *gitprovider.CommitTooLargeError
+	if !errors.As(err, &sizeErr) {
+		t.Errorf("Create() error = %v, want CommitTooLargeError", err)
+	}
+}
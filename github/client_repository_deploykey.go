@@ -42,7 +42,7 @@ func (c *DeployKeyClient) Get(ctx context.Context, name string) (gitprovider.Dep
 }
 
 func (c *DeployKeyClient) get(ctx context.Context, name string) (*deployKey, error) {
-	deployKeys, err := c.list(ctx)
+	deployKeys, err := c.list(gitprovider.WithoutListOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,28 @@ func (c *DeployKeyClient) List(ctx context.Context) ([]gitprovider.DeployKey, er
 	for _, dk := range dks {
 		keys = append(keys, dk)
 	}
-	return keys, nil
+	return gitprovider.LimitItems(ctx, keys), nil
+}
+
+// ListIter returns an iterator over the deploy keys List returns, fetching them a page at a time.
+func (c *DeployKeyClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.DeployKey] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.DeployKey, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /repos/{owner}/{repo}/keys
+		apiObjs, resp, err := c.c.Client().Repositories.ListKeys(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		keys := make([]gitprovider.DeployKey, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateDeployKeyAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			keys = append(keys, newDeployKey(c, apiObj))
+		}
+		return keys, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
 }
 
 func (c *DeployKeyClient) list(ctx context.Context) ([]*deployKey, error) {
@@ -117,6 +138,7 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating deploy key, as it doesn't exist", "repository", c.ref.String(), "deployKey", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -127,9 +149,11 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("deploy key is up to date", "repository", c.ref.String(), "deployKey", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating deploy key to the desired state", "repository", c.ref.String(), "deployKey", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// DeploymentClient implements the gitprovider.DeploymentClient interface.
+var _ gitprovider.DeploymentClient = &DeploymentClient{}
+
+// DeploymentClient operates on the deployments of a specific repository.
+type DeploymentClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the deployment with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *DeploymentClient) Get(ctx context.Context, id int64) (gitprovider.Deployment, error) {
+	// GET /repos/{owner}/{repo}/deployments/{deployment_id}
+	apiObj, _, err := c.c.Client().Repositories.GetDeployment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), id)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateDeploymentAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newDeployment(c, apiObj), nil
+}
+
+// List lists the deployments of the repository, most recent first. If environment isn't
+// empty, only the deployments to that environment are listed.
+//
+// List returns all available deployments, using multiple paginated requests if needed.
+func (c *DeploymentClient) List(ctx context.Context, environment string) ([]gitprovider.Deployment, error) {
+	opts := &github.DeploymentsListOptions{Environment: environment}
+	apiObjs := []*github.Deployment{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/deployments
+		pageObjs, resp, listErr := c.c.Client().Repositories.ListDeployments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := make([]gitprovider.Deployment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateDeploymentAPI(apiObj); err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, newDeployment(c, apiObj))
+	}
+	return gitprovider.LimitItems(ctx, deployments), nil
+}
+
+// ListIter returns an iterator over the deployments List returns, fetching them a page at a time.
+func (c *DeploymentClient) ListIter(ctx context.Context, environment string) *gitprovider.Iterator[gitprovider.Deployment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Deployment, gitprovider.PageInfo, error) {
+		opts := &github.DeploymentsListOptions{Environment: environment, ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/deployments
+		apiObjs, resp, err := c.c.Client().Repositories.ListDeployments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		deployments := make([]gitprovider.Deployment, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateDeploymentAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			deployments = append(deployments, newDeployment(c, apiObj))
+		}
+		return deployments, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates a deployment with the given specifications.
+func (c *DeploymentClient) Create(ctx context.Context, req gitprovider.DeploymentInfo) (gitprovider.Deployment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	// By default, GitHub merges the default branch into ref before deploying, which isn't
+	// what a controller reporting on a rollout of a specific ref wants
+	opts := &github.DeploymentRequest{
+		Ref:         &req.Ref,
+		Environment: &req.Environment,
+		Description: req.Description,
+		AutoMerge:   github.Bool(false),
+	}
+	// POST /repos/{owner}/{repo}/deployments
+	apiObj, _, err := c.c.Client().Repositories.CreateDeployment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateDeploymentAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newDeployment(c, apiObj), nil
+}
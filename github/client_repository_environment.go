@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// protectionRuleWaitTimer is the type of the protection rule holding the wait timer.
+	protectionRuleWaitTimer = "wait_timer"
+	// protectionRuleRequiredReviewers is the type of the protection rule holding the required reviewers.
+	protectionRuleRequiredReviewers = "required_reviewers"
+	// reviewerTypeUser and reviewerTypeTeam are the GitHub types of required reviewers.
+	reviewerTypeUser = "User"
+	reviewerTypeTeam = "Team"
+)
+
+// EnvironmentClient implements the gitprovider.EnvironmentClient interface.
+var _ gitprovider.EnvironmentClient = &EnvironmentClient{}
+
+// EnvironmentClient operates on the deployment environments of a specific repository.
+type EnvironmentClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the environment with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *EnvironmentClient) Get(ctx context.Context, name string) (gitprovider.Environment, error) {
+	return c.get(ctx, name)
+}
+
+func (c *EnvironmentClient) get(ctx context.Context, name string) (*environment, error) {
+	// GET /repos/{owner}/{repo}/environments/{environment_name}
+	apiObj, _, err := c.c.Client().Repositories.GetEnvironment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateEnvironmentAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newEnvironment(c, apiObj), nil
+}
+
+// List lists all environments of the repository.
+//
+// List returns all available environments, using multiple paginated requests if needed.
+func (c *EnvironmentClient) List(ctx context.Context) ([]gitprovider.Environment, error) {
+	opts := &github.EnvironmentListOptions{}
+	apiObjs := []*github.Environment{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/environments
+		page, resp, listErr := c.c.Client().Repositories.ListEnvironments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if page != nil {
+			apiObjs = append(apiObjs, page.Environments...)
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	environments := make([]gitprovider.Environment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateEnvironmentAPI(apiObj); err != nil {
+			return nil, err
+		}
+		environments = append(environments, newEnvironment(c, apiObj))
+	}
+	return gitprovider.LimitItems(ctx, environments), nil
+}
+
+// ListIter returns an iterator over the environments List returns, fetching them a page at a time.
+func (c *EnvironmentClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Environment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Environment, gitprovider.PageInfo, error) {
+		opts := &github.EnvironmentListOptions{ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/environments
+		apiObj, resp, err := c.c.Client().Repositories.ListEnvironments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		environments := make([]gitprovider.Environment, 0, len(apiObj.Environments))
+		for _, env := range apiObj.Environments {
+			if err := validateEnvironmentAPI(env); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			environments = append(environments, newEnvironment(c, env))
+		}
+		return environments, gitprovider.PageInfo{NextPage: resp.NextPage, Total: apiObj.TotalCount}, nil
+	})
+}
+
+// Create creates an environment with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *EnvironmentClient) Create(ctx context.Context, req gitprovider.EnvironmentInfo) (gitprovider.Environment, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// Environments are created and updated using the same call, so check for existence first
+	if _, err := c.get(ctx, req.Name); err == nil {
+		return nil, gitprovider.ErrAlreadyExists
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := c.createOrUpdate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return newEnvironment(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *EnvironmentClient) Reconcile(ctx context.Context, req gitprovider.EnvironmentInfo) (gitprovider.Environment, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating environment, as it doesn't exist", "repository", c.ref.String(), "environment", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// Environment names are case-insensitive, a different case is not a diff
+	if gitprovider.NamesEqual(req.Name, actual.Get().Name, caseSensitiveNames) {
+		req.Name = actual.Get().Name
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("environment is up to date", "repository", c.ref.String(), "environment", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating environment to the desired state", "repository", c.ref.String(), "environment", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// createOrUpdate applies req, resolving the reviewers to their GitHub IDs.
+func (c *EnvironmentClient) createOrUpdate(ctx context.Context, req gitprovider.EnvironmentInfo) (*github.Environment, error) {
+	if req.ExternalURL != nil {
+		return nil, fmt.Errorf("environment URLs: %w", gitprovider.ErrNoProviderSupport)
+	}
+	opts := &github.CreateUpdateEnvironment{
+		WaitTimer: req.WaitTimer,
+		Reviewers: []*github.EnvReviewers{},
+	}
+	for _, reviewer := range req.Reviewers {
+		apiReviewer, err := c.resolveReviewer(ctx, reviewer)
+		if err != nil {
+			return nil, err
+		}
+		opts.Reviewers = append(opts.Reviewers, apiReviewer)
+	}
+
+	// PUT /repos/{owner}/{repo}/environments/{environment_name}
+	apiObj, _, err := c.c.Client().Repositories.CreateUpdateEnvironment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), req.Name, opts)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateEnvironmentAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// resolveReviewer looks up the ID of the given user or team. Teams are looked up in the
+// organization owning the repository.
+func (c *EnvironmentClient) resolveReviewer(ctx context.Context, reviewer gitprovider.EnvironmentReviewer) (*github.EnvReviewers, error) {
+	if reviewer.Type == gitprovider.EnvironmentReviewerTypeTeam {
+		// GET /orgs/{org}/teams/{team_slug}
+		team, _, err := c.c.Client().Teams.GetTeamBySlug(ctx, c.ref.GetIdentity(), reviewer.Name)
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return &github.EnvReviewers{Type: github.String(reviewerTypeTeam), ID: team.ID}, nil
+	}
+	// GET /users/{username}
+	user, _, err := c.c.Client().Users.Get(ctx, reviewer.Name)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return &github.EnvReviewers{Type: github.String(reviewerTypeUser), ID: user.ID}, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific repository.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number. GitHub treats pull requests as issues too,
+// so ErrNotFound is also returned if the number belongs to a pull request.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *IssueClient) Get(ctx context.Context, number int) (gitprovider.Issue, error) {
+	// GET /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, _, err := c.c.Client().Issues.Get(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if apiObj.IsPullRequest() {
+		return nil, gitprovider.ErrNotFound
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// List lists all open issues of the repository.
+//
+// List returns all available issues, using multiple paginated requests if needed.
+func (c *IssueClient) List(ctx context.Context) ([]gitprovider.Issue, error) {
+	opts := &github.IssueListByRepoOptions{State: "open"}
+	issues := []gitprovider.Issue{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/issues
+		pageObjs, resp, listErr := c.c.Client().Issues.ListByRepo(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			if !apiObj.IsPullRequest() {
+				issues = append(issues, newIssue(c, apiObj))
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, issues), nil
+}
+
+// ListIter returns an iterator over the issues List returns, fetching them a page at a time.
+// As GitHub lists pull requests along with issues, pages may hold fewer issues than the page size.
+func (c *IssueClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Issue] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Issue, gitprovider.PageInfo, error) {
+		opts := &github.IssueListByRepoOptions{
+			State:       "open",
+			ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		// GET /repos/{owner}/{repo}/issues
+		apiObjs, resp, err := c.c.Client().Issues.ListByRepo(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		issues := make([]gitprovider.Issue, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if !apiObj.IsPullRequest() {
+				issues = append(issues, newIssue(c, apiObj))
+			}
+		}
+		return issues, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates an issue with the given specifications.
+func (c *IssueClient) Create(ctx context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/issues
+	apiObj, _, err := c.c.Client().Issues.Create(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), issueToAPI(req, false))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Issues can't be created closed
+	if req.State == gitprovider.IssueStateClosed {
+		issue := newIssue(c, apiObj)
+		return issue, issue.Close(ctx)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// issueToAPI converts info to an issue request. The state is only included if withState is set.
+func issueToAPI(info gitprovider.IssueInfo, withState bool) *github.IssueRequest {
+	req := &github.IssueRequest{
+		Title: &info.Title,
+		Body:  &info.Description,
+	}
+	if info.Labels != nil {
+		req.Labels = &info.Labels
+	}
+	if info.Assignees != nil {
+		req.Assignees = &info.Assignees
+	}
+	if withState && info.State != "" {
+		req.State = github.String(string(info.State))
+	}
+	return req
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommentClient implements the gitprovider.CommentClient interface.
+var _ gitprovider.CommentClient = &CommentClient{}
+
+// CommentClient operates on the comments of a specific issue.
+type CommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// List lists all comments of the issue, oldest first.
+//
+// List returns all available comments, using multiple paginated requests if needed.
+func (c *CommentClient) List(ctx context.Context) ([]gitprovider.Comment, error) {
+	opts := &github.IssueListCommentsOptions{}
+	comments := []gitprovider.Comment{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/issues/{issue_number}/comments
+		pageObjs, resp, listErr := c.c.Client().Issues.ListComments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number, opts)
+		for _, apiObj := range pageObjs {
+			comments = append(comments, newComment(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, comments), nil
+}
+
+// ListIter returns an iterator over the comments List returns, fetching them a page at a time.
+func (c *CommentClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Comment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Comment, gitprovider.PageInfo, error) {
+		opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/issues/{issue_number}/comments
+		apiObjs, resp, err := c.c.Client().Issues.ListComments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		comments := make([]gitprovider.Comment, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			comments = append(comments, newComment(c, apiObj))
+		}
+		return comments, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates a comment with the given specifications.
+func (c *CommentClient) Create(ctx context.Context, req gitprovider.CommentInfo) (gitprovider.Comment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/issues/{issue_number}/comments
+	apiObj, _, err := c.c.Client().Issues.CreateComment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number, &github.IssueComment{
+		Body: &req.Body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newComment(c, apiObj), nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestIssueClient_ListIter(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/fluxcd/flux2":
+			_, _ = w.Write([]byte(`{"name":"flux2"}`))
+		case "/api/v3/repos/fluxcd/flux2/issues":
+			page := r.URL.Query().Get("page")
+			pages = append(pages, page)
+			next := fmt.Sprintf(`<%s%s?page=%%d>; rel="next"`, "http://"+r.Host, r.URL.Path)
+			switch page {
+			case "1":
+				// A page holding only pull requests
+				w.Header().Set("Link", fmt.Sprintf(next, 2))
+				_, _ = w.Write([]byte(`[{"number":1,"pull_request":{}}]`))
+			case "2":
+				w.Header().Set("Link", fmt.Sprintf(next, 3))
+				_, _ = w.Write([]byte(`[{"number":2,"title":"first"}]`))
+			case "3":
+				_, _ = w.Write([]byte(`[{"number":3,"title":"second"}]`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	repo, err := c.OrgRepositories().Get(ctx, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := repo.Issues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := issues.ListIter(ctx)
+	var titles []string
+	for it.Next(ctx) {
+		titles = append(titles, it.Value().Get().Title)
+		if len(titles) == 1 && len(pages) != 2 {
+			t.Errorf("fetched pages %v before returning the first issue", pages)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListIter() error = %v", err)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("ListIter() = %v, want %v", titles, want)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the issue labels of a specific repository.
+type LabelClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the label with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *LabelClient) Get(ctx context.Context, name string) (gitprovider.Label, error) {
+	return c.get(ctx, name)
+}
+
+func (c *LabelClient) get(ctx context.Context, name string) (*label, error) {
+	// GET /repos/{owner}/{repo}/labels/{name}
+	apiObj, _, err := c.c.Client().Issues.GetLabel(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newLabel(c, apiObj), nil
+}
+
+// List lists all labels of the repository.
+//
+// List returns all available labels, using multiple paginated requests if needed.
+func (c *LabelClient) List(ctx context.Context) ([]gitprovider.Label, error) {
+	opts := &github.ListOptions{}
+	labels := []gitprovider.Label{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/labels
+		pageObjs, resp, listErr := c.c.Client().Issues.ListLabels(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			labels = append(labels, newLabel(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, labels), nil
+}
+
+// ListIter returns an iterator over the labels List returns, fetching them a page at a time.
+func (c *LabelClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Label] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Label, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /repos/{owner}/{repo}/labels
+		apiObjs, resp, err := c.c.Client().Issues.ListLabels(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		labels := make([]gitprovider.Label, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			labels = append(labels, newLabel(c, apiObj))
+		}
+		return labels, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates a label with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *LabelClient) Create(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/labels
+	apiObj, _, err := c.c.Client().Issues.CreateLabel(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), labelToAPI(req))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newLabel(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *LabelClient) Reconcile(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating label, as it doesn't exist", "repository", c.ref.String(), "label", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("label is up to date", "repository", c.ref.String(), "label", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating label to the desired state", "repository", c.ref.String(), "label", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+func labelToAPI(info gitprovider.LabelInfo) *github.Label {
+	return &github.Label{
+		Name:        &info.Name,
+		Color:       info.Color,
+		Description: &info.Description,
+	}
+}
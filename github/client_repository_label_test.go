@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestLabelClient_Reconcile_Log(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v3/repos/fluxcd/flux2":
+			_, _ = w.Write([]byte(`{"name":"flux2"}`))
+		case "GET /api/v3/repos/fluxcd/flux2/labels/bug":
+			_, _ = w.Write([]byte(`{"name":"bug","color":"d73a4a"}`))
+		case "POST /api/v3/repos/fluxcd/flux2/labels":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"name":"drift","color":"ededed"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var messages []string
+	msg := regexp.MustCompile(`"msg"="([^"]*)"`)
+	log := funcr.New(func(_, args string) {
+		if strings.Contains(args, `"label"=`) {
+			messages = append(messages, msg.FindStringSubmatch(args)[1])
+		}
+	}, funcr.Options{Verbosity: 1})
+	c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true), gitprovider.WithLogger(&log))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	repo, err := c.OrgRepositories().Get(ctx, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels, err := repo.Labels()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, actionTaken, err := labels.Reconcile(ctx, gitprovider.LabelInfo{Name: "bug", Color: gitprovider.StringVar("d73a4a")}); err != nil || actionTaken {
+		t.Fatalf("Reconcile() = %v, %v, want no action", actionTaken, err)
+	}
+	if _, actionTaken, err := labels.Reconcile(ctx, gitprovider.LabelInfo{Name: "drift"}); err != nil || !actionTaken {
+		t.Fatalf("Reconcile() = %v, %v, want the label created", actionTaken, err)
+	}
+	want := []string{"label is up to date", "creating label, as it doesn't exist"}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("logged %q, want %q", messages, want)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// MilestoneClient implements the gitprovider.MilestoneClient interface.
+var _ gitprovider.MilestoneClient = &MilestoneClient{}
+
+// MilestoneClient operates on the milestones of a specific repository.
+type MilestoneClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the milestone with the given number.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *MilestoneClient) Get(ctx context.Context, number int) (gitprovider.Milestone, error) {
+	// GET /repos/{owner}/{repo}/milestones/{milestone_number}
+	apiObj, _, err := c.c.Client().Issues.GetMilestone(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newMilestone(c, apiObj), nil
+}
+
+// List lists all open and closed milestones of the repository.
+//
+// List returns all available milestones, using multiple paginated requests if needed.
+func (c *MilestoneClient) List(ctx context.Context) ([]gitprovider.Milestone, error) {
+	opts := &github.MilestoneListOptions{State: "all"}
+	milestones := []gitprovider.Milestone{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/milestones
+		pageObjs, resp, listErr := c.c.Client().Issues.ListMilestones(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			milestones = append(milestones, newMilestone(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, milestones), nil
+}
+
+// ListIter returns an iterator over the milestones List returns, fetching them a page at a time.
+func (c *MilestoneClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Milestone] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Milestone, gitprovider.PageInfo, error) {
+		opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/milestones
+		apiObjs, resp, err := c.c.Client().Issues.ListMilestones(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		milestones := make([]gitprovider.Milestone, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			milestones = append(milestones, newMilestone(c, apiObj))
+		}
+		return milestones, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates a milestone with the given specifications.
+func (c *MilestoneClient) Create(ctx context.Context, req gitprovider.MilestoneInfo) (gitprovider.Milestone, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/milestones
+	apiObj, _, err := c.c.Client().Issues.CreateMilestone(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), milestoneToAPI(req))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newMilestone(c, apiObj), nil
+}
+
+func milestoneToAPI(info gitprovider.MilestoneInfo) *github.Milestone {
+	apiObj := &github.Milestone{
+		Title:       &info.Title,
+		Description: &info.Description,
+	}
+	if info.DueDate != nil {
+		apiObj.DueOn = &github.Timestamp{Time: *info.DueDate}
+	}
+	if info.State != "" {
+		apiObj.State = github.String(string(info.State))
+	}
+	return apiObj
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// packageTypes are the package types GitHub Packages can list. GitHub only lists packages
+// of one type at a time.
+//
+//nolint:gochecknoglobals
+var packageTypes = []string{"container", "docker", "npm", "maven", "rubygems", "nuget"}
+
+// PackageClient implements the gitprovider.PackageClient interface.
+var _ gitprovider.PackageClient = &PackageClient{}
+
+// PackageClient operates on the GitHub Packages published from a specific repository.
+// Packages belong to the owner of the repository, and are linked to at most one repository.
+type PackageClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all packages of the owner that are linked to the repository.
+//
+// List returns all available packages, using multiple paginated requests if needed.
+func (c *PackageClient) List(ctx context.Context) ([]gitprovider.Package, error) {
+	if err := c.checkCapability(ctx, gitprovider.CapabilityPackages); err != nil {
+		return nil, err
+	}
+	packages := []gitprovider.Package{}
+	for _, packageType := range packageTypes {
+		opts := &github.PackageListOptions{
+			PackageType: github.String(packageType),
+		}
+		err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+			// GET /orgs/{org}/packages or GET /users/{username}/packages
+			pageObjs, resp, listErr := c.listPackages(ctx, opts)
+			for _, apiObj := range pageObjs {
+				if strings.EqualFold(apiObj.GetRepository().GetName(), c.ref.GetRepository()) {
+					packages = append(packages, newPackage(c, apiObj))
+				}
+			}
+			return resp, listErr
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return gitprovider.LimitItems(ctx, packages), nil
+}
+
+// ListIter returns an iterator over the packages List returns, fetching them a page at a time,
+// one package type after the other.
+func (c *PackageClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Package] {
+	fetches := make([]gitprovider.PageFunc[gitprovider.Package], 0, len(packageTypes))
+	for _, packageType := range packageTypes {
+		fetches = append(fetches, c.packagesPage(packageType))
+	}
+	return gitprovider.NewIterator(ctx, gitprovider.ConcatPages(fetches...))
+}
+
+// packagesPage returns a PageFunc listing the packages of the given type published from the repository.
+func (c *PackageClient) packagesPage(packageType string) gitprovider.PageFunc[gitprovider.Package] {
+	return func(ctx context.Context, page int) ([]gitprovider.Package, gitprovider.PageInfo, error) {
+		if err := c.checkCapability(ctx, gitprovider.CapabilityPackages); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		opts := &github.PackageListOptions{
+			PackageType: github.String(packageType),
+			ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		// GET /orgs/{org}/packages or GET /users/{username}/packages
+		apiObjs, resp, err := c.listPackages(ctx, opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		packages := make([]gitprovider.Package, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if strings.EqualFold(apiObj.GetRepository().GetName(), c.ref.GetRepository()) {
+				packages = append(packages, newPackage(c, apiObj))
+			}
+		}
+		return packages, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	}
+}
+
+func (c *PackageClient) listPackages(ctx context.Context, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error) {
+	if c.isOrg() {
+		return c.c.Client().Organizations.ListPackages(ctx, c.ref.GetIdentity(), opts)
+	}
+	return c.c.Client().Users.ListPackages(ctx, c.ref.GetIdentity(), opts)
+}
+
+func (c *PackageClient) listVersions(ctx context.Context, packageType, name string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error) {
+	if c.isOrg() {
+		return c.c.Client().Organizations.PackageGetAllVersions(ctx, c.ref.GetIdentity(), packageType, name, opts)
+	}
+	return c.c.Client().Users.PackageGetAllVersions(ctx, c.ref.GetIdentity(), packageType, name, opts)
+}
+
+func (c *PackageClient) deleteVersion(ctx context.Context, packageType, name string, id int64) (*github.Response, error) {
+	if c.isOrg() {
+		return c.c.Client().Organizations.PackageDeleteVersion(ctx, c.ref.GetIdentity(), packageType, name, id)
+	}
+	return c.c.Client().Users.PackageDeleteVersion(ctx, c.ref.GetIdentity(), packageType, name, id)
+}
+
+func (c *PackageClient) isOrg() bool {
+	_, ok := c.ref.(gitprovider.OrgRepositoryRef)
+	return ok
+}
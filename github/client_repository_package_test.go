@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTestPackageClient(t *testing.T) gitprovider.PackageClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v3/orgs/fluxcd/packages", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("package_type") {
+		case "container":
+			_, _ = w.Write([]byte(`[
+				{"name":"flux2","package_type":"container","repository":{"name":"flux2"}},
+				{"name":"source-controller","package_type":"container","repository":{"name":"source-controller"}}
+			]`))
+		case "docker":
+			_, _ = w.Write([]byte(`[{"name":"flux2-legacy","package_type":"docker","repository":{"name":"Flux2"}}]`))
+		case "npm":
+			_, _ = w.Write([]byte(`[{"name":"flux-js","package_type":"npm","repository":{"name":"flux2"}}]`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	})
+	mux.HandleFunc("GET /api/v3/orgs/fluxcd/packages/container/flux2/versions", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id":2,"name":"sha256:2222","created_at":"2026-01-02T00:00:00Z","metadata":{"package_type":"container","container":{"tags":["v2.0.0","latest"]}}},
+			{"id":1,"name":"sha256:1111","created_at":"2026-01-01T00:00:00Z","metadata":{"package_type":"container","container":{"tags":[]}}}
+		]`))
+	})
+	r := newTestRepository(t, mux)
+	packages, err := r.Packages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return packages
+}
+
+func TestPackageClient_List(t *testing.T) {
+	packages, err := newTestPackageClient(t).List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	got := make([]gitprovider.PackageInfo, 0, len(packages))
+	for _, p := range packages {
+		got = append(got, p.Get())
+	}
+	// Only the packages linked to the repository are listed
+	want := []gitprovider.PackageInfo{
+		{Name: "flux2", Type: gitprovider.PackageTypeContainer},
+		{Name: "flux2-legacy", Type: gitprovider.PackageTypeContainer},
+		{Name: "flux-js", Type: gitprovider.PackageTypeNPM},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("List() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPackage_Versions(t *testing.T) {
+	packages, err := newTestPackageClient(t).List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	versions, err := packages[0].Versions(context.Background())
+	if err != nil {
+		t.Fatalf("Versions() error = %v", err)
+	}
+	got := make([]gitprovider.PackageVersionInfo, 0, len(versions))
+	for _, v := range versions {
+		got = append(got, v.Get())
+	}
+	created := func(day int) *time.Time {
+		t := time.Date(2026, 1, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	want := []gitprovider.PackageVersionInfo{
+		{Version: "sha256:2222", Tags: []string{"v2.0.0", "latest"}, CreatedAt: created(2)},
+		{Version: "sha256:1111", Tags: []string{}, CreatedAt: created(1)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Versions() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+	"gopkg.in/yaml.v3"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PipelineClient implements the gitprovider.PipelineClient interface.
+var _ gitprovider.PipelineClient = &PipelineClient{}
+
+// PipelineClient operates on the GitHub Actions workflows of a specific repository.
+type PipelineClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Trigger dispatches every active workflow that has a workflow_dispatch trigger in its
+// file on the given ref. Each workflow only receives the variables it declares as inputs,
+// as GitHub rejects unknown inputs.
+//
+// ErrNotFound is returned if no workflow can be dispatched on the ref.
+func (c *PipelineClient) Trigger(ctx context.Context, ref string, variables map[string]string) error {
+	if ref == "" {
+		return fmt.Errorf("ref is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	workflows := []*github.Workflow{}
+	opts := &github.ListOptions{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/actions/workflows
+		pageObjs, resp, listErr := c.c.Client().Actions.ListWorkflows(ctx, owner, repo, opts)
+		if pageObjs != nil {
+			for _, w := range pageObjs.Workflows {
+				if w.GetState() == "active" {
+					workflows = append(workflows, w)
+				}
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return err
+	}
+
+	dispatched := false
+	for _, w := range workflows {
+		// GET /repos/{owner}/{repo}/contents/{path}
+		apiObj, _, _, err := c.c.Client().Repositories.GetContents(ctx, owner, repo, w.GetPath(), &github.RepositoryContentGetOptions{Ref: ref})
+		if err != nil {
+			// The workflow might not exist on this ref
+			if errors.Is(handleHTTPError(err), gitprovider.ErrNotFound) {
+				continue
+			}
+			return handleHTTPError(err)
+		}
+		content, err := apiObj.GetContent()
+		if err != nil {
+			return err
+		}
+		ok, inputs, err := parseWorkflowDispatch([]byte(content))
+		if err != nil {
+			return fmt.Errorf("failed to parse workflow %s: %w", w.GetPath(), err)
+		}
+		if !ok {
+			continue
+		}
+
+		event := github.CreateWorkflowDispatchEventRequest{Ref: ref}
+		for _, input := range inputs {
+			if value, ok := variables[input]; ok {
+				if event.Inputs == nil {
+					event.Inputs = map[string]interface{}{}
+				}
+				event.Inputs[input] = value
+			}
+		}
+		// POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches
+		if _, err := c.c.Client().Actions.CreateWorkflowDispatchEventByID(ctx, owner, repo, w.GetID(), event); err != nil {
+			return handleHTTPError(err)
+		}
+		dispatched = true
+	}
+	if !dispatched {
+		return fmt.Errorf("no workflow with a workflow_dispatch trigger on %q: %w", ref, gitprovider.ErrNotFound)
+	}
+	return nil
+}
+
+// Get returns the workflow run with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *PipelineClient) Get(ctx context.Context, id int64) (gitprovider.Pipeline, error) {
+	// GET /repos/{owner}/{repo}/actions/runs/{run_id}
+	apiObj, _, err := c.c.Client().Actions.GetWorkflowRunByID(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), id)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newPipeline(c, apiObj), nil
+}
+
+// List lists the workflow runs for the given branch, tag or full commit SHA, most recent first.
+//
+// List returns all available workflow runs, using multiple paginated requests if needed.
+func (c *PipelineClient) List(ctx context.Context, ref string) ([]gitprovider.Pipeline, error) {
+	if ref == "" {
+		return nil, fmt.Errorf("ref is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	opts := &github.ListWorkflowRunsOptions{}
+	if gitprovider.IsCommitSHA(ref) {
+		opts.HeadSHA = ref
+	} else {
+		opts.Branch = ref
+	}
+	pipelines := []gitprovider.Pipeline{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/actions/runs
+		pageObjs, resp, listErr := c.c.Client().Actions.ListRepositoryWorkflowRuns(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if pageObjs != nil {
+			for _, apiObj := range pageObjs.WorkflowRuns {
+				pipelines = append(pipelines, newPipeline(c, apiObj))
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, pipelines), nil
+}
+
+// ListIter returns an iterator over the pipelines List returns, fetching them a page at a time.
+func (c *PipelineClient) ListIter(ctx context.Context, ref string) *gitprovider.Iterator[gitprovider.Pipeline] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Pipeline, gitprovider.PageInfo, error) {
+		if ref == "" {
+			return nil, gitprovider.PageInfo{}, fmt.Errorf("ref is required: %w", gitprovider.ErrInvalidArgument)
+		}
+		opts := &github.ListWorkflowRunsOptions{ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		if gitprovider.IsCommitSHA(ref) {
+			opts.HeadSHA = ref
+		} else {
+			opts.Branch = ref
+		}
+		// GET /repos/{owner}/{repo}/actions/runs
+		apiObj, resp, err := c.c.Client().Actions.ListRepositoryWorkflowRuns(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		pipelines := make([]gitprovider.Pipeline, 0, len(apiObj.WorkflowRuns))
+		for _, run := range apiObj.WorkflowRuns {
+			pipelines = append(pipelines, newPipeline(c, run))
+		}
+		return pipelines, gitprovider.PageInfo{NextPage: resp.NextPage, Total: apiObj.TotalCount}, nil
+	})
+}
+
+// parseWorkflowDispatch returns whether the workflow in content can be dispatched
+// manually, and the names of the inputs it declares.
+func parseWorkflowDispatch(content []byte) (bool, []string, error) {
+	var workflow struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(content, &workflow); err != nil {
+		return false, nil, err
+	}
+	switch workflow.On.Kind {
+	case yaml.ScalarNode:
+		return workflow.On.Value == "workflow_dispatch", nil, nil
+	case yaml.SequenceNode:
+		for _, event := range workflow.On.Content {
+			if event.Value == "workflow_dispatch" {
+				return true, nil, nil
+			}
+		}
+		return false, nil, nil
+	case yaml.MappingNode:
+		var triggers map[string]struct {
+			Inputs yaml.Node `yaml:"inputs"`
+		}
+		if err := workflow.On.Decode(&triggers); err != nil {
+			return false, nil, err
+		}
+		dispatch, ok := triggers["workflow_dispatch"]
+		if !ok {
+			return false, nil, nil
+		}
+		var inputs []string
+		if dispatch.Inputs.Kind == yaml.MappingNode {
+			// Keys and values alternate in the content of a mapping node
+			for i := 0; i < len(dispatch.Inputs.Content); i += 2 {
+				inputs = append(inputs, dispatch.Inputs.Content[i].Value)
+			}
+		}
+		return true, inputs, nil
+	}
+	return false, nil, nil
+}
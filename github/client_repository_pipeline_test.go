@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseWorkflowDispatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantOK     bool
+		wantInputs []string
+	}{
+		{
+			name: "dispatch with inputs",
+			content: `name: Release
+on:
+  push:
+    branches: [main]
+  workflow_dispatch:
+    inputs:
+      version:
+        required: true
+      dry-run:
+        type: boolean
+`,
+			wantOK:     true,
+			wantInputs: []string{"version", "dry-run"},
+		},
+		{
+			name:    "dispatch without inputs",
+			content: "on:\n  workflow_dispatch:\n",
+			wantOK:  true,
+		},
+		{
+			name:    "single event name",
+			content: "on: workflow_dispatch\n",
+			wantOK:  true,
+		},
+		{
+			name:    "event name list",
+			content: "on: [push, workflow_dispatch]\n",
+			wantOK:  true,
+		},
+		{
+			name:    "no dispatch",
+			content: "on:\n  push: {}\n",
+			wantOK:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, inputs, err := parseWorkflowDispatch([]byte(tt.content))
+			if err != nil {
+				t.Fatalf("parseWorkflowDispatch() error = %v", err)
+			}
+			if ok != tt.wantOK {
+				t.Errorf("parseWorkflowDispatch() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(inputs, tt.wantInputs) {
+				t.Errorf("parseWorkflowDispatch() inputs = %v, want %v", inputs, tt.wantInputs)
+			}
+		})
+	}
+}
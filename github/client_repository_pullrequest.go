@@ -18,6 +18,7 @@ package github
 
 import (
 	"context"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/google/go-github/v66/github"
@@ -45,7 +46,26 @@ func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest
 		requests[idx] = newPullRequest(c.clientContext, pr)
 	}
 
-	return requests, nil
+	return gitprovider.LimitItems(ctx, requests), nil
+}
+
+// ListIter returns an iterator over the open pull requests of the repository, fetching them a
+// page at a time.
+func (c *PullRequestClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.PullRequest] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.PullRequest, gitprovider.PageInfo, error) {
+		opts := &github.PullRequestListOptions{ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /repos/{owner}/{repo}/pulls
+		prs, resp, err := c.c.Client().PullRequests.List(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		requests := make([]gitprovider.PullRequest, 0, len(prs))
+		for _, pr := range prs {
+			requests = append(requests, newPullRequest(c.clientContext, pr))
+		}
+		return requests, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
 }
 
 // Create creates a pull request with the given specifications.
@@ -104,3 +124,42 @@ func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod g
 
 	return nil
 }
+
+// EnableAutoMerge enables auto-merge for the pull request, so GitHub merges it once all
+// requirements are met. Auto-merge has to be allowed in the repository settings.
+func (c *PullRequestClient) EnableAutoMerge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	nodeID, err := c.nodeID(ctx, number)
+	if err != nil {
+		return err
+	}
+	// Auto-merge is only available through the GraphQL API
+	return graphQL(ctx, c.c.Client(), `mutation($id: ID!, $method: PullRequestMergeMethod!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) { clientMutationId }
+}`, map[string]interface{}{
+		"id":     nodeID,
+		"method": strings.ToUpper(string(mergeMethod)),
+	}, nil)
+}
+
+// DisableAutoMerge disables auto-merge for the pull request.
+func (c *PullRequestClient) DisableAutoMerge(ctx context.Context, number int) error {
+	nodeID, err := c.nodeID(ctx, number)
+	if err != nil {
+		return err
+	}
+	return graphQL(ctx, c.c.Client(), `mutation($id: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $id}) { clientMutationId }
+}`, map[string]interface{}{
+		"id": nodeID,
+	}, nil)
+}
+
+// nodeID returns the GraphQL node ID of the pull request.
+func (c *PullRequestClient) nodeID(ctx context.Context, number int) (string, error) {
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	pr, _, err := c.c.Client().PullRequests.Get(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return "", handleHTTPError(err)
+	}
+	return pr.GetNodeID(), nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+// ReleaseClient implements the experimental.ReleaseClient interface.
+var _ experimental.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific repository.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ExperimentalAPI implements experimental.API.
+func (c *ReleaseClient) ExperimentalAPI() {}
+
+// Get returns the release for the given tag.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ReleaseClient) Get(ctx context.Context, tag string) (experimental.Release, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	apiObj, _, err := c.c.Client().Repositories.GetReleaseByTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), tag)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// List lists all releases of the repository, newest first. Draft releases are only included
+// if the user has push access to the repository.
+//
+// List returns all available releases, using multiple paginated requests if needed.
+func (c *ReleaseClient) List(ctx context.Context) ([]experimental.Release, error) {
+	opts := &github.ListOptions{}
+	releases := []experimental.Release{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/releases
+		pageObjs, resp, listErr := c.c.Client().Repositories.ListReleases(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		for _, apiObj := range pageObjs {
+			releases = append(releases, newRelease(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, releases), nil
+}
+
+// ListIter returns an iterator over the releases List returns, fetching them a page at a time.
+func (c *ReleaseClient) ListIter(ctx context.Context) *gitprovider.Iterator[experimental.Release] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]experimental.Release, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /repos/{owner}/{repo}/releases
+		apiObjs, resp, err := c.c.Client().Repositories.ListReleases(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		releases := make([]experimental.Release, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			releases = append(releases, newRelease(c, apiObj))
+		}
+		return releases, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates a release with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *ReleaseClient) Create(ctx context.Context, req experimental.ReleaseInfo) (experimental.Release, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/releases
+	apiObj, _, err := c.c.Client().Repositories.CreateRelease(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), &github.RepositoryRelease{
+		TagName:         &req.TagName,
+		TargetCommitish: req.Target,
+		Name:            &req.Name,
+		Body:            &req.Description,
+		Draft:           &req.Draft,
+		Prerelease:      &req.Prerelease,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newRelease(c, apiObj), nil
+}
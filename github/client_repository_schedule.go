@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+	"gopkg.in/yaml.v3"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// workflowsDir is the directory GitHub Actions workflows are read from.
+const workflowsDir = ".github/workflows"
+
+// errScheduledWorkflows is returned when trying to change a scheduled workflow through the API.
+var errScheduledWorkflows = fmt.Errorf("scheduled workflows are defined in workflow files: %w", gitprovider.ErrNoProviderSupport)
+
+// ScheduleClient implements the gitprovider.ScheduleClient interface.
+var _ gitprovider.ScheduleClient = &ScheduleClient{}
+
+// ScheduleClient lists the scheduled GitHub Actions workflows of a specific repository.
+// GitHub has no API for schedules: they are the "schedule" triggers of the workflow files on
+// the default branch. Each cron expression is a schedule with the ID "{workflow path}#{index}".
+// Schedules are read-only, so change the workflow files instead of creating or updating them.
+type ScheduleClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the workflow schedule with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ScheduleClient) Get(ctx context.Context, id string) (gitprovider.Schedule, error) {
+	file, _, ok := strings.Cut(id, "#")
+	if !ok || path.Dir(file) != workflowsDir {
+		return nil, fmt.Errorf("invalid schedule ID %q: %w", id, gitprovider.ErrInvalidArgument)
+	}
+	schedules, err := c.list(gitprovider.WithoutListOptions(ctx), file)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schedules {
+		if s.info.ID == id {
+			return s, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists the schedules of all workflows of the repository.
+//
+// List returns all available schedules, using multiple paginated requests if needed.
+func (c *ScheduleClient) List(ctx context.Context) ([]gitprovider.Schedule, error) {
+	schedules, err := c.list(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]gitprovider.Schedule, 0, len(schedules))
+	for _, s := range schedules {
+		result = append(result, s)
+	}
+	return gitprovider.LimitItems(ctx, result), nil
+}
+
+// ListIter returns an iterator over the schedules List returns. The schedules are parsed from
+// the workflow files, which GitHub doesn't list by page, so they're all fetched by a single call
+// to List.
+func (c *ScheduleClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Schedule] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
+
+// list returns the schedules of the given workflow file, or of all workflows if file is empty.
+func (c *ScheduleClient) list(ctx context.Context, file string) ([]*schedule, error) {
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	// GET /repos/{owner}/{repo}
+	repository, _, err := c.c.Client().Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	// Workflows can be disabled without changing their files
+	states := map[string]string{}
+	opts := &github.ListOptions{}
+	err = allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/actions/workflows
+		pageObjs, resp, listErr := c.c.Client().Actions.ListWorkflows(ctx, owner, repo, opts)
+		if pageObjs != nil {
+			for _, w := range pageObjs.Workflows {
+				states[w.GetPath()] = w.GetState()
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{file}
+	if file == "" {
+		// GET /repos/{owner}/{repo}/contents/{path}
+		_, apiObjs, _, err := c.c.Client().Repositories.GetContents(ctx, owner, repo, workflowsDir, nil)
+		if err != nil {
+			if errors.Is(handleHTTPError(err), gitprovider.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, handleHTTPError(err)
+		}
+		files = files[:0]
+		for _, apiObj := range apiObjs {
+			if apiObj.GetType() == "file" && (path.Ext(apiObj.GetName()) == ".yml" || path.Ext(apiObj.GetName()) == ".yaml") {
+				files = append(files, apiObj.GetPath())
+			}
+		}
+	}
+
+	schedules := []*schedule{}
+	for _, f := range files {
+		// GET /repos/{owner}/{repo}/contents/{path}
+		apiObj, _, _, err := c.c.Client().Repositories.GetContents(ctx, owner, repo, f, nil)
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		content, err := apiObj.GetContent()
+		if err != nil {
+			return nil, err
+		}
+		name, crons, err := parseWorkflowSchedules([]byte(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse workflow %s: %w", f, err)
+		}
+		if name == "" {
+			name = f
+		}
+		for i, cron := range crons {
+			schedules = append(schedules, &schedule{
+				info: gitprovider.ScheduleInfo{
+					ID:           f + "#" + strconv.Itoa(i),
+					Description:  name,
+					Ref:          repository.GetDefaultBranch(),
+					Cron:         cron,
+					CronTimezone: gitprovider.StringVar("UTC"),
+					Active:       gitprovider.BoolVar(states[f] == "active"),
+				},
+				c: c,
+			})
+		}
+	}
+	return schedules, nil
+}
+
+// Create returns ErrNoProviderSupport, as schedules are part of the workflow files.
+func (c *ScheduleClient) Create(_ context.Context, _ gitprovider.ScheduleInfo) (gitprovider.Schedule, error) {
+	return nil, errScheduledWorkflows
+}
+
+// Reconcile returns ErrNoProviderSupport, as schedules are part of the workflow files.
+func (c *ScheduleClient) Reconcile(_ context.Context, _ gitprovider.ScheduleInfo) (gitprovider.Schedule, bool, error) {
+	return nil, false, errScheduledWorkflows
+}
+
+// workflowTriggers are the triggers of a workflow that are relevant for schedules.
+type workflowTriggers struct {
+	Schedule []struct {
+		Cron string `yaml:"cron"`
+	} `yaml:"schedule"`
+}
+
+// parseWorkflowSchedules returns the name of the workflow in content, and the cron
+// expressions it is scheduled with.
+func parseWorkflowSchedules(content []byte) (string, []string, error) {
+	var workflow struct {
+		Name string    `yaml:"name"`
+		On   yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(content, &workflow); err != nil {
+		return "", nil, err
+	}
+	// Triggers can also be given as a single event name or a list of event names,
+	// but a schedule always needs a mapping for its cron expressions
+	if workflow.On.Kind != yaml.MappingNode {
+		return workflow.Name, nil, nil
+	}
+	var triggers workflowTriggers
+	if err := workflow.On.Decode(&triggers); err != nil {
+		return "", nil, err
+	}
+	crons := make([]string, 0, len(triggers.Schedule))
+	for _, s := range triggers.Schedule {
+		crons = append(crons, s.Cron)
+	}
+	return workflow.Name, crons, nil
+}
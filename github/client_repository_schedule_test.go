@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseWorkflowSchedules(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantName  string
+		wantCrons []string
+	}{
+		{
+			name: "scheduled workflow",
+			content: `name: Nightly
+on:
+  push:
+    branches: [main]
+  schedule:
+    - cron: '0 4 * * *'
+    - cron: '30 12 * * 1'
+`,
+			wantName:  "Nightly",
+			wantCrons: []string{"0 4 * * *", "30 12 * * 1"},
+		},
+		{
+			name:      "event name triggers",
+			content:   "on: [push, pull_request]\n",
+			wantCrons: nil,
+		},
+		{
+			name:      "no schedule",
+			content:   "name: CI\non:\n  push: {}\n",
+			wantName:  "CI",
+			wantCrons: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, crons, err := parseWorkflowSchedules([]byte(tt.content))
+			if err != nil {
+				t.Fatalf("parseWorkflowSchedules() error = %v", err)
+			}
+			if name != tt.wantName {
+				t.Errorf("parseWorkflowSchedules() name = %q, want %q", name, tt.wantName)
+			}
+			if !reflect.DeepEqual(crons, tt.wantCrons) {
+				t.Errorf("parseWorkflowSchedules() crons = %v, want %v", crons, tt.wantCrons)
+			}
+		})
+	}
+}
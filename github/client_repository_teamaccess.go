@@ -19,6 +19,9 @@ package github
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -73,7 +76,33 @@ func (c *TeamAccessClient) List(ctx context.Context) ([]gitprovider.TeamAccess,
 		teamAccess = append(teamAccess, ta)
 	}
 
-	return teamAccess, nil
+	return gitprovider.LimitItems(ctx, teamAccess), nil
+}
+
+// ListIter returns an iterator over the team accesses List returns, fetching them a page at a time,
+// and the detailed information about each team of a page along with it.
+func (c *TeamAccessClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.TeamAccess] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.TeamAccess, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /repos/{owner}/{repo}/teams
+		apiObjs, resp, err := c.c.Client().Repositories.ListTeams(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		teamAccess := make([]gitprovider.TeamAccess, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if apiObj.Slug == nil {
+				return nil, gitprovider.PageInfo{}, fmt.Errorf("didn't expect slug to be nil for team: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+			}
+			ta, err := c.Get(ctx, *apiObj.Slug)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			teamAccess = append(teamAccess, ta)
+		}
+		return teamAccess, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
 }
 
 // Create adds a given team to the repo's team access control list.
@@ -112,6 +141,7 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team access, as it doesn't exist", "repository", c.ref.String(), "team", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -122,9 +152,11 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team access is up to date", "repository", c.ref.String(), "team", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating team access to the desired state", "repository", c.ref.String(), "team", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
@@ -71,6 +71,10 @@ func (c *TreeClient) Get(ctx context.Context, sha string, recursive bool) (*gitp
 
 // List files (blob) in a tree givent the tree sha (path is not used with Github Tree client)
 func (c *TreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
+	path, err := gitprovider.NormalizePath(path)
+	if err != nil {
+		return nil, err
+	}
 	treeInfo, err := c.Get(ctx, sha, recursive)
 	if err != nil {
 		return nil, err
@@ -91,5 +95,13 @@ func (c *TreeClient) List(ctx context.Context, sha string, path string, recursiv
 		}
 	}
 
-	return treeEntries, nil
+	return gitprovider.LimitItems(ctx, treeEntries), nil
+}
+
+// ListIter returns an iterator over the tree entries List returns. GitHub returns a tree in a
+// single response rather than by page, so the entries are all fetched by a single call to List.
+func (c *TreeClient) ListIter(ctx context.Context, sha string, path string, recursive bool) *gitprovider.Iterator[*gitprovider.TreeEntry] {
+	return gitprovider.ListIterator(ctx, func(ctx context.Context) ([]*gitprovider.TreeEntry, error) {
+		return c.List(ctx, sha, path, recursive)
+	})
 }
@@ -0,0 +1,444 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+	"golang.org/x/crypto/nacl/box"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// VariableClient implements the gitprovider.VariableClient interface.
+var _ gitprovider.VariableClient = &VariableClient{}
+
+// VariableClient operates on the GitHub Actions variables and secrets of a specific repository.
+// Masked variables are stored as Actions secrets, whose values are sealed with the public key
+// of the repository (or environment) and can't be read back. Unmasked variables are stored
+// as plain Actions variables.
+type VariableClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	repoID int64
+}
+
+// Get returns the repository-wide variable or secret with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *VariableClient) Get(ctx context.Context, key string) (gitprovider.Variable, error) {
+	return c.get(ctx, key, "")
+}
+
+func (c *VariableClient) get(ctx context.Context, key, environment string) (*variable, error) {
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	// Plain variables can be read back, so look for one of those first
+	var apiObj *github.ActionsVariable
+	var err error
+	if environment == "" {
+		// GET /repos/{owner}/{repo}/actions/variables/{name}
+		apiObj, _, err = c.c.Client().Actions.GetRepoVariable(ctx, owner, repo, key)
+	} else {
+		// GET /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}
+		apiObj, _, err = c.c.Client().Actions.GetEnvVariable(ctx, owner, repo, environment, key)
+	}
+	if err == nil {
+		return newVariable(c, variableFromAPI(apiObj, environment), apiObj), nil
+	}
+	if err = handleHTTPError(err); !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	var secret *github.Secret
+	if environment == "" {
+		// GET /repos/{owner}/{repo}/actions/secrets/{secret_name}
+		secret, _, err = c.c.Client().Actions.GetRepoSecret(ctx, owner, repo, key)
+	} else {
+		// GET /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
+		secret, _, err = c.c.Client().Actions.GetEnvSecret(ctx, int(c.repoID), environment, key)
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newVariable(c, secretFromAPI(secret, environment), secret), nil
+}
+
+// List lists all variables and secrets of the repository, including the ones
+// scoped to a deployment environment.
+//
+// List returns all available variables, using multiple paginated requests if needed.
+func (c *VariableClient) List(ctx context.Context) ([]gitprovider.Variable, error) {
+	environments, err := c.listEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := []gitprovider.Variable{}
+	// The empty environment lists the repository-wide variables and secrets
+	for _, environment := range append([]string{""}, environments...) {
+		vs, err := c.listVariables(ctx, environment)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vs {
+			variables = append(variables, newVariable(c, variableFromAPI(v, environment), v))
+		}
+
+		secrets, err := c.listSecrets(ctx, environment)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range secrets {
+			variables = append(variables, newVariable(c, secretFromAPI(s, environment), s))
+		}
+	}
+	return gitprovider.LimitItems(ctx, variables), nil
+}
+
+// ListIter returns an iterator over the variables List returns, fetching them a page at a time:
+// the variables, then the secrets, of the repository and then of each environment. The names
+// of the environments are listed along with the first page.
+func (c *VariableClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Variable] {
+	var fetch gitprovider.PageFunc[gitprovider.Variable]
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Variable, gitprovider.PageInfo, error) {
+		if fetch == nil {
+			environments, err := c.listEnvironments(gitprovider.WithoutListOptions(ctx))
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			// The empty environment lists the repository-wide variables and secrets
+			fetches := []gitprovider.PageFunc[gitprovider.Variable]{}
+			for _, environment := range append([]string{""}, environments...) {
+				fetches = append(fetches, c.variablesPage(environment), c.secretsPage(environment))
+			}
+			fetch = gitprovider.ConcatPages(fetches...)
+		}
+		return fetch(ctx, page)
+	})
+}
+
+// variablesPage returns a PageFunc listing the variables of the environment, or of the
+// repository if environment is empty.
+func (c *VariableClient) variablesPage(environment string) gitprovider.PageFunc[gitprovider.Variable] {
+	return func(ctx context.Context, page int) ([]gitprovider.Variable, gitprovider.PageInfo, error) {
+		owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		var apiObj *github.ActionsVariables
+		var resp *github.Response
+		var err error
+		if environment == "" {
+			// GET /repos/{owner}/{repo}/actions/variables
+			apiObj, resp, err = c.c.Client().Actions.ListRepoVariables(ctx, owner, repo, opts)
+		} else {
+			// GET /repos/{owner}/{repo}/environments/{environment_name}/variables
+			apiObj, resp, err = c.c.Client().Actions.ListEnvVariables(ctx, owner, repo, environment, opts)
+		}
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		variables := make([]gitprovider.Variable, 0, len(apiObj.Variables))
+		for _, v := range apiObj.Variables {
+			variables = append(variables, newVariable(c, variableFromAPI(v, environment), v))
+		}
+		return variables, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	}
+}
+
+// secretsPage returns a PageFunc listing the secrets of the environment, or of the repository
+// if environment is empty.
+func (c *VariableClient) secretsPage(environment string) gitprovider.PageFunc[gitprovider.Variable] {
+	return func(ctx context.Context, page int) ([]gitprovider.Variable, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		var apiObj *github.Secrets
+		var resp *github.Response
+		var err error
+		if environment == "" {
+			// GET /repos/{owner}/{repo}/actions/secrets
+			apiObj, resp, err = c.c.Client().Actions.ListRepoSecrets(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		} else {
+			// GET /repositories/{repository_id}/environments/{environment_name}/secrets
+			apiObj, resp, err = c.c.Client().Actions.ListEnvSecrets(ctx, int(c.repoID), environment, opts)
+		}
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		variables := make([]gitprovider.Variable, 0, len(apiObj.Secrets))
+		for _, s := range apiObj.Secrets {
+			variables = append(variables, newVariable(c, secretFromAPI(s, environment), s))
+		}
+		return variables, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	}
+}
+
+// Create creates a variable, or a secret if req is masked.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *VariableClient) Create(ctx context.Context, req gitprovider.VariableInfo) (gitprovider.Variable, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// Secrets are created and updated using the same call, so check for existence first
+	if _, err := c.get(ctx, req.Key, req.Environment); err == nil {
+		return nil, gitprovider.ErrAlreadyExists
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := c.createOrUpdate(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	return newVariable(c, req, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// As the value of a secret can't be read back, masked variables are always updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *VariableClient) Reconcile(ctx context.Context, req gitprovider.VariableInfo) (gitprovider.Variable, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the variable with the desired key and environment
+	actual, err := c.get(ctx, req.Key, req.Environment)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating variable, as it doesn't exist", "repository", c.ref.String(), "variable", req.Key)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("variable is up to date", "repository", c.ref.String(), "variable", req.Key)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating variable to the desired state", "repository", c.ref.String(), "variable", req.Key)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// createOrUpdate writes req as a plain variable, or as a sealed secret if req is masked.
+// The returned API object is either a *github.ActionsVariable or a *github.Secret.
+func (c *VariableClient) createOrUpdate(ctx context.Context, req gitprovider.VariableInfo, exists bool) (interface{}, error) {
+	// GitHub has no notion of protected variables; use a protected environment instead
+	if req.Protected != nil && *req.Protected {
+		return nil, fmt.Errorf("protected variables: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.Masked != nil && *req.Masked {
+		return c.putSecret(ctx, req)
+	}
+
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	apiObj := &github.ActionsVariable{Name: req.Key, Value: req.Value}
+	var err error
+	switch {
+	case req.Environment == "" && exists:
+		// PATCH /repos/{owner}/{repo}/actions/variables/{name}
+		_, err = c.c.Client().Actions.UpdateRepoVariable(ctx, owner, repo, apiObj)
+	case req.Environment == "":
+		// POST /repos/{owner}/{repo}/actions/variables
+		_, err = c.c.Client().Actions.CreateRepoVariable(ctx, owner, repo, apiObj)
+	case exists:
+		// PATCH /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}
+		_, err = c.c.Client().Actions.UpdateEnvVariable(ctx, owner, repo, req.Environment, apiObj)
+	default:
+		// POST /repos/{owner}/{repo}/environments/{environment_name}/variables
+		_, err = c.c.Client().Actions.CreateEnvVariable(ctx, owner, repo, req.Environment, apiObj)
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+// putSecret seals the value of req with the public key of the repository or environment,
+// and creates or updates the secret.
+func (c *VariableClient) putSecret(ctx context.Context, req gitprovider.VariableInfo) (*github.Secret, error) {
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	var publicKey *github.PublicKey
+	var err error
+	if req.Environment == "" {
+		// GET /repos/{owner}/{repo}/actions/secrets/public-key
+		publicKey, _, err = c.c.Client().Actions.GetRepoPublicKey(ctx, owner, repo)
+	} else {
+		// GET /repositories/{repository_id}/environments/{environment_name}/secrets/public-key
+		publicKey, _, err = c.c.Client().Actions.GetEnvPublicKey(ctx, int(c.repoID), req.Environment)
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	encryptedValue, err := sealSecret(publicKey.GetKey(), req.Value)
+	if err != nil {
+		return nil, err
+	}
+	encryptedSecret := &github.EncryptedSecret{
+		Name:           req.Key,
+		KeyID:          publicKey.GetKeyID(),
+		EncryptedValue: encryptedValue,
+	}
+	if req.Environment == "" {
+		// PUT /repos/{owner}/{repo}/actions/secrets/{secret_name}
+		_, err = c.c.Client().Actions.CreateOrUpdateRepoSecret(ctx, owner, repo, encryptedSecret)
+	} else {
+		// PUT /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
+		_, err = c.c.Client().Actions.CreateOrUpdateEnvSecret(ctx, int(c.repoID), req.Environment, encryptedSecret)
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return &github.Secret{Name: req.Key}, nil
+}
+
+// delete deletes the variable or secret with the given key and environment.
+func (c *VariableClient) delete(ctx context.Context, info gitprovider.VariableInfo) error {
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	var err error
+	switch {
+	case info.Masked != nil && *info.Masked && info.Environment == "":
+		// DELETE /repos/{owner}/{repo}/actions/secrets/{secret_name}
+		_, err = c.c.Client().Actions.DeleteRepoSecret(ctx, owner, repo, info.Key)
+	case info.Masked != nil && *info.Masked:
+		// DELETE /repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}
+		_, err = c.c.Client().Actions.DeleteEnvSecret(ctx, int(c.repoID), info.Environment, info.Key)
+	case info.Environment == "":
+		// DELETE /repos/{owner}/{repo}/actions/variables/{name}
+		_, err = c.c.Client().Actions.DeleteRepoVariable(ctx, owner, repo, info.Key)
+	default:
+		// DELETE /repos/{owner}/{repo}/environments/{environment_name}/variables/{name}
+		_, err = c.c.Client().Actions.DeleteEnvVariable(ctx, owner, repo, info.Environment, info.Key)
+	}
+	return handleHTTPError(err)
+}
+
+// listEnvironments returns the names of all deployment environments of the repository.
+func (c *VariableClient) listEnvironments(ctx context.Context) ([]string, error) {
+	opts := &github.EnvironmentListOptions{}
+	names := []string{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/environments
+		envs, resp, listErr := c.c.Client().Repositories.ListEnvironments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if envs != nil {
+			for _, env := range envs.Environments {
+				names = append(names, env.GetName())
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// listVariables returns all plain variables of the repository, or of the given environment.
+func (c *VariableClient) listVariables(ctx context.Context, environment string) ([]*github.ActionsVariable, error) {
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	opts := &github.ListOptions{}
+	apiObjs := []*github.ActionsVariable{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		var page *github.ActionsVariables
+		var resp *github.Response
+		var listErr error
+		if environment == "" {
+			// GET /repos/{owner}/{repo}/actions/variables
+			page, resp, listErr = c.c.Client().Actions.ListRepoVariables(ctx, owner, repo, opts)
+		} else {
+			// GET /repos/{owner}/{repo}/environments/{environment_name}/variables
+			page, resp, listErr = c.c.Client().Actions.ListEnvVariables(ctx, owner, repo, environment, opts)
+		}
+		if page != nil {
+			apiObjs = append(apiObjs, page.Variables...)
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+// listSecrets returns all secrets of the repository, or of the given environment.
+func (c *VariableClient) listSecrets(ctx context.Context, environment string) ([]*github.Secret, error) {
+	opts := &github.ListOptions{}
+	apiObjs := []*github.Secret{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		var page *github.Secrets
+		var resp *github.Response
+		var listErr error
+		if environment == "" {
+			// GET /repos/{owner}/{repo}/actions/secrets
+			page, resp, listErr = c.c.Client().Actions.ListRepoSecrets(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		} else {
+			// GET /repositories/{repository_id}/environments/{environment_name}/secrets
+			page, resp, listErr = c.c.Client().Actions.ListEnvSecrets(ctx, int(c.repoID), environment, opts)
+		}
+		if page != nil {
+			apiObjs = append(apiObjs, page.Secrets...)
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+// sealSecret encrypts value using a libsodium sealed box with the given base64-encoded
+// public key, as required by the GitHub Actions secrets API.
+func sealSecret(publicKey, value string) (string, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(decodedKey) != 32 {
+		return "", fmt.Errorf("public key has length %d, expected 32: %w", len(decodedKey), gitprovider.ErrInvalidServerData)
+	}
+	var key [32]byte
+	copy(key[:], decodedKey)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/nacl/box"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_sealSecret(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := sealSecret(base64.StdEncoding.EncodeToString(publicKey[:]), "s3cr3t")
+	if err != nil {
+		t.Fatalf("sealSecret() error = %v", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opened, ok := box.OpenAnonymous(nil, decoded, publicKey, privateKey)
+	if !ok {
+		t.Fatal("sealSecret() returned a value that can't be opened")
+	}
+	if string(opened) != "s3cr3t" {
+		t.Errorf("sealSecret() sealed %q, want %q", opened, "s3cr3t")
+	}
+
+	_, err = sealSecret(base64.StdEncoding.EncodeToString([]byte("too-short")), "s3cr3t")
+	if !errors.Is(err, gitprovider.ErrInvalidServerData) {
+		t.Errorf("sealSecret() error = %v, want %v", err, gitprovider.ErrInvalidServerData)
+	}
+}
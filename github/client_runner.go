@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// RunnerClient implements the gitprovider.RunnerClient interface.
+var _ gitprovider.RunnerClient = &RunnerClient{}
+
+// RunnerClient enrolls GitHub Actions self-hosted runners in an organization or repository.
+type RunnerClient struct {
+	*clientContext
+	// ref is either an OrganizationRef or a RepositoryRef
+	ref gitprovider.IdentityRef
+}
+
+// CreateToken creates a registration token for a self-hosted runner, which expires after an hour.
+// Runner descriptions and tags aren't supported, as runners are labeled when registering them.
+func (c *RunnerClient) CreateToken(ctx context.Context, req gitprovider.RunnerTokenInfo) (*gitprovider.RunnerTokenInfo, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.Description != nil || len(req.Tags) != 0 {
+		return nil, fmt.Errorf("runner descriptions and tags: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return c.createRegistrationToken(ctx)
+}
+
+// RegistrationToken creates a new registration token, as GitHub doesn't return existing ones.
+func (c *RunnerClient) RegistrationToken(ctx context.Context) (*gitprovider.RunnerTokenInfo, error) {
+	return c.createRegistrationToken(ctx)
+}
+
+// RotateRegistrationToken creates a new registration token. Previously created tokens can't
+// be revoked, and stay valid until they expire.
+func (c *RunnerClient) RotateRegistrationToken(ctx context.Context) (*gitprovider.RunnerTokenInfo, error) {
+	return c.createRegistrationToken(ctx)
+}
+
+func (c *RunnerClient) createRegistrationToken(ctx context.Context) (*gitprovider.RunnerTokenInfo, error) {
+	var apiObj *github.RegistrationToken
+	var err error
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// POST /repos/{owner}/{repo}/actions/runners/registration-token
+		apiObj, _, err = c.c.Client().Actions.CreateRegistrationToken(ctx, repoRef.GetIdentity(), repoRef.GetRepository())
+	} else {
+		// POST /orgs/{org}/actions/runners/registration-token
+		apiObj, _, err = c.c.Client().Actions.CreateOrganizationRegistrationToken(ctx, c.ref.GetIdentity())
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	token := &gitprovider.RunnerTokenInfo{
+		Token: apiObj.GetToken(),
+	}
+	if apiObj.ExpiresAt != nil {
+		token.ExpiresAt = &apiObj.ExpiresAt.Time
+	}
+	return token, nil
+}
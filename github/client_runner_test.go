@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestRunnerClient_RegistrationToken(t *testing.T) {
+	expiresAt := time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		ref  func(domain string) gitprovider.IdentityRef
+		path string
+	}{
+		{
+			name: "organization",
+			ref: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.OrganizationRef{Domain: domain, Organization: "fluxcd"}
+			},
+			path: "/api/v3/orgs/fluxcd/actions/runners/registration-token",
+		},
+		{
+			name: "repository",
+			ref: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.OrgRepositoryRef{
+					OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "fluxcd"},
+					RepositoryName:  "flux2",
+				}
+			},
+			path: "/api/v3/repos/fluxcd/flux2/actions/runners/registration-token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST "+tt.path, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"token":"AABF3JGZDX3P5PMEXLND6TS6FCWO6","expires_at":"2026-10-17T11:00:00Z"}`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			c, err := NewClient(gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+			if err != nil {
+				t.Fatal(err)
+			}
+			runners := &RunnerClient{clientContext: c.(*Client).clientContext, ref: tt.ref(c.SupportedDomain())}
+
+			want := &gitprovider.RunnerTokenInfo{Token: "AABF3JGZDX3P5PMEXLND6TS6FCWO6", ExpiresAt: &expiresAt}
+			got, err := runners.RegistrationToken(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("RegistrationToken() mismatch (-want +got):\n%s", diff)
+			}
+			// GitHub can't revoke registration tokens, so rotating creates a new one
+			got, err = runners.RotateRegistrationToken(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("RotateRegistrationToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_Runners(t *testing.T) {
+	c, err := NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Runners(); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Runners() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// GPGKeyClient implements the gitprovider.GPGKeyClient interface.
+var _ gitprovider.GPGKeyClient = &GPGKeyClient{}
+
+// GPGKeyClient operates on the GPG keys of the authenticated user.
+type GPGKeyClient struct {
+	*clientContext
+}
+
+// Get returns the GPG key with the given key ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *GPGKeyClient) Get(ctx context.Context, keyID string) (gitprovider.GPGKey, error) {
+	keys, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if strings.EqualFold(key.Get().KeyID, keyID) {
+			return key, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all GPG keys of the authenticated user.
+func (c *GPGKeyClient) List(ctx context.Context) ([]gitprovider.GPGKey, error) {
+	opts := &github.ListOptions{}
+	keys := []gitprovider.GPGKey{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /user/gpg_keys
+		pageObjs, resp, listErr := c.c.Client().Users.ListGPGKeys(ctx, "", opts)
+		for _, apiObj := range pageObjs {
+			keys = append(keys, newGPGKey(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, keys), nil
+}
+
+// ListIter returns an iterator over the GPG keys List returns, fetching them a page at a time.
+func (c *GPGKeyClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.GPGKey] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.GPGKey, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /user/gpg_keys
+		apiObjs, resp, err := c.c.Client().Users.ListGPGKeys(ctx, "", opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		keys := make([]gitprovider.GPGKey, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			keys = append(keys, newGPGKey(c, apiObj))
+		}
+		return keys, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create adds a GPG key with the given specifications.
+//
+// ErrAlreadyExists will be returned if the key has been added already.
+func (c *GPGKeyClient) Create(ctx context.Context, req gitprovider.GPGKeyInfo) (gitprovider.GPGKey, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	keyID, _, err := gitprovider.ParseGPGPublicKey(req.ArmoredPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.Get(ctx, keyID); err == nil {
+		return nil, fmt.Errorf("GPG key %s: %w", keyID, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	// POST /user/gpg_keys
+	apiObj, _, err := c.c.Client().Users.CreateGPGKey(ctx, req.ArmoredPublicKey)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newGPGKey(c, apiObj), nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// SSHKeyClient implements the gitprovider.SSHKeyClient interface.
+var _ gitprovider.SSHKeyClient = &SSHKeyClient{}
+
+// SSHKeyClient operates on the SSH keys of the authenticated user.
+type SSHKeyClient struct {
+	*clientContext
+}
+
+// Get returns the SSH key with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *SSHKeyClient) Get(ctx context.Context, name string) (gitprovider.SSHKey, error) {
+	keys, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Get().Name == name {
+			return key, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all SSH keys of the authenticated user.
+func (c *SSHKeyClient) List(ctx context.Context) ([]gitprovider.SSHKey, error) {
+	opts := &github.ListOptions{}
+	keys := []gitprovider.SSHKey{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /user/keys
+		pageObjs, resp, listErr := c.c.Client().Users.ListKeys(ctx, "", opts)
+		for _, apiObj := range pageObjs {
+			keys = append(keys, newSSHKey(c, apiObj))
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, keys), nil
+}
+
+// ListIter returns an iterator over the SSH keys List returns, fetching them a page at a time.
+func (c *SSHKeyClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.SSHKey] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.SSHKey, gitprovider.PageInfo, error) {
+		opts := &github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /user/keys
+		apiObjs, resp, err := c.c.Client().Users.ListKeys(ctx, "", opts)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		keys := make([]gitprovider.SSHKey, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			keys = append(keys, newSSHKey(c, apiObj))
+		}
+		return keys, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create adds an SSH key with the given specifications. GitHub keys don't expire.
+//
+// ErrAlreadyExists will be returned if a key with the same name exists.
+func (c *SSHKeyClient) Create(ctx context.Context, req gitprovider.SSHKeyInfo) (gitprovider.SSHKey, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil {
+		return nil, fmt.Errorf("SSH key expiry: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if _, err := c.Get(ctx, req.Name); err == nil {
+		return nil, fmt.Errorf("SSH key %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	// POST /user/keys
+	apiObj, _, err := c.c.Client().Users.CreateKey(ctx, &github.Key{
+		Title: &req.Name,
+		Key:   github.String(string(req.Key)),
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newSSHKey(c, apiObj), nil
+}
@@ -51,17 +51,32 @@ type githubClient interface {
 	GetRepo(ctx context.Context, owner, repo string) (*github.Repository, error)
 	// ListOrgRepos is a wrapper for "GET /orgs/{org}/repos".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
-	ListOrgRepos(ctx context.Context, org string) ([]*github.Repository, error)
+	ListOrgRepos(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, error)
 	// ListUserRepos is a wrapper for "GET /users/{username}/repos".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListUserRepos(ctx context.Context, username string) ([]*github.Repository, error)
+	// SearchRepos is a wrapper for "GET /search/repositories".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	SearchRepos(ctx context.Context, query string) ([]*github.Repository, error)
+	// ListOrgReposPage, ListUserReposPage and SearchReposPage fetch a single page of
+	// ListOrgRepos, ListUserRepos and SearchRepos respectively.
+	// These functions handle HTTP error wrapping, and validate the server result.
+	ListOrgReposPage(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions, page int) ([]*github.Repository, gitprovider.PageInfo, error)
+	ListUserReposPage(ctx context.Context, username string, page int) ([]*github.Repository, gitprovider.PageInfo, error)
+	SearchReposPage(ctx context.Context, query string, page int) ([]*github.Repository, gitprovider.PageInfo, error)
 	// CreateRepo is a wrapper for "POST /user/repos" (if orgName == "")
 	// or "POST /orgs/{org}/repos" (if orgName != "").
 	// This function handles HTTP error wrapping, and validates the server result.
 	CreateRepo(ctx context.Context, orgName string, req *github.Repository) (*github.Repository, error)
+	// CreateRepoFromTemplate is a wrapper for "POST /repos/{template_owner}/{template_repo}/generate".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateRepoFromTemplate(ctx context.Context, templateOwner, templateRepo string, req *github.TemplateRepoRequest) (*github.Repository, error)
 	// UpdateRepo is a wrapper for "PATCH /repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping, and validates the server result.
 	UpdateRepo(ctx context.Context, owner, repo string, req *github.Repository) (*github.Repository, error)
+	// ReplaceTopics is a wrapper for "PUT /repos/{owner}/{repo}/topics".
+	// This function handles HTTP error wrapping.
+	ReplaceTopics(ctx context.Context, owner, repo string, topics []string) ([]string, error)
 	// DeleteRepo is a wrapper for "DELETE /repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping.
 	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
@@ -129,7 +144,7 @@ func (c *githubClientImpl) GetOrg(ctx context.Context, orgName string) (*github.
 func (c *githubClientImpl) ListOrgs(ctx context.Context) ([]*github.Organization, error) {
 	apiObjs := []*github.Organization{}
 	opts := &github.ListOptions{}
-	err := allPages(opts, func() (*github.Response, error) {
+	err := allPages(ctx, opts, func() (*github.Response, error) {
 		// GET /user/orgs
 		pageObjs, resp, listErr := c.c.Organizations.List(ctx, "", opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -151,7 +166,7 @@ func (c *githubClientImpl) ListOrgs(ctx context.Context) ([]*github.Organization
 func (c *githubClientImpl) ListOrgTeamMembers(ctx context.Context, orgName, teamName string) ([]*github.User, error) {
 	apiObjs := []*github.User{}
 	opts := &github.TeamListTeamMembersOptions{}
-	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
 		// GET /orgs/{org}/teams/{team_slug}/members
 		pageObjs, resp, listErr := c.c.Teams.ListTeamMembersBySlug(ctx, orgName, teamName, opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -175,7 +190,7 @@ func (c *githubClientImpl) ListOrgTeams(ctx context.Context, orgName string) ([]
 	// List all teams, using pagination. This does not contain information about the members
 	apiObjs := []*github.Team{}
 	opts := &github.ListOptions{}
-	err := allPages(opts, func() (*github.Response, error) {
+	err := allPages(ctx, opts, func() (*github.Response, error) {
 		// GET /orgs/{org}/teams
 		pageObjs, resp, listErr := c.c.Teams.ListTeams(ctx, orgName, opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -212,10 +227,9 @@ func validateRepositoryAPIResp(apiObj *github.Repository, err error) (*github.Re
 	return apiObj, nil
 }
 
-func (c *githubClientImpl) ListOrgRepos(ctx context.Context, org string) ([]*github.Repository, error) {
+func (c *githubClientImpl) ListOrgRepos(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, error) {
 	var apiObjs []*github.Repository
-	opts := &github.RepositoryListByOrgOptions{}
-	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
 		// GET /orgs/{org}/repos
 		pageObjs, resp, listErr := c.c.Repositories.ListByOrg(ctx, org, opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -240,7 +254,7 @@ func validateRepositoryObjects(apiObjs []*github.Repository) ([]*github.Reposito
 func (c *githubClientImpl) ListUserRepos(ctx context.Context, username string) ([]*github.Repository, error) {
 	var apiObjs []*github.Repository
 	opts := &github.RepositoryListOptions{}
-	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
 		// GET /users/{username}/repos
 		pageObjs, resp, listErr := c.c.Repositories.List(ctx, username, opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -252,6 +266,67 @@ func (c *githubClientImpl) ListUserRepos(ctx context.Context, username string) (
 	return validateRepositoryObjects(apiObjs)
 }
 
+func (c *githubClientImpl) SearchRepos(ctx context.Context, query string) ([]*github.Repository, error) {
+	var apiObjs []*github.Repository
+	opts := &github.SearchOptions{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /search/repositories
+		result, resp, listErr := c.c.Search.Repositories(ctx, query, opts)
+		if result != nil {
+			apiObjs = append(apiObjs, result.Repositories...)
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return validateRepositoryObjects(apiObjs)
+}
+
+func (c *githubClientImpl) ListOrgReposPage(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions, page int) ([]*github.Repository, gitprovider.PageInfo, error) {
+	pageOpts := *opts
+	pageOpts.ListOptions = github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+	// GET /orgs/{org}/repos
+	apiObjs, resp, err := c.c.Repositories.ListByOrg(ctx, org, &pageOpts)
+	return repositoryPage(apiObjs, resp, nil, err)
+}
+
+func (c *githubClientImpl) ListUserReposPage(ctx context.Context, username string, page int) ([]*github.Repository, gitprovider.PageInfo, error) {
+	opts := &github.RepositoryListOptions{
+		ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+	}
+	// GET /users/{username}/repos
+	apiObjs, resp, err := c.c.Repositories.List(ctx, username, opts)
+	return repositoryPage(apiObjs, resp, nil, err)
+}
+
+func (c *githubClientImpl) SearchReposPage(ctx context.Context, query string, page int) ([]*github.Repository, gitprovider.PageInfo, error) {
+	opts := &github.SearchOptions{
+		ListOptions: github.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+	}
+	// GET /search/repositories
+	result, resp, err := c.c.Search.Repositories(ctx, query, opts)
+	if err != nil || result == nil {
+		return repositoryPage(nil, resp, nil, err)
+	}
+	return repositoryPage(result.Repositories, resp, result.Total, nil)
+}
+
+// repositoryPage validates a page of repositories, and describes it using resp.
+func repositoryPage(apiObjs []*github.Repository, resp *github.Response, total *int, err error) ([]*github.Repository, gitprovider.PageInfo, error) {
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+	}
+	if _, err := validateRepositoryObjects(apiObjs); err != nil {
+		return nil, gitprovider.PageInfo{}, err
+	}
+	info := gitprovider.PageInfo{Total: total}
+	if resp != nil {
+		info.NextPage = resp.NextPage
+	}
+	return apiObjs, info, nil
+}
+
 func (c *githubClientImpl) CreateRepo(ctx context.Context, orgName string, req *github.Repository) (*github.Repository, error) {
 	// POST /user/repos (if orgName == "")
 	// POST /orgs/{org}/repos (if orgName != "")
@@ -263,12 +338,24 @@ func (c *githubClientImpl) CreateRepo(ctx context.Context, orgName string, req *
 	return validateRepositoryAPIResp(apiObj, err)
 }
 
+func (c *githubClientImpl) CreateRepoFromTemplate(ctx context.Context, templateOwner, templateRepo string, req *github.TemplateRepoRequest) (*github.Repository, error) {
+	// POST /repos/{template_owner}/{template_repo}/generate
+	apiObj, _, err := c.c.Repositories.CreateFromTemplate(ctx, templateOwner, templateRepo, req)
+	return validateRepositoryAPIResp(apiObj, err)
+}
+
 func (c *githubClientImpl) UpdateRepo(ctx context.Context, owner, repo string, req *github.Repository) (*github.Repository, error) {
 	// PATCH /repos/{owner}/{repo}
 	apiObj, _, err := c.c.Repositories.Edit(ctx, owner, repo, req)
 	return validateRepositoryAPIResp(apiObj, err)
 }
 
+func (c *githubClientImpl) ReplaceTopics(ctx context.Context, owner, repo string, topics []string) ([]string, error) {
+	// PUT /repos/{owner}/{repo}/topics
+	apiObj, _, err := c.c.Repositories.ReplaceAllTopics(ctx, owner, repo, topics)
+	return apiObj, handleHTTPError(err)
+}
+
 func (c *githubClientImpl) DeleteRepo(ctx context.Context, owner, repo string) error {
 	// Don't allow deleting repositories if the user didn't explicitly allow dangerous API calls.
 	if !c.destructiveActions {
@@ -282,7 +369,7 @@ func (c *githubClientImpl) DeleteRepo(ctx context.Context, owner, repo string) e
 func (c *githubClientImpl) ListKeys(ctx context.Context, owner, repo string) ([]*github.Key, error) {
 	apiObjs := []*github.Key{}
 	opts := &github.ListOptions{}
-	err := allPages(opts, func() (*github.Response, error) {
+	err := allPages(ctx, opts, func() (*github.Response, error) {
 		// GET /repos/{owner}/{repo}/keys
 		pageObjs, resp, listErr := c.c.Repositories.ListKeys(ctx, owner, repo, opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -371,7 +458,7 @@ func (c *githubClientImpl) GetTeamPermissions(ctx context.Context, orgName, repo
 func (c *githubClientImpl) ListRepoTeams(ctx context.Context, orgName, repo string) ([]*github.Team, error) {
 	apiObjs := []*github.Team{}
 	opts := &github.ListOptions{}
-	err := allPages(opts, func() (*github.Response, error) {
+	err := allPages(ctx, opts, func() (*github.Response, error) {
 		// GET /repos/{owner}/{repo}/teams
 		pageObjs, resp, listErr := c.c.Repositories.ListTeams(ctx, orgName, repo, opts)
 		apiObjs = append(apiObjs, pageObjs...)
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newAutolink(c *AutolinkClient, apiObj *github.Autolink) *autolink {
+	return &autolink{
+		a: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Autolink = &autolink{}
+
+type autolink struct {
+	a github.Autolink
+	c *AutolinkClient
+}
+
+func (a *autolink) Get() gitprovider.AutolinkInfo {
+	return gitprovider.AutolinkInfo{
+		ID:             a.a.GetID(),
+		KeyPrefix:      a.a.GetKeyPrefix(),
+		URLTemplate:    a.a.GetURLTemplate(),
+		IsAlphanumeric: a.a.IsAlphanumeric,
+	}
+}
+
+func (a *autolink) APIObject() interface{} {
+	return &a.a
+}
+
+func (a *autolink) Repository() gitprovider.RepositoryRef {
+	return a.c.ref
+}
+
+// Delete deletes the autolink from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (a *autolink) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/autolinks/{autolink_id}
+	_, err := a.c.c.Client().Repositories.DeleteAutolink(ctx, a.c.ref.GetIdentity(), a.c.ref.GetRepository(), a.a.GetID())
+	return handleHTTPError(err)
+}
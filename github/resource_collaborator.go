@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, apiObj interface{}, info gitprovider.CollaboratorInfo) *collaborator {
+	return &collaborator{
+		info:   info,
+		apiObj: apiObj,
+		c:      c,
+	}
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	info gitprovider.CollaboratorInfo
+	// apiObj is a *github.User for collaborators, and a *github.RepositoryInvitation or
+	// *github.CollaboratorInvitation for pending invitations
+	apiObj interface{}
+	c      *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	info.Pending = c.info.Pending
+	c.info = info
+	return nil
+}
+
+func (c *collaborator) APIObject() interface{} {
+	return c.apiObj
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Update changes the permission of the collaborator, or of the pending invitation.
+func (c *collaborator) Update(ctx context.Context) error {
+	if !c.info.Pending {
+		resp, err := c.c.add(ctx, c.info)
+		if err != nil {
+			return err
+		}
+		c.info = resp.Get()
+		c.apiObj = resp.APIObject()
+		return nil
+	}
+
+	// PATCH /repos/{owner}/{repo}/invitations/{invitation_id}
+	apiObj, _, err := c.c.c.Client().Repositories.UpdateInvitation(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(),
+		c.invitationID(), invitationPermissionToAPI(*c.info.Permission))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	c.info = invitationFromAPI(apiObj.GetInvitee(), apiObj.GetPermissions())
+	c.apiObj = apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.Get(ctx, req.Username)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			c.info = resp.Get()
+			c.apiObj = resp.APIObject()
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Continue from the actual object, which knows whether the user is still invited
+	c.info.Pending = actual.Get().Pending
+	c.apiObj = actual.APIObject()
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+	return true, c.Update(ctx)
+}
+
+// Delete removes the user from the repository, or withdraws the pending invitation.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	if c.info.Pending {
+		// DELETE /repos/{owner}/{repo}/invitations/{invitation_id}
+		_, err := c.c.c.Client().Repositories.DeleteInvitation(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.invitationID())
+		return handleHTTPError(err)
+	}
+	// DELETE /repos/{owner}/{repo}/collaborators/{username}
+	_, err := c.c.c.Client().Repositories.RemoveCollaborator(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.info.Username)
+	return handleHTTPError(err)
+}
+
+func (c *collaborator) invitationID() int64 {
+	switch apiObj := c.apiObj.(type) {
+	case *github.RepositoryInvitation:
+		return apiObj.GetID()
+	case *github.CollaboratorInvitation:
+		return apiObj.GetID()
+	default:
+		return 0
+	}
+}
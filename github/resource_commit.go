@@ -45,7 +45,7 @@ func (c *commitType) APIObject() interface{} {
 }
 
 func commitFromAPI(apiObj *github.Commit) gitprovider.CommitInfo {
-	return gitprovider.CommitInfo{
+	info := gitprovider.CommitInfo{
 		Sha:       *apiObj.SHA,
 		TreeSha:   *apiObj.Tree.SHA,
 		Author:    *apiObj.Author.Name,
@@ -53,4 +53,23 @@ func commitFromAPI(apiObj *github.Commit) gitprovider.CommitInfo {
 		CreatedAt: *apiObj.Author.Date.GetTime(),
 		URL:       *apiObj.URL,
 	}
+	if apiObj.Verification != nil {
+		info.Signature = signatureFromAPI(apiObj.Verification, apiObj.Committer)
+	}
+	return info
+}
+
+// signatureFromAPI returns the verification of a commit or tag, which GitHub only verifies for
+// the committer or tagger, the signer. A nil verification means the object isn't signed.
+func signatureFromAPI(apiObj *github.SignatureVerification, signer *github.CommitAuthor) *gitprovider.SignatureVerification {
+	if apiObj == nil || apiObj.GetSignature() == "" {
+		return &gitprovider.SignatureVerification{Reason: gitprovider.SignatureVerificationReasonUnsigned}
+	}
+	return &gitprovider.SignatureVerification{
+		Verified:    apiObj.GetVerified(),
+		Reason:      apiObj.GetReason(),
+		Type:        gitprovider.DetectSignatureType(apiObj.GetSignature()),
+		SignerName:  signer.GetName(),
+		SignerEmail: signer.GetEmail(),
+	}
 }
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newDeployment(c *DeploymentClient, apiObj *github.Deployment) *deployment {
+	return &deployment{
+		d: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Deployment = &deployment{}
+
+type deployment struct {
+	d github.Deployment
+	c *DeploymentClient
+}
+
+func (d *deployment) Get() gitprovider.DeploymentInfo {
+	return deploymentFromAPI(&d.d)
+}
+
+func (d *deployment) APIObject() interface{} {
+	return &d.d
+}
+
+func (d *deployment) Repository() gitprovider.RepositoryRef {
+	return d.c.ref
+}
+
+// CreateStatus reports the given state of the deployment back to GitHub.
+func (d *deployment) CreateStatus(ctx context.Context, req gitprovider.DeploymentStatusInfo) error {
+	if err := req.ValidateInfo(); err != nil {
+		return err
+	}
+
+	opts := &github.DeploymentStatusRequest{
+		State:          github.String(string(req.State)),
+		Description:    req.Description,
+		EnvironmentURL: req.EnvironmentURL,
+		LogURL:         req.LogURL,
+	}
+	// POST /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+	_, _, err := d.c.c.Client().Repositories.CreateDeploymentStatus(ctx, d.c.ref.GetIdentity(), d.c.ref.GetRepository(), d.d.GetID(), opts)
+	return handleHTTPError(err)
+}
+
+// ListStatuses lists the states reported for this deployment, most recent first.
+//
+// ListStatuses returns all available statuses, using multiple paginated requests if needed.
+func (d *deployment) ListStatuses(ctx context.Context) ([]gitprovider.DeploymentStatusInfo, error) {
+	opts := &github.ListOptions{}
+	apiObjs := []*github.DeploymentStatus{}
+	err := allPages(ctx, opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+		pageObjs, resp, listErr := d.c.c.Client().Repositories.ListDeploymentStatuses(ctx, d.c.ref.GetIdentity(), d.c.ref.GetRepository(), d.d.GetID(), opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]gitprovider.DeploymentStatusInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		statuses = append(statuses, deploymentStatusFromAPI(apiObj))
+	}
+	return statuses, nil
+}
+
+func validateDeploymentAPI(apiObj *github.Deployment) error {
+	return validateAPIObject("GitHub.Deployment", func(validator validation.Validator) {
+		if apiObj.ID == nil {
+			validator.Required("ID")
+		}
+		if apiObj.Ref == nil {
+			validator.Required("Ref")
+		}
+	})
+}
+
+func deploymentFromAPI(apiObj *github.Deployment) gitprovider.DeploymentInfo {
+	info := gitprovider.DeploymentInfo{
+		ID:          apiObj.GetID(),
+		Ref:         apiObj.GetRef(),
+		SHA:         apiObj.GetSHA(),
+		Environment: apiObj.GetEnvironment(),
+	}
+	if apiObj.GetDescription() != "" {
+		info.Description = apiObj.Description
+	}
+	return info
+}
+
+func deploymentStatusFromAPI(apiObj *github.DeploymentStatus) gitprovider.DeploymentStatusInfo {
+	return gitprovider.DeploymentStatusInfo{
+		State:          gitprovider.DeploymentState(apiObj.GetState()),
+		Description:    apiObj.Description,
+		EnvironmentURL: apiObj.EnvironmentURL,
+		LogURL:         apiObj.LogURL,
+	}
+}
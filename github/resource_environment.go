@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newEnvironment(c *EnvironmentClient, apiObj *github.Environment) *environment {
+	return &environment{
+		e:    *apiObj,
+		info: environmentFromAPI(apiObj),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Environment = &environment{}
+
+type environment struct {
+	e github.Environment
+	// info holds the desired state, as reviewers are referenced by ID in the API object
+	info gitprovider.EnvironmentInfo
+	c    *EnvironmentClient
+}
+
+func (e *environment) Get() gitprovider.EnvironmentInfo {
+	return e.info
+}
+
+func (e *environment) Set(info gitprovider.EnvironmentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	e.info = info
+	return nil
+}
+
+func (e *environment) APIObject() interface{} {
+	return &e.e
+}
+
+func (e *environment) Repository() gitprovider.RepositoryRef {
+	return e.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// The internal API object will be overridden with the received server data.
+func (e *environment) Update(ctx context.Context) error {
+	apiObj, err := e.c.createOrUpdate(ctx, e.info)
+	if err != nil {
+		return err
+	}
+	e.e = *apiObj
+	e.info = environmentFromAPI(apiObj)
+	return nil
+}
+
+// Delete deletes the environment from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (e *environment) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/environments/{environment_name}
+	_, err := e.c.c.Client().Repositories.DeleteEnvironment(ctx, e.c.ref.GetIdentity(), e.c.ref.GetRepository(), e.info.Name)
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (e *environment) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := e.c.get(ctx, e.info.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, e.Update(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	desired := e.info
+	if gitprovider.NamesEqual(desired.Name, actual.info.Name, caseSensitiveNames) {
+		desired.Name = actual.info.Name
+	}
+	if desired.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	return true, e.Update(ctx)
+}
+
+func validateEnvironmentAPI(apiObj *github.Environment) error {
+	return validateAPIObject("GitHub.Environment", func(validator validation.Validator) {
+		if apiObj.Name == nil {
+			validator.Required("Name")
+		}
+	})
+}
+
+func environmentFromAPI(apiObj *github.Environment) gitprovider.EnvironmentInfo {
+	info := gitprovider.EnvironmentInfo{
+		Name: apiObj.GetName(),
+	}
+	for _, rule := range apiObj.ProtectionRules {
+		switch rule.GetType() {
+		case protectionRuleWaitTimer:
+			if rule.GetWaitTimer() != 0 {
+				info.WaitTimer = rule.WaitTimer
+			}
+		case protectionRuleRequiredReviewers:
+			for _, reviewer := range rule.Reviewers {
+				switch r := reviewer.Reviewer.(type) {
+				case *github.User:
+					info.Reviewers = append(info.Reviewers, gitprovider.EnvironmentReviewer{
+						Type: gitprovider.EnvironmentReviewerTypeUser,
+						Name: r.GetLogin(),
+					})
+				case *github.Team:
+					info.Reviewers = append(info.Reviewers, gitprovider.EnvironmentReviewer{
+						Type: gitprovider.EnvironmentReviewerTypeTeam,
+						Name: r.GetSlug(),
+					})
+				}
+			}
+		}
+	}
+	return info
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, apiObj *github.Issue) *issue {
+	return &issue{
+		i:    *apiObj,
+		info: issueFromAPI(apiObj),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i    github.Issue
+	info gitprovider.IssueInfo
+	c    *IssueClient
+}
+
+func (i *issue) Get() gitprovider.IssueInfo {
+	return i.info
+}
+
+func (i *issue) Set(info gitprovider.IssueInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	i.info = info
+	return nil
+}
+
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+func (i *issue) Repository() gitprovider.RepositoryRef {
+	return i.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (i *issue) Update(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, _, err := i.c.c.Client().Issues.Edit(ctx, i.c.ref.GetIdentity(), i.c.ref.GetRepository(), i.i.GetNumber(), issueToAPI(i.info, true))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*i = *newIssue(i.c, apiObj)
+	return nil
+}
+
+// Close closes the issue.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (i *issue) Close(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, _, err := i.c.c.Client().Issues.Edit(ctx, i.c.ref.GetIdentity(), i.c.ref.GetRepository(), i.i.GetNumber(), &github.IssueRequest{
+		State: github.String(string(gitprovider.IssueStateClosed)),
+	})
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*i = *newIssue(i.c, apiObj)
+	return nil
+}
+
+// Comments gives access to the comments of this issue.
+func (i *issue) Comments() gitprovider.CommentClient {
+	return &CommentClient{
+		clientContext: i.c.clientContext,
+		ref:           i.c.ref,
+		number:        i.i.GetNumber(),
+	}
+}
+
+func issueFromAPI(apiObj *github.Issue) gitprovider.IssueInfo {
+	info := gitprovider.IssueInfo{
+		Number:      apiObj.GetNumber(),
+		Title:       apiObj.GetTitle(),
+		Description: apiObj.GetBody(),
+		Labels:      make([]string, 0, len(apiObj.Labels)),
+		Assignees:   make([]string, 0, len(apiObj.Assignees)),
+		State:       gitprovider.IssueState(apiObj.GetState()),
+		WebURL:      apiObj.GetHTMLURL(),
+	}
+	for _, label := range apiObj.Labels {
+		info.Labels = append(info.Labels, label.GetName())
+	}
+	for _, assignee := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, assignee.GetLogin())
+	}
+	return info
+}
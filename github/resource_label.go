@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newLabel(c *LabelClient, apiObj *github.Label) *label {
+	return &label{
+		l: *apiObj,
+		info: gitprovider.LabelInfo{
+			Name:        apiObj.GetName(),
+			Color:       apiObj.Color,
+			Description: apiObj.GetDescription(),
+		},
+		c: c,
+	}
+}
+
+var _ gitprovider.Label = &label{}
+
+type label struct {
+	l    github.Label
+	info gitprovider.LabelInfo
+	c    *LabelClient
+}
+
+func (l *label) Get() gitprovider.LabelInfo {
+	return l.info
+}
+
+func (l *label) Set(info gitprovider.LabelInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	l.info = info
+	return nil
+}
+
+func (l *label) APIObject() interface{} {
+	return &l.l
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (l *label) Update(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/labels/{name}
+	apiObj, _, err := l.c.c.Client().Issues.EditLabel(ctx, l.c.ref.GetIdentity(), l.c.ref.GetRepository(), l.l.GetName(), labelToAPI(l.info))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*l = *newLabel(l.c, apiObj)
+	return nil
+}
+
+// Delete deletes the label from the repository, and removes it from all issues.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (l *label) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/labels/{name}
+	_, err := l.c.c.Client().Issues.DeleteLabel(ctx, l.c.ref.GetIdentity(), l.c.ref.GetRepository(), l.l.GetName())
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (l *label) Reconcile(ctx context.Context) (bool, error) {
+	req := l.info
+	req.Default()
+	actual, err := l.c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			// POST /repos/{owner}/{repo}/labels
+			apiObj, _, err := l.c.c.Client().Issues.CreateLabel(ctx, l.c.ref.GetIdentity(), l.c.ref.GetRepository(), labelToAPI(req))
+			if err != nil {
+				return true, handleHTTPError(err)
+			}
+			*l = *newLabel(l.c, apiObj)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update the label with the desired name
+	l.l = actual.l
+	return true, l.Update(ctx)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newMilestone(c *MilestoneClient, apiObj *github.Milestone) *milestone {
+	return &milestone{
+		m:    *apiObj,
+		info: milestoneFromAPI(apiObj),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Milestone = &milestone{}
+
+type milestone struct {
+	m    github.Milestone
+	info gitprovider.MilestoneInfo
+	c    *MilestoneClient
+}
+
+func (m *milestone) Get() gitprovider.MilestoneInfo {
+	return m.info
+}
+
+func (m *milestone) Set(info gitprovider.MilestoneInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	m.info = info
+	return nil
+}
+
+func (m *milestone) APIObject() interface{} {
+	return &m.m
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *milestone) Update(ctx context.Context) error {
+	return m.edit(ctx, milestoneToAPI(m.info))
+}
+
+// Close closes the milestone.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *milestone) Close(ctx context.Context) error {
+	return m.edit(ctx, &github.Milestone{
+		State: github.String(string(gitprovider.MilestoneStateClosed)),
+	})
+}
+
+func (m *milestone) edit(ctx context.Context, req *github.Milestone) error {
+	// PATCH /repos/{owner}/{repo}/milestones/{milestone_number}
+	apiObj, _, err := m.c.c.Client().Issues.EditMilestone(ctx, m.c.ref.GetIdentity(), m.c.ref.GetRepository(), m.m.GetNumber(), req)
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*m = *newMilestone(m.c, apiObj)
+	return nil
+}
+
+func milestoneFromAPI(apiObj *github.Milestone) gitprovider.MilestoneInfo {
+	info := gitprovider.MilestoneInfo{
+		ID:          apiObj.GetNumber(),
+		Title:       apiObj.GetTitle(),
+		Description: apiObj.GetDescription(),
+		State:       gitprovider.MilestoneState(apiObj.GetState()),
+		WebURL:      apiObj.GetHTMLURL(),
+	}
+	if apiObj.DueOn != nil {
+		info.DueDate = &apiObj.DueOn.Time
+	}
+	return info
+}
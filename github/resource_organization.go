@@ -17,6 +17,9 @@ limitations under the License.
 package github
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/google/go-github/v66/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -32,6 +35,30 @@ func newOrganization(ctx *clientContext, apiObj *github.Organization, ref gitpro
 			clientContext: ctx,
 			ref:           ref,
 		},
+		runners: &RunnerClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		members: &OrganizationMemberClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		variables: &OrganizationVariableClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		auditLog: &AuditLogClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		scim: &SCIMClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		ipAllowList: &IPAllowListClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
@@ -43,7 +70,13 @@ type organization struct {
 	o   github.Organization
 	ref gitprovider.OrganizationRef
 
-	teams *TeamsClient
+	teams       *TeamsClient
+	runners     *RunnerClient
+	members     *OrganizationMemberClient
+	variables   *OrganizationVariableClient
+	auditLog    *AuditLogClient
+	scim        *SCIMClient
+	ipAllowList *IPAllowListClient
 }
 
 func (o *organization) Get() gitprovider.OrganizationInfo {
@@ -62,10 +95,169 @@ func (o *organization) Teams() gitprovider.TeamsClient {
 	return o.teams
 }
 
+func (o *organization) Runners() (gitprovider.RunnerClient, error) {
+	return o.runners, nil
+}
+
+// Bots returns ErrNoProviderSupport, as GitHub has no API for creating machine users.
+func (o *organization) Bots() (gitprovider.BotClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AccessTokens returns ErrNoProviderSupport, as GitHub has no access tokens per organization.
+// Use NewAppClient to authenticate with short-lived tokens instead.
+func (o *organization) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Labels returns ErrNoProviderSupport, as GitHub only has labels per repository.
+func (o *organization) Labels() (gitprovider.LabelClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Milestones returns ErrNoProviderSupport, as GitHub only has milestones per repository.
+func (o *organization) Milestones() (gitprovider.MilestoneClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (o *organization) Members() (gitprovider.OrganizationMemberClient, error) {
+	return o.members, nil
+}
+
+func (o *organization) Variables() (gitprovider.OrganizationVariableClient, error) {
+	return o.variables, nil
+}
+
+// AuditLog returns the audit log client. Whether the token can access it is only checked
+// when streaming, as that may need to request the token.
+func (o *organization) AuditLog() (gitprovider.AuditLogClient, error) {
+	return o.auditLog, nil
+}
+
+// SCIM returns the SCIM client. Whether the token can access it is only checked when using
+// the client, as that may need to request the token.
+func (o *organization) SCIM() (gitprovider.SCIMClient, error) {
+	return o.scim, nil
+}
+
+// SSHCertificateAuthorities returns ErrNoProviderSupport. Organization SSH certificate
+// authorities can only be managed in the organization settings, GitHub's REST API doesn't
+// expose them.
+func (o *organization) SSHCertificateAuthorities() (gitprovider.SSHCertificateAuthorityClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (o *organization) IPAllowList() (gitprovider.IPAllowListClient, error) {
+	return o.ipAllowList, nil
+}
+
+// Set sets the desired state of the organization. In order to apply these changes, run
+// .Update() or .Reconcile().
+//
+// ErrNoProviderSupport is returned when trying to make an organization non-public, or to
+// change its two-factor authentication requirement.
+func (o *organization) Set(info gitprovider.OrganizationInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Visibility != nil && *info.Visibility != gitprovider.RepositoryVisibilityPublic {
+		return fmt.Errorf("GitHub organizations are always public: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.RequireTwoFactor != nil && *info.RequireTwoFactor != o.o.GetTwoFactorRequirementEnabled() {
+		return fmt.Errorf("the two-factor authentication requirement can't be changed through the API: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.DefaultRepositoryPermission != nil {
+		permission, err := defaultRepositoryPermissionToAPI(*info.DefaultRepositoryPermission)
+		if err != nil {
+			return err
+		}
+		o.o.DefaultRepoPermission = &permission
+	}
+	if info.Name != nil {
+		o.o.Name = info.Name
+	}
+	if info.Description != nil {
+		o.o.Description = info.Description
+	}
+	return nil
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a *github.Organization and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (o *organization) Update(ctx context.Context) error {
+	req := &github.Organization{
+		Name:                  o.o.Name,
+		Description:           o.o.Description,
+		DefaultRepoPermission: o.o.DefaultRepoPermission,
+	}
+	// PATCH /orgs/{org}
+	apiObj, _, err := o.c.Client().Organizations.Edit(ctx, o.ref.Organization, req)
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	o.o = *apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (o *organization) Reconcile(ctx context.Context) (bool, error) {
+	orgs := &OrganizationsClient{clientContext: o.clientContext}
+	resp, actionTaken, err := orgs.Reconcile(ctx, o.ref, o.Get())
+	if err != nil {
+		return actionTaken, err
+	}
+	*o = *resp.(*organization)
+	return actionTaken, nil
+}
+
 func organizationFromAPI(apiObj *github.Organization) gitprovider.OrganizationInfo {
 	return gitprovider.OrganizationInfo{
-		Name:        apiObj.Name,
-		Description: apiObj.Description,
+		Name:                        apiObj.Name,
+		Description:                 apiObj.Description,
+		Visibility:                  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic),
+		DefaultRepositoryPermission: defaultRepositoryPermissionFromAPI(apiObj.GetDefaultRepoPermission()),
+		RequireTwoFactor:            apiObj.TwoFactorRequirementEnabled,
+	}
+}
+
+// defaultRepositoryPermissionFromAPI maps the default repository permission of an
+// organization to a RepositoryPermission. nil is returned for "none".
+func defaultRepositoryPermissionFromAPI(permission string) *gitprovider.RepositoryPermission {
+	switch permission {
+	case "read":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull)
+	case "write":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush)
+	case "admin":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin)
+	default:
+		return nil
+	}
+}
+
+// defaultRepositoryPermissionToAPI maps a RepositoryPermission to the default repository
+// permission of an organization, which doesn't know about triage and maintain.
+func defaultRepositoryPermissionToAPI(permission gitprovider.RepositoryPermission) (string, error) {
+	switch permission {
+	case gitprovider.RepositoryPermissionPull:
+		return "read", nil
+	case gitprovider.RepositoryPermissionPush:
+		return "write", nil
+	case gitprovider.RepositoryPermissionAdmin:
+		return "admin", nil
+	default:
+		return "", fmt.Errorf("default repository permission %q: %w", permission, gitprovider.ErrInvalidPermissionLevel)
 	}
 }
 
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_defaultRepositoryPermissionToAPI(t *testing.T) {
+	tests := []struct {
+		permission gitprovider.RepositoryPermission
+		want       string
+		wantErr    bool
+	}{
+		{gitprovider.RepositoryPermissionPull, "read", false},
+		{gitprovider.RepositoryPermissionPush, "write", false},
+		{gitprovider.RepositoryPermissionAdmin, "admin", false},
+		{gitprovider.RepositoryPermissionTriage, "", true},
+		{gitprovider.RepositoryPermissionMaintain, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.permission), func(t *testing.T) {
+			got, err := defaultRepositoryPermissionToAPI(tt.permission)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("defaultRepositoryPermissionToAPI() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, gitprovider.ErrInvalidPermissionLevel) {
+				t.Errorf("defaultRepositoryPermissionToAPI() error = %v, want ErrInvalidPermissionLevel", err)
+			}
+			if got != tt.want {
+				t.Errorf("defaultRepositoryPermissionToAPI() = %q, want %q", got, tt.want)
+			}
+			if err == nil {
+				if back := defaultRepositoryPermissionFromAPI(got); back == nil || *back != tt.permission {
+					t.Errorf("defaultRepositoryPermissionFromAPI(%q) = %v, want %q", got, back, tt.permission)
+				}
+			}
+		})
+	}
+	if got := defaultRepositoryPermissionFromAPI("none"); got != nil {
+		t.Errorf("defaultRepositoryPermissionFromAPI(\"none\") = %q, want nil", *got)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// newOrganizationVariable creates a new organization variable. apiObj is either a
+// *github.ActionsVariable or a *github.Secret.
+func newOrganizationVariable(c *OrganizationVariableClient, info gitprovider.OrganizationVariableInfo, apiObj interface{}) *organizationVariable {
+	return &organizationVariable{
+		info:   info,
+		apiObj: apiObj,
+		c:      c,
+	}
+}
+
+var _ gitprovider.OrganizationVariable = &organizationVariable{}
+
+type organizationVariable struct {
+	info   gitprovider.OrganizationVariableInfo
+	apiObj interface{}
+	c      *OrganizationVariableClient
+}
+
+func (v *organizationVariable) Get() gitprovider.OrganizationVariableInfo {
+	return v.info
+}
+
+func (v *organizationVariable) Set(info gitprovider.OrganizationVariableInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&info); err != nil {
+		return err
+	}
+	v.info = info
+	return nil
+}
+
+func (v *organizationVariable) APIObject() interface{} {
+	return v.apiObj
+}
+
+func (v *organizationVariable) Organization() gitprovider.OrganizationRef {
+	return v.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// If the variable was changed from plain to masked (or the other way around),
+// it is deleted and recreated as the other kind.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (v *organizationVariable) Update(ctx context.Context) error {
+	_, isSecret := v.apiObj.(*github.Secret)
+	wantSecret := v.info.Masked != nil && *v.info.Masked
+	exists := true
+	if isSecret != wantSecret {
+		if err := v.c.delete(ctx, v.info.Key, isSecret); err != nil {
+			return err
+		}
+		exists = false
+	}
+
+	apiObj, err := v.c.createOrUpdate(ctx, v.info, exists)
+	if err != nil {
+		return err
+	}
+	v.apiObj = apiObj
+	return nil
+}
+
+// Delete deletes the variable or secret from the organization.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *organizationVariable) Delete(ctx context.Context) error {
+	_, isSecret := v.apiObj.(*github.Secret)
+	return v.c.delete(ctx, v.info.Key, isSecret)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (v *organizationVariable) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := v.c.get(ctx, v.info.Key)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			apiObj, err := v.c.createOrUpdate(ctx, v.info, false)
+			if err != nil {
+				return false, err
+			}
+			v.apiObj = apiObj
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if v.info.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	v.apiObj = actual.apiObj
+	return true, v.Update(ctx)
+}
+
+func organizationVariableFromAPI(apiObj *github.ActionsVariable, repositories []string) gitprovider.OrganizationVariableInfo {
+	return gitprovider.OrganizationVariableInfo{
+		Key:          apiObj.Name,
+		Value:        apiObj.Value,
+		Masked:       gitprovider.BoolVar(false),
+		Protected:    gitprovider.BoolVar(false),
+		Visibility:   gitprovider.VariableVisibilityVar(gitprovider.VariableVisibility(apiObj.GetVisibility())),
+		Repositories: repositories,
+	}
+}
+
+// organizationSecretFromAPI returns the information of a secret. Its value can't be read back,
+// and is empty.
+func organizationSecretFromAPI(apiObj *github.Secret, repositories []string) gitprovider.OrganizationVariableInfo {
+	return gitprovider.OrganizationVariableInfo{
+		Key:          apiObj.Name,
+		Masked:       gitprovider.BoolVar(true),
+		Protected:    gitprovider.BoolVar(false),
+		Visibility:   gitprovider.VariableVisibilityVar(gitprovider.VariableVisibility(apiObj.Visibility)),
+		Repositories: repositories,
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPackage(c *PackageClient, apiObj *github.Package) *pkg {
+	return &pkg{
+		p: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Package = &pkg{}
+
+type pkg struct {
+	p github.Package
+	c *PackageClient
+}
+
+func (p *pkg) Get() gitprovider.PackageInfo {
+	return gitprovider.PackageInfo{
+		Name: p.p.GetName(),
+		Type: packageTypeFromAPI(p.p.GetPackageType()),
+	}
+}
+
+func (p *pkg) APIObject() interface{} {
+	return &p.p
+}
+
+func (p *pkg) Repository() gitprovider.RepositoryRef {
+	return p.c.ref
+}
+
+// Versions lists the versions of the package, using multiple paginated requests if needed.
+// Container images are listed by manifest digest, with the tags pointing to them.
+func (p *pkg) Versions(ctx context.Context) ([]gitprovider.PackageVersion, error) {
+	opts := &github.PackageListOptions{}
+	versions := []gitprovider.PackageVersion{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /{orgs|users}/{owner}/packages/{package_type}/{package_name}/versions
+		pageObjs, resp, listErr := p.c.listVersions(ctx, p.p.GetPackageType(), p.p.GetName(), opts)
+		for _, apiObj := range pageObjs {
+			versions = append(versions, &packageVersion{v: *apiObj, p: p})
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+var _ gitprovider.PackageVersion = &packageVersion{}
+
+type packageVersion struct {
+	v github.PackageVersion
+	p *pkg
+}
+
+func (v *packageVersion) Get() gitprovider.PackageVersionInfo {
+	info := gitprovider.PackageVersionInfo{
+		Version: v.v.GetName(),
+	}
+	if container := v.v.GetMetadata().GetContainer(); container != nil {
+		info.Tags = container.Tags
+	}
+	if v.v.CreatedAt != nil {
+		info.CreatedAt = &v.v.CreatedAt.Time
+	}
+	return info
+}
+
+func (v *packageVersion) APIObject() interface{} {
+	return &v.v
+}
+
+// Delete removes the version from the package. GitHub doesn't allow deleting
+// the last version of a public package with many downloads.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *packageVersion) Delete(ctx context.Context) error {
+	// DELETE /{orgs|users}/{owner}/packages/{package_type}/{package_name}/versions/{package_version_id}
+	_, err := v.p.c.deleteVersion(ctx, v.p.p.GetPackageType(), v.p.p.GetName(), v.v.GetID())
+	return handleHTTPError(err)
+}
+
+// packageTypeFromAPI maps the GitHub package types to gitprovider.PackageType.
+// The legacy Docker registry holds container images as well.
+func packageTypeFromAPI(t string) gitprovider.PackageType {
+	if t == "docker" {
+		return gitprovider.PackageTypeContainer
+	}
+	return gitprovider.PackageType(t)
+}
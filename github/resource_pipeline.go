@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// pipelineConclusions maps the conclusions of GitHub workflow runs and jobs
+// to gitprovider.PipelineConclusion.
+//
+//nolint:gochecknoglobals
+var pipelineConclusions = map[string]gitprovider.PipelineConclusion{
+	"success":         gitprovider.PipelineConclusionSuccess,
+	"neutral":         gitprovider.PipelineConclusionSuccess,
+	"failure":         gitprovider.PipelineConclusionFailure,
+	"timed_out":       gitprovider.PipelineConclusionFailure,
+	"startup_failure": gitprovider.PipelineConclusionFailure,
+	"cancelled":       gitprovider.PipelineConclusionCancelled,
+	"stale":           gitprovider.PipelineConclusionCancelled,
+	"skipped":         gitprovider.PipelineConclusionSkipped,
+	"action_required": gitprovider.PipelineConclusionActionRequired,
+}
+
+func newPipeline(c *PipelineClient, apiObj *github.WorkflowRun) *pipeline {
+	return &pipeline{
+		r: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Pipeline = &pipeline{}
+
+type pipeline struct {
+	r github.WorkflowRun
+	c *PipelineClient
+}
+
+func (p *pipeline) Get() gitprovider.PipelineInfo {
+	status, conclusion := pipelineStatusFromAPI(p.r.GetStatus(), p.r.GetConclusion())
+	return gitprovider.PipelineInfo{
+		ID:         p.r.GetID(),
+		Name:       p.r.GetName(),
+		Ref:        p.r.GetHeadBranch(),
+		SHA:        p.r.GetHeadSHA(),
+		Status:     status,
+		Conclusion: conclusion,
+		WebURL:     p.r.GetHTMLURL(),
+		CreatedAt:  p.r.GetCreatedAt().Time,
+		UpdatedAt:  p.r.GetUpdatedAt().Time,
+	}
+}
+
+func (p *pipeline) APIObject() interface{} {
+	return &p.r
+}
+
+func (p *pipeline) Repository() gitprovider.RepositoryRef {
+	return p.c.ref
+}
+
+// Jobs lists the jobs of the latest attempt of the workflow run,
+// using multiple paginated requests if needed.
+func (p *pipeline) Jobs(ctx context.Context) ([]gitprovider.PipelineJobInfo, error) {
+	opts := &github.ListWorkflowJobsOptions{}
+	jobs := []gitprovider.PipelineJobInfo{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs
+		pageObjs, resp, listErr := p.c.c.Client().Actions.ListWorkflowJobs(ctx, p.c.ref.GetIdentity(), p.c.ref.GetRepository(), p.r.GetID(), opts)
+		if pageObjs != nil {
+			for _, apiObj := range pageObjs.Jobs {
+				jobs = append(jobs, pipelineJobFromAPI(apiObj))
+			}
+		}
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func pipelineJobFromAPI(apiObj *github.WorkflowJob) gitprovider.PipelineJobInfo {
+	status, conclusion := pipelineStatusFromAPI(apiObj.GetStatus(), apiObj.GetConclusion())
+	job := gitprovider.PipelineJobInfo{
+		ID:         apiObj.GetID(),
+		Name:       apiObj.GetName(),
+		Status:     status,
+		Conclusion: conclusion,
+		WebURL:     apiObj.GetHTMLURL(),
+	}
+	if apiObj.StartedAt != nil {
+		job.StartedAt = &apiObj.StartedAt.Time
+	}
+	if apiObj.CompletedAt != nil {
+		job.FinishedAt = &apiObj.CompletedAt.Time
+	}
+	return job
+}
+
+// pipelineStatusFromAPI maps the status and conclusion of a workflow run or job. Runs that
+// are waiting for approval or for a concurrency group count as queued.
+func pipelineStatusFromAPI(status, conclusion string) (gitprovider.PipelineStatus, *gitprovider.PipelineConclusion) {
+	switch status {
+	case "completed":
+		if c, ok := pipelineConclusions[conclusion]; ok {
+			return gitprovider.PipelineStatusCompleted, gitprovider.PipelineConclusionVar(c)
+		}
+		return gitprovider.PipelineStatusCompleted, nil
+	case "in_progress":
+		return gitprovider.PipelineStatusInProgress, nil
+	default:
+		return gitprovider.PipelineStatusQueued, nil
+	}
+}
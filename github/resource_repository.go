@@ -18,12 +18,15 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 
 	"github.com/google/go-github/v66/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
 	"github.com/fluxcd/go-git-providers/validation"
 )
 
@@ -37,6 +40,7 @@ var githubRepositoryKnownFields = map[string]struct{}{
 	"HasProjects": {},
 	"HasWiki":     {},
 	"IsTemplate":  {},
+	"Archived":    {},
 	// Update-specific parameters
 	// See: https://docs.github.com/en/rest/reference/repos#update-a-repository
 	"DefaultBranch": {},
@@ -82,6 +86,59 @@ func newUserRepository(ctx *clientContext, apiObj *github.Repository, ref gitpro
 			clientContext: ctx,
 			ref:           ref,
 		},
+		artifacts: &ArtifactClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		packages: &PackageClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		variables: &VariableClient{
+			clientContext: ctx,
+			ref:           ref,
+			repoID:        apiObj.GetID(),
+		},
+		environments: &EnvironmentClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		deployments: &DeploymentClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		runners: &RunnerClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		schedules: &ScheduleClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		pipelines: &PipelineClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		milestones: &MilestoneClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		autolinks: &AutolinkClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
@@ -93,17 +150,38 @@ type userRepository struct {
 	r         github.Repository // go-github
 	topUpdate *github.Repository
 	ref       gitprovider.RepositoryRef
-
-	deployKeys   *DeployKeyClient
-	commits      *CommitClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	files        *FileClient
-	trees        *TreeClient
+	// lfsUpdate is the desired LFS state, which is set through a separate endpoint
+	lfsUpdate *bool
+	// statistics and metadata are only fetched if requested through the call options
+	statistics *gitprovider.RepositoryStatistics
+	metadata   *gitprovider.RepositoryMetadata
+
+	deployKeys    *DeployKeyClient
+	commits       *CommitClient
+	branches      *BranchClient
+	pullRequests  *PullRequestClient
+	files         *FileClient
+	trees         *TreeClient
+	artifacts     *ArtifactClient
+	packages      *PackageClient
+	variables     *VariableClient
+	environments  *EnvironmentClient
+	deployments   *DeploymentClient
+	runners       *RunnerClient
+	schedules     *ScheduleClient
+	pipelines     *PipelineClient
+	issues        *IssueClient
+	labels        *LabelClient
+	milestones    *MilestoneClient
+	autolinks     *AutolinkClient
+	collaborators *CollaboratorClient
 }
 
 func (r *userRepository) Get() gitprovider.RepositoryInfo {
-	return repositoryFromAPI(&r.r)
+	info := repositoryFromAPI(&r.r)
+	info.Statistics = r.statistics
+	info.Metadata = r.metadata
+	return info
 }
 
 // Set sets the desired state of this object.
@@ -114,6 +192,7 @@ func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
 		return err
 	}
 	r.topUpdate = updateApiObjWithRepositoryInfo(&info, &r.r)
+	r.lfsUpdate = info.LFSEnabled
 	return nil
 }
 
@@ -121,6 +200,11 @@ func (r *userRepository) APIObject() interface{} {
 	return &r.r
 }
 
+// SSHEndpoint returns the SSH endpoint reported by GitHub, e.g. "git@github.com:org/repo.git".
+func (r *userRepository) SSHEndpoint() (gitprovider.SSHEndpoint, error) {
+	return gitprovider.GetSSHEndpoint(r.r.GetSSHURL(), r.ref)
+}
+
 func (r *userRepository) Repository() gitprovider.RepositoryRef {
 	return r.ref
 }
@@ -133,6 +217,353 @@ func (r *userRepository) DeployTokens() (gitprovider.DeployTokenClient, error) {
 	return nil, gitprovider.ErrNoProviderSupport
 }
 
+func (r *userRepository) Variables() (gitprovider.VariableClient, error) {
+	return r.variables, nil
+}
+
+func (r *userRepository) Artifacts() (gitprovider.ArtifactClient, error) {
+	return r.artifacts, nil
+}
+
+// Packages returns the package client. Whether the token can access GitHub Packages is only
+// checked when listing, as that may need to request the token.
+func (r *userRepository) Packages() (gitprovider.PackageClient, error) {
+	return r.packages, nil
+}
+
+func (r *userRepository) Environments() (gitprovider.EnvironmentClient, error) {
+	return r.environments, nil
+}
+
+func (r *userRepository) Deployments() (gitprovider.DeploymentClient, error) {
+	return r.deployments, nil
+}
+
+func (r *userRepository) Mirrors() (gitprovider.MirrorClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Runners() (gitprovider.RunnerClient, error) {
+	return r.runners, nil
+}
+
+func (r *userRepository) Issues() (gitprovider.IssueClient, error) {
+	return r.issues, nil
+}
+
+func (r *userRepository) Labels() (gitprovider.LabelClient, error) {
+	return r.labels, nil
+}
+
+func (r *userRepository) Milestones() (gitprovider.MilestoneClient, error) {
+	return r.milestones, nil
+}
+
+func (r *userRepository) Autolinks() (gitprovider.AutolinkClient, error) {
+	return r.autolinks, nil
+}
+
+func (r *userRepository) Collaborators() (gitprovider.CollaboratorClient, error) {
+	return r.collaborators, nil
+}
+
+// Releases returns the experimental ReleaseClient of the repository.
+// Use experimental.Releases to access it through the gitprovider interfaces.
+func (r *userRepository) Releases() (experimental.ReleaseClient, error) {
+	return &ReleaseClient{
+		clientContext: r.clientContext,
+		ref:           r.ref,
+	}, nil
+}
+
+func (r *userRepository) Bots() (gitprovider.BotClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AccessTokens returns ErrNoProviderSupport, as GitHub has no access tokens per repository.
+func (r *userRepository) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Schedules() (gitprovider.ScheduleClient, error) {
+	return r.schedules, nil
+}
+
+func (r *userRepository) Pipelines() (gitprovider.PipelineClient, error) {
+	return r.pipelines, nil
+}
+
+func (r *userRepository) LFSUsage(_ context.Context) (int64, error) {
+	return 0, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Languages(ctx context.Context) (map[string]float64, error) {
+	// GET /repos/{owner}/{repo}/languages
+	apiObj, _, err := r.c.Client().Repositories.ListLanguages(ctx, r.ref.GetIdentity(), r.ref.GetRepository())
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	bytes := make(map[string]int64, len(apiObj))
+	for lang, b := range apiObj {
+		bytes[lang] = int64(b)
+	}
+	return gitprovider.LanguagePercentages(bytes), nil
+}
+
+func (r *userRepository) GetUserPermission(ctx context.Context, username string) (*gitprovider.RepositoryPermission, error) {
+	// GET /repos/{owner}/{repo}/collaborators/{username}/permission
+	apiObj, _, err := r.c.Client().Repositories.GetPermissionLevel(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), username)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if permission := getPermissionFromMap(apiObj.GetUser().Permissions); permission != nil {
+		return permission, nil
+	}
+	// Fall back to the legacy permission, which only knows about read, write and admin
+	switch apiObj.GetPermission() {
+	case "admin":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin), nil
+	case "write":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush), nil
+	case "read":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull), nil
+	default:
+		return nil, nil
+	}
+}
+
+// loadOptionalInfo fetches the statistics and metadata of the repository, if requested through
+// the call options.
+func (r *userRepository) loadOptionalInfo(ctx context.Context) error {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	if callOpts.ShouldIncludeStatistics() {
+		if err := r.loadStatistics(ctx); err != nil {
+			return err
+		}
+	}
+	if callOpts.ShouldIncludeMetadata() {
+		return r.loadMetadata(ctx)
+	}
+	return nil
+}
+
+// loadStatistics fetches the statistics of the repository.
+func (r *userRepository) loadStatistics(ctx context.Context) error {
+	languages, err := r.Languages(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Count the commits and pull requests by listing them one per page
+	commitCount := 0
+	if r.r.GetSize() > 0 {
+		// GET /repos/{owner}/{repo}/commits
+		commits, resp, err := r.c.Client().Repositories.ListCommits(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), &github.CommitsListOptions{
+			SHA:         r.r.GetDefaultBranch(),
+			ListOptions: github.ListOptions{PerPage: 1},
+		})
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		commitCount = countPages(resp, len(commits))
+	}
+	// GET /repos/{owner}/{repo}/pulls
+	pulls, resp, err := r.c.Client().PullRequests.List(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), &github.PullRequestListOptions{
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	openPulls := countPages(resp, len(pulls))
+	// GitHub counts open pull requests as open issues
+	openIssues := r.r.GetOpenIssuesCount() - openPulls
+
+	r.statistics = &gitprovider.RepositoryStatistics{
+		Size:             int64(r.r.GetSize()) * 1024,
+		Languages:        languages,
+		CommitCount:      &commitCount,
+		OpenIssues:       &openIssues,
+		OpenPullRequests: &openPulls,
+	}
+	return nil
+}
+
+// loadMetadata detects the license and README of the repository.
+func (r *userRepository) loadMetadata(ctx context.Context) error {
+	readme, err := gitprovider.DetectReadme(ctx, r.listDir)
+	if err != nil {
+		return err
+	}
+	r.metadata = &gitprovider.RepositoryMetadata{
+		// GitHub detects the license when getting the repository
+		LicenseSPDXID: r.r.GetLicense().GetSPDXID(),
+		ReadmePath:    readme,
+	}
+	return nil
+}
+
+// countPages returns the number of objects of a listing with one object per page, given the
+// response and the number of objects of its first page.
+func countPages(resp *github.Response, n int) int {
+	if resp.LastPage == 0 {
+		return n
+	}
+	return resp.LastPage
+}
+
+func (r *userRepository) GetWatchLevel(ctx context.Context) (gitprovider.WatchLevel, error) {
+	// GET /repos/{owner}/{repo}/subscription
+	apiObj, _, err := r.c.Client().Activity.GetRepositorySubscription(ctx, r.ref.GetIdentity(), r.ref.GetRepository())
+	if err != nil {
+		return "", handleHTTPError(err)
+	}
+	switch {
+	case apiObj.GetIgnored():
+		return gitprovider.WatchLevelIgnoring, nil
+	case apiObj.GetSubscribed():
+		return gitprovider.WatchLevelWatching, nil
+	default:
+		// Without a subscription, notifications follow participation
+		return gitprovider.WatchLevelParticipating, nil
+	}
+}
+
+func (r *userRepository) SetWatchLevel(ctx context.Context, level gitprovider.WatchLevel) error {
+	if err := gitprovider.ValidateWatchLevel(level); err != nil {
+		return fmt.Errorf("watch level %q: %w", level, err)
+	}
+	if level == gitprovider.WatchLevelParticipating {
+		// DELETE /repos/{owner}/{repo}/subscription
+		_, err := r.c.Client().Activity.DeleteRepositorySubscription(ctx, r.ref.GetIdentity(), r.ref.GetRepository())
+		return handleHTTPError(err)
+	}
+	// PUT /repos/{owner}/{repo}/subscription
+	_, _, err := r.c.Client().Activity.SetRepositorySubscription(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), &github.Subscription{
+		Subscribed: github.Bool(level == gitprovider.WatchLevelWatching),
+		Ignored:    github.Bool(level == gitprovider.WatchLevelIgnoring),
+	})
+	return handleHTTPError(err)
+}
+
+func (r *userRepository) DetectCI(ctx context.Context) (*gitprovider.CIInfo, error) {
+	return gitprovider.DetectCI(ctx, r.listDir)
+}
+
+// listDir lists the paths of the entries of dir on the default branch.
+func (r *userRepository) listDir(ctx context.Context, dir string) ([]string, error) {
+	// GET /repos/{owner}/{repo}/contents/{path}
+	_, apiObjs, _, err := r.c.Client().Repositories.GetContents(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), dir, nil)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	paths := make([]string, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		paths = append(paths, apiObj.GetPath())
+	}
+	return paths, nil
+}
+
+// Fork forks the repository into the given user account or organization. GitHub can only fork
+// into the account of the authenticated user, and creates forks asynchronously, so it may take a
+// moment until the contents of the fork are available.
+func (r *userRepository) Fork(ctx context.Context, target gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	opts := &github.RepositoryCreateForkOptions{}
+	if target != nil {
+		if err := validateIdentityFields(target, r.domain); err != nil {
+			return nil, err
+		}
+		if target.GetType() == gitprovider.IdentityTypeOrganization {
+			opts.Organization = target.GetIdentity()
+		} else {
+			// GET /user
+			user, err := r.c.GetUser(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !gitprovider.NamesEqual(user.GetLogin(), target.GetIdentity(), caseSensitiveNames) {
+				return nil, gitprovider.NewErrIncorrectUser(target.GetIdentity())
+			}
+		}
+	}
+
+	// POST /repos/{owner}/{repo}/forks
+	apiObj, _, err := r.c.Client().Repositories.CreateFork(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), opts)
+	// An AcceptedError means that the fork is being created, and apiObj is already populated
+	acceptedErr := &github.AcceptedError{}
+	if err != nil && !errors.As(err, &acceptedErr) {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateRepositoryAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newRepositoryFromAPI(r.clientContext, apiObj), nil
+}
+
+// ListForks lists the forks of the repository.
+//
+// ListForks returns all available forks, using multiple paginated requests if needed.
+func (r *userRepository) ListForks(ctx context.Context) ([]gitprovider.UserRepository, error) {
+	opts := &github.RepositoryListForksOptions{}
+	apiObjs := []*github.Repository{}
+	err := allPages(ctx, &opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/forks
+		pageObjs, resp, listErr := r.c.Client().Repositories.ListForks(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	forks := make([]gitprovider.UserRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateRepositoryAPI(apiObj); err != nil {
+			return nil, err
+		}
+		forks = append(forks, newRepositoryFromAPI(r.clientContext, apiObj))
+	}
+	return forks, nil
+}
+
+// Transfer transfers the repository to the given user account or organization. GitHub moves the
+// repository asynchronously. Transfers to another user account have to be accepted by that user,
+// in which case ErrTransferPending is returned.
+func (r *userRepository) Transfer(ctx context.Context, newOwner gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	// Don't allow transferring repositories if the user didn't explicitly allow dangerous API calls.
+	if !r.destructiveActions {
+		return nil, fmt.Errorf("cannot transfer repository: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	if newOwner == nil {
+		return nil, fmt.Errorf("new owner is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	if err := validateIdentityFields(newOwner, r.domain); err != nil {
+		return nil, err
+	}
+
+	// POST /repos/{owner}/{repo}/transfer
+	apiObj, _, err := r.c.Client().Repositories.Transfer(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), github.TransferRequest{
+		NewOwner: newOwner.GetIdentity(),
+	})
+	// An AcceptedError means that the transfer is being carried out, the repository is in the body
+	acceptedErr := &github.AcceptedError{}
+	if errors.As(err, &acceptedErr) {
+		apiObj = &github.Repository{}
+		err = json.Unmarshal(acceptedErr.Raw, apiObj)
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateRepositoryAPI(apiObj); err != nil {
+		return nil, err
+	}
+	// The original owner is returned until the new owner accepts the transfer
+	if !gitprovider.NamesEqual(apiObj.GetOwner().GetLogin(), newOwner.GetIdentity(), caseSensitiveNames) {
+		return nil, fmt.Errorf("transfer of %s to %q: %w", r.ref, newOwner.GetIdentity(), gitprovider.ErrTransferPending)
+	}
+	return newRepositoryFromAPI(r.clientContext, apiObj), nil
+}
+
 func (r *userRepository) Commits() gitprovider.CommitClient {
 	return r.commits
 }
@@ -163,11 +594,76 @@ func (r *userRepository) Trees() gitprovider.TreeClient {
 //
 // The internal API object will be overridden with the received server data.
 func (r *userRepository) Update(ctx context.Context) error {
+	// Topics can't be set when editing the repository, they are replaced separately
+	var topics []string
+	// Archived repositories are read-only, so unarchive before, and archive after any other change
+	var archived *bool
+	if r.topUpdate != nil {
+		topics = r.topUpdate.Topics
+		r.topUpdate.Topics = nil
+		archived = r.topUpdate.Archived
+		r.topUpdate.Archived = nil
+	}
+	if archived != nil && !*archived {
+		if err := r.Unarchive(ctx); err != nil {
+			return err
+		}
+	}
 	// PATCH /repos/{owner}/{repo}
 	apiObj, err := r.c.UpdateRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), r.topUpdate)
 	if err != nil {
 		return err
 	}
+	if topics != nil {
+		if apiObj.Topics, err = r.c.ReplaceTopics(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), topics); err != nil {
+			return err
+		}
+	}
+	r.r = *apiObj
+	if r.lfsUpdate != nil {
+		if err := setLFS(ctx, r.c, r.ref.GetIdentity(), r.ref.GetRepository(), *r.lfsUpdate); err != nil {
+			return err
+		}
+		r.lfsUpdate = nil
+	}
+	if archived != nil && *archived {
+		return r.Archive(ctx)
+	}
+	return nil
+}
+
+// setLFS enables or disables Git LFS for the given repository.
+func setLFS(ctx context.Context, c githubClient, owner, repo string, enabled bool) error {
+	var err error
+	if enabled {
+		// PUT /repos/{owner}/{repo}/lfs
+		_, err = c.Client().Repositories.EnableLFS(ctx, owner, repo)
+	} else {
+		// DELETE /repos/{owner}/{repo}/lfs
+		_, err = c.Client().Repositories.DisableLFS(ctx, owner, repo)
+	}
+	return handleHTTPError(err)
+}
+
+// Archive archives the repository, after which it's read-only.
+func (r *userRepository) Archive(ctx context.Context) error {
+	return r.setArchived(ctx, true)
+}
+
+// Unarchive unarchives the repository.
+func (r *userRepository) Unarchive(ctx context.Context) error {
+	return r.setArchived(ctx, false)
+}
+
+func (r *userRepository) setArchived(ctx context.Context, archived bool) error {
+	// PATCH /repos/{owner}/{repo}
+	apiObj, err := r.c.UpdateRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), &github.Repository{
+		Name:     gitprovider.StringVar(r.ref.GetRepository()),
+		Archived: &archived,
+	})
+	if err != nil {
+		return err
+	}
 	r.r = *apiObj
 	return nil
 }
@@ -244,6 +740,40 @@ func (r *orgRepository) TeamAccess() gitprovider.TeamAccessClient {
 	return r.teamAccess
 }
 
+// newRepositoryFromAPI wraps a repository that may be owned by any user or organization, e.g. a fork.
+func newRepositoryFromAPI(ctx *clientContext, apiObj *github.Repository) gitprovider.UserRepository {
+	owner := apiObj.GetOwner()
+	if owner.GetType() == "Organization" {
+		return newOrgRepository(ctx, apiObj, gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{
+				Domain:       ctx.domain,
+				Organization: owner.GetLogin(),
+			},
+			RepositoryName: apiObj.GetName(),
+		})
+	}
+	return newUserRepository(ctx, apiObj, gitprovider.UserRepositoryRef{
+		UserRef: gitprovider.UserRef{
+			Domain:    ctx.domain,
+			UserLogin: owner.GetLogin(),
+		},
+		RepositoryName: apiObj.GetName(),
+	})
+}
+
+// repositoryMoved returns the repository at its canonical location, and true, if apiObj, as
+// returned when getting ref, shows that the repository has been renamed or transferred.
+func repositoryMoved(ctx *clientContext, ref gitprovider.RepositoryRef, apiObj *github.Repository) (gitprovider.UserRepository, bool) {
+	if apiObj.GetOwner().GetLogin() == "" {
+		return nil, false
+	}
+	repo := newRepositoryFromAPI(ctx, apiObj)
+	if gitprovider.RepositoryRefsEqual(ref, repo.Repository(), caseSensitiveNames) {
+		return nil, false
+	}
+	return repo, true
+}
+
 // validateRepositoryAPI validates the apiObj received from the server, to make sure that it is
 // valid for our use.
 func validateRepositoryAPI(apiObj *github.Repository) error {
@@ -268,6 +798,11 @@ func repositoryFromAPI(apiObj *github.Repository) gitprovider.RepositoryInfo {
 	if apiObj.Visibility != nil {
 		repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility(*apiObj.Visibility))
 	}
+	if len(apiObj.Topics) != 0 {
+		repo.Topics = apiObj.Topics
+	}
+	repo.Archived = apiObj.Archived
+	repo.WikiEnabled = apiObj.HasWiki
 	return repo
 }
 
@@ -289,6 +824,9 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *github.Rep
 	if repo.Visibility != nil {
 		apiObj.Visibility = gitprovider.StringVar(string(*repo.Visibility))
 	}
+	if repo.WikiEnabled != nil {
+		apiObj.HasWiki = repo.WikiEnabled
+	}
 }
 
 func updateApiObjWithRepositoryInfo(repo *gitprovider.RepositoryInfo, apiObj *github.Repository) *github.Repository {
@@ -304,6 +842,15 @@ func updateApiObjWithRepositoryInfo(repo *gitprovider.RepositoryInfo, apiObj *gi
 	if repo.Visibility != nil {
 		desired.Visibility = gitprovider.StringVar(string(*repo.Visibility))
 	}
+	if repo.Topics != nil {
+		desired.Topics = repo.Topics
+	}
+	if repo.Archived != nil {
+		desired.Archived = repo.Archived
+	}
+	if repo.WikiEnabled != nil {
+		desired.HasWiki = repo.WikiEnabled
+	}
 
 	// create the update repository
 	return updateGithubRepository(desired, actual)
@@ -345,7 +892,12 @@ type githubRepositorySpec struct {
 }
 
 func (s *githubRepositorySpec) Equals(other *githubRepositorySpec) bool {
-	return reflect.DeepEqual(s, other)
+	// Names only differing in case refer to the same repository
+	desired := *s.Repository
+	if gitprovider.NamesEqual(desired.GetName(), other.GetName(), caseSensitiveNames) {
+		desired.Name = other.Name
+	}
+	return reflect.DeepEqual(&desired, other.Repository)
 }
 
 func updateGithubRepository(desired, actual *github.Repository) *github.Repository {
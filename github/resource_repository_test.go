@@ -0,0 +1,494 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func Test_repositoryMoved(t *testing.T) {
+	ctx := &clientContext{domain: "github.com"}
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: "github.com", Organization: "fluxcd"},
+		RepositoryName:  "old-name",
+	}
+	tests := []struct {
+		name      string
+		apiObj    *github.Repository
+		wantMoved bool
+		wantRef   string
+	}{
+		{
+			name: "same repository",
+			apiObj: &github.Repository{
+				Name:  github.String("old-name"),
+				Owner: &github.User{Login: github.String("fluxcd"), Type: github.String("Organization")},
+			},
+		},
+		{
+			name: "different case",
+			apiObj: &github.Repository{
+				Name:  github.String("Old-Name"),
+				Owner: &github.User{Login: github.String("FluxCD"), Type: github.String("Organization")},
+			},
+		},
+		{
+			name: "no owner",
+			apiObj: &github.Repository{
+				Name: github.String("new-name"),
+			},
+		},
+		{
+			name: "renamed",
+			apiObj: &github.Repository{
+				Name:  github.String("new-name"),
+				Owner: &github.User{Login: github.String("fluxcd"), Type: github.String("Organization")},
+			},
+			wantMoved: true,
+			wantRef:   "https://github.com/fluxcd/new-name",
+		},
+		{
+			name: "transferred to a user",
+			apiObj: &github.Repository{
+				Name:  github.String("old-name"),
+				Owner: &github.User{Login: github.String("octocat"), Type: github.String("User")},
+			},
+			wantMoved: true,
+			wantRef:   "https://github.com/octocat/old-name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, moved := repositoryMoved(ctx, ref, tt.apiObj)
+			if moved != tt.wantMoved {
+				t.Fatalf("repositoryMoved() moved = %t, want %t", moved, tt.wantMoved)
+			}
+			if moved && repo.Repository().String() != tt.wantRef {
+				t.Errorf("repositoryMoved() ref = %s, want %s", repo.Repository(), tt.wantRef)
+			}
+		})
+	}
+}
+
+func Test_userRepository_SSHEndpoint(t *testing.T) {
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: "github.com", Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	r := newUserRepository(&clientContext{domain: "github.com"}, &github.Repository{SSHURL: github.String("git@ssh.github.com:fluxcd/flux2.git")}, ref)
+	got, err := r.SSHEndpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := gitprovider.SSHEndpoint{User: "git", Host: "ssh.github.com", Port: 22, Path: "fluxcd/flux2.git"}
+	if got != want {
+		t.Errorf("SSHEndpoint() = %+v, want %+v", got, want)
+	}
+
+	// Repositories which weren't fetched from the API fall back to the clone URL of the reference
+	r = newUserRepository(&clientContext{domain: "github.com"}, &github.Repository{}, ref)
+	if got, err = r.SSHEndpoint(); err != nil || got.URL() != "ssh://git@github.com/fluxcd/flux2" {
+		t.Errorf("SSHEndpoint() = %q, %v, want the clone URL", got.URL(), err)
+	}
+}
+
+// newTestRepository returns the fluxcd/flux2 repository of a client talking to a server with the given handler.
+func newTestRepository(t *testing.T, handler http.Handler, opts ...gitprovider.ClientOption) *userRepository {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(append([]gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	return newUserRepository(c.(*Client).clientContext, &github.Repository{Name: github.String("flux2")}, ref)
+}
+
+func Test_userRepository_Fork(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        func(domain string) gitprovider.IdentityRef
+		wantOrg       string
+		wantRef       string
+		incorrectUser bool
+	}{
+		{
+			name:    "into the authenticated user",
+			target:  func(string) gitprovider.IdentityRef { return nil },
+			wantRef: "alice/flux2",
+		},
+		{
+			name: "into the given user",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.UserRef{Domain: domain, UserLogin: "alice"}
+			},
+			wantRef: "alice/flux2",
+		},
+		{
+			name: "into an organization",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.OrganizationRef{Domain: domain, Organization: "my-org"}
+			},
+			wantOrg: "my-org",
+			wantRef: "my-org/flux2",
+		},
+		{
+			name: "into another user",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.UserRef{Domain: domain, UserLogin: "bob"}
+			},
+			incorrectUser: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forkReq *github.RepositoryCreateForkOptions
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method + " " + r.URL.Path {
+				case "GET /api/v3/user":
+					_, _ = w.Write([]byte(`{"login":"alice"}`))
+				case "POST /api/v3/repos/fluxcd/flux2/forks":
+					forkReq = &github.RepositoryCreateForkOptions{}
+					_ = json.NewDecoder(r.Body).Decode(forkReq)
+					owner := `{"login":"alice","type":"User"}`
+					if forkReq.Organization != "" {
+						owner = `{"login":"` + forkReq.Organization + `","type":"Organization"}`
+					}
+					// GitHub creates forks asynchronously
+					w.WriteHeader(http.StatusAccepted)
+					_, _ = w.Write([]byte(`{"name":"flux2","fork":true,"owner":` + owner + `}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			fork, err := r.Fork(context.Background(), tt.target(r.domain))
+			if tt.incorrectUser {
+				incorrectUser := &gitprovider.ErrIncorrectUser{}
+				if !errors.As(err, &incorrectUser) {
+					t.Fatalf("Fork() error = %v, want %T", err, incorrectUser)
+				}
+				if forkReq != nil {
+					t.Error("Fork() sent a fork request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fork() error = %v", err)
+			}
+			if forkReq == nil || forkReq.Organization != tt.wantOrg {
+				t.Errorf("fork request = %+v, want organization %q", forkReq, tt.wantOrg)
+			}
+			if got := fork.Repository().GetIdentity() + "/" + fork.Repository().GetRepository(); got != tt.wantRef {
+				t.Errorf("Fork() = %s, want %s", got, tt.wantRef)
+			}
+			if _, isOrg := fork.(gitprovider.OrgRepository); isOrg != (tt.wantOrg != "") {
+				t.Errorf("Fork() returned an OrgRepository = %t, want %t", isOrg, tt.wantOrg != "")
+			}
+		})
+	}
+}
+
+func Test_userRepository_ListForks(t *testing.T) {
+	r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /api/v3/repos/fluxcd/flux2/forks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"name":"flux2","fork":true,"owner":{"login":"alice","type":"User"}},
+			{"name":"flux2-fork","fork":true,"owner":{"login":"my-org","type":"Organization"}}
+		]`))
+	}))
+
+	forks, err := r.ListForks(context.Background())
+	if err != nil {
+		t.Fatalf("ListForks() error = %v", err)
+	}
+	want := []struct {
+		ref   string
+		isOrg bool
+	}{
+		{"alice/flux2", false},
+		{"my-org/flux2-fork", true},
+	}
+	if len(forks) != len(want) {
+		t.Fatalf("ListForks() returned %d forks, want %d", len(forks), len(want))
+	}
+	for i, w := range want {
+		if got := forks[i].Repository().GetIdentity() + "/" + forks[i].Repository().GetRepository(); got != w.ref {
+			t.Errorf("ListForks()[%d] = %s, want %s", i, got, w.ref)
+		}
+		if _, isOrg := forks[i].(gitprovider.OrgRepository); isOrg != w.isOrg {
+			t.Errorf("ListForks()[%d] is an OrgRepository = %t, want %t", i, isOrg, w.isOrg)
+		}
+	}
+}
+
+func Test_userRepository_Transfer(t *testing.T) {
+	tests := []struct {
+		name         string
+		destructive  bool
+		newOwner     string
+		acceptedBy   string
+		wantRef      string
+		expectedErr  error
+		wantTransfer bool
+	}{
+		{
+			name:         "to an organization",
+			destructive:  true,
+			newOwner:     "my-org",
+			acceptedBy:   "my-org",
+			wantRef:      "my-org/flux2",
+			wantTransfer: true,
+		},
+		{
+			name:         "to a user who has to accept it",
+			destructive:  true,
+			newOwner:     "bob",
+			acceptedBy:   "fluxcd",
+			expectedErr:  gitprovider.ErrTransferPending,
+			wantTransfer: true,
+		},
+		{
+			name:        "destructive calls are off",
+			newOwner:    "my-org",
+			expectedErr: gitprovider.ErrDestructiveCallDisallowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var transferReq *github.TransferRequest
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.Path != "POST /api/v3/repos/fluxcd/flux2/transfer" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				transferReq = &github.TransferRequest{}
+				_ = json.NewDecoder(r.Body).Decode(transferReq)
+				// GitHub transfers repositories asynchronously
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"name":"flux2","owner":{"login":"` + tt.acceptedBy + `","type":"Organization"}}`))
+			}), gitprovider.WithDestructiveAPICalls(tt.destructive))
+
+			newOwner := gitprovider.OrganizationRef{Domain: r.domain, Organization: tt.newOwner}
+			repo, err := r.Transfer(context.Background(), newOwner)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Transfer() error = %v, want %v", err, tt.expectedErr)
+			}
+			if (transferReq != nil) != tt.wantTransfer {
+				t.Fatalf("Transfer() sent a transfer request = %t, want %t", transferReq != nil, tt.wantTransfer)
+			}
+			if transferReq != nil && transferReq.NewOwner != tt.newOwner {
+				t.Errorf("transfer request new owner = %q, want %q", transferReq.NewOwner, tt.newOwner)
+			}
+			if err != nil {
+				return
+			}
+			if got := repo.Repository().GetIdentity() + "/" + repo.Repository().GetRepository(); got != tt.wantRef {
+				t.Errorf("Transfer() = %s, want %s", got, tt.wantRef)
+			}
+		})
+	}
+}
+
+func Test_userRepository_GetUserPermission(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want *gitprovider.RepositoryPermission
+	}{
+		{
+			name: "admin",
+			body: `{"permission":"admin","user":{"login":"alice","permissions":{"admin":true,"maintain":true,"push":true,"triage":true,"pull":true}}}`,
+			want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin),
+		},
+		{
+			name: "maintain",
+			body: `{"permission":"write","user":{"login":"alice","permissions":{"maintain":true,"push":true,"triage":true,"pull":true}}}`,
+			want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionMaintain),
+		},
+		{
+			name: "push",
+			body: `{"permission":"write","user":{"login":"alice","permissions":{"push":true,"triage":true,"pull":true}}}`,
+			want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush),
+		},
+		{
+			name: "triage",
+			body: `{"permission":"read","user":{"login":"alice","permissions":{"triage":true,"pull":true}}}`,
+			want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionTriage),
+		},
+		{
+			name: "pull",
+			body: `{"permission":"read","user":{"login":"alice","permissions":{"pull":true}}}`,
+			want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull),
+		},
+		{
+			name: "legacy write permission only",
+			body: `{"permission":"write","user":{"login":"alice"}}`,
+			want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush),
+		},
+		{
+			name: "not a collaborator",
+			body: `{"permission":"none","user":{"login":"alice","permissions":{}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.Path != "GET /api/v3/repos/fluxcd/flux2/collaborators/alice/permission" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(tt.body))
+			}))
+
+			got, err := r.GetUserPermission(context.Background(), "alice")
+			if err != nil {
+				t.Fatalf("GetUserPermission() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetUserPermission() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_userRepository_GetWatchLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   gitprovider.WatchLevel
+	}{
+		{
+			name:   "watching",
+			status: http.StatusOK,
+			body:   `{"subscribed":true,"ignored":false}`,
+			want:   gitprovider.WatchLevelWatching,
+		},
+		{
+			name:   "ignoring",
+			status: http.StatusOK,
+			body:   `{"subscribed":false,"ignored":true}`,
+			want:   gitprovider.WatchLevelIgnoring,
+		},
+		{
+			name:   "not subscribed",
+			status: http.StatusNotFound,
+			body:   `{"message":"Not Found"}`,
+			want:   gitprovider.WatchLevelParticipating,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v3/repos/fluxcd/flux2/subscription", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			r := newTestRepository(t, mux)
+
+			got, err := r.GetWatchLevel(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("GetWatchLevel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userRepository_SetWatchLevel(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       gitprovider.WatchLevel
+		wantRequest string
+		wantSub     *github.Subscription
+	}{
+		{
+			name:        "watch",
+			level:       gitprovider.WatchLevelWatching,
+			wantRequest: "PUT",
+			wantSub:     &github.Subscription{Subscribed: github.Bool(true), Ignored: github.Bool(false)},
+		},
+		{
+			name:        "ignore",
+			level:       gitprovider.WatchLevelIgnoring,
+			wantRequest: "PUT",
+			wantSub:     &github.Subscription{Subscribed: github.Bool(false), Ignored: github.Bool(true)},
+		},
+		{
+			name:        "unwatch",
+			level:       gitprovider.WatchLevelParticipating,
+			wantRequest: "DELETE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotRequest string
+			var gotSub *github.Subscription
+			r := newTestRepository(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/repos/fluxcd/flux2/subscription" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				gotRequest = r.Method
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				gotSub = &github.Subscription{}
+				_ = json.NewDecoder(r.Body).Decode(gotSub)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+
+			if err := r.SetWatchLevel(context.Background(), tt.level); err != nil {
+				t.Fatal(err)
+			}
+			if gotRequest != tt.wantRequest {
+				t.Errorf("SetWatchLevel() sent %s, want %s", gotRequest, tt.wantRequest)
+			}
+			if diff := cmp.Diff(tt.wantSub, gotSub); diff != "" {
+				t.Errorf("SetWatchLevel() subscription mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	r := &userRepository{}
+	if err := r.SetWatchLevel(context.Background(), "bogus"); !errors.Is(err, validation.ErrFieldEnumInvalid) {
+		t.Errorf("SetWatchLevel() error = %v, want %v", err, validation.ErrFieldEnumInvalid)
+	}
+}
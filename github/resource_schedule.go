@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Schedule = &schedule{}
+
+// schedule is a cron expression of a scheduled workflow. There is no API object for it.
+type schedule struct {
+	info gitprovider.ScheduleInfo
+	c    *ScheduleClient
+}
+
+func (s *schedule) Get() gitprovider.ScheduleInfo {
+	return s.info
+}
+
+func (s *schedule) Set(info gitprovider.ScheduleInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	s.info = info
+	return nil
+}
+
+func (s *schedule) APIObject() interface{} {
+	return nil
+}
+
+func (s *schedule) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+// Update returns ErrNoProviderSupport, as schedules are part of the workflow files.
+func (s *schedule) Update(_ context.Context) error {
+	return errScheduledWorkflows
+}
+
+// Delete returns ErrNoProviderSupport, as schedules are part of the workflow files.
+func (s *schedule) Delete(_ context.Context) error {
+	return errScheduledWorkflows
+}
+
+// Reconcile returns ErrNoProviderSupport, as schedules are part of the workflow files.
+func (s *schedule) Reconcile(_ context.Context) (bool, error) {
+	return false, errScheduledWorkflows
+}
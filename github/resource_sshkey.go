@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newSSHKey(c *SSHKeyClient, apiObj *github.Key) *sshKey {
+	return &sshKey{
+		k: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.SSHKey = &sshKey{}
+
+type sshKey struct {
+	k github.Key
+	c *SSHKeyClient
+}
+
+func (k *sshKey) Get() gitprovider.SSHKeyInfo {
+	return gitprovider.SSHKeyInfo{
+		Name: k.k.GetTitle(),
+		Key:  []byte(k.k.GetKey()),
+	}
+}
+
+func (k *sshKey) APIObject() interface{} {
+	return &k.k
+}
+
+// Delete removes the SSH key from the authenticated user.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (k *sshKey) Delete(ctx context.Context) error {
+	// DELETE /user/keys/{key_id}
+	_, err := k.c.c.Client().Users.DeleteKey(ctx, k.k.GetID())
+	return handleHTTPError(err)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v66/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// newVariable creates a new variable. apiObj is either a *github.ActionsVariable or a *github.Secret.
+func newVariable(c *VariableClient, info gitprovider.VariableInfo, apiObj interface{}) *variable {
+	return &variable{
+		info:   info,
+		apiObj: apiObj,
+		c:      c,
+	}
+}
+
+var _ gitprovider.Variable = &variable{}
+
+type variable struct {
+	info   gitprovider.VariableInfo
+	apiObj interface{}
+	c      *VariableClient
+}
+
+func (v *variable) Get() gitprovider.VariableInfo {
+	return v.info
+}
+
+func (v *variable) Set(info gitprovider.VariableInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	v.info = info
+	return nil
+}
+
+func (v *variable) APIObject() interface{} {
+	return v.apiObj
+}
+
+func (v *variable) Repository() gitprovider.RepositoryRef {
+	return v.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// If the variable was changed from plain to masked (or the other way around),
+// it is deleted and recreated as the other kind.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (v *variable) Update(ctx context.Context) error {
+	_, isSecret := v.apiObj.(*github.Secret)
+	wantSecret := v.info.Masked != nil && *v.info.Masked
+	exists := true
+	if isSecret != wantSecret {
+		actual := v.info
+		actual.Masked = gitprovider.BoolVar(isSecret)
+		if err := v.c.delete(ctx, actual); err != nil {
+			return err
+		}
+		exists = false
+	}
+
+	apiObj, err := v.c.createOrUpdate(ctx, v.info, exists)
+	if err != nil {
+		return err
+	}
+	v.apiObj = apiObj
+	return nil
+}
+
+// Delete deletes the variable or secret from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *variable) Delete(ctx context.Context) error {
+	_, isSecret := v.apiObj.(*github.Secret)
+	info := v.info
+	info.Masked = gitprovider.BoolVar(isSecret)
+	return v.c.delete(ctx, info)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (v *variable) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := v.c.get(ctx, v.info.Key, v.info.Environment)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			apiObj, err := v.c.createOrUpdate(ctx, v.info, false)
+			if err != nil {
+				return false, err
+			}
+			v.apiObj = apiObj
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if v.info.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	v.apiObj = actual.apiObj
+	return true, v.Update(ctx)
+}
+
+func variableFromAPI(apiObj *github.ActionsVariable, environment string) gitprovider.VariableInfo {
+	return gitprovider.VariableInfo{
+		Key:         apiObj.Name,
+		Value:       apiObj.Value,
+		Masked:      gitprovider.BoolVar(false),
+		Protected:   gitprovider.BoolVar(false),
+		Environment: environment,
+	}
+}
+
+// secretFromAPI returns the information of a secret. Its value can't be read back, and is empty.
+func secretFromAPI(apiObj *github.Secret, environment string) gitprovider.VariableInfo {
+	return gitprovider.VariableInfo{
+		Key:         apiObj.Name,
+		Masked:      gitprovider.BoolVar(true),
+		Protected:   gitprovider.BoolVar(false),
+		Environment: environment,
+	}
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package github
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/google/go-github/v66/github"
 
@@ -30,6 +32,14 @@ import (
 const (
 	alreadyExistsMagicString = "name already exists on this account"
 	rateLimitDocURL          = "https://developer.github.com/v3/#rate-limiting"
+	// caseSensitiveNames is false, as GitHub resolves repository, organization, team
+	// and environment names case-insensitively.
+	caseSensitiveNames = false
+	// defaultPageSize is the number of items GitHub returns per page by default.
+	defaultPageSize = 30
+	// listPageSize is the number of items requested per page by the List iterators,
+	// the maximum GitHub allows.
+	listPageSize = 100
 )
 
 // TODO: Guard better against nil pointer dereference panics in this package, also
@@ -78,7 +88,7 @@ func validateUserRef(ref gitprovider.UserRef, expectedDomain string) error {
 // validateIdentityFields makes sure the type of the IdentityRef is supported, and the domain is as expected.
 func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string) error {
 	// Make sure the expected domain is used
-	if ref.GetDomain() != expectedDomain {
+	if !gitprovider.DomainsEqual(ref.GetDomain(), expectedDomain) {
 		return fmt.Errorf("domain %q not supported by this client: %w", ref.GetDomain(), gitprovider.ErrDomainUnsupported)
 	}
 	// Make sure the right type of identityref is used
@@ -145,18 +155,38 @@ func handleHTTPError(err error) error {
 	return err
 }
 
+// iteratorPageSize returns the number of items the List iterators request per page.
+func iteratorPageSize(ctx context.Context) int {
+	return gitprovider.CallOptionsFromContext(ctx).ListOptions().PageSize(listPageSize, listPageSize)
+}
+
 // allPages runs fn for each page, expecting a HTTP request to be made and returned during that call.
 // allPages expects that the data is saved in fn to an outer variable.
 // allPages calls fn as many times as needed to get all pages, and modifies opts for each call.
+// The page size, first page and number of pages honor the ListOptions of ctx.
 // There is no need to wrap the resulting error in handleHTTPError(err), as that's already done.
-func allPages(opts *github.ListOptions, fn func() (*github.Response, error)) error {
-	for {
-
+func allPages(ctx context.Context, opts *github.ListOptions, fn func() (*github.Response, error)) error {
+	listOpts := gitprovider.CallOptionsFromContext(ctx).ListOptions()
+	if listOpts.PerPage > 0 {
+		opts.PerPage = listOpts.PageSize(defaultPageSize, listPageSize)
+	}
+	if listOpts.Page > 0 {
+		opts.Page = listOpts.Page
+	}
+	pageSize := opts.PerPage
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	for fetched := pageSize; ; fetched += pageSize {
+		// Stop between pages once the call is cancelled or past its deadline.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		resp, err := fn()
 		if err != nil {
 			return handleHTTPError(err)
 		}
-		if resp.NextPage == 0 {
+		if resp.NextPage == 0 || listOpts.Reached(fetched) {
 			return nil
 		}
 		opts.Page = resp.NextPage
@@ -176,3 +206,43 @@ func validateAPIObject(name string, fn func(validation.Validator)) error {
 	}
 	return nil
 }
+
+// graphQLError is an error of a GitHub GraphQL API response.
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// graphQL runs the given query or mutation against the GitHub GraphQL API, and decodes the
+// data of the response into data, if it isn't nil. The GraphQL endpoint is resolved relative
+// to the REST API base URL, i.e. "https://api.github.com/graphql" on GitHub.com and
+// "https://{host}/api/graphql" on GitHub Enterprise Server, where the base URL ends in "/api/v3/".
+func graphQL(ctx context.Context, c *github.Client, query string, variables map[string]interface{}, data interface{}) error {
+	req, err := c.NewRequest(http.MethodPost, "../graphql", map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		Data   interface{}    `json:"data"`
+		Errors []graphQLError `json:"errors"`
+	}
+	resp.Data = data
+	if _, err := c.Do(ctx, req, &resp); err != nil {
+		return handleHTTPError(err)
+	}
+	if len(resp.Errors) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		messages = append(messages, e.Message)
+	}
+	err = errors.New(strings.Join(messages, "; "))
+	if resp.Errors[0].Type == "NOT_FOUND" {
+		return fmt.Errorf("%w: %w", err, gitprovider.ErrNotFound)
+	}
+	return err
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package github
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -71,6 +75,7 @@ func Test_allPages(t *testing.T) {
 	tests := []struct {
 		name          string
 		opts          *github.ListOptions
+		list          gitprovider.ListOptions
 		fn            func(int) (*github.Response, error)
 		expectedErrs  []error
 		expectedCalls int
@@ -112,6 +117,15 @@ func Test_allPages(t *testing.T) {
 			expectedCalls: 2,
 			expectedErrs:  []error{&validation.MultiError{}, gitprovider.ErrNotFound, newGHError()},
 		},
+		{
+			name: "three pages, enough items after the second",
+			opts: &github.ListOptions{},
+			list: gitprovider.ListOptions{PerPage: 10, MaxItems: 15},
+			fn: func(i int) (*github.Response, error) {
+				return &github.Response{NextPage: i + 1}, nil
+			},
+			expectedCalls: 2,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -119,7 +133,8 @@ func Test_allPages(t *testing.T) {
 			// the page index are 1-based, and omitting page is the same as page=1
 			// set page=1 here just to be able to test more easily
 			tt.opts.Page = 1
-			err := allPages(tt.opts, func() (*github.Response, error) {
+			ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallListOptions(tt.list))
+			err := allPages(ctx, tt.opts, func() (*github.Response, error) {
 				i++
 				if tt.opts.Page != i {
 					t.Fatalf("page number is unexpected: got = %d want = %d", tt.opts.Page, i)
@@ -130,6 +145,52 @@ func Test_allPages(t *testing.T) {
 			if i != tt.expectedCalls {
 				t.Errorf("allPages() expectedCalls = %v, want %v", i, tt.expectedCalls)
 			}
+			if tt.list.PerPage != 0 && tt.opts.PerPage != tt.list.PerPage {
+				t.Errorf("allPages() PerPage = %d, want %d", tt.opts.PerPage, tt.list.PerPage)
+			}
 		})
 	}
 }
+
+func Test_graphQL(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body.Variables["id"] == "missing" {
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a node"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"node":{"id":"` + body.Variables["id"].(string) + `"}}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// GitHub Enterprise Server serves the REST API under /api/v3/
+	c, err := github.NewClient(nil).WithEnterpriseURLs(server.URL, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data struct {
+		Node struct {
+			ID string `json:"id"`
+		} `json:"node"`
+	}
+	if err := graphQL(context.Background(), c, "query", map[string]interface{}{"id": "PR_1"}, &data); err != nil {
+		t.Fatalf("graphQL() error = %v", err)
+	}
+	if data.Node.ID != "PR_1" {
+		t.Errorf("graphQL() data = %v, want PR_1", data.Node.ID)
+	}
+
+	err = graphQL(context.Background(), c, "query", map[string]interface{}{"id": "missing"}, nil)
+	if !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("graphQL() error = %v, expected ErrNotFound", err)
+	}
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package gitlab
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	gogitlab "github.com/xanzy/go-gitlab"
@@ -32,9 +36,27 @@ const (
 	TokenTypeOAuth2 TokenType = "oauth2"
 	TokenTypePat    TokenType = "pat"
 	TokenTypeBasic  TokenType = "basicauth"
+	// TokenTypeJobToken is the CI_JOB_TOKEN of a GitLab CI/CD job, sent in the JOB-TOKEN header.
+	// Job tokens can only call a few endpoints, mostly of the project running the job, see
+	// https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html
+	TokenTypeJobToken TokenType = "jobtoken"
 )
 
 // NewClient creates a new gitlab.Client instance for GitLab API endpoints.
+//
+// To authenticate with tokens which change over time, pass an empty token together with
+// gitprovider.WithTokenSource, which authenticates every request with the current token. For
+// GitLab OAuth applications, pass an empty token with TokenTypeOAuth2 together with
+// gitprovider.WithOAuth2TokenSource, which refreshes the access token once it expires.
+// For read-only access to public projects, pass empty credentials together with
+// gitprovider.WithAnonymous.
+//
+// With gitprovider.WithScopeCheck, the scopes of the token are verified through the token
+// introspection endpoints of GitLab.
+//
+// Within GitLab CI/CD jobs, pass the CI_JOB_TOKEN with TokenTypeJobToken to use the library
+// without a personal access token. The user-scoped clients, e.g. GPGKeys, then return
+// ErrNoProviderSupport, as job tokens can't access them.
 func NewClient(username, password, token string, tokenType TokenType, optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
 	var gl *gogitlab.Client
 	var domain, sshDomain string
@@ -45,11 +67,20 @@ func NewClient(username, password, token string, tokenType TokenType, optFns ...
 		return nil, err
 	}
 
+	if opts.Anonymous() {
+		if username != "" || password != "" || token != "" {
+			return nil, fmt.Errorf("anonymous client can't be given credentials: %w", gitprovider.ErrInvalidClientOptions)
+		}
+		// An empty private token is treated as no token by GitLab
+		tokenType = TokenTypePat
+	}
+
 	// Create a *http.Client using the transport chain
 	httpClient, err := gitprovider.BuildClientFromTransportChain(opts.GetTransportChain())
 	if err != nil {
 		return nil, err
 	}
+	httpClient.CheckRedirect = checkRedirect
 
 	if tokenType == TokenTypeOAuth2 {
 		if opts.Domain == nil || *opts.Domain == DefaultDomain {
@@ -61,7 +92,10 @@ func NewClient(username, password, token string, tokenType TokenType, optFns ...
 			}
 		} else {
 			domain = *opts.Domain
-			baseURL := fmt.Sprintf("https://%s", domain)
+			baseURL, err := opts.DomainURL()
+			if err != nil {
+				return nil, err
+			}
 			gl, err = gogitlab.NewOAuthClient(token, gogitlab.WithHTTPClient(httpClient), gogitlab.WithBaseURL(baseURL))
 			if err != nil {
 				return nil, err
@@ -77,7 +111,10 @@ func NewClient(username, password, token string, tokenType TokenType, optFns ...
 			}
 		} else {
 			domain = *opts.Domain
-			baseURL := fmt.Sprintf("https://%s", domain)
+			baseURL, err := opts.DomainURL()
+			if err != nil {
+				return nil, err
+			}
 			gl, err = gogitlab.NewClient(token, gogitlab.WithHTTPClient(httpClient), gogitlab.WithBaseURL(baseURL))
 			if err != nil {
 				return nil, err
@@ -93,12 +130,40 @@ func NewClient(username, password, token string, tokenType TokenType, optFns ...
 			}
 		} else {
 			domain = *opts.Domain
-			baseURL := fmt.Sprintf("https://%s", domain)
+			baseURL, err := opts.DomainURL()
+			if err != nil {
+				return nil, err
+			}
 			gl, err = gogitlab.NewBasicAuthClient(username, password, gogitlab.WithHTTPClient(httpClient), gogitlab.WithBaseURL(baseURL))
 			if err != nil {
 				return nil, err
 			}
 		}
+	} else if tokenType == TokenTypeJobToken {
+		if opts.Domain == nil || *opts.Domain == DefaultDomain {
+			// No domain set or the default gitlab.com used
+			domain = DefaultDomain
+			gl, err = gogitlab.NewJobClient(token, gogitlab.WithHTTPClient(httpClient))
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			domain = *opts.Domain
+			baseURL, err := opts.DomainURL()
+			if err != nil {
+				return nil, err
+			}
+			gl, err = gogitlab.NewJobClient(token, gogitlab.WithHTTPClient(httpClient), gogitlab.WithBaseURL(baseURL))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if scopes := opts.RequiredScopes(); len(scopes) != 0 {
+		if err := checkScopes(context.Background(), gl, tokenType, scopes); err != nil {
+			return nil, err
+		}
 	}
 
 	// By default, turn destructive actions off. But allow overrides.
@@ -106,6 +171,100 @@ func NewClient(username, password, token string, tokenType TokenType, optFns ...
 	if opts.EnableDestructiveAPICalls != nil {
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
+	followRedirects := opts.FollowRedirects == nil || *opts.FollowRedirects
+
+	c := newClient(gl, domain, sshDomain, destructiveActions, followRedirects, opts.GetLogger())
+	c.tokenType = tokenType
+	if opts.CommitSizeLimits != nil {
+		c.commitSizeLimits = *opts.CommitSizeLimits
+	}
+	return c, nil
+}
+
+// maxRedirects is the number of redirects a request follows, like the default of net/http.
+const maxRedirects = 10
+
+// tokenHeaders are the headers go-gitlab sends the token in. Unlike the Authorization header,
+// net/http keeps them when a request is redirected to another host. The Authorization header is
+// also dropped, as net/http keeps it for other ports of the same host.
+//
+//nolint:gochecknoglobals
+var tokenHeaders = []string{"PRIVATE-TOKEN", "JOB-TOKEN", "Authorization"}
+
+// checkRedirect drops the token headers when a request is redirected to another host, e.g. to
+// the object storage a package file is downloaded from, so that the token isn't sent there.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if req.URL.Host != via[0].URL.Host {
+		for _, header := range tokenHeaders {
+			req.Header.Del(header)
+		}
+	}
+	return nil
+}
+
+// impliedScopes maps the token scopes of GitLab to the scopes they include, see
+// https://docs.gitlab.com/ee/user/profile/personal_access_tokens.html#personal-access-token-scopes
+//
+//nolint:gochecknoglobals
+var impliedScopes = map[string][]string{
+	"api":              {"read_api", "read_user", "write_repository", "write_registry"},
+	"write_repository": {"read_repository"},
+	"write_registry":   {"read_registry"},
+}
+
+// checkScopes verifies that the token has the required scopes. Personal, project and group
+// access tokens are introspected through the personal_access_tokens/self endpoint, OAuth2
+// tokens through the token info endpoint of the OAuth provider.
+func checkScopes(ctx context.Context, gl *gogitlab.Client, tokenType TokenType, required []string) error {
+	granted, _, err := tokenInfo(ctx, gl, tokenType)
+	if err != nil {
+		return err
+	}
+	return gitprovider.CheckScopes(granted, required, impliedScopes)
+}
+
+// tokenInfo returns the scopes and expiry of the token. Personal, project and group access
+// tokens are introspected through the personal_access_tokens/self endpoint, OAuth2 tokens
+// through the token info endpoint of the OAuth provider.
+func tokenInfo(ctx context.Context, gl *gogitlab.Client, tokenType TokenType) ([]string, *time.Time, error) {
+	if tokenType == TokenTypeJobToken {
+		// Job tokens have no scopes, but the permissions of the user running the job
+		return nil, nil, fmt.Errorf("job tokens can't be introspected: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if tokenType != TokenTypeOAuth2 && tokenType != TokenTypeBasic {
+		token, _, err := gl.PersonalAccessTokens.GetSinglePersonalAccessToken(gogitlab.WithContext(ctx))
+		if err != nil {
+			return nil, nil, handleHTTPError(err)
+		}
+		var expiresAt *time.Time
+		if token.ExpiresAt != nil {
+			t := time.Time(*token.ExpiresAt)
+			expiresAt = &t
+		}
+		return token.Scopes, expiresAt, nil
+	}
 
-	return newClient(gl, domain, sshDomain, destructiveActions), nil
+	req, err := gl.NewRequest(http.MethodGet, "", nil, []gogitlab.RequestOptionFunc{gogitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, nil, err
+	}
+	// The endpoint isn't part of the API, but served next to it
+	req.URL.Path = strings.TrimSuffix(req.URL.Path, "api/v4/") + "oauth/token/info"
+	req.URL.RawPath = ""
+	var info struct {
+		Scope            []string `json:"scope"`
+		ExpiresInSeconds *int64   `json:"expires_in_seconds"`
+	}
+	if _, err := gl.Do(req, &info); err != nil {
+		return nil, nil, handleHTTPError(err)
+	}
+	var expiresAt *time.Time
+	if info.ExpiresInSeconds != nil {
+		t := time.Now().Add(time.Duration(*info.ExpiresInSeconds) * time.Second)
+		expiresAt = &t
+	}
+	return info.Scope, expiresAt, nil
 }
@@ -17,17 +17,26 @@ limitations under the License.
 package gitlab
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+	gogitlab "github.com/xanzy/go-gitlab"
 )
 
 func TestSupportedDomain(t *testing.T) {
 	tests := []struct {
-		name         string
-		opts         gitprovider.ClientOption
-		want         string
-		expectedErrs []error
+		name              string
+		opts              gitprovider.ClientOption
+		allowInsecureHTTP bool
+		want              string
+		expectedErrs      []error
 	}{
 		{
 			name: "gitlab.com domain",
@@ -45,17 +54,34 @@ func TestSupportedDomain(t *testing.T) {
 			want: "https://my-gitlab.dev.com",
 		},
 		{
-			name: "custom domain with http protocol",
-			opts: gitprovider.WithDomain("http://my-gitlab.dev.com"),
-			want: "http://my-gitlab.dev.com",
+			name:              "custom domain with http protocol",
+			opts:              gitprovider.WithDomain("http://my-gitlab.dev.com"),
+			allowInsecureHTTP: true,
+			want:              "http://my-gitlab.dev.com",
+		},
+		{
+			name:         "custom domain with http protocol, insecure HTTP not allowed",
+			opts:         gitprovider.WithDomain("http://my-gitlab.dev.com"),
+			expectedErrs: []error{gitprovider.ErrInvalidClientOptions},
+		},
+		{
+			name: "custom domain with custom port",
+			opts: gitprovider.WithDomain("my-gitlab.dev.com:8443"),
+			want: "https://my-gitlab.dev.com:8443",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c1, _ := NewClient("", "", "token", TokenTypeOAuth2, tt.opts)
+			insecureOpt := gitprovider.WithAllowInsecureHTTP(tt.allowInsecureHTTP)
+			c1, err := NewClient("", "", "token", TokenTypeOAuth2, tt.opts, insecureOpt)
+			validation.TestExpectErrors(t, "NewClient", err, tt.expectedErrs...)
+			if err != nil {
+				return
+			}
 			assertEqual(t, tt.want, c1.SupportedDomain())
 
-			c2, _ := NewClient("", "", "token", TokenTypePat, tt.opts)
+			c2, err := NewClient("", "", "token", TokenTypePat, tt.opts, insecureOpt)
+			validation.TestExpectErrors(t, "NewClient", err, tt.expectedErrs...)
 			assertEqual(t, tt.want, c2.SupportedDomain())
 		})
 	}
@@ -66,3 +92,130 @@ func assertEqual(t *testing.T, a interface{}, b interface{}) {
 		t.Fatalf("%s != %s", a, b)
 	}
 }
+
+func TestNewClientAnonymous(t *testing.T) {
+	if _, err := NewClient("", "", "", TokenTypeBasic, gitprovider.WithAnonymous(true)); err != nil {
+		t.Errorf("NewClient() error = %v", err)
+	}
+	_, err := NewClient("", "", "token", TokenTypePat, gitprovider.WithAnonymous(true))
+	validation.TestExpectErrors(t, "NewClient", err, gitprovider.ErrInvalidClientOptions)
+}
+
+func TestNewClientScopeCheck(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"scopes":["api"]}`))
+	})
+	mux.HandleFunc("GET /oauth/token/info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"scope":["read_repository"]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		tokenType    TokenType
+		required     []string
+		expectedErrs []error
+	}{
+		{
+			name:      "personal access token",
+			tokenType: TokenTypePat,
+			required:  []string{"api", "read_repository"},
+		},
+		{
+			name:         "personal access token, missing scopes",
+			tokenType:    TokenTypePat,
+			required:     []string{"sudo"},
+			expectedErrs: []error{gitprovider.ErrMissingScopes},
+		},
+		{
+			name:      "oauth2 token",
+			tokenType: TokenTypeOAuth2,
+			required:  []string{"read_repository"},
+		},
+		{
+			name:         "oauth2 token, missing scopes",
+			tokenType:    TokenTypeOAuth2,
+			required:     []string{"write_repository"},
+			expectedErrs: []error{gitprovider.ErrMissingScopes},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient("", "", "token", tt.tokenType, gitprovider.WithDomain(server.URL),
+				gitprovider.WithAllowInsecureHTTP(true), gitprovider.WithScopeCheck(tt.required...))
+			validation.TestExpectErrors(t, "NewClient", err, tt.expectedErrs...)
+		})
+	}
+}
+
+func TestClientCurrentUser(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":7,"username":"project_1_bot","name":"deploy","bot":true}`))
+	})
+	mux.HandleFunc("GET /api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"scopes":["read_repository"],"expires_at":"2026-12-31"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient("", "", "token", TokenTypePat, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.CurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentUser() error = %v", err)
+	}
+	if info.ID != 7 || info.Username != "project_1_bot" || !info.Bot || info.Name == nil || *info.Name != "deploy" || info.Email != nil {
+		t.Errorf("CurrentUser() = %+v", info)
+	}
+	if !reflect.DeepEqual(info.Scopes, []string{"read_repository"}) {
+		t.Errorf("CurrentUser() scopes = %v", info.Scopes)
+	}
+	if want := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC); info.TokenExpiresAt == nil || !info.TokenExpiresAt.Equal(want) {
+		t.Errorf("CurrentUser() token expiry = %v, want %v", info.TokenExpiresAt, want)
+	}
+}
+
+func TestNewClientJobToken(t *testing.T) {
+	var jobToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobToken = r.Header.Get("JOB-TOKEN")
+		_, _ = w.Write([]byte(`{"id":1,"path_with_namespace":"group/project"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("", "", "job-token", TokenTypeJobToken, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.Raw().(*gogitlab.Client).Projects.GetProject("group/project", nil); err != nil {
+		t.Fatal(err)
+	}
+	if jobToken != "job-token" {
+		t.Errorf("JOB-TOKEN header = %q, want %q", jobToken, "job-token")
+	}
+
+	if _, err := c.GPGKeys(); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("GPGKeys() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+	if _, err := c.Instance().Users(); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Instance().Users() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+	if _, err := c.CurrentUser(context.Background()); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("CurrentUser() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+	var capErr *gitprovider.CapabilityError
+	if err := c.CheckCapability(context.Background(), gitprovider.CapabilityRunners); !errors.As(err, &capErr) || capErr.Capability != gitprovider.CapabilityRunners {
+		t.Errorf("CheckCapability(runners) error = %v, want a *CapabilityError", err)
+	}
+	if err := c.CheckCapability(context.Background(), gitprovider.CapabilityPackages); err != nil {
+		t.Errorf("CheckCapability(packages) error = %v", err)
+	}
+	_, err = NewClient("", "", "job-token", TokenTypeJobToken, gitprovider.WithDomain(server.URL),
+		gitprovider.WithAllowInsecureHTTP(true), gitprovider.WithScopeCheck("api"))
+	validation.TestExpectErrors(t, "NewClient", err, gitprovider.ErrNoProviderSupport)
+}
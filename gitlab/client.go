@@ -18,19 +18,18 @@ package gitlab
 
 import (
 	"context"
-	"fmt"
-	"net/url"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-logr/logr"
 	"github.com/xanzy/go-gitlab"
 )
 
 // ProviderID is the provider ID for GitLab.
 const ProviderID = gitprovider.ProviderID("gitlab")
 
-func newClient(c *gitlab.Client, domain string, sshDomain string, destructiveActions bool) *Client {
+func newClient(c *gitlab.Client, domain string, sshDomain string, destructiveActions, followRedirects bool, log logr.Logger) *Client {
 	glClient := &gitlabClientImpl{c, destructiveActions}
-	ctx := &clientContext{glClient, domain, sshDomain, destructiveActions}
+	ctx := &clientContext{glClient, domain, sshDomain, destructiveActions, followRedirects, "", log, defaultCommitSizeLimits}
 	return &Client{
 		clientContext: ctx,
 		orgs: &OrganizationsClient{
@@ -42,6 +41,15 @@ func newClient(c *gitlab.Client, domain string, sshDomain string, destructiveAct
 		userRepos: &UserRepositoriesClient{
 			clientContext: ctx,
 		},
+		gpgKeys: &GPGKeyClient{
+			clientContext: ctx,
+		},
+		sshKeys: &SSHKeyClient{
+			clientContext: ctx,
+		},
+		instance: &InstanceClient{
+			clientContext: ctx,
+		},
 	}
 }
 
@@ -50,6 +58,31 @@ type clientContext struct {
 	domain             string
 	sshDomain          string
 	destructiveActions bool
+	followRedirects    bool
+	// tokenType is used to introspect the token, and to report the clients job tokens can't
+	// access.
+	tokenType TokenType
+	log       logr.Logger
+	// commitSizeLimits are the limits commits are checked against before they're sent.
+	commitSizeLimits gitprovider.SizeLimits
+}
+
+// checkCapability returns a *gitprovider.CapabilityError for the capabilities job tokens can't
+// access, as they're limited to a small set of project endpoints.
+func (c *clientContext) checkCapability(capability gitprovider.Capability) error {
+	if c.tokenType != TokenTypeJobToken {
+		return nil
+	}
+	switch capability {
+	case gitprovider.CapabilityGPGKeys, gitprovider.CapabilitySSHKeys, gitprovider.CapabilityRunners,
+		gitprovider.CapabilityInstanceUsers, gitprovider.CapabilityCurrentUser, gitprovider.CapabilityAuditLog,
+		gitprovider.CapabilitySCIM:
+		return &gitprovider.CapabilityError{
+			Capability: capability,
+			Reason:     "CI/CD job tokens can't access it, use a personal, group or project access token",
+		}
+	}
+	return nil
 }
 
 // Client implements the gitprovider.Client interface.
@@ -62,6 +95,9 @@ type Client struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+	gpgKeys   *GPGKeyClient
+	sshKeys   *SSHKeyClient
+	instance  *InstanceClient
 }
 
 // SupportedDomain returns the domain endpoint for this client, e.g. "gitlab.com" or
@@ -69,12 +105,7 @@ type Client struct {
 // what endpoints.
 // This field is set at client creation time, and can't be changed.
 func (c *Client) SupportedDomain() string {
-	u, _ := url.Parse(c.domain)
-	domain := c.domain
-	if u.Scheme == "" {
-		domain = fmt.Sprintf("https://%s", c.domain)
-	}
-	return domain
+	return gitprovider.GetDomainURL(c.domain)
 }
 
 // SupportedSSHDomain returns the ssh domain endpoint for this client, e.g. "gitlab.com" or
@@ -112,7 +143,82 @@ func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
 	return c.userRepos
 }
 
+// GPGKeys returns the GPGKeyClient handling the GPG keys of the authenticated user.
+// Returns a *gitprovider.CapabilityError for job tokens, which have no user endpoint access.
+func (c *Client) GPGKeys() (gitprovider.GPGKeyClient, error) {
+	if err := c.checkCapability(gitprovider.CapabilityGPGKeys); err != nil {
+		return nil, err
+	}
+	return c.gpgKeys, nil
+}
+
+// SSHKeys returns the SSHKeyClient handling the SSH keys of the authenticated user.
+// Returns a *gitprovider.CapabilityError for job tokens, which have no user endpoint access.
+func (c *Client) SSHKeys() (gitprovider.SSHKeyClient, error) {
+	if err := c.checkCapability(gitprovider.CapabilitySSHKeys); err != nil {
+		return nil, err
+	}
+	return c.sshKeys, nil
+}
+
+// Instance returns the InstanceClient handling the administration of the instance.
+func (c *Client) Instance() gitprovider.InstanceClient {
+	return c.instance
+}
+
+// Runners returns the RunnerClient handling the instance runners, which requires an
+// administrator token.
+func (c *Client) Runners() (gitprovider.RunnerClient, error) {
+	if err := c.checkCapability(gitprovider.CapabilityRunners); err != nil {
+		return nil, err
+	}
+	return &RunnerClient{clientContext: c.clientContext}, nil
+}
+
+// CheckCapability returns a *gitprovider.CapabilityError for the capabilities CI/CD job tokens
+// can't access, see TokenTypeJobToken.
+func (c *Client) CheckCapability(_ context.Context, capability gitprovider.Capability) error {
+	return c.checkCapability(capability)
+}
+
+// SSHHostKeys returns ErrNoProviderSupport, GitLab only publishes the fingerprints of its SSH host
+// keys in the instance configuration page, not through the API.
+func (c *Client) SSHHostKeys(_ context.Context) ([]gitprovider.SSHHostKey, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
 // HasTokenPermission returns true if the given token has the given permissions.
 func (c *Client) HasTokenPermission(_ context.Context, _ gitprovider.TokenPermission) (bool, error) {
 	return false, gitprovider.ErrNoProviderSupport
 }
+
+// CurrentUser returns the identity the client is authenticated as, along with the scopes and
+// expiry of the token. Project and group access tokens authenticate as bot users.
+func (c *Client) CurrentUser(ctx context.Context) (gitprovider.CurrentUserInfo, error) {
+	if err := c.checkCapability(gitprovider.CapabilityCurrentUser); err != nil {
+		// The user endpoint isn't accessible with job tokens
+		return gitprovider.CurrentUserInfo{}, err
+	}
+	user, err := c.c.GetUser(ctx)
+	if err != nil {
+		return gitprovider.CurrentUserInfo{}, handleHTTPError(err)
+	}
+	info := gitprovider.CurrentUserInfo{
+		ID:       int64(user.ID),
+		Username: user.Username,
+		Bot:      user.Bot,
+	}
+	if user.Name != "" {
+		info.Name = gitlab.Ptr(user.Name)
+	}
+	if email := user.Email; email != "" {
+		info.Email = gitlab.Ptr(email)
+	} else if user.PublicEmail != "" {
+		info.Email = gitlab.Ptr(user.PublicEmail)
+	}
+	// Tokens can't be introspected on GitLab versions before 15.5, which isn't fatal here
+	if scopes, expiresAt, err := tokenInfo(ctx, c.c.Client(), c.tokenType); err == nil {
+		info.Scopes, info.TokenExpiresAt = scopes, expiresAt
+	}
+	return info, nil
+}
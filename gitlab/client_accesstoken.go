@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AccessTokenClient implements the gitprovider.AccessTokenClient interface.
+var _ gitprovider.AccessTokenClient = &AccessTokenClient{}
+
+// AccessTokenClient manages the project or group access tokens of a project or group. GitLab
+// creates a bot user for every token, which is a member of the project or group with the
+// access level of the token.
+type AccessTokenClient struct {
+	*clientContext
+	// ref is either an OrganizationRef or a RepositoryRef
+	ref gitprovider.IdentityRef
+}
+
+// List lists the access tokens of the project or group.
+//
+// List returns all available tokens, using multiple paginated requests if needed.
+func (c *AccessTokenClient) List(ctx context.Context) ([]gitprovider.AccessTokenInfo, error) {
+	tokens := []gitprovider.AccessTokenInfo{}
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		opts := &gitlab.ListProjectAccessTokensOptions{}
+		err := allProjectAccessTokenPages(ctx, opts, func() (*gitlab.Response, error) {
+			// GET /projects/{project}/access_tokens
+			pageObjs, resp, listErr := c.c.Client().ProjectAccessTokens.ListProjectAccessTokens(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			for _, apiObj := range pageObjs {
+				tokens = append(tokens, projectAccessTokenFromAPI(apiObj))
+			}
+			return resp, handleHTTPError(listErr)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return gitprovider.LimitItems(ctx, tokens), nil
+	}
+
+	opts := &gitlab.ListGroupAccessTokensOptions{}
+	err := allGroupAccessTokenPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /groups/{group}/access_tokens
+		pageObjs, resp, listErr := c.c.Client().GroupAccessTokens.ListGroupAccessTokens(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			tokens = append(tokens, groupAccessTokenFromAPI(apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, tokens), nil
+}
+
+// ListIter returns an iterator over the access tokens List returns, fetching them a page at a time.
+func (c *AccessTokenClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.AccessTokenInfo] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.AccessTokenInfo, gitprovider.PageInfo, error) {
+		listOpts := gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+			opts := (*gitlab.ListProjectAccessTokensOptions)(&listOpts)
+			// GET /projects/{project}/access_tokens
+			apiObjs, resp, err := c.c.Client().ProjectAccessTokens.ListProjectAccessTokens(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+			}
+			tokens := make([]gitprovider.AccessTokenInfo, 0, len(apiObjs))
+			for _, apiObj := range apiObjs {
+				tokens = append(tokens, projectAccessTokenFromAPI(apiObj))
+			}
+			return tokens, pageInfo(resp), nil
+		}
+
+		opts := (*gitlab.ListGroupAccessTokensOptions)(&listOpts)
+		// GET /groups/{group}/access_tokens
+		apiObjs, resp, err := c.c.Client().GroupAccessTokens.ListGroupAccessTokens(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+		tokens := make([]gitprovider.AccessTokenInfo, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			tokens = append(tokens, groupAccessTokenFromAPI(apiObj))
+		}
+		return tokens, pageInfo(resp), nil
+	})
+}
+
+// Create creates a project or group access token. GitLab requires an expiry date, which
+// defaults to the maximum lifetime allowed by the instance if ExpiresAt is nil.
+func (c *AccessTokenClient) Create(ctx context.Context, req gitprovider.AccessTokenInfo) (*gitprovider.AccessTokenInfo, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	var accessLevel *gitlab.AccessLevelValue
+	if req.Permission != nil {
+		level, err := getGitlabPermission(*req.Permission)
+		if err != nil {
+			return nil, err
+		}
+		accessLevel = gitlab.Ptr(gitlab.AccessLevelValue(level))
+	}
+
+	var token gitprovider.AccessTokenInfo
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// POST /projects/{project}/access_tokens
+		apiObj, _, err := c.c.Client().ProjectAccessTokens.CreateProjectAccessToken(getRepoPath(repoRef), &gitlab.CreateProjectAccessTokenOptions{
+			Name:        gitlab.Ptr(req.Name),
+			Scopes:      &req.Scopes,
+			AccessLevel: accessLevel,
+			ExpiresAt:   isoTime(req.ExpiresAt),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		token = projectAccessTokenFromAPI(apiObj)
+	} else {
+		// POST /groups/{group}/access_tokens
+		apiObj, _, err := c.c.Client().GroupAccessTokens.CreateGroupAccessToken(c.ref.GetIdentity(), &gitlab.CreateGroupAccessTokenOptions{
+			Name:        gitlab.Ptr(req.Name),
+			Scopes:      &req.Scopes,
+			AccessLevel: accessLevel,
+			ExpiresAt:   isoTime(req.ExpiresAt),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		token = groupAccessTokenFromAPI(apiObj)
+	}
+	return &token, nil
+}
+
+// Rotate revokes the access token with the given ID, and returns a new one with the same
+// name, scopes and access level. GitLab defaults the expiry of the new token to one week.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AccessTokenClient) Rotate(ctx context.Context, id int64, expiresAt *time.Time) (*gitprovider.AccessTokenInfo, error) {
+	var token gitprovider.AccessTokenInfo
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// POST /projects/{project}/access_tokens/{id}/rotate
+		apiObj, _, err := c.c.Client().ProjectAccessTokens.RotateProjectAccessToken(getRepoPath(repoRef), int(id), &gitlab.RotateProjectAccessTokenOptions{
+			ExpiresAt: isoTime(expiresAt),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		token = projectAccessTokenFromAPI(apiObj)
+	} else {
+		// POST /groups/{group}/access_tokens/{id}/rotate
+		apiObj, _, err := c.c.Client().GroupAccessTokens.RotateGroupAccessToken(c.ref.GetIdentity(), int(id), &gitlab.RotateGroupAccessTokenOptions{
+			ExpiresAt: isoTime(expiresAt),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		token = groupAccessTokenFromAPI(apiObj)
+	}
+	return &token, nil
+}
+
+// Revoke revokes the access token with the given ID, which also removes its bot user.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AccessTokenClient) Revoke(ctx context.Context, id int64) error {
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// DELETE /projects/{project}/access_tokens/{id}
+		_, err := c.c.Client().ProjectAccessTokens.RevokeProjectAccessToken(getRepoPath(repoRef), int(id), gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	}
+	// DELETE /groups/{group}/access_tokens/{id}
+	_, err := c.c.Client().GroupAccessTokens.RevokeGroupAccessToken(c.ref.GetIdentity(), int(id), gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func projectAccessTokenFromAPI(apiObj *gitlab.ProjectAccessToken) gitprovider.AccessTokenInfo {
+	return accessTokenFromAPI(apiObj.ID, apiObj.Name, apiObj.Scopes, apiObj.AccessLevel, apiObj.ExpiresAt, apiObj.Active, apiObj.Token)
+}
+
+func groupAccessTokenFromAPI(apiObj *gitlab.GroupAccessToken) gitprovider.AccessTokenInfo {
+	return accessTokenFromAPI(apiObj.ID, apiObj.Name, apiObj.Scopes, apiObj.AccessLevel, apiObj.ExpiresAt, apiObj.Active, apiObj.Token)
+}
+
+// accessTokenFromAPI converts the fields project and group access tokens have in common.
+func accessTokenFromAPI(id int, name string, scopes []string, accessLevel gitlab.AccessLevelValue, expiresAt *gitlab.ISOTime, active bool, token string) gitprovider.AccessTokenInfo {
+	info := gitprovider.AccessTokenInfo{
+		ID:        int64(id),
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: (*time.Time)(expiresAt),
+		Active:    gitprovider.BoolVar(active),
+		Token:     token,
+	}
+	if permission, err := getGitProviderPermission(int(accessLevel)); err == nil {
+		info.Permission = permission
+	}
+	return info
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_groupAccessTokenFromAPI(t *testing.T) {
+	expiresAt := gitlab.ISOTime(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC))
+	got := groupAccessTokenFromAPI(&gitlab.GroupAccessToken{
+		ID:          7,
+		Name:        "flux",
+		Scopes:      []string{"read_repository"},
+		AccessLevel: gitlab.ReporterPermissions,
+		ExpiresAt:   &expiresAt,
+		Active:      true,
+		Token:       "glpat-secret",
+	})
+	want := gitprovider.AccessTokenInfo{
+		ID:         7,
+		Name:       "flux",
+		Scopes:     []string{"read_repository"},
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionTriage),
+		ExpiresAt:  (*time.Time)(&expiresAt),
+		Active:     gitprovider.BoolVar(true),
+		Token:      "glpat-secret",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("groupAccessTokenFromAPI() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BotClient implements the gitprovider.BotClient interface.
+var _ gitprovider.BotClient = &BotClient{}
+
+// BotClient manages the bot users of a group or project.
+// Bots of a group are service accounts with a personal access token, which requires GitLab
+// Premium. Bots of a project are the bot users GitLab creates for project access tokens.
+// Any non-empty username can be used together with the token of a project bot.
+type BotClient struct {
+	*clientContext
+	// ref is either an OrganizationRef or a RepositoryRef
+	ref gitprovider.IdentityRef
+}
+
+// Get returns the bot with the given ID, which is the user ID of a group service account,
+// or the ID of the access token of a project bot.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *BotClient) Get(ctx context.Context, id string) (gitprovider.Bot, error) {
+	botID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bot ID %q: %w", id, gitprovider.ErrInvalidArgument)
+	}
+
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// GET /projects/{project}/access_tokens/{id}
+		apiObj, _, err := c.c.Client().ProjectAccessTokens.GetProjectAccessToken(getRepoPath(repoRef), botID, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		if !apiObj.Active || apiObj.Revoked {
+			return nil, gitprovider.ErrNotFound
+		}
+		return newProjectBot(c, apiObj), nil
+	}
+
+	// There is no endpoint for getting a single service account
+	bots, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range bots {
+		if b.Get().ID == id {
+			return b, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists the service accounts of the group, or the bots of the project with an active token.
+//
+// List returns all available bots, using multiple paginated requests if needed.
+func (c *BotClient) List(ctx context.Context) ([]gitprovider.Bot, error) {
+	bots := []gitprovider.Bot{}
+
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		opts := &gitlab.ListProjectAccessTokensOptions{}
+		err := allProjectAccessTokenPages(ctx, opts, func() (*gitlab.Response, error) {
+			// GET /projects/{project}/access_tokens
+			pageObjs, resp, listErr := c.c.Client().ProjectAccessTokens.ListProjectAccessTokens(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			for _, apiObj := range pageObjs {
+				if apiObj.Active && !apiObj.Revoked {
+					bots = append(bots, newProjectBot(c, apiObj))
+				}
+			}
+			return resp, handleHTTPError(listErr)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return gitprovider.LimitItems(ctx, bots), nil
+	}
+
+	opts := &gitlab.ListServiceAccountsOptions{}
+	err := allServiceAccountPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /groups/{group}/service_accounts
+		pageObjs, resp, listErr := c.c.Client().Groups.ListServiceAccounts(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			bots = append(bots, newServiceAccountBot(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, bots), nil
+}
+
+// ListIter returns an iterator over the bots List returns, fetching them a page at a time.
+func (c *BotClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Bot] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Bot, gitprovider.PageInfo, error) {
+		listOpts := gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+			opts := (*gitlab.ListProjectAccessTokensOptions)(&listOpts)
+			// GET /projects/{project}/access_tokens
+			apiObjs, resp, err := c.c.Client().ProjectAccessTokens.ListProjectAccessTokens(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+			}
+			bots := make([]gitprovider.Bot, 0, len(apiObjs))
+			for _, apiObj := range apiObjs {
+				if apiObj.Active && !apiObj.Revoked {
+					bots = append(bots, newProjectBot(c, apiObj))
+				}
+			}
+			// The total includes the inactive tokens, which aren't bots
+			return bots, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+		}
+
+		opts := &gitlab.ListServiceAccountsOptions{ListOptions: listOpts}
+		// GET /groups/{group}/service_accounts
+		apiObjs, resp, err := c.c.Client().Groups.ListServiceAccounts(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+		bots := make([]gitprovider.Bot, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			bots = append(bots, newServiceAccountBot(c, apiObj))
+		}
+		return bots, pageInfo(resp), nil
+	})
+}
+
+// Create creates a project access token and its bot user, or a group service account with
+// a personal access token. The token is returned in the Token field of the bot.
+func (c *BotClient) Create(ctx context.Context, req gitprovider.BotInfo) (gitprovider.Bot, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	var accessLevel *gitlab.AccessLevelValue
+	if req.Permission != nil {
+		level, err := getGitlabPermission(*req.Permission)
+		if err != nil {
+			return nil, err
+		}
+		accessLevel = gitlab.Ptr(gitlab.AccessLevelValue(level))
+	}
+
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		opts := &gitlab.CreateProjectAccessTokenOptions{
+			Name:        gitlab.Ptr(req.Name),
+			Scopes:      &req.Scopes,
+			AccessLevel: accessLevel,
+			ExpiresAt:   isoTime(req.ExpiresAt),
+		}
+		// POST /projects/{project}/access_tokens
+		apiObj, _, err := c.c.Client().ProjectAccessTokens.CreateProjectAccessToken(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return newProjectBot(c, apiObj), nil
+	}
+
+	group := c.ref.GetIdentity()
+	// POST /groups/{group}/service_accounts
+	sa, _, err := c.c.Client().Groups.CreateServiceAccount(group, &gitlab.CreateServiceAccountOptions{
+		Name: gitlab.Ptr(req.Name),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	b := newServiceAccountBot(c, sa)
+
+	// POST /groups/{group}/service_accounts/{id}/personal_access_tokens
+	token, _, err := c.c.Client().Groups.CreateServiceAccountPersonalAccessToken(group, sa.ID, &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+		Name:      gitlab.Ptr(req.Name),
+		Scopes:    &req.Scopes,
+		ExpiresAt: isoTime(req.ExpiresAt),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return b, handleHTTPError(err)
+	}
+	b.info.Scopes = token.Scopes
+	b.info.ExpiresAt = (*time.Time)(token.ExpiresAt)
+	b.info.Token = token.Token
+
+	if accessLevel != nil {
+		// POST /groups/{group}/members
+		_, _, err := c.c.Client().GroupMembers.AddGroupMember(group, &gitlab.AddGroupMemberOptions{
+			UserID:      gitlab.Ptr(sa.ID),
+			AccessLevel: accessLevel,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return b, handleHTTPError(err)
+		}
+		b.info.Permission = req.Permission
+	}
+	return b, nil
+}
+
+// isoTime converts t to the date-only format of GitLab tokens.
+func isoTime(t *time.Time) *gitlab.ISOTime {
+	if t == nil {
+		return nil
+	}
+	return gitlab.Ptr(gitlab.ISOTime(*t))
+}
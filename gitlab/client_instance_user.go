@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AdminUserClient implements the gitprovider.AdminUserClient interface.
+var _ gitprovider.AdminUserClient = &AdminUserClient{}
+
+// AdminUserClient manages the user accounts of a self-managed GitLab instance, which requires
+// an administrator token.
+type AdminUserClient struct {
+	*clientContext
+}
+
+// Get returns the user with the given username.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Get(ctx context.Context, username string) (*gitprovider.UserInfo, error) {
+	// GET /users?username={username}
+	apiObjs, _, err := c.c.Client().Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr(username)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if len(apiObjs) == 0 {
+		return nil, fmt.Errorf("user %q: %w", username, gitprovider.ErrNotFound)
+	}
+	return userFromAPI(apiObjs[0]), nil
+}
+
+// Create creates a user with a confirmed email address. If no password is set, GitLab
+// generates a random one.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *AdminUserClient) Create(ctx context.Context, req gitprovider.UserInfo) (*gitprovider.UserInfo, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	opts := &gitlab.CreateUserOptions{
+		Username:         gitlab.Ptr(req.Username),
+		Name:             req.Name,
+		Email:            gitlab.Ptr(req.Email),
+		Admin:            req.Admin,
+		SkipConfirmation: gitlab.Ptr(true),
+	}
+	if req.Password != nil {
+		opts.Password = req.Password
+	} else {
+		opts.ForceRandomPassword = gitlab.Ptr(true)
+	}
+	// POST /users
+	apiObj, _, err := c.c.Client().Users.CreateUser(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return userFromAPI(apiObj), nil
+}
+
+// Block blocks the user. Users synchronized from LDAP can't be blocked.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Block(ctx context.Context, username string) error {
+	userID, err := getUserID(ctx, c.c.Client(), username)
+	if err != nil {
+		return err
+	}
+	// POST /users/{user}/block
+	return handleHTTPError(c.c.Client().Users.BlockUser(userID, gitlab.WithContext(ctx)))
+}
+
+// Unblock unblocks the user. Users blocked by LDAP can't be unblocked.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Unblock(ctx context.Context, username string) error {
+	userID, err := getUserID(ctx, c.c.Client(), username)
+	if err != nil {
+		return err
+	}
+	// POST /users/{user}/unblock
+	return handleHTTPError(c.c.Client().Users.UnblockUser(userID, gitlab.WithContext(ctx)))
+}
+
+// Delete deletes the user. GitLab deletes the user asynchronously, and keeps its contributions
+// under the "Ghost User".
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *AdminUserClient) Delete(ctx context.Context, username string) error {
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete user: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	userID, err := getUserID(ctx, c.c.Client(), username)
+	if err != nil {
+		return err
+	}
+	// DELETE /users/{user}
+	_, err = c.c.Client().Users.DeleteUser(userID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// CreateImpersonationToken creates an impersonation token for the user, which is used like a
+// personal access token of the user.
+func (c *AdminUserClient) CreateImpersonationToken(ctx context.Context, username string, req gitprovider.ImpersonationTokenInfo) (*gitprovider.ImpersonationTokenInfo, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	userID, err := getUserID(ctx, c.c.Client(), username)
+	if err != nil {
+		return nil, err
+	}
+	// POST /users/{user}/impersonation_tokens
+	apiObj, _, err := c.c.Client().Users.CreateImpersonationToken(userID, &gitlab.CreateImpersonationTokenOptions{
+		Name:      gitlab.Ptr(req.Name),
+		Scopes:    &req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	token := &gitprovider.ImpersonationTokenInfo{
+		ID:     int64(apiObj.ID),
+		Name:   apiObj.Name,
+		Scopes: apiObj.Scopes,
+		Token:  apiObj.Token,
+	}
+	if apiObj.ExpiresAt != nil {
+		token.ExpiresAt = gitlab.Ptr(time.Time(*apiObj.ExpiresAt))
+	}
+	return token, nil
+}
+
+// userFromAPI converts a user as seen by an administrator. Users blocked by LDAP, pending
+// approval or banned are reported as blocked, too, but deactivated users aren't, as they can
+// reactivate themselves by signing in.
+func userFromAPI(apiObj *gitlab.User) *gitprovider.UserInfo {
+	user := &gitprovider.UserInfo{
+		ID:       int64(apiObj.ID),
+		Username: apiObj.Username,
+		Name:     gitlab.Ptr(apiObj.Name),
+		Email:    apiObj.Email,
+		Admin:    gitlab.Ptr(apiObj.IsAdmin),
+	}
+	switch apiObj.State {
+	case "blocked", "ldap_blocked", "blocked_pending_approval", "banned":
+		user.Blocked = true
+	}
+	return user
+}
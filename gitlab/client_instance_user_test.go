@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_userFromAPI_blocked(t *testing.T) {
+	tests := []struct {
+		state string
+		want  bool
+	}{
+		{"active", false},
+		{"deactivated", false},
+		{"blocked", true},
+		{"ldap_blocked", true},
+		{"blocked_pending_approval", true},
+		{"banned", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			got := userFromAPI(&gitlab.User{Username: "bot", State: tt.state})
+			if got.Blocked != tt.want {
+				t.Errorf("userFromAPI().Blocked = %t, want %t", got.Blocked, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the labels of a specific group or project.
+type LabelClient struct {
+	*clientContext
+	// ref is either an OrganizationRef or a RepositoryRef
+	ref gitprovider.IdentityRef
+}
+
+// Get returns the label with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *LabelClient) Get(ctx context.Context, name string) (gitprovider.Label, error) {
+	return c.get(ctx, name)
+}
+
+func (c *LabelClient) get(ctx context.Context, name string) (*label, error) {
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// GET /projects/{project}/labels/{label}
+		apiObj, _, err := c.c.Client().Labels.GetLabel(getRepoPath(repoRef), name, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return newLabel(c, apiObj), nil
+	}
+	// GET /groups/{group}/labels/{label}
+	apiObj, _, err := c.c.Client().GroupLabels.GetGroupLabel(c.ref.GetIdentity(), name, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newLabel(c, (*gitlab.Label)(apiObj)), nil
+}
+
+// List lists the labels of the group or project. Labels of ancestor groups aren't included.
+//
+// List returns all available labels, using multiple paginated requests if needed.
+func (c *LabelClient) List(ctx context.Context) ([]gitprovider.Label, error) {
+	labels := []gitprovider.Label{}
+
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		opts := &gitlab.ListLabelsOptions{
+			IncludeAncestorGroups: gitlab.Ptr(false),
+		}
+		err := allLabelPages(ctx, opts, func() (*gitlab.Response, error) {
+			// GET /projects/{project}/labels
+			pageObjs, resp, listErr := c.c.Client().Labels.ListLabels(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			for _, apiObj := range pageObjs {
+				labels = append(labels, newLabel(c, apiObj))
+			}
+			return resp, handleHTTPError(listErr)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return gitprovider.LimitItems(ctx, labels), nil
+	}
+
+	opts := &gitlab.ListGroupLabelsOptions{
+		IncludeAncestorGroups: gitlab.Ptr(false),
+		OnlyGroupLabels:       gitlab.Ptr(true),
+	}
+	err := allGroupLabelPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /groups/{group}/labels
+		pageObjs, resp, listErr := c.c.Client().GroupLabels.ListGroupLabels(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			labels = append(labels, newLabel(c, (*gitlab.Label)(apiObj)))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, labels), nil
+}
+
+// ListIter returns an iterator over the labels List returns, fetching them a page at a time.
+func (c *LabelClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Label] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Label, gitprovider.PageInfo, error) {
+		listOpts := gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+			opts := &gitlab.ListLabelsOptions{
+				IncludeAncestorGroups: gitlab.Ptr(false),
+				ListOptions:           listOpts,
+			}
+			// GET /projects/{project}/labels
+			apiObjs, resp, err := c.c.Client().Labels.ListLabels(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+			}
+			labels := make([]gitprovider.Label, 0, len(apiObjs))
+			for _, apiObj := range apiObjs {
+				labels = append(labels, newLabel(c, apiObj))
+			}
+			return labels, pageInfo(resp), nil
+		}
+
+		opts := &gitlab.ListGroupLabelsOptions{
+			IncludeAncestorGroups: gitlab.Ptr(false),
+			OnlyGroupLabels:       gitlab.Ptr(true),
+			ListOptions:           listOpts,
+		}
+		// GET /groups/{group}/labels
+		apiObjs, resp, err := c.c.Client().GroupLabels.ListGroupLabels(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+		labels := make([]gitprovider.Label, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			labels = append(labels, newLabel(c, (*gitlab.Label)(apiObj)))
+		}
+		return labels, pageInfo(resp), nil
+	})
+}
+
+// Create creates a label with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *LabelClient) Create(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	return c.create(ctx, req)
+}
+
+func (c *LabelClient) create(ctx context.Context, req gitprovider.LabelInfo) (*label, error) {
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// POST /projects/{project}/labels
+		apiObj, _, err := c.c.Client().Labels.CreateLabel(getRepoPath(repoRef), &gitlab.CreateLabelOptions{
+			Name:        gitlab.Ptr(req.Name),
+			Color:       labelColorToAPI(req.Color),
+			Description: gitlab.Ptr(req.Description),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return newLabel(c, apiObj), nil
+	}
+	// POST /groups/{group}/labels
+	apiObj, _, err := c.c.Client().GroupLabels.CreateGroupLabel(c.ref.GetIdentity(), &gitlab.CreateGroupLabelOptions{
+		Name:        gitlab.Ptr(req.Name),
+		Color:       labelColorToAPI(req.Color),
+		Description: gitlab.Ptr(req.Description),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newLabel(c, (*gitlab.Label)(apiObj)), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *LabelClient) Reconcile(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating label, as it doesn't exist", "owner", c.ref.String(), "label", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("label is up to date", "owner", c.ref.String(), "label", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating label to the desired state", "owner", c.ref.String(), "label", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// labelColorToAPI adds the leading "#" GitLab expects.
+func labelColorToAPI(color *string) *string {
+	if color == nil {
+		return nil
+	}
+	return gitlab.Ptr("#" + *color)
+}
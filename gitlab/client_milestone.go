@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// MilestoneClient implements the gitprovider.MilestoneClient interface.
+var _ gitprovider.MilestoneClient = &MilestoneClient{}
+
+// MilestoneClient operates on the milestones of a specific group or project.
+type MilestoneClient struct {
+	*clientContext
+	// ref is either an OrganizationRef or a RepositoryRef
+	ref gitprovider.IdentityRef
+}
+
+// Get returns the milestone with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *MilestoneClient) Get(ctx context.Context, id int) (gitprovider.Milestone, error) {
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// GET /projects/{project}/milestones/{milestone_id}
+		apiObj, _, err := c.c.Client().Milestones.GetMilestone(getRepoPath(repoRef), id, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return newProjectMilestone(c, apiObj), nil
+	}
+	// GET /groups/{group}/milestones/{milestone_id}
+	apiObj, _, err := c.c.Client().GroupMilestones.GetGroupMilestone(c.ref.GetIdentity(), id, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newGroupMilestone(c, apiObj), nil
+}
+
+// List lists all active and closed milestones of the group or project. Milestones of
+// ancestor groups aren't included.
+//
+// List returns all available milestones, using multiple paginated requests if needed.
+func (c *MilestoneClient) List(ctx context.Context) ([]gitprovider.Milestone, error) {
+	milestones := []gitprovider.Milestone{}
+
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		opts := &gitlab.ListMilestonesOptions{}
+		err := allMilestonePages(ctx, opts, func() (*gitlab.Response, error) {
+			// GET /projects/{project}/milestones
+			pageObjs, resp, listErr := c.c.Client().Milestones.ListMilestones(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			for _, apiObj := range pageObjs {
+				milestones = append(milestones, newProjectMilestone(c, apiObj))
+			}
+			return resp, handleHTTPError(listErr)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return gitprovider.LimitItems(ctx, milestones), nil
+	}
+
+	opts := &gitlab.ListGroupMilestonesOptions{}
+	err := allGroupMilestonePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /groups/{group}/milestones
+		pageObjs, resp, listErr := c.c.Client().GroupMilestones.ListGroupMilestones(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			milestones = append(milestones, newGroupMilestone(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, milestones), nil
+}
+
+// ListIter returns an iterator over the milestones List returns, fetching them a page at a time.
+func (c *MilestoneClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Milestone] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Milestone, gitprovider.PageInfo, error) {
+		listOpts := gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+			opts := &gitlab.ListMilestonesOptions{ListOptions: listOpts}
+			// GET /projects/{project}/milestones
+			apiObjs, resp, err := c.c.Client().Milestones.ListMilestones(getRepoPath(repoRef), opts, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+			}
+			milestones := make([]gitprovider.Milestone, 0, len(apiObjs))
+			for _, apiObj := range apiObjs {
+				milestones = append(milestones, newProjectMilestone(c, apiObj))
+			}
+			return milestones, pageInfo(resp), nil
+		}
+
+		opts := &gitlab.ListGroupMilestonesOptions{ListOptions: listOpts}
+		// GET /groups/{group}/milestones
+		apiObjs, resp, err := c.c.Client().GroupMilestones.ListGroupMilestones(c.ref.GetIdentity(), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+		milestones := make([]gitprovider.Milestone, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			milestones = append(milestones, newGroupMilestone(c, apiObj))
+		}
+		return milestones, pageInfo(resp), nil
+	})
+}
+
+// Create creates a milestone with the given specifications. GitLab creates milestones
+// active, so a closed milestone is closed right after creation.
+func (c *MilestoneClient) Create(ctx context.Context, req gitprovider.MilestoneInfo) (gitprovider.Milestone, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	var m *milestone
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// POST /projects/{project}/milestones
+		apiObj, _, err := c.c.Client().Milestones.CreateMilestone(getRepoPath(repoRef), &gitlab.CreateMilestoneOptions{
+			Title:       gitlab.Ptr(req.Title),
+			Description: gitlab.Ptr(req.Description),
+			DueDate:     dueDateToAPI(req.DueDate),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		m = newProjectMilestone(c, apiObj)
+	} else {
+		// POST /groups/{group}/milestones
+		apiObj, _, err := c.c.Client().GroupMilestones.CreateGroupMilestone(c.ref.GetIdentity(), &gitlab.CreateGroupMilestoneOptions{
+			Title:       gitlab.Ptr(req.Title),
+			Description: gitlab.Ptr(req.Description),
+			DueDate:     dueDateToAPI(req.DueDate),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		m = newGroupMilestone(c, apiObj)
+	}
+
+	if req.State == gitprovider.MilestoneStateClosed {
+		return m, m.Close(ctx)
+	}
+	return m, nil
+}
+
+func dueDateToAPI(dueDate *time.Time) *gitlab.ISOTime {
+	if dueDate == nil {
+		return nil
+	}
+	return gitlab.Ptr(gitlab.ISOTime(dueDate.UTC()))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AuditLogClient implements the gitprovider.AuditLogClient interface.
+var _ gitprovider.AuditLogClient = &AuditLogClient{}
+
+// AuditLogClient reads the audit events of a specific group. Group audit events need GitLab
+// Premium, and the owner role in the group.
+type AuditLogClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Stream calls fn for each audit event of the group within the time range given by opts,
+// newest first, using multiple paginated requests if needed.
+func (c *AuditLogClient) Stream(ctx context.Context, opts gitprovider.AuditLogOptions, fn func(gitprovider.AuditEventInfo) error) error {
+	if err := opts.ValidateOptions(); err != nil {
+		return err
+	}
+
+	apiOpts := &gitlab.ListAuditEventsOptions{
+		ListOptions:   gitlab.ListOptions{PerPage: 100},
+		CreatedAfter:  opts.Since,
+		CreatedBefore: opts.Until,
+	}
+	for {
+		// GET /groups/{group}/audit_events
+		apiObjs, resp, err := c.c.Client().AuditEvents.ListGroupAuditEvents(c.ref.Organization, apiOpts, gitlab.WithContext(ctx))
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		for _, apiObj := range apiObjs {
+			// created_before is inclusive, while Until isn't
+			if opts.Until != nil && apiObj.CreatedAt != nil && !apiObj.CreatedAt.Before(*opts.Until) {
+				continue
+			}
+			if err := fn(auditEventFromAPI(apiObj)); err != nil {
+				return err
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		apiOpts.Page = resp.NextPage
+	}
+}
+
+func auditEventFromAPI(apiObj *gitlab.AuditEvent) gitprovider.AuditEventInfo {
+	info := gitprovider.AuditEventInfo{
+		ID:     strconv.Itoa(apiObj.ID),
+		Action: auditActionFromAPI(apiObj),
+		Actor:  apiObj.Details.AuthorName,
+		Target: apiObj.Details.TargetDetails,
+	}
+	if apiObj.CreatedAt != nil {
+		info.CreatedAt = *apiObj.CreatedAt
+	}
+	return info
+}
+
+// auditActionFromAPI returns the name of the event. Older events have no name, their kind is
+// derived from the details instead.
+func auditActionFromAPI(apiObj *gitlab.AuditEvent) string {
+	details := apiObj.Details
+	switch {
+	case apiObj.EventName != "":
+		return apiObj.EventName
+	case details.EventName != "":
+		return details.EventName
+	case details.Add != "":
+		return "add_" + details.Add
+	case details.Change != "":
+		return "change_" + details.Change
+	case details.Remove != "":
+		return "remove_" + details.Remove
+	default:
+		return details.CustomMessage
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_auditActionFromAPI(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiObj gitlab.AuditEvent
+		want   string
+	}{
+		{
+			name:   "event name",
+			apiObj: gitlab.AuditEvent{EventName: "member_created", Details: gitlab.AuditEventDetails{Add: "user_access"}},
+			want:   "member_created",
+		},
+		{
+			name:   "event name in details",
+			apiObj: gitlab.AuditEvent{Details: gitlab.AuditEventDetails{EventName: "group_deleted"}},
+			want:   "group_deleted",
+		},
+		{
+			name:   "added",
+			apiObj: gitlab.AuditEvent{Details: gitlab.AuditEventDetails{Add: "user_access", As: "Developer"}},
+			want:   "add_user_access",
+		},
+		{
+			name:   "changed",
+			apiObj: gitlab.AuditEvent{Details: gitlab.AuditEventDetails{Change: "visibility", From: "Private", To: "Public"}},
+			want:   "change_visibility",
+		},
+		{
+			name:   "removed",
+			apiObj: gitlab.AuditEvent{Details: gitlab.AuditEventDetails{Remove: "user_access"}},
+			want:   "remove_user_access",
+		},
+		{
+			name:   "custom message",
+			apiObj: gitlab.AuditEvent{Details: gitlab.AuditEventDetails{CustomMessage: "Project archived"}},
+			want:   "Project archived",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := auditActionFromAPI(&tt.apiObj); got != tt.want {
+				t.Errorf("auditActionFromAPI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IPAllowListClient implements the gitprovider.IPAllowListClient interface.
+var _ gitprovider.IPAllowListClient = &IPAllowListClient{}
+
+// IPAllowListClient operates on the IP address restriction of a specific top-level group,
+// which needs GitLab Premium. GitLab stores the allow list as a single comma-separated
+// setting of the group, so entries have neither a name nor an ID besides their value, and
+// are always active.
+type IPAllowListClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// List lists the entries of the IP allow list.
+func (c *IPAllowListClient) List(ctx context.Context) ([]gitprovider.IPAllowListEntryInfo, error) {
+	ranges, err := c.getRanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]gitprovider.IPAllowListEntryInfo, 0, len(ranges))
+	for _, r := range ranges {
+		entries = append(entries, ipAllowListEntryFromRange(r))
+	}
+	return gitprovider.LimitItems(ctx, entries), nil
+}
+
+// ListIter returns an iterator over the allow list entries List returns. The allow list is a
+// single setting of the group rather than a paginated list, so the entries are all fetched by
+// a single call to List.
+func (c *IPAllowListClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.IPAllowListEntryInfo] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
+
+// Create adds an entry to the IP allow list. Name and Active are ignored.
+//
+// ErrAlreadyExists is returned if an entry with the same value exists.
+func (c *IPAllowListClient) Create(ctx context.Context, req gitprovider.IPAllowListEntryInfo) (*gitprovider.IPAllowListEntryInfo, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	ranges, err := c.getRanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(ranges, req.Value) {
+		return nil, fmt.Errorf("IP allow list entry %q: %w", req.Value, gitprovider.ErrAlreadyExists)
+	}
+	if err := c.setRanges(ctx, append(ranges, req.Value)); err != nil {
+		return nil, err
+	}
+	entry := ipAllowListEntryFromRange(req.Value)
+	return &entry, nil
+}
+
+// Delete removes the entry with the given value from the IP allow list.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *IPAllowListClient) Delete(ctx context.Context, id string) error {
+	ranges, err := c.getRanges(ctx)
+	if err != nil {
+		return err
+	}
+	i := slices.Index(ranges, id)
+	if i < 0 {
+		return gitprovider.ErrNotFound
+	}
+	return c.setRanges(ctx, slices.Delete(ranges, i, i+1))
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If an entry with the same value doesn't exist, it is created.
+// As entries can't differ in anything but their value, no action is taken otherwise.
+func (c *IPAllowListClient) Reconcile(ctx context.Context, req gitprovider.IPAllowListEntryInfo) (*gitprovider.IPAllowListEntryInfo, bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	ranges, err := c.getRanges(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	entry := ipAllowListEntryFromRange(req.Value)
+	if slices.Contains(ranges, req.Value) {
+		c.log.V(1).Info("IP allow list entry is up to date", "organization", c.ref.String(), "value", req.Value)
+		return &entry, false, nil
+	}
+	c.log.V(1).Info("creating IP allow list entry, as it doesn't exist", "organization", c.ref.String(), "value", req.Value)
+	if err := c.setRanges(ctx, append(ranges, req.Value)); err != nil {
+		return nil, false, err
+	}
+	return &entry, true, nil
+}
+
+func (c *IPAllowListClient) getRanges(ctx context.Context) ([]string, error) {
+	// GET /groups/{group}
+	apiObj, err := c.c.GetGroup(ctx, c.ref.Organization)
+	if err != nil {
+		return nil, err
+	}
+	return parseIPRestrictionRanges(apiObj.IPRestrictionRanges), nil
+}
+
+func (c *IPAllowListClient) setRanges(ctx context.Context, ranges []string) error {
+	// PUT /groups/{group}
+	_, _, err := c.c.Client().Groups.UpdateGroup(c.ref.Organization, &gitlab.UpdateGroupOptions{
+		IPRestrictionRanges: gitlab.Ptr(strings.Join(ranges, ",")),
+	}, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// parseIPRestrictionRanges splits the comma-separated IP restriction setting of a group.
+func parseIPRestrictionRanges(s string) []string {
+	ranges := []string{}
+	for _, r := range strings.Split(s, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			ranges = append(ranges, r)
+		}
+	}
+	return ranges
+}
+
+func ipAllowListEntryFromRange(r string) gitprovider.IPAllowListEntryInfo {
+	return gitprovider.IPAllowListEntryInfo{
+		ID:     r,
+		Value:  r,
+		Active: gitprovider.BoolVar(true),
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseIPRestrictionRanges(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{
+			name: "empty",
+			s:    "",
+			want: []string{},
+		},
+		{
+			name: "single",
+			s:    "192.0.2.0/24",
+			want: []string{"192.0.2.0/24"},
+		},
+		{
+			name: "spaces and trailing comma",
+			s:    "192.0.2.0/24, 2001:db8::/32,",
+			want: []string{"192.0.2.0/24", "2001:db8::/32"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseIPRestrictionRanges(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseIPRestrictionRanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrganizationMemberClient implements the gitprovider.OrganizationMemberClient interface.
+var _ gitprovider.OrganizationMemberClient = &OrganizationMemberClient{}
+
+// OrganizationMemberClient operates on the direct members of a specific group.
+type OrganizationMemberClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// List lists the direct members of the group. Owners of the group are reported as owners,
+// all other members as members. Invitations by email address aren't listed.
+//
+// List returns all members, using multiple paginated requests if needed.
+func (c *OrganizationMemberClient) List(ctx context.Context) ([]gitprovider.OrganizationMemberInfo, error) {
+	// GET /groups/{group}/members
+	apiObjs, err := c.c.ListGroupMembers(ctx, c.ref.Organization)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]gitprovider.OrganizationMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		members = append(members, gitprovider.OrganizationMemberInfo{
+			Username: apiObj.Username,
+			Role:     gitprovider.OrganizationRoleVar(organizationRoleFromAccessLevel(apiObj.AccessLevel)),
+		})
+	}
+	return gitprovider.LimitItems(ctx, members), nil
+}
+
+// ListIter returns an iterator over the members List returns, fetching them a page at a time.
+func (c *OrganizationMemberClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.OrganizationMemberInfo] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrganizationMemberInfo, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListGroupMembersOptions{ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /groups/{group}/members
+		apiObjs, resp, err := c.c.Client().Groups.ListGroupMembers(c.ref.Organization, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		members := make([]gitprovider.OrganizationMemberInfo, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			members = append(members, gitprovider.OrganizationMemberInfo{
+				Username: apiObj.Username,
+				Role:     gitprovider.OrganizationRoleVar(organizationRoleFromAccessLevel(apiObj.AccessLevel)),
+			})
+		}
+		return members, pageInfo(resp), nil
+	})
+}
+
+// Invite adds the user to the group with developer (member) or owner access, or changes
+// the access level if the user is already a member with another role. GitLab has no
+// billing role, ErrNoProviderSupport is returned for it.
+func (c *OrganizationMemberClient) Invite(ctx context.Context, req gitprovider.OrganizationMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	accessLevel, err := accessLevelFromOrganizationRole(*req.Role)
+	if err != nil {
+		return err
+	}
+	userID, err := getUserID(ctx, c.c.Client(), req.Username)
+	if err != nil {
+		return err
+	}
+
+	// GET /groups/{group}/members/{user_id}
+	apiObj, _, err := c.c.Client().GroupMembers.GetGroupMember(c.ref.Organization, userID, gitlab.WithContext(ctx))
+	if err = handleHTTPError(err); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return err
+		}
+		opts := &gitlab.AddGroupMemberOptions{
+			UserID:      gitlab.Ptr(userID),
+			AccessLevel: gitlab.Ptr(accessLevel),
+		}
+		// POST /groups/{group}/members
+		_, _, err = c.c.Client().GroupMembers.AddGroupMember(c.ref.Organization, opts, gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	}
+
+	if organizationRoleFromAccessLevel(apiObj.AccessLevel) == *req.Role {
+		return nil
+	}
+	opts := &gitlab.EditGroupMemberOptions{
+		AccessLevel: gitlab.Ptr(accessLevel),
+	}
+	// PUT /groups/{group}/members/{user_id}
+	_, _, err = c.c.Client().GroupMembers.EditGroupMember(c.ref.Organization, userID, opts, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Remove removes the user from the group.
+//
+// ErrNotFound is returned if the user isn't a direct member of the group.
+func (c *OrganizationMemberClient) Remove(ctx context.Context, username string) error {
+	userID, err := getUserID(ctx, c.c.Client(), username)
+	if err != nil {
+		return err
+	}
+	// DELETE /groups/{group}/members/{user_id}
+	_, err = c.c.Client().GroupMembers.RemoveGroupMember(c.ref.Organization, userID, nil, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// organizationRoleFromAccessLevel maps the access level of a group member to an organization role.
+func organizationRoleFromAccessLevel(level gitlab.AccessLevelValue) gitprovider.OrganizationRole {
+	if level >= gitlab.OwnerPermissions {
+		return gitprovider.OrganizationRoleOwner
+	}
+	return gitprovider.OrganizationRoleMember
+}
+
+// accessLevelFromOrganizationRole maps an organization role to the access level of a group member.
+func accessLevelFromOrganizationRole(role gitprovider.OrganizationRole) (gitlab.AccessLevelValue, error) {
+	switch role {
+	case gitprovider.OrganizationRoleOwner:
+		return gitlab.OwnerPermissions, nil
+	case gitprovider.OrganizationRoleMember:
+		return gitlab.DeveloperPermissions, nil
+	default:
+		return 0, fmt.Errorf("organization role %q: %w", role, gitprovider.ErrNoProviderSupport)
+	}
+}
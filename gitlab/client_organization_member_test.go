@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_organizationRoleFromAccessLevel(t *testing.T) {
+	tests := []struct {
+		level gitlab.AccessLevelValue
+		want  gitprovider.OrganizationRole
+	}{
+		{gitlab.ReporterPermissions, gitprovider.OrganizationRoleMember},
+		{gitlab.DeveloperPermissions, gitprovider.OrganizationRoleMember},
+		{gitlab.MaintainerPermissions, gitprovider.OrganizationRoleMember},
+		{gitlab.OwnerPermissions, gitprovider.OrganizationRoleOwner},
+	}
+	for _, tt := range tests {
+		if got := organizationRoleFromAccessLevel(tt.level); got != tt.want {
+			t.Errorf("organizationRoleFromAccessLevel(%d) = %q, want %q", tt.level, got, tt.want)
+		}
+	}
+}
+
+func Test_accessLevelFromOrganizationRole(t *testing.T) {
+	tests := []struct {
+		role    gitprovider.OrganizationRole
+		want    gitlab.AccessLevelValue
+		wantErr error
+	}{
+		{gitprovider.OrganizationRoleMember, gitlab.DeveloperPermissions, nil},
+		{gitprovider.OrganizationRoleOwner, gitlab.OwnerPermissions, nil},
+		{gitprovider.OrganizationRoleBilling, 0, gitprovider.ErrNoProviderSupport},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			got, err := accessLevelFromOrganizationRole(tt.role)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("accessLevelFromOrganizationRole() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("accessLevelFromOrganizationRole() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// SCIMClient implements the gitprovider.SCIMClient interface.
+var _ gitprovider.SCIMClient = &SCIMClient{}
+
+// SCIMClient operates on the SCIM identities of a specific top-level group, which needs
+// GitLab.com Premium with SAML single sign-on. Identities are identified by the user ID in
+// the identity provider ("extern_uid").
+type SCIMClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// scimIdentity is a SCIM identity of the group SCIM API, which the go-gitlab client doesn't
+// cover.
+type scimIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+	Active    bool   `json:"active"`
+}
+
+// Get returns the user with the given SCIM identity. The username is resolved with an
+// additional request.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *SCIMClient) Get(ctx context.Context, id string) (*gitprovider.SCIMUserInfo, error) {
+	identity, err := c.getIdentity(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.scimUserFromIdentity(ctx, identity)
+}
+
+// List lists the users with a SCIM identity in the group. The usernames are resolved with one
+// additional request per user.
+func (c *SCIMClient) List(ctx context.Context) ([]gitprovider.SCIMUserInfo, error) {
+	var identities []scimIdentity
+	// GET /groups/{group}/scim/identities
+	if err := c.scimRequest(ctx, http.MethodGet, "identities", &identities); err != nil {
+		return nil, err
+	}
+	users := make([]gitprovider.SCIMUserInfo, 0, len(identities))
+	for i := range identities {
+		user, err := c.scimUserFromIdentity(ctx, &identities[i])
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+	return gitprovider.LimitItems(ctx, users), nil
+}
+
+// ListIter returns an iterator over the provisioned users List returns. GitLab doesn't paginate
+// the SCIM identities of a group, so they're all fetched by a single call to List.
+func (c *SCIMClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.SCIMUserInfo] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
+
+// Provision returns ErrNoProviderSupport, as GitLab only provisions users through its SCIM
+// endpoint, which authenticates the identity provider with a dedicated SCIM token instead of
+// a personal access token.
+func (c *SCIMClient) Provision(_ context.Context, _ gitprovider.SCIMUserInfo) (*gitprovider.SCIMUserInfo, error) {
+	return nil, fmt.Errorf("provisioning SCIM users: %w", gitprovider.ErrNoProviderSupport)
+}
+
+// Deprovision removes the user from the group, and deletes its SCIM identity.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *SCIMClient) Deprovision(ctx context.Context, id string) error {
+	identity, err := c.getIdentity(ctx, id)
+	if err != nil {
+		return err
+	}
+	// DELETE /groups/{group}/members/{user}
+	_, err = c.c.Client().GroupMembers.RemoveGroupMember(c.ref.Organization, identity.UserID, nil, gitlab.WithContext(ctx))
+	// The user might have left the group already
+	if err = handleHTTPError(err); err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+	// DELETE /groups/{group}/scim/{uid}
+	return c.scimRequest(ctx, http.MethodDelete, gitlab.PathEscape(id), nil)
+}
+
+func (c *SCIMClient) getIdentity(ctx context.Context, id string) (*scimIdentity, error) {
+	identity := &scimIdentity{}
+	// GET /groups/{group}/scim/{uid}
+	if err := c.scimRequest(ctx, http.MethodGet, gitlab.PathEscape(id), identity); err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// scimRequest sends a request to the group SCIM API, decoding the response into v if set.
+func (c *SCIMClient) scimRequest(ctx context.Context, method, path string, v interface{}) error {
+	u := fmt.Sprintf("groups/%s/scim/%s", gitlab.PathEscape(c.ref.Organization), path)
+	req, err := c.c.Client().NewRequest(method, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = c.c.Client().Do(req, v)
+	return handleHTTPError(err)
+}
+
+// scimUserFromIdentity looks up the user of the given identity. GitLab doesn't keep the
+// attributes sent by the identity provider, so only the username and state are set.
+func (c *SCIMClient) scimUserFromIdentity(ctx context.Context, identity *scimIdentity) (*gitprovider.SCIMUserInfo, error) {
+	// GET /users/{user}
+	user, _, err := c.c.Client().Users.GetUser(identity.UserID, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return &gitprovider.SCIMUserInfo{
+		ID:         identity.ExternUID,
+		ExternalID: gitprovider.StringVar(identity.ExternUID),
+		UserName:   user.Username,
+		Active:     gitprovider.BoolVar(identity.Active),
+	}, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// SSHCertificateAuthorityClient implements the gitprovider.SSHCertificateAuthorityClient interface.
+var _ gitprovider.SSHCertificateAuthorityClient = &SSHCertificateAuthorityClient{}
+
+// SSHCertificateAuthorityClient operates on the SSH certificates of a specific top-level
+// group, which are only available on GitLab.com.
+type SSHCertificateAuthorityClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// List lists the SSH certificate authorities of the group.
+func (c *SSHCertificateAuthorityClient) List(ctx context.Context) ([]gitprovider.SSHCertificateAuthorityInfo, error) {
+	// GET /groups/{group}/ssh_certificates
+	apiObjs, _, err := c.c.Client().GroupSSHCertificates.ListGroupSSHCertificates(c.ref.Organization, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	cas := make([]gitprovider.SSHCertificateAuthorityInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		cas = append(cas, sshCertificateAuthorityFromAPI(apiObj))
+	}
+	return gitprovider.LimitItems(ctx, cas), nil
+}
+
+// ListIter returns an iterator over the certificate authorities List returns. GitLab doesn't
+// paginate the SSH certificates of a group, so they're all fetched by a single call to List.
+func (c *SSHCertificateAuthorityClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.SSHCertificateAuthorityInfo] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
+
+// Create uploads the public key of an SSH certificate authority to the group.
+func (c *SSHCertificateAuthorityClient) Create(ctx context.Context, req gitprovider.SSHCertificateAuthorityInfo) (*gitprovider.SSHCertificateAuthorityInfo, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	opts := &gitlab.CreateGroupSSHCertificateOptions{
+		Title: gitlab.Ptr(req.Title),
+		Key:   gitlab.Ptr(req.Key),
+	}
+	// POST /groups/{group}/ssh_certificates
+	apiObj, _, err := c.c.Client().GroupSSHCertificates.CreateGroupSSHCertificate(c.ref.Organization, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	ca := sshCertificateAuthorityFromAPI(apiObj)
+	return &ca, nil
+}
+
+// Delete removes the SSH certificate authority with the given ID from the group.
+func (c *SSHCertificateAuthorityClient) Delete(ctx context.Context, id int64) error {
+	// DELETE /groups/{group}/ssh_certificates/{id}
+	_, err := c.c.Client().GroupSSHCertificates.DeleteGroupSSHCertificate(c.ref.Organization, int(id), gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func sshCertificateAuthorityFromAPI(apiObj *gitlab.GroupSSHCertificate) gitprovider.SSHCertificateAuthorityInfo {
+	return gitprovider.SSHCertificateAuthorityInfo{
+		ID:    int64(apiObj.ID),
+		Title: apiObj.Title,
+		Key:   apiObj.Key,
+	}
+}
@@ -18,6 +18,10 @@ package gitlab
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/xanzy/go-gitlab"
@@ -39,26 +43,24 @@ type TeamsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
-	apiObjs, err := c.c.ListGroupMembers(ctx, c.ref.Organization)
+	return c.get(ctx, teamName)
+}
+
+func (c *TeamsClient) get(ctx context.Context, teamName string) (*team, error) {
+	groupPath := fmt.Sprintf("%s/%s", c.ref.Organization, teamName)
+	// GET /groups/{group}
+	apiObj, err := c.c.GetGroup(ctx, groupPath)
 	if err != nil {
 		return nil, err
 	}
 
-	// Collect a list of the members' names. Login is validated to be non-nil in ListOrgTeamMembers.
-	logins := make([]string, 0, len(apiObjs))
-	for _, apiObj := range apiObjs {
-		// Login is validated to be non-nil in ListOrgTeamMembers
-		logins = append(logins, apiObj.Username)
+	// GET /groups/{group}/members
+	apiObjs, err := c.c.ListGroupMembers(gitprovider.WithoutListOptions(ctx), groupPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return &team{
-		users: apiObjs,
-		info: gitprovider.TeamInfo{
-			Name:    teamName,
-			Members: logins,
-		},
-		ref: c.ref,
-	}, nil
+	return newTeam(c, apiObj, apiObjs), nil
 }
 
 // List all teams (recursively, in terms of subgroups) within the specific organization.
@@ -72,7 +74,8 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 
 	teams := make([]gitprovider.Team, 0, len(subgroups))
 	for _, subgroup := range subgroups {
-		team, err := c.Get(ctx, subgroup.Name)
+		// Path is validated to be non-empty in ListSubgroups.
+		team, err := c.Get(ctx, subgroup.Path)
 		if err != nil {
 			return nil, err
 		}
@@ -80,25 +83,307 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 		teams = append(teams, team)
 	}
 
-	return teams, nil
+	return gitprovider.LimitItems(ctx, teams), nil
+}
+
+// ListIter returns an iterator over the teams List returns, fetching them a page at a time,
+// and the detailed information about each team of a page along with it.
+func (c *TeamsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Team] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Team, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListSubGroupsOptions{ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /groups/{group}/subgroups
+		subgroups, resp, err := c.c.Client().Groups.ListSubGroups(c.ref.Organization, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		teams := make([]gitprovider.Team, 0, len(subgroups))
+		for _, subgroup := range subgroups {
+			if err := validateGroupAPI(subgroup); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			team, err := c.Get(ctx, subgroup.Path)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			teams = append(teams, team)
+		}
+		return teams, pageInfo(resp), nil
+	})
+}
+
+// Create a team, i.e. a subgroup, within the specific organization.
+// If req.Name contains slashes, the team is created below the given existing subgroup.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	parentPath := c.ref.Organization
+	if dir := path.Dir(req.Name); dir != "." {
+		parentPath = fmt.Sprintf("%s/%s", parentPath, dir)
+	}
+	// GET /groups/{group}
+	parent, err := c.c.GetGroup(ctx, parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	name := path.Base(req.Name)
+	opts := &gitlab.CreateGroupOptions{
+		Name:        gitlab.Ptr(name),
+		Path:        gitlab.Ptr(name),
+		ParentID:    gitlab.Ptr(parent.ID),
+		Description: req.Description,
+	}
+	// POST /groups
+	apiObj, _, err := c.c.Client().Groups.CreateGroup(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newTeam(c, apiObj, nil), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team, as it doesn't exist", "organization", c.ref.String(), "team", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team is up to date", "organization", c.ref.String(), "team", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating team to the desired state", "organization", c.ref.String(), "team", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+func newTeam(c *TeamsClient, apiObj *gitlab.Group, users []*gitlab.GroupMember) *team {
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		logins = append(logins, user.Username)
+	}
+
+	return &team{
+		g:     *apiObj,
+		users: users,
+		info: gitprovider.TeamInfo{
+			Name:        teamNameFromPath(c.ref.Organization, apiObj.FullPath),
+			Description: gitlab.Ptr(apiObj.Description),
+			Members:     logins,
+		},
+		c: c,
+	}
+}
+
+// teamRoleFromAccessLevel maps the access level of a group member to a team role.
+// Maintainers and owners of the subgroup can manage its members.
+func teamRoleFromAccessLevel(level gitlab.AccessLevelValue) gitprovider.TeamRole {
+	if level >= gitlab.MaintainerPermissions {
+		return gitprovider.TeamRoleMaintainer
+	}
+	return gitprovider.TeamRoleMember
+}
+
+// accessLevelFromTeamRole maps a team role to the access level of a group member.
+func accessLevelFromTeamRole(role gitprovider.TeamRole) gitlab.AccessLevelValue {
+	if role == gitprovider.TeamRoleMaintainer {
+		return gitlab.MaintainerPermissions
+	}
+	return gitlab.DeveloperPermissions
+}
+
+// teamNameFromPath returns the full path of a subgroup relative to the organization.
+// GitLab paths are matched case-insensitively.
+func teamNameFromPath(orgName, fullPath string) string {
+	prefix := orgName + "/"
+	if len(fullPath) > len(prefix) && strings.EqualFold(fullPath[:len(prefix)], prefix) {
+		return fullPath[len(prefix):]
+	}
+	return fullPath
 }
 
 var _ gitprovider.Team = &team{}
 
 type team struct {
+	g     gitlab.Group
 	users []*gitlab.GroupMember
 	info  gitprovider.TeamInfo
-	ref   gitprovider.OrganizationRef
+	c     *TeamsClient
 }
 
 func (t *team) Get() gitprovider.TeamInfo {
 	return t.info
 }
 
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	t.info = info
+	return nil
+}
+
 func (t *team) APIObject() interface{} {
 	return t.users
 }
 
 func (t *team) Organization() gitprovider.OrganizationRef {
-	return t.ref
+	return t.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function. Changing the last element of the name renames the subgroup; moving it
+// to another parent group is not supported.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (t *team) Update(ctx context.Context) error {
+	current := teamNameFromPath(t.c.ref.Organization, t.g.FullPath)
+	if path.Dir(t.info.Name) != path.Dir(current) {
+		return fmt.Errorf("cannot move team %q to %q: %w", current, t.info.Name, gitprovider.ErrNoProviderSupport)
+	}
+
+	name := path.Base(t.info.Name)
+	opts := &gitlab.UpdateGroupOptions{
+		Name:        gitlab.Ptr(name),
+		Path:        gitlab.Ptr(name),
+		Description: t.info.Description,
+	}
+	// PUT /groups/{group}
+	apiObj, _, err := t.c.c.Client().Groups.UpdateGroup(t.g.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*t = *newTeam(t.c, apiObj, t.users)
+	return nil
+}
+
+// Delete deletes the subgroup, including all projects and subgroups within it.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(ctx context.Context) error {
+	// Don't allow deleting teams if the user didn't explicitly allow dangerous API calls.
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /groups/{group}
+	_, err := t.c.c.Client().Groups.DeleteGroup(t.g.ID, nil, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// ListMembers lists the direct members of the subgroup. Members with at least
+// maintainer access are reported as maintainers.
+//
+// ListMembers returns all members, using multiple paginated requests if needed.
+func (t *team) ListMembers(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	// GET /groups/{group}/members
+	apiObjs, err := t.c.c.ListGroupMembers(ctx, t.g.FullPath)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]gitprovider.TeamMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		members = append(members, gitprovider.TeamMemberInfo{
+			Username: apiObj.Username,
+			Role:     gitprovider.TeamRoleVar(teamRoleFromAccessLevel(apiObj.AccessLevel)),
+		})
+	}
+	return members, nil
+}
+
+// AddMember adds the user to the subgroup with developer (member) or maintainer access,
+// or changes the access level if the user is already a member with another role.
+func (t *team) AddMember(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	userID, err := getUserID(ctx, t.c.c.Client(), req.Username)
+	if err != nil {
+		return err
+	}
+
+	// GET /groups/{group}/members/{user_id}
+	apiObj, _, err := t.c.c.Client().GroupMembers.GetGroupMember(t.g.ID, userID, gitlab.WithContext(ctx))
+	if err = handleHTTPError(err); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return err
+		}
+		opts := &gitlab.AddGroupMemberOptions{
+			UserID:      gitlab.Ptr(userID),
+			AccessLevel: gitlab.Ptr(accessLevelFromTeamRole(*req.Role)),
+		}
+		// POST /groups/{group}/members
+		_, _, err = t.c.c.Client().GroupMembers.AddGroupMember(t.g.ID, opts, gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	}
+
+	if teamRoleFromAccessLevel(apiObj.AccessLevel) == *req.Role {
+		return nil
+	}
+	opts := &gitlab.EditGroupMemberOptions{
+		AccessLevel: gitlab.Ptr(accessLevelFromTeamRole(*req.Role)),
+	}
+	// PUT /groups/{group}/members/{user_id}
+	_, _, err = t.c.c.Client().GroupMembers.EditGroupMember(t.g.ID, userID, opts, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// RemoveMember removes the user from the subgroup.
+//
+// ErrNotFound is returned if the user isn't a member of the subgroup.
+func (t *team) RemoveMember(ctx context.Context, username string) error {
+	userID, err := getUserID(ctx, t.c.c.Client(), username)
+	if err != nil {
+		return err
+	}
+	// DELETE /groups/{group}/members/{user_id}
+	_, err = t.c.c.Client().GroupMembers.RemoveGroupMember(t.g.ID, userID, nil, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	resp, actionTaken, err := t.c.Reconcile(ctx, t.info)
+	if err != nil {
+		return actionTaken, err
+	}
+	*t = *resp.(*team)
+	return actionTaken, nil
 }
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_teamNameFromPath(t *testing.T) {
+	tests := []struct {
+		orgName  string
+		fullPath string
+		want     string
+	}{
+		{"fluxcd", "fluxcd/maintainers", "maintainers"},
+		{"FluxCD", "fluxcd/maintainers", "maintainers"},
+		{"fluxcd", "fluxcd/sig/reviewers", "sig/reviewers"},
+		{"fluxcd/sig", "fluxcd/sig/reviewers", "reviewers"},
+		{"fluxcd", "other/maintainers", "other/maintainers"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fullPath, func(t *testing.T) {
+			if got := teamNameFromPath(tt.orgName, tt.fullPath); got != tt.want {
+				t.Errorf("teamNameFromPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_teamRoleFromAccessLevel(t *testing.T) {
+	tests := []struct {
+		level gitlab.AccessLevelValue
+		want  gitprovider.TeamRole
+	}{
+		{gitlab.GuestPermissions, gitprovider.TeamRoleMember},
+		{gitlab.DeveloperPermissions, gitprovider.TeamRoleMember},
+		{gitlab.MaintainerPermissions, gitprovider.TeamRoleMaintainer},
+		{gitlab.OwnerPermissions, gitprovider.TeamRoleMaintainer},
+	}
+	for _, tt := range tests {
+		if got := teamRoleFromAccessLevel(tt.level); got != tt.want {
+			t.Errorf("teamRoleFromAccessLevel(%d) = %q, want %q", tt.level, got, tt.want)
+		}
+	}
+	for _, role := range []gitprovider.TeamRole{gitprovider.TeamRoleMember, gitprovider.TeamRoleMaintainer} {
+		if got := teamRoleFromAccessLevel(accessLevelFromTeamRole(role)); got != role {
+			t.Errorf("teamRoleFromAccessLevel(accessLevelFromTeamRole(%q)) = %q", role, got)
+		}
+	}
+}
+
+func TestTeamsClient_GetWithListOptions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/groups/fluxcd%2Fmaintainers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":2,"name":"maintainers","path":"maintainers","full_path":"fluxcd/maintainers"}`))
+	})
+	mux.HandleFunc("GET /api/v4/groups/fluxcd%2Fmaintainers/members", func(w http.ResponseWriter, r *http.Request) {
+		// The members span two pages
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"id":2,"username":"bob"}]`))
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		_, _ = w.Write([]byte(`[{"id":1,"username":"alice"}]`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("", "", "token", TokenTypePat, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := &TeamsClient{
+		clientContext: c.(*Client).clientContext,
+		ref:           gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+	}
+
+	// The list options of the caller don't limit the members of the team
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallListOptions(gitprovider.ListOptions{PerPage: 1, MaxItems: 1}))
+	team, err := teams.Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got, want := team.Get().Members, []string{"alice", "bob"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get() members = %v, want %v", got, want)
+	}
+}
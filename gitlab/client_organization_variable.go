@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrganizationVariableClient implements the gitprovider.OrganizationVariableClient interface.
+var _ gitprovider.OrganizationVariableClient = &OrganizationVariableClient{}
+
+// OrganizationVariableClient operates on the CI/CD variables of a specific group, which are
+// available to all projects in the group and its subgroups. GitLab can't tell apart group
+// variables with the same key in different environment scopes when updating or deleting them,
+// so keys should be unique within a group.
+type OrganizationVariableClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Get returns the variable with the given key, available in all environments.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrganizationVariableClient) Get(ctx context.Context, key string) (gitprovider.OrganizationVariable, error) {
+	return c.get(ctx, key, "")
+}
+
+func (c *OrganizationVariableClient) get(ctx context.Context, key, environment string) (*organizationVariable, error) {
+	variables, err := c.list(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// Loop through variables once we find one with the right key and environment scope
+	for _, v := range variables {
+		if v.v.Key == key && v.Get().Environment == environment {
+			return v, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all variables of the group.
+//
+// List returns all available variables, using multiple paginated requests if needed.
+func (c *OrganizationVariableClient) List(ctx context.Context) ([]gitprovider.OrganizationVariable, error) {
+	vs, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.OrganizationVariable
+	variables := make([]gitprovider.OrganizationVariable, 0, len(vs))
+	for _, v := range vs {
+		variables = append(variables, v)
+	}
+	return gitprovider.LimitItems(ctx, variables), nil
+}
+
+// ListIter returns an iterator over the variables List returns, fetching them a page at a time.
+func (c *OrganizationVariableClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.OrganizationVariable] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrganizationVariable, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListGroupVariablesOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /groups/{group}/variables
+		apiObjs, resp, err := c.c.Client().GroupVariables.ListVariables(c.ref.Organization, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		variables := make([]gitprovider.OrganizationVariable, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			variables = append(variables, newOrganizationVariable(c, apiObj))
+		}
+		return variables, pageInfo(resp), nil
+	})
+}
+
+func (c *OrganizationVariableClient) list(ctx context.Context) ([]*organizationVariable, error) {
+	apiObjs := []*gitlab.GroupVariable{}
+	opts := &gitlab.ListGroupVariablesOptions{}
+	err := allGroupVariablePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /groups/{group}/variables
+		pageObjs, resp, listErr := c.c.Client().GroupVariables.ListVariables(c.ref.Organization, opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]*organizationVariable, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		variables = append(variables, newOrganizationVariable(c, apiObj))
+	}
+	return variables, nil
+}
+
+// Create creates a variable with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationVariableClient) Create(ctx context.Context, req gitprovider.OrganizationVariableInfo) (gitprovider.OrganizationVariable, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	apiObj := &gitlab.GroupVariable{}
+	if err := organizationVariableInfoToAPIObj(&req, apiObj); err != nil {
+		return nil, err
+	}
+	v := newOrganizationVariable(c, apiObj)
+	if err := v.createIntoSelf(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationVariableClient) Reconcile(ctx context.Context, req gitprovider.OrganizationVariableInfo) (gitprovider.OrganizationVariable, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the variable with the desired key and environment
+	actual, err := c.get(ctx, req.Key, req.Environment)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating variable, as it doesn't exist", "organization", c.ref.String(), "variable", req.Key)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("variable is up to date", "organization", c.ref.String(), "variable", req.Key)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating variable to the desired state", "organization", c.ref.String(), "variable", req.Key)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// organizationVariableInfoToAPIObj applies info to apiObj. Group variables are available to
+// all projects, so ErrNoProviderSupport is returned for any other visibility.
+func organizationVariableInfoToAPIObj(info *gitprovider.OrganizationVariableInfo, apiObj *gitlab.GroupVariable) error {
+	if info.Visibility != nil && *info.Visibility != gitprovider.VariableVisibilityAll {
+		return fmt.Errorf("group variables with %q visibility: %w", *info.Visibility, gitprovider.ErrNoProviderSupport)
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.Key = info.Key
+	apiObj.Value = info.Value
+	apiObj.EnvironmentScope = allEnvironmentsScope
+	// optional fields
+	if info.Environment != "" {
+		apiObj.EnvironmentScope = info.Environment
+	}
+	if info.Masked != nil {
+		apiObj.Masked = *info.Masked
+	}
+	if info.Protected != nil {
+		apiObj.Protected = *info.Protected
+	}
+	return nil
+}
+
+func organizationVariableFromAPI(apiObj *gitlab.GroupVariable) gitprovider.OrganizationVariableInfo {
+	info := gitprovider.OrganizationVariableInfo{
+		Key:        apiObj.Key,
+		Value:      apiObj.Value,
+		Masked:     gitprovider.BoolVar(apiObj.Masked),
+		Protected:  gitprovider.BoolVar(apiObj.Protected),
+		Visibility: gitprovider.VariableVisibilityVar(gitprovider.VariableVisibilityAll),
+	}
+	if apiObj.EnvironmentScope != allEnvironmentsScope {
+		info.Environment = apiObj.EnvironmentScope
+	}
+	return info
+}
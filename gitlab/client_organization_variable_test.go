@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_organizationVariableInfoToAPIObj(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    gitprovider.OrganizationVariableInfo
+		want    gitlab.GroupVariable
+		wantErr error
+	}{
+		{
+			name: "all environments",
+			info: gitprovider.OrganizationVariableInfo{
+				Key:        "REGISTRY_PASSWORD",
+				Value:      "secret",
+				Masked:     gitprovider.BoolVar(true),
+				Visibility: gitprovider.VariableVisibilityVar(gitprovider.VariableVisibilityAll),
+			},
+			want: gitlab.GroupVariable{Key: "REGISTRY_PASSWORD", Value: "secret", Masked: true, EnvironmentScope: allEnvironmentsScope},
+		},
+		{
+			name: "environment scope",
+			info: gitprovider.OrganizationVariableInfo{
+				Key:         "REGISTRY_PASSWORD",
+				Environment: "production",
+				Protected:   gitprovider.BoolVar(true),
+			},
+			want: gitlab.GroupVariable{Key: "REGISTRY_PASSWORD", Protected: true, EnvironmentScope: "production"},
+		},
+		{
+			name: "selected repositories",
+			info: gitprovider.OrganizationVariableInfo{
+				Key:          "REGISTRY_PASSWORD",
+				Visibility:   gitprovider.VariableVisibilityVar(gitprovider.VariableVisibilitySelected),
+				Repositories: []string{"flux2"},
+			},
+			wantErr: gitprovider.ErrNoProviderSupport,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gitlab.GroupVariable{}
+			err := organizationVariableInfoToAPIObj(&tt.info, &got)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("organizationVariableInfoToAPIObj() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("organizationVariableInfoToAPIObj() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
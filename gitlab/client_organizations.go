@@ -18,6 +18,11 @@ package gitlab
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/xanzy/go-gitlab"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -35,6 +40,10 @@ type OrganizationsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *OrganizationsClient) Get(ctx context.Context, ref gitprovider.OrganizationRef) (gitprovider.Organization, error) {
+	return c.get(ctx, ref)
+}
+
+func (c *OrganizationsClient) get(ctx context.Context, ref gitprovider.OrganizationRef) (*organization, error) {
 	// GET /groups/{group}
 	apiObj, err := c.c.GetGroup(ctx, ref.Organization)
 	if err != nil {
@@ -63,7 +72,32 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 		groups = append(groups, newOrganization(c.clientContext, apiObj, ref))
 	}
 
-	return groups, nil
+	return gitprovider.LimitItems(ctx, groups), nil
+}
+
+// ListIter returns an iterator over the organizations List returns, fetching them a page at a time.
+func (c *OrganizationsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Organization] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Organization, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListGroupsOptions{ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /groups
+		apiObjs, resp, err := c.c.Client().Groups.ListGroups(opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		groups := make([]gitprovider.Organization, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateGroupAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			ref := gitprovider.OrganizationRef{
+				Domain:       apiObj.WebURL,
+				Organization: apiObj.FullName,
+			}
+			groups = append(groups, newOrganization(c.clientContext, apiObj, ref))
+		}
+		return groups, pageInfo(resp), nil
+	})
 }
 
 // Children returns the immediate child-organizations for the specific OrganizationRef o.
@@ -87,3 +121,99 @@ func (c *OrganizationsClient) Children(ctx context.Context, ref gitprovider.Orga
 
 	return subgroups, nil
 }
+
+// Create creates a group. ref.Organization must be the full path of the new group, e.g.
+// "fluxcd" for a top-level group or "fluxcd/engineering/frontend" for a subgroup, of which
+// the parent group must already exist. The name of the group defaults to the last element
+// of the path. Note that GitLab.com doesn't allow creating top-level groups through the API.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.DefaultRepositoryPermission != nil {
+		return nil, errNoDefaultRepositoryPermission
+	}
+
+	parentPath, groupPath := path.Split(ref.Organization)
+	opts := &gitlab.CreateGroupOptions{
+		Name:                 gitlab.Ptr(groupPath),
+		Path:                 gitlab.Ptr(groupPath),
+		Description:          req.Description,
+		RequireTwoFactorAuth: req.RequireTwoFactor,
+	}
+	if req.Visibility != nil {
+		opts.Visibility = gitlab.Ptr(gitlab.VisibilityValue(*req.Visibility))
+	}
+	// Top-level groups have no parent
+	if parentPath != "" {
+		// GET /groups/{group}
+		parent, err := c.c.GetGroup(ctx, path.Clean(parentPath))
+		if err != nil {
+			return nil, err
+		}
+		opts.ParentID = gitlab.Ptr(parent.ID)
+	}
+	if req.Name != nil {
+		opts.Name = req.Name
+	}
+	// POST /groups
+	apiObj, _, err := c.c.Client().Groups.CreateGroup(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating organization, as it doesn't exist", "organization", ref.String())
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("organization is up to date", "organization", ref.String())
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating organization to the desired state", "organization", ref.String())
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// Delete deletes a group, including all projects and subgroups within it.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// Don't allow deleting groups if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete group: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /groups/{group}
+	_, err := c.c.Client().Groups.DeleteGroup(ref.Organization, nil, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// newTestOrganizationsClient returns the organizations client of a client talking to a server with the given handler.
+func newTestOrganizationsClient(t *testing.T, handler http.Handler, opts ...gitprovider.ClientOption) gitprovider.OrganizationsClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("", "", "token", TokenTypePat, append([]gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.Organizations()
+}
+
+func TestOrganizationsClient_Create(t *testing.T) {
+	var got map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/groups", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"id":42,"name":"my-group","path":"my-group","full_path":"my-group","description":"Top-level group","visibility":"private"}`))
+	})
+	c := newTestOrganizationsClient(t, mux)
+
+	ref := gitprovider.OrganizationRef{Domain: c.(*OrganizationsClient).domain, Organization: "my-group"}
+	org, err := c.Create(context.Background(), ref, gitprovider.OrganizationInfo{
+		Description: gitprovider.StringVar("Top-level group"),
+		Visibility:  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"name":        "my-group",
+		"path":        "my-group",
+		"description": "Top-level group",
+		"visibility":  "private",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Create() request mismatch (-want +got):\n%s", diff)
+	}
+	if org.Organization().Organization != "my-group" {
+		t.Errorf("Create() = %s, want my-group", org.Organization().Organization)
+	}
+	if desc := org.Get().Description; desc == nil || *desc != "Top-level group" {
+		t.Errorf("Create() description = %v, want %q", desc, "Top-level group")
+	}
+}
+
+func TestOrganizationsClient_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		destructive bool
+		expectedErr error
+		wantDeleted bool
+	}{
+		{
+			name:        "destructive calls are on",
+			destructive: true,
+			wantDeleted: true,
+		},
+		{
+			name:        "destructive calls are off",
+			expectedErr: gitprovider.ErrDestructiveCallDisallowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("DELETE /api/v4/groups/my-group", func(w http.ResponseWriter, r *http.Request) {
+				deleted = true
+				w.WriteHeader(http.StatusAccepted)
+			})
+			c := newTestOrganizationsClient(t, mux, gitprovider.WithDestructiveAPICalls(tt.destructive))
+
+			ref := gitprovider.OrganizationRef{Domain: c.(*OrganizationsClient).domain, Organization: "my-group"}
+			if err := c.Delete(context.Background(), ref); !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.expectedErr)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("Delete() sent a delete request = %t, want %t", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestOrganizationsClient_CreateSubgroup(t *testing.T) {
+	tests := []struct {
+		name        string
+		parent      string
+		expectedErr error
+		want        map[string]interface{}
+	}{
+		{
+			name:   "parent exists",
+			parent: `{"id":7,"name":"fluxcd","path":"fluxcd","full_path":"fluxcd"}`,
+			want: map[string]interface{}{
+				"name":        "team",
+				"path":        "team",
+				"parent_id":   float64(7),
+				"description": "Subgroup",
+			},
+		},
+		{
+			name:        "parent doesn't exist",
+			expectedErr: gitprovider.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got map[string]interface{}
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v4/groups/fluxcd", func(w http.ResponseWriter, r *http.Request) {
+				if tt.parent == "" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message":"404 Group Not Found"}`))
+					return
+				}
+				_, _ = w.Write([]byte(tt.parent))
+			})
+			mux.HandleFunc("POST /api/v4/groups", func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&got)
+				_, _ = w.Write([]byte(`{"id":8,"name":"team","path":"team","full_path":"fluxcd/team","description":"Subgroup","parent_id":7}`))
+			})
+			c := newTestOrganizationsClient(t, mux)
+
+			ref := gitprovider.OrganizationRef{Domain: c.(*OrganizationsClient).domain, Organization: "fluxcd/team"}
+			org, err := c.Create(context.Background(), ref, gitprovider.OrganizationInfo{
+				Description: gitprovider.StringVar("Subgroup"),
+			})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.expectedErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Create() request mismatch (-want +got):\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if org.Organization().Organization != "fluxcd/team" {
+				t.Errorf("Create() = %s, want fluxcd/team", org.Organization().Organization)
+			}
+		})
+	}
+}
+
+func TestOrganizationsClient_DeleteSubgroup(t *testing.T) {
+	deleted := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("DELETE /api/v4/groups/fluxcd%2Fteam", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+		w.WriteHeader(http.StatusAccepted)
+	})
+	c := newTestOrganizationsClient(t, mux, gitprovider.WithDestructiveAPICalls(true))
+
+	ref := gitprovider.OrganizationRef{Domain: c.(*OrganizationsClient).domain, Organization: "fluxcd/team"}
+	if err := c.Delete(context.Background(), ref); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !deleted {
+		t.Error("Delete() didn't send a delete request for the subgroup")
+	}
+}
+
+func TestOrganizationsClient_Reconcile(t *testing.T) {
+	const actual = `{"id":8,"name":"team","path":"team","full_path":"fluxcd/team","description":"Subgroup","visibility":"private"}`
+	tests := []struct {
+		name            string
+		exists          bool
+		req             gitprovider.OrganizationInfo
+		wantActionTaken bool
+		wantCreate      map[string]interface{}
+		wantUpdate      map[string]interface{}
+	}{
+		{
+			name:   "up to date",
+			exists: true,
+			req: gitprovider.OrganizationInfo{
+				Description: gitprovider.StringVar("Subgroup"),
+				Visibility:  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate),
+			},
+		},
+		{
+			name:   "update",
+			exists: true,
+			req: gitprovider.OrganizationInfo{
+				Description: gitprovider.StringVar("Updated"),
+			},
+			wantActionTaken: true,
+			wantUpdate: map[string]interface{}{
+				"name":                              "team",
+				"description":                       "Updated",
+				"visibility":                        "private",
+				"require_two_factor_authentication": false,
+			},
+		},
+		{
+			name: "create",
+			req: gitprovider.OrganizationInfo{
+				Description: gitprovider.StringVar("Subgroup"),
+			},
+			wantActionTaken: true,
+			wantCreate: map[string]interface{}{
+				"name":        "team",
+				"path":        "team",
+				"parent_id":   float64(7),
+				"description": "Subgroup",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotCreate, gotUpdate map[string]interface{}
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v4/groups/fluxcd", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"id":7,"name":"fluxcd","path":"fluxcd","full_path":"fluxcd"}`))
+			})
+			mux.HandleFunc("GET /api/v4/groups/fluxcd%2Fteam", func(w http.ResponseWriter, r *http.Request) {
+				if !tt.exists {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message":"404 Group Not Found"}`))
+					return
+				}
+				_, _ = w.Write([]byte(actual))
+			})
+			mux.HandleFunc("POST /api/v4/groups", func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&gotCreate)
+				_, _ = w.Write([]byte(actual))
+			})
+			mux.HandleFunc("PUT /api/v4/groups/8", func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&gotUpdate)
+				_, _ = w.Write([]byte(`{"id":8,"name":"team","path":"team","full_path":"fluxcd/team","description":"Updated","visibility":"private"}`))
+			})
+			c := newTestOrganizationsClient(t, mux)
+
+			ref := gitprovider.OrganizationRef{Domain: c.(*OrganizationsClient).domain, Organization: "fluxcd/team"}
+			org, actionTaken, err := c.Reconcile(context.Background(), ref, tt.req)
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if actionTaken != tt.wantActionTaken {
+				t.Errorf("Reconcile() actionTaken = %t, want %t", actionTaken, tt.wantActionTaken)
+			}
+			if diff := cmp.Diff(tt.wantCreate, gotCreate); diff != "" {
+				t.Errorf("Reconcile() create request mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantUpdate, gotUpdate); diff != "" {
+				t.Errorf("Reconcile() update request mismatch (-want +got):\n%s", diff)
+			}
+			if !tt.req.Equals(org.Get()) {
+				t.Errorf("Reconcile() = %v, want %v", org.Get(), tt.req)
+			}
+		})
+	}
+}
@@ -19,8 +19,12 @@ package gitlab
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-logr/logr"
 	"github.com/xanzy/go-gitlab"
 )
 
@@ -45,7 +49,21 @@ func (c *OrgRepositoriesClient) Get(ctx context.Context, ref gitprovider.OrgRepo
 	if err != nil {
 		return nil, err
 	}
-	return newGroupProject(c.clientContext, apiObj, ref), nil
+	// GitLab redirects requests for renamed or transferred projects
+	if moved, isMoved := projectMoved(c.clientContext, ref, apiObj); isMoved {
+		if !gitprovider.CallOptionsFromContext(ctx).ShouldFollowRedirects(c.followRedirects) {
+			return nil, &gitprovider.RepositoryMovedError{Ref: ref, CanonicalRef: moved.Repository()}
+		}
+		// A project transferred to a user keeps the requested reference
+		if canonicalRef, isGroup := moved.Repository().(gitprovider.OrgRepositoryRef); isGroup {
+			ref = canonicalRef
+		}
+	}
+	project := newGroupProject(c.clientContext, apiObj, ref)
+	if err := project.loadOptionalInfo(ctx); err != nil {
+		return nil, err
+	}
+	return project, nil
 }
 
 // List all repositories in the given organization.
@@ -63,18 +81,90 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 		return nil, err
 	}
 
-	// Traverse the list, and return a list of OrgRepository objects
+	repos := c.groupProjects(ref, apiObjs, gitprovider.CallOptionsFromContext(ctx).RepositoryFilter())
+	return gitprovider.LimitItems(ctx, repos), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+func (c *OrgRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.OrganizationRef) *gitprovider.Iterator[gitprovider.OrgRepository] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrgRepository, gitprovider.PageInfo, error) {
+		// Make sure the OrganizationRef is valid
+		if err := validateOrganizationRef(ref, c.domain); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		// GET /groups/{group}/projects
+		apiObjs, info, err := c.c.ListGroupProjectsPage(ctx, ref.Organization, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.groupProjects(ref, apiObjs, gitprovider.CallOptionsFromContext(ctx).RepositoryFilter()), info, nil
+	})
+}
+
+// groupProjects returns the OrgRepository objects for the projects of the group matching the filter.
+func (c *OrgRepositoriesClient) groupProjects(ref gitprovider.OrganizationRef, apiObjs []*gitlab.Project, filter gitprovider.RepositoryFilter) []gitprovider.OrgRepository {
 	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
+		// The last activity can't be filtered on the server side, and the search matches the path too
+		if !filter.Matches(apiObj.Name, repositoryFromAPI(apiObj), lastActivity(apiObj)) {
+			continue
+		}
 		// apiObj is already validated at ListOrgRepos
 		repos = append(repos, newGroupProject(c.clientContext, apiObj, gitprovider.OrgRepositoryRef{
-			OrganizationRef: ref,
+			OrganizationRef: subgroupRef(ref, apiObj),
 			RepositoryName:  apiObj.Name,
 		}))
 	}
+	return repos
+}
+
+// Search searches the projects of all groups for projects whose name contains query.
+func (c *OrgRepositoriesClient) Search(ctx context.Context, query string) ([]gitprovider.OrgRepository, error) {
+	// GET /projects
+	apiObjs, err := c.c.SearchProjects(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := gitprovider.CallOptionsFromContext(ctx).RepositoryFilter()
+	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// GitLab searches the path and description as well
+		if !strings.Contains(strings.ToLower(apiObj.Name), strings.ToLower(query)) ||
+			!filter.Matches(apiObj.Name, repositoryFromAPI(apiObj), lastActivity(apiObj)) {
+			continue
+		}
+		// Search returns the projects of users too
+		if repo, isGroup := newProjectFromAPI(c.clientContext, apiObj).(gitprovider.OrgRepository); isGroup {
+			repos = append(repos, repo)
+		}
+	}
 	return repos, nil
 }
 
+// lastActivity returns the time of the last activity in the project, if known.
+func lastActivity(apiObj *gitlab.Project) time.Time {
+	if apiObj.LastActivityAt == nil {
+		return time.Time{}
+	}
+	return *apiObj.LastActivityAt
+}
+
+// subgroupRef returns the reference to the group the project is in, which is a subgroup of
+// ref if the projects of subgroups are listed.
+func subgroupRef(ref gitprovider.OrganizationRef, apiObj *gitlab.Project) gitprovider.OrganizationRef {
+	if apiObj.Namespace == nil {
+		return ref
+	}
+	subPath, ok := strings.CutPrefix(apiObj.Namespace.FullPath, ref.Organization+"/")
+	if !ok {
+		return ref
+	}
+	subs := strings.Split(subPath, "/")
+	ref.SubOrganizations = append(make([]string, 0, len(subs)), subs...)
+	return ref
+}
+
 // Create creates a repository for the given organization, with the data and options.
 //
 // ErrAlreadyExists will be returned if the resource already exists.
@@ -107,6 +197,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -114,7 +205,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 		// Unexpected path, Get should succeed or return NotFound
 		return nil, false, err
 	}
-	actionTaken, err := reconcileRepository(ctx, actual, req)
+	actionTaken, err := reconcileRepository(ctx, c.log, actual, req)
 	return actual, actionTaken, err
 }
 
@@ -141,16 +232,39 @@ func createProject(ctx context.Context, c gitlabClient, ref gitprovider.Reposito
 	}
 	apiOpts := gitlab.CreateProjectOptions{
 		InitializeWithReadme: o.AutoInit,
+		// Only override the instance default if requested
+		LFSEnabled: req.LFSEnabled,
+	}
+	if req.WikiEnabled != nil {
+		apiOpts.WikiAccessLevel = gitlab.Ptr(wikiAccessLevel(*req.WikiEnabled))
+	}
+	if o.Template != nil {
+		if o.TemplateIncludeAllBranches != nil {
+			return nil, fmt.Errorf("including all branches of templates: %w", gitprovider.ErrNoProviderSupport)
+		}
+		if err := validateIdentityFields(o.Template, ref.GetDomain()); err != nil {
+			return nil, err
+		}
+		// Custom project templates are referenced by ID
+		// GET /projects/{project}
+		template, _, err := c.Client().Projects.GetProject(getRepoPath(o.Template), nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		apiOpts.UseCustomTemplate = gitlab.Ptr(true)
+		apiOpts.TemplateProjectID = gitlab.Ptr(template.ID)
 	}
 
 	return c.CreateProject(ctx, &data, &apiOpts)
 }
 
-func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
+func reconcileRepository(ctx context.Context, log logr.Logger, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		log.V(1).Info("repository is up to date", "repository", actual.Repository().String())
 		return false, nil
 	}
+	log.V(1).Info("updating repository to the desired state", "repository", actual.Repository().String())
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return false, err
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestOrgRepositoriesClient_ListIter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/groups/fluxcd/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Total", "3")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"name":"flux2"},{"name":"agent","namespace":{"full_path":"fluxcd/tools"}}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"name":"source-controller"}]`))
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient("", "", "token", TokenTypePat, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"}
+	it := c.OrgRepositories().ListIter(context.Background(), ref)
+
+	var names []string
+	var newPages []bool
+	for it.Next(context.Background()) {
+		names = append(names, it.Value().Repository().String())
+		newPages = append(newPages, it.NewPage())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListIter() error = %v", err)
+	}
+	want := []string{
+		server.URL + "/fluxcd/flux2",
+		server.URL + "/fluxcd/tools/agent",
+		server.URL + "/fluxcd/source-controller",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("ListIter() = %v, want %v", names, want)
+	}
+	if want := []bool{true, false, true}; !reflect.DeepEqual(newPages, want) {
+		t.Errorf("NewPage() = %v, want %v", newPages, want)
+	}
+	if total, ok := it.Total(); !ok || total != 3 {
+		t.Errorf("Total() = %d, %t, want 3, true", total, ok)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/xanzy/go-gitlab"
 )
 
 // UserRepositoriesClient implements the gitprovider.UserRepositoriesClient interface.
@@ -58,7 +59,26 @@ func (c *UserRepositoriesClient) Get(ctx context.Context, ref gitprovider.UserRe
 	if err != nil {
 		return nil, err
 	}
-	return newUserProject(c.clientContext, apiObj, ref), nil
+	// GitLab redirects requests for renamed or transferred projects
+	if moved, isMoved := projectMoved(c.clientContext, ref, apiObj); isMoved {
+		if !gitprovider.CallOptionsFromContext(ctx).ShouldFollowRedirects(c.followRedirects) {
+			return nil, &gitprovider.RepositoryMovedError{Ref: ref, CanonicalRef: moved.Repository()}
+		}
+		// The project may have been transferred to a group
+		if orgRef, isGroup := moved.Repository().(gitprovider.OrgRepositoryRef); isGroup {
+			project := newGroupProject(c.clientContext, apiObj, orgRef)
+			if err := project.loadOptionalInfo(ctx); err != nil {
+				return nil, err
+			}
+			return project, nil
+		}
+		ref = moved.Repository().(gitprovider.UserRepositoryRef)
+	}
+	project := newUserProject(c.clientContext, apiObj, ref)
+	if err := project.loadOptionalInfo(ctx); err != nil {
+		return nil, err
+	}
+	return project, nil
 }
 
 // List all repositories in the given organization.
@@ -76,7 +96,27 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 		return nil, err
 	}
 
-	// Traverse the list, and return a list of UserRepository objects
+	return gitprovider.LimitItems(ctx, c.userProjects(ref, apiObjs)), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+func (c *UserRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.UserRef) *gitprovider.Iterator[gitprovider.UserRepository] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.UserRepository, gitprovider.PageInfo, error) {
+		// Make sure the UserRef is valid
+		if err := validateUserRef(ref, c.domain); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		// GET /users/{username}/projects
+		apiObjs, info, err := c.c.ListUserProjectsPage(ctx, ref.UserLogin, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.userProjects(ref, apiObjs), info, nil
+	})
+}
+
+// userProjects returns the UserRepository objects for the projects of the user.
+func (c *UserRepositoriesClient) userProjects(ref gitprovider.UserRef, apiObjs []*gitlab.Project) []gitprovider.UserRepository {
 	repos := make([]gitprovider.UserRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
 		// apiObj is already validated at ListUserRepos
@@ -85,7 +125,7 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 			RepositoryName: apiObj.Name,
 		}))
 	}
-	return repos, nil
+	return repos
 }
 
 // Create creates a repository for the given organization, with the data and options
@@ -109,7 +149,7 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 		return nil, fmt.Errorf("unable to get owner from API")
 	}
 
-	if ref.GetIdentity() != idRef.GetIdentity() {
+	if !gitprovider.NamesEqual(ref.GetIdentity(), idRef.GetIdentity(), caseSensitiveNames) {
 		return nil, gitprovider.NewErrIncorrectUser(ref.GetIdentity())
 	}
 
@@ -142,6 +182,7 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -150,6 +191,6 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 		return nil, false, err
 	}
 
-	actionTaken, err := reconcileRepository(ctx, actual, req)
+	actionTaken, err := reconcileRepository(ctx, c.log, actual, req)
 	return actual, actionTaken, err
 }
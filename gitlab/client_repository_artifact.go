@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// genericPackageType is the GitLab package type used for storing artifacts.
+const genericPackageType = "generic"
+
+// ArtifactClient implements the gitprovider.ArtifactClient interface.
+var _ gitprovider.ArtifactClient = &ArtifactClient{}
+
+// ArtifactClient operates on the generic packages of a specific repository.
+// All artifacts are stored in a generic package named after the repository,
+// with the artifact version as the package version.
+type ArtifactClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Upload uploads content as the artifact with the given name, under the given version.
+// uses https://docs.gitlab.com/ee/user/packages/generic_packages/#publish-a-package-file
+func (c *ArtifactClient) Upload(ctx context.Context, version, name string, content io.Reader) (*gitprovider.ArtifactInfo, error) {
+	// PUT /projects/{project}/packages/generic/{package_name}/{package_version}/{file_name}
+	apiObj, _, err := c.c.Client().GenericPackages.PublishPackageFile(getRepoPath(c.ref), c.packageName(), version, name, content, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return &gitprovider.ArtifactInfo{
+		Name:        apiObj.FileName,
+		Version:     version,
+		Size:        int64(apiObj.Size),
+		DownloadURL: c.downloadURL(version, apiObj.FileName),
+	}, nil
+}
+
+// Download returns the content of the artifact with the given name and version.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ArtifactClient) Download(ctx context.Context, version, name string) (io.ReadCloser, error) {
+	// GET /projects/{project}/packages/generic/{package_name}/{package_version}/{file_name}
+	path := fmt.Sprintf("projects/%s/packages/generic/%s/%s/%s",
+		gitlab.PathEscape(getRepoPath(c.ref)), gitlab.PathEscape(c.packageName()), gitlab.PathEscape(version), gitlab.PathEscape(name))
+	req, err := c.c.Client().NewRequest(http.MethodGet, path, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	// go-gitlab copies the response body to the given writer before it returns, so the content is
+	// streamed through a pipe. The reader is returned once the content starts coming in, or the
+	// request is done, so that errors of the response are still returned here. Closing the reader
+	// stops the copy.
+	pr, pw := io.Pipe()
+	started := make(chan struct{})
+	errc := make(chan error, 1)
+	go func() {
+		_, err := c.c.Client().Do(req, &startWriter{w: pw, started: started})
+		err = handleHTTPError(err)
+		pw.CloseWithError(err)
+		errc <- err
+	}()
+	select {
+	case <-started:
+		return pr, nil
+	case err := <-errc:
+		if err != nil {
+			return nil, err
+		}
+		return pr, nil
+	}
+}
+
+// startWriter writes to w, and closes started on the first write.
+type startWriter struct {
+	w       io.Writer
+	started chan struct{}
+	once    sync.Once
+}
+
+// Write implements io.Writer.
+func (w *startWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.started) })
+	return w.w.Write(p)
+}
+
+// List lists all artifacts stored under the given version.
+func (c *ArtifactClient) List(ctx context.Context, version string) ([]*gitprovider.ArtifactInfo, error) {
+	packages, err := c.listPackages(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := []*gitprovider.ArtifactInfo{}
+	for _, pkg := range packages {
+		fileOpts := &gitlab.ListPackageFilesOptions{}
+		err := allPackageFilePages(ctx, fileOpts, func() (*gitlab.Response, error) {
+			// GET /projects/{project}/packages/{package_id}/package_files
+			files, resp, listErr := c.c.Client().Packages.ListPackageFiles(getRepoPath(c.ref), pkg.ID, fileOpts, gitlab.WithContext(ctx))
+			for _, file := range files {
+				artifacts = append(artifacts, c.artifactFromFile(file, version))
+			}
+			return resp, handleHTTPError(listErr)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return gitprovider.LimitItems(ctx, artifacts), nil
+}
+
+// ListIter returns an iterator over the artifacts List returns, fetching them a page at a time.
+// The packages holding the files of the version are looked up along with the first page.
+func (c *ArtifactClient) ListIter(ctx context.Context, version string) *gitprovider.Iterator[*gitprovider.ArtifactInfo] {
+	var fetch gitprovider.PageFunc[*gitprovider.ArtifactInfo]
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]*gitprovider.ArtifactInfo, gitprovider.PageInfo, error) {
+		if fetch == nil {
+			packages, err := c.listPackages(gitprovider.WithoutListOptions(ctx), version)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			fetches := make([]gitprovider.PageFunc[*gitprovider.ArtifactInfo], 0, len(packages))
+			for _, pkg := range packages {
+				fetches = append(fetches, c.filesPage(pkg.ID, version))
+			}
+			if len(fetches) == 0 {
+				return nil, gitprovider.PageInfo{}, nil
+			}
+			fetch = gitprovider.ConcatPages(fetches...)
+		}
+		return fetch(ctx, page)
+	})
+}
+
+// listPackages returns the generic packages holding the files of the version.
+func (c *ArtifactClient) listPackages(ctx context.Context, version string) ([]*gitlab.Package, error) {
+	packageType := genericPackageType
+	packageName := c.packageName()
+	opts := &gitlab.ListProjectPackagesOptions{
+		PackageType:    &packageType,
+		PackageName:    &packageName,
+		PackageVersion: &version,
+	}
+	packages := []*gitlab.Package{}
+	err := allPackagePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/packages
+		pageObjs, resp, listErr := c.c.Client().Packages.ListProjectPackages(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, pkg := range pageObjs {
+			// The package name filter matches on substrings, so make sure it's an exact match
+			if pkg.Name == packageName && pkg.Version == version {
+				packages = append(packages, pkg)
+			}
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return packages, nil
+}
+
+// filesPage returns a PageFunc listing the files of the package as artifacts of the version.
+func (c *ArtifactClient) filesPage(packageID int, version string) gitprovider.PageFunc[*gitprovider.ArtifactInfo] {
+	return func(ctx context.Context, page int) ([]*gitprovider.ArtifactInfo, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListPackageFilesOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/packages/{package_id}/package_files
+		files, resp, err := c.c.Client().Packages.ListPackageFiles(getRepoPath(c.ref), packageID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		artifacts := make([]*gitprovider.ArtifactInfo, 0, len(files))
+		for _, file := range files {
+			artifacts = append(artifacts, c.artifactFromFile(file, version))
+		}
+		return artifacts, pageInfo(resp), nil
+	}
+}
+
+// artifactFromFile maps a package file to the artifact of the version.
+func (c *ArtifactClient) artifactFromFile(file *gitlab.PackageFile, version string) *gitprovider.ArtifactInfo {
+	return &gitprovider.ArtifactInfo{
+		Name:        file.FileName,
+		Version:     version,
+		Size:        int64(file.Size),
+		DownloadURL: c.downloadURL(version, file.FileName),
+	}
+}
+
+// packageName returns the name of the generic package the artifacts are stored in.
+func (c *ArtifactClient) packageName() string {
+	return c.ref.GetRepository()
+}
+
+// downloadURL returns the API URL the given artifact can be downloaded from.
+func (c *ArtifactClient) downloadURL(version, name string) string {
+	path, err := c.c.Client().GenericPackages.FormatPackageURL(getRepoPath(c.ref), c.packageName(), version, name)
+	if err != nil {
+		return ""
+	}
+	return c.c.Client().BaseURL().String() + path
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTestArtifactClient(t *testing.T, mux *http.ServeMux, optFns ...gitprovider.ClientOption) (*ArtifactClient, string) {
+	t.Helper()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	token := "token"
+	if len(optFns) != 0 {
+		// The options authenticate the client
+		token = ""
+	}
+	optFns = append([]gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true)}, optFns...)
+	c, err := NewClient("", "", token, TokenTypePat, optFns...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ArtifactClient{
+		clientContext: c.(*Client).clientContext,
+		ref: gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+			RepositoryName:  "flux2",
+		},
+	}, server.URL
+}
+
+func TestArtifactClient_Upload(t *testing.T) {
+	var body string
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1.0.0/manifests.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":2,"package_id":1,"file_name":"manifests.tar.gz","size":8}`))
+	})
+	artifacts, serverURL := newTestArtifactClient(t, mux)
+
+	info, err := artifacts.Upload(context.Background(), "v1.0.0", "manifests.tar.gz", strings.NewReader("artifact"))
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if body != "artifact" {
+		t.Errorf("uploaded %q, want %q", body, "artifact")
+	}
+	want := gitprovider.ArtifactInfo{
+		Name:        "manifests.tar.gz",
+		Version:     "v1.0.0",
+		Size:        8,
+		DownloadURL: serverURL + "/api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1%2E0%2E0/manifests%2Etar%2Egz",
+	}
+	if *info != want {
+		t.Errorf("Upload() = %+v, want %+v", *info, want)
+	}
+}
+
+func TestArtifactClient_List(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("package_type") != "generic" || q.Get("package_name") != "flux2" || q.Get("package_version") != "v1.0.0" {
+			t.Errorf("unexpected package filter %q", r.URL.RawQuery)
+		}
+		// The name filter matches on substrings, so other packages can be returned
+		_, _ = w.Write([]byte(`[{"id":1,"name":"flux2","version":"v1.0.0"},{"id":9,"name":"flux2-extra","version":"v1.0.0"}]`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/1/package_files", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":2,"file_name":"manifests.tar.gz","size":8},{"id":3,"file_name":"checksums.txt","size":64}]`))
+	})
+	artifacts, _ := newTestArtifactClient(t, mux)
+
+	got, err := artifacts.List(context.Background(), "v1.0.0")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []string{"manifests.tar.gz", "checksums.txt"}
+	if len(got) != len(want) {
+		t.Fatalf("List() returned %d artifacts, want %d", len(got), len(want))
+	}
+	for i, name := range want {
+		if got[i].Name != name || got[i].Version != "v1.0.0" {
+			t.Errorf("List()[%d] = %+v, want %s@v1.0.0", i, *got[i], name)
+		}
+	}
+}
+
+func TestArtifactClient_ListIter(t *testing.T) {
+	var packageLists int
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages", func(w http.ResponseWriter, r *http.Request) {
+		packageLists++
+		_, _ = w.Write([]byte(`[{"id":1,"name":"flux2","version":"v1.0.0"},{"id":9,"name":"flux2-extra","version":"v1.0.0"},{"id":4,"name":"flux2","version":"v1.0.0"}]`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/1/package_files", func(w http.ResponseWriter, r *http.Request) {
+		// The files of the first package span two pages
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"id":3,"file_name":"checksums.txt","size":64}]`))
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		_, _ = w.Write([]byte(`[{"id":2,"file_name":"manifests.tar.gz","size":8}]`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/4/package_files", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":5,"file_name":"sbom.json","size":16}]`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/9/package_files", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("listed the files of a package with another name")
+	})
+	artifacts, _ := newTestArtifactClient(t, mux)
+
+	ctx := context.Background()
+	it := artifacts.ListIter(ctx, "v1.0.0")
+	var got []string
+	pages := 0
+	for it.Next(ctx) {
+		if it.NewPage() {
+			pages++
+		}
+		got = append(got, it.Value().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListIter() error = %v", err)
+	}
+	want := []string{"manifests.tar.gz", "checksums.txt", "sbom.json"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ListIter() = %v, want %v", got, want)
+	}
+	if pages != 3 {
+		t.Errorf("ListIter() fetched %d pages, want 3", pages)
+	}
+	if packageLists != 1 {
+		t.Errorf("ListIter() listed the packages %d times, want once", packageLists)
+	}
+}
+
+func TestArtifactClient_Download(t *testing.T) {
+	var storageToken string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageToken = r.Header.Get("Private-Token") + r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("redirected artifact"))
+	}))
+	defer storage.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1.0.0/manifests.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("artifact"))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1.0.0/checksums.txt", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL+"/checksums.txt", http.StatusFound)
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1.0.0/missing.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Not Found"}`))
+	})
+	artifacts, _ := newTestArtifactClient(t, mux)
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr error
+	}{
+		{name: "manifests.tar.gz", want: "artifact"},
+		{name: "checksums.txt", want: "redirected artifact"},
+		{name: "missing.txt", wantErr: gitprovider.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc, err := artifacts.Download(context.Background(), "v1.0.0", tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Download() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer rc.Close()
+			data, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Download() = %q, want %q", data, tt.want)
+			}
+		})
+	}
+	if storageToken != "" {
+		t.Errorf("the redirect to the storage host sent the token %q, want none", storageToken)
+	}
+}
+
+func TestArtifactClient_DownloadWithTokenSource(t *testing.T) {
+	var storageToken string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageToken = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("redirected artifact"))
+	}))
+	defer storage.Close()
+
+	var apiToken string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1.0.0/checksums.txt", func(w http.ResponseWriter, r *http.Request) {
+		apiToken = r.Header.Get("Authorization")
+		http.Redirect(w, r, storage.URL+"/checksums.txt", http.StatusFound)
+	})
+	artifacts, _ := newTestArtifactClient(t, mux, gitprovider.WithTokenSource(func(context.Context) (string, error) {
+		return "token", nil
+	}))
+
+	rc, err := artifacts.Download(context.Background(), "v1.0.0", "checksums.txt")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "redirected artifact" {
+		t.Errorf("Download() = %q, want %q", data, "redirected artifact")
+	}
+	if apiToken != "Bearer token" {
+		t.Errorf("the API request sent Authorization %q, want %q", apiToken, "Bearer token")
+	}
+	if storageToken != "" {
+		t.Errorf("the redirect to the storage host sent Authorization %q, want none", storageToken)
+	}
+}
+
+func TestArtifactClient_DownloadStreams(t *testing.T) {
+	// The server only sends the rest of the content once the first part was read.
+	read := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages/generic/flux2/v1.0.0/manifests.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("first "))
+		w.(http.Flusher).Flush()
+		select {
+		case <-read:
+			_, _ = w.Write([]byte("second"))
+		case <-time.After(5 * time.Second):
+			_, _ = w.Write([]byte("not streamed"))
+		}
+	})
+	artifacts, _ := newTestArtifactClient(t, mux)
+
+	rc, err := artifacts.Download(context.Background(), "v1.0.0", "manifests.tar.gz")
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	defer rc.Close()
+	first := make([]byte, len("first "))
+	if _, err := io.ReadFull(rc, first); err != nil {
+		t.Fatal(err)
+	}
+	close(read)
+	rest, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(first) + string(rest); got != "first second" {
+		t.Errorf("Download() = %q, want %q", got, "first second")
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// AutolinkClient implements the gitprovider.AutolinkClient interface.
+var _ gitprovider.AutolinkClient = &AutolinkClient{}
+
+// AutolinkClient operates on the custom issue tracker integration of a specific project,
+// which links references like "JIRA-123" to the tracker. A project has at most one.
+type AutolinkClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// gitlabIssueIDPlaceholder is GitLab's equivalent of gitprovider.AutolinkNumberPlaceholder.
+const gitlabIssueIDPlaceholder = ":id"
+
+// List returns the custom issue tracker of the project, if it's active.
+func (c *AutolinkClient) List(ctx context.Context) ([]gitprovider.Autolink, error) {
+	// GET /projects/{project}/integrations/custom-issue-tracker
+	apiObj, _, err := c.c.Client().Services.GetCustomIssueTrackerService(getRepoPath(c.ref), gitlab.WithContext(ctx))
+	if err != nil {
+		if err = handleHTTPError(err); errors.Is(err, gitprovider.ErrNotFound) {
+			return []gitprovider.Autolink{}, nil
+		}
+		return nil, err
+	}
+	if !apiObj.Active || apiObj.Properties == nil {
+		return []gitprovider.Autolink{}, nil
+	}
+	return []gitprovider.Autolink{newAutolink(c, apiObj)}, nil
+}
+
+// ListIter returns an iterator over the autolinks List returns. The custom issue tracker is a
+// single integration of the project rather than a paginated list, so it's fetched by a single
+// call to List.
+func (c *AutolinkClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Autolink] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
+
+// Create sets up the custom issue tracker of the project. GitLab links references with any
+// upper-case prefix, so KeyPrefix is only used to validate the request.
+//
+// ErrAlreadyExists will be returned if the project already has an active custom issue tracker.
+func (c *AutolinkClient) Create(ctx context.Context, req gitprovider.AutolinkInfo) (gitprovider.Autolink, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.IsAlphanumeric != nil && *req.IsAlphanumeric {
+		return nil, fmt.Errorf("alphanumeric autolinks: %w", gitprovider.ErrNoProviderSupport)
+	}
+	autolinks, err := c.List(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(autolinks) != 0 {
+		return nil, fmt.Errorf("custom issue tracker: %w", gitprovider.ErrAlreadyExists)
+	}
+
+	u, err := url.Parse(req.URLTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL template %q: %w", req.URLTemplate, gitprovider.ErrInvalidArgument)
+	}
+	trackerURL := (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+	// PUT /projects/{project}/integrations/custom-issue-tracker
+	apiObj, _, err := c.c.Client().Services.SetCustomIssueTrackerService(getRepoPath(c.ref), &gitlab.SetCustomIssueTrackerServiceOptions{
+		IssuesURL:   gitlab.Ptr(strings.ReplaceAll(req.URLTemplate, gitprovider.AutolinkNumberPlaceholder, gitlabIssueIDPlaceholder)),
+		ProjectURL:  gitlab.Ptr(trackerURL),
+		NewIssueURL: gitlab.Ptr(trackerURL),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newAutolink(c, apiObj), nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the direct members of a specific project.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the direct project member, or the pending invitation, with the given username.
+// Invitations of people without a GitLab account can be looked up by their email address.
+//
+// ErrNotFound is returned if the user is neither a member nor invited.
+func (c *CollaboratorClient) Get(ctx context.Context, username string) (gitprovider.Collaborator, error) {
+	userID, err := c.userID(ctx, username)
+	if err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		// GET /projects/{id}/members/{user_id}
+		apiObj, _, err := c.c.Client().ProjectMembers.GetProjectMember(getRepoPath(c.ref), userID, gitlab.WithContext(ctx))
+		if err == nil {
+			return newCollaborator(c, apiObj)
+		}
+		if err = handleHTTPError(err); !errors.Is(err, gitprovider.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	invitations, err := c.ListInvitations(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range invitations {
+		if strings.EqualFold(invitation.Get().Username, username) {
+			return invitation, nil
+		}
+	}
+	return nil, fmt.Errorf("collaborator %q: %w", username, gitprovider.ErrNotFound)
+}
+
+// List lists the direct members of the project, leaving out members inherited from groups.
+//
+// List returns all available collaborators, using multiple paginated requests if needed.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	opts := &gitlab.ListProjectMembersOptions{}
+	collaborators := []gitprovider.Collaborator{}
+	err := allProjectMemberPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{id}/members
+		pageObjs, resp, listErr := c.c.Client().ProjectMembers.ListProjectMembers(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			collaborator, err := newCollaborator(c, apiObj)
+			if err != nil {
+				return nil, err
+			}
+			collaborators = append(collaborators, collaborator)
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, collaborators), nil
+}
+
+// ListIter returns an iterator over the collaborators List returns, fetching them a page at a time.
+func (c *CollaboratorClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Collaborator] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Collaborator, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectMembersOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		// GET /projects/{id}/members
+		apiObjs, resp, err := c.c.Client().ProjectMembers.ListProjectMembers(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		collaborators := make([]gitprovider.Collaborator, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			collaborator, err := newCollaborator(c, apiObj)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			collaborators = append(collaborators, collaborator)
+		}
+		return collaborators, pageInfo(resp), nil
+	})
+}
+
+// ListInvitations lists the invitations that haven't been accepted yet. GitLab adds existing
+// users right away, so these are invitations sent to email addresses.
+//
+// ListInvitations returns all available invitations, using multiple paginated requests if needed.
+func (c *CollaboratorClient) ListInvitations(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	opts := &gitlab.ListPendingInvitationsOptions{}
+	invitations := []gitprovider.Collaborator{}
+	err := allPendingInvitationPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{id}/invitations
+		pageObjs, resp, listErr := c.c.Client().Invites.ListPendingProjectInvitations(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			invitation, err := newCollaborator(c, apiObj)
+			if err != nil {
+				return nil, err
+			}
+			invitations = append(invitations, invitation)
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// Create adds the given user as a direct member of the project.
+//
+// ErrAlreadyExists will be returned if the user already is a member or invited.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.Get(ctx, req.Username); err == nil {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Username, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	userID, err := c.userID(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	accessLevel, err := getGitlabPermission(*req.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /projects/{id}/members
+	apiObj, _, err := c.c.Client().ProjectMembers.AddProjectMember(getRepoPath(c.ref), &gitlab.AddProjectMemberOptions{
+		UserID:      userID,
+		AccessLevel: gitlab.Ptr(gitlab.AccessLevelValue(accessLevel)),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newCollaborator(c, apiObj)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Username)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating collaborator, as it doesn't exist", "repository", c.ref.String(), "collaborator", req.Username)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("collaborator is up to date", "repository", c.ref.String(), "collaborator", req.Username)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating collaborator to the desired state", "repository", c.ref.String(), "collaborator", req.Username)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+// userID looks up the ID of the user with the given username.
+func (c *CollaboratorClient) userID(ctx context.Context, username string) (int, error) {
+	return getUserID(ctx, c.c.Client(), username)
+}
+
+// invitationRequest sends a request for the invitation of the given email address,
+// which the go-gitlab client only supports listing and creating.
+func (c *CollaboratorClient) invitationRequest(ctx context.Context, method, email string, opt interface{}) error {
+	u := fmt.Sprintf("projects/%s/invitations/%s", gitlab.PathEscape(getRepoPath(c.ref)), gitlab.PathEscape(email))
+	req, err := c.c.Client().NewRequest(method, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = c.c.Client().Do(req, nil)
+	return handleHTTPError(err)
+}
+
+// updateInvitation changes the access level of the invitation of the given email address.
+func (c *CollaboratorClient) updateInvitation(ctx context.Context, email string, permission gitprovider.RepositoryPermission) error {
+	accessLevel, err := getGitlabPermission(permission)
+	if err != nil {
+		return err
+	}
+	// PUT /projects/{id}/invitations/{email}
+	return c.invitationRequest(ctx, http.MethodPut, email, &struct {
+		AccessLevel gitlab.AccessLevelValue `json:"access_level"`
+	}{
+		AccessLevel: gitlab.AccessLevelValue(accessLevel),
+	})
+}
+
+// deleteInvitation withdraws the invitation of the given email address.
+func (c *CollaboratorClient) deleteInvitation(ctx context.Context, email string) error {
+	// DELETE /projects/{id}/invitations/{email}
+	return c.invitationRequest(ctx, http.MethodDelete, email, nil)
+}
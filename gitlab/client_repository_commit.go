@@ -18,7 +18,9 @@ package gitlab
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/xanzy/go-gitlab"
@@ -27,6 +29,16 @@ import (
 // CommitClient implements the gitprovider.CommitClient interface.
 var _ gitprovider.CommitClient = &CommitClient{}
 
+// defaultCommitSizeLimits mirror the default maximum file size of GitLab. The Commits API sends
+// all files in a single request, which GitLab limits to 300 MiB by default. Instances with raised
+// limits can be configured with gitprovider.WithCommitSizeLimits.
+//
+//nolint:gochecknoglobals
+var defaultCommitSizeLimits = gitprovider.SizeLimits{
+	MaxFileSize: 100 << 20,
+	MaxPushSize: 300 << 20,
+}
+
 // CommitClient operates on the commits for a specific repository.
 type CommitClient struct {
 	*clientContext
@@ -34,8 +46,8 @@ type CommitClient struct {
 }
 
 // ListPage lists repository commits of the given page and page size.
-func (c *CommitClient) ListPage(_ context.Context, branch string, perPage, page int) ([]gitprovider.Commit, error) {
-	dks, err := c.listPage(branch, perPage, page)
+func (c *CommitClient) ListPage(ctx context.Context, branch string, perPage, page int) ([]gitprovider.Commit, error) {
+	dks, err := c.listPage(ctx, branch, perPage, page)
 	if err != nil {
 		return nil, err
 	}
@@ -47,9 +59,26 @@ func (c *CommitClient) ListPage(_ context.Context, branch string, perPage, page
 	return commits, nil
 }
 
-func (c *CommitClient) listPage(branch string, perPage, page int) ([]*commitType, error) {
+// ListIter returns an iterator over the commits of the given branch, fetching them a page at a
+// time with ListPage. A page holding fewer commits than the page size is the last one.
+func (c *CommitClient) ListIter(ctx context.Context, branch string) *gitprovider.Iterator[gitprovider.Commit] {
+	perPage := iteratorPageSize(ctx)
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Commit, gitprovider.PageInfo, error) {
+		commits, err := c.ListPage(ctx, branch, perPage, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		var info gitprovider.PageInfo
+		if len(commits) == perPage {
+			info.NextPage = page + 1
+		}
+		return commits, info, nil
+	})
+}
+
+func (c *CommitClient) listPage(ctx context.Context, branch string, perPage, page int) ([]*commitType, error) {
 	// GET /repos/{owner}/{repo}/commits
-	apiObjs, err := c.c.ListCommitsPage(getRepoPath(c.ref), branch, perPage, page)
+	apiObjs, err := c.c.ListCommitsPage(ctx, getRepoPath(c.ref), branch, perPage, page)
 	if err != nil {
 		return nil, err
 	}
@@ -64,12 +93,21 @@ func (c *CommitClient) listPage(branch string, perPage, page int) ([]*commitType
 }
 
 // Create creates a commit with the given specifications.
-func (c *CommitClient) Create(_ context.Context, branch string, message string, files []gitprovider.CommitFile) (gitprovider.Commit, error) {
+func (c *CommitClient) Create(ctx context.Context, branch string, message string, files []gitprovider.CommitFile) (gitprovider.Commit, error) {
 
 	if len(files) == 0 {
 		return nil, fmt.Errorf("no files added")
 	}
 
+	files, err := gitprovider.NormalizeCommitFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gitprovider.CheckCommitSize(files, gitprovider.CallOptionsFromContext(ctx).SizeLimits(c.commitSizeLimits)); err != nil {
+		return nil, err
+	}
+
 	commitActions := make([]*gitlab.CommitActionOptions, 0)
 	for _, file := range files {
 		fileAction := gitlab.FileCreate
@@ -77,10 +115,16 @@ func (c *CommitClient) Create(_ context.Context, branch string, message string,
 			fileAction = gitlab.FileDelete
 		}
 
+		var encoding *string
+		if file.IsBase64() {
+			encoding = gitlab.Ptr(string(gitprovider.CommitFileEncodingBase64))
+		}
+
 		commitActions = append(commitActions, &gitlab.CommitActionOptions{
 			Action:   &fileAction,
 			FilePath: file.Path,
 			Content:  file.Content,
+			Encoding: encoding,
 		})
 	}
 
@@ -90,10 +134,50 @@ func (c *CommitClient) Create(_ context.Context, branch string, message string,
 		Actions:       commitActions,
 	}
 
-	commit, _, err := c.c.Client().Commits.CreateCommit(getRepoPath(c.ref), opts)
+	commit, _, err := c.c.Client().Commits.CreateCommit(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	return newCommit(c, commit), nil
 }
+
+// GetSignature returns the signature verification of the commit with the given sha.
+func (c *CommitClient) GetSignature(ctx context.Context, sha string) (*gitprovider.SignatureVerification, error) {
+	// GET /projects/{id}/repository/commits/{sha}
+	if _, _, err := c.c.Client().Commits.GetCommit(getRepoPath(c.ref), sha, nil, gitlab.WithContext(ctx)); err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// GET /projects/{id}/repository/commits/{sha}/signature
+	return c.getSignature(ctx, fmt.Sprintf("projects/%s/repository/commits/%s/signature",
+		gitlab.PathEscape(getRepoPath(c.ref)), gitlab.PathEscape(sha)))
+}
+
+// GetTagSignature returns the signature verification of the tag with the given name. GitLab only
+// verifies X.509 signatures of tags.
+func (c *CommitClient) GetTagSignature(ctx context.Context, tag string) (*gitprovider.SignatureVerification, error) {
+	// GET /projects/{id}/repository/tags/{tag_name}
+	if _, _, err := c.c.Client().Tags.GetTag(getRepoPath(c.ref), tag, gitlab.WithContext(ctx)); err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// GET /projects/{id}/repository/tags/{tag_name}/signature
+	return c.getSignature(ctx, fmt.Sprintf("projects/%s/repository/tags/%s/signature",
+		gitlab.PathEscape(getRepoPath(c.ref)), gitlab.PathEscape(tag)))
+}
+
+// getSignature gets the signature at the given path, which isn't found for unsigned objects.
+// go-gitlab only decodes GPG signatures, so the request is made directly.
+func (c *CommitClient) getSignature(ctx context.Context, u string) (*gitprovider.SignatureVerification, error) {
+	req, err := c.c.Client().NewRequest(http.MethodGet, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	apiObj := &signatureAPI{}
+	if _, err := c.c.Client().Do(req, apiObj); err != nil {
+		if errors.Is(err, gitlab.ErrNotFound) {
+			return &gitprovider.SignatureVerification{Reason: gitprovider.SignatureVerificationReasonUnsigned}, nil
+		}
+		return nil, handleHTTPError(err)
+	}
+	return signatureFromAPI(apiObj), nil
+}
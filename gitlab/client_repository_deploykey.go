@@ -37,12 +37,12 @@ type DeployKeyClient struct {
 // Get returns the repository at the given path.
 //
 // ErrNotFound is returned if the resource does not exist.
-func (c *DeployKeyClient) Get(_ context.Context, deployKeyName string) (gitprovider.DeployKey, error) {
-	return c.get(deployKeyName)
+func (c *DeployKeyClient) Get(ctx context.Context, deployKeyName string) (gitprovider.DeployKey, error) {
+	return c.get(ctx, deployKeyName)
 }
 
-func (c *DeployKeyClient) get(deployKeyName string) (*deployKey, error) {
-	deployKeys, err := c.list()
+func (c *DeployKeyClient) get(ctx context.Context, deployKeyName string) (*deployKey, error) {
+	deployKeys, err := c.list(gitprovider.WithoutListOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,8 @@ func (c *DeployKeyClient) get(deployKeyName string) (*deployKey, error) {
 //
 // List returns all available repository deploy keys for the given type,
 // using multiple paginated requests if needed.
-func (c *DeployKeyClient) List(_ context.Context) ([]gitprovider.DeployKey, error) {
-	dks, err := c.list()
+func (c *DeployKeyClient) List(ctx context.Context) ([]gitprovider.DeployKey, error) {
+	dks, err := c.list(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +69,33 @@ func (c *DeployKeyClient) List(_ context.Context) ([]gitprovider.DeployKey, erro
 	for _, dk := range dks {
 		keys = append(keys, dk)
 	}
-	return keys, nil
+	return gitprovider.LimitItems(ctx, keys), nil
+}
+
+// ListIter returns an iterator over the deploy keys List returns, fetching them a page at a time.
+func (c *DeployKeyClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.DeployKey] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.DeployKey, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectDeployKeysOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/deploy_keys
+		apiObjs, resp, err := c.c.Client().DeployKeys.ListProjectDeployKeys(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		keys := make([]gitprovider.DeployKey, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateDeployKeyAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			keys = append(keys, newDeployKey(c, apiObj))
+		}
+		return keys, pageInfo(resp), nil
+	})
 }
 
-func (c *DeployKeyClient) list() ([]*deployKey, error) {
+func (c *DeployKeyClient) list(ctx context.Context) ([]*deployKey, error) {
 	// GET /repos/{owner}/{repo}/keys
-	apiObjs, err := c.c.ListKeys(getRepoPath(c.ref))
+	apiObjs, err := c.c.ListKeys(ctx, getRepoPath(c.ref))
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +113,8 @@ func (c *DeployKeyClient) list() ([]*deployKey, error) {
 // Create creates a deploy key with the given specifications.
 //
 // ErrAlreadyExists will be returned if the resource already exists.
-func (c *DeployKeyClient) Create(_ context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, error) {
-	apiObj, err := createDeployKey(c.c, c.ref, req)
+func (c *DeployKeyClient) Create(ctx context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, error) {
+	apiObj, err := createDeployKey(ctx, c.c, c.ref, req)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +138,7 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating deploy key, as it doesn't exist", "repository", c.ref.String(), "deployKey", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -127,9 +149,11 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("deploy key is up to date", "repository", c.ref.String(), "deployKey", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating deploy key to the desired state", "repository", c.ref.String(), "deployKey", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
@@ -138,12 +162,12 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 	return actual, true, actual.Update(ctx)
 }
 
-func createDeployKey(c gitlabClient, ref gitprovider.RepositoryRef, req gitprovider.DeployKeyInfo) (*gitlab.ProjectDeployKey, error) {
+func createDeployKey(ctx context.Context, c gitlabClient, ref gitprovider.RepositoryRef, req gitprovider.DeployKeyInfo) (*gitlab.ProjectDeployKey, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
 		return nil, err
 	}
 
-	return c.CreateKey(fmt.Sprintf("%s/%s", ref.GetIdentity(), ref.GetRepository()), deployKeyToAPI(&req))
+	return c.CreateKey(ctx, fmt.Sprintf("%s/%s", ref.GetIdentity(), ref.GetRepository()), deployKeyToAPI(&req))
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// DeploymentClient implements the gitprovider.DeploymentClient interface.
+var _ gitprovider.DeploymentClient = &DeploymentClient{}
+
+// DeploymentClient operates on the deployments of a specific repository.
+// GitLab only keeps the current status of a deployment, which is one of "created", "running",
+// "success", "failed" and "canceled", so the gitprovider.DeploymentState values are mapped
+// onto those.
+type DeploymentClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the deployment with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *DeploymentClient) Get(ctx context.Context, id int64) (gitprovider.Deployment, error) {
+	// GET /projects/{project}/deployments/{id}
+	apiObj, _, err := c.c.Client().Deployments.GetProjectDeployment(getRepoPath(c.ref), int(id), gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newDeployment(c, apiObj), nil
+}
+
+// List lists the deployments of the repository, most recent first. If environment isn't
+// empty, only the deployments to that environment are listed.
+//
+// List returns all available deployments, using multiple paginated requests if needed.
+func (c *DeploymentClient) List(ctx context.Context, environment string) ([]gitprovider.Deployment, error) {
+	opts := &gitlab.ListProjectDeploymentsOptions{
+		OrderBy: gitlab.Ptr("id"),
+		Sort:    gitlab.Ptr("desc"),
+	}
+	if environment != "" {
+		opts.Environment = &environment
+	}
+	apiObjs := []*gitlab.Deployment{}
+	err := allDeploymentPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/deployments
+		pageObjs, resp, listErr := c.c.Client().Deployments.ListProjectDeployments(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := make([]gitprovider.Deployment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		deployments = append(deployments, newDeployment(c, apiObj))
+	}
+	return gitprovider.LimitItems(ctx, deployments), nil
+}
+
+// ListIter returns an iterator over the deployments List returns, fetching them a page at a time.
+func (c *DeploymentClient) ListIter(ctx context.Context, environment string) *gitprovider.Iterator[gitprovider.Deployment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Deployment, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectDeploymentsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+			OrderBy:     gitlab.Ptr("id"),
+			Sort:        gitlab.Ptr("desc"),
+		}
+		if environment != "" {
+			opts.Environment = &environment
+		}
+		// GET /projects/{project}/deployments
+		apiObjs, resp, err := c.c.Client().Deployments.ListProjectDeployments(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		deployments := make([]gitprovider.Deployment, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			deployments = append(deployments, newDeployment(c, apiObj))
+		}
+		return deployments, pageInfo(resp), nil
+	})
+}
+
+// Create creates a deployment with the given specifications. The environment is created
+// if it doesn't exist.
+func (c *DeploymentClient) Create(ctx context.Context, req gitprovider.DeploymentInfo) (gitprovider.Deployment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.Description != nil {
+		return nil, fmt.Errorf("deployment descriptions: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	// GitLab requires the SHA to be set, and whether ref is a tag
+	// GET /projects/{project}/repository/commits/{ref}
+	commit, _, err := c.c.Client().Commits.GetCommit(getRepoPath(c.ref), req.Ref, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	isTag := true
+	// GET /projects/{project}/repository/tags/{ref}
+	if _, _, err := c.c.Client().Tags.GetTag(getRepoPath(c.ref), req.Ref, gitlab.WithContext(ctx)); err != nil {
+		if err = handleHTTPError(err); !errors.Is(err, gitprovider.ErrNotFound) {
+			return nil, err
+		}
+		isTag = false
+	}
+
+	opts := &gitlab.CreateProjectDeploymentOptions{
+		Environment: &req.Environment,
+		Ref:         &req.Ref,
+		SHA:         &commit.ID,
+		Tag:         &isTag,
+		Status:      gitlab.DeploymentStatus(gitlab.DeploymentStatusCreated),
+	}
+	// POST /projects/{project}/deployments
+	apiObj, _, err := c.c.Client().Deployments.CreateProjectDeployment(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newDeployment(c, apiObj), nil
+}
@@ -37,12 +37,12 @@ type DeployTokenClient struct {
 // Get returns the repository at the given path.
 //
 // ErrNotFound is returned if the resource does not exist.
-func (c *DeployTokenClient) Get(_ context.Context, deployTokenName string) (gitprovider.DeployToken, error) {
-	return c.get(deployTokenName)
+func (c *DeployTokenClient) Get(ctx context.Context, deployTokenName string) (gitprovider.DeployToken, error) {
+	return c.get(ctx, deployTokenName)
 }
 
-func (c *DeployTokenClient) get(deployTokenName string) (*deployToken, error) {
-	deployTokens, err := c.list()
+func (c *DeployTokenClient) get(ctx context.Context, deployTokenName string) (*deployToken, error) {
+	deployTokens, err := c.list(gitprovider.WithoutListOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,8 @@ func (c *DeployTokenClient) get(deployTokenName string) (*deployToken, error) {
 //
 // List returns all available repository deploy tokens for the given type,
 // using multiple paginated requests if needed.
-func (c *DeployTokenClient) List(_ context.Context) ([]gitprovider.DeployToken, error) {
-	dks, err := c.list()
+func (c *DeployTokenClient) List(ctx context.Context) ([]gitprovider.DeployToken, error) {
+	dks, err := c.list(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +69,38 @@ func (c *DeployTokenClient) List(_ context.Context) ([]gitprovider.DeployToken,
 	for _, dk := range dks {
 		tokens = append(tokens, dk)
 	}
-	return tokens, nil
+	return gitprovider.LimitItems(ctx, tokens), nil
+}
+
+// ListIter returns an iterator over the deploy tokens List returns, fetching them a page at a time.
+func (c *DeployTokenClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.DeployToken] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.DeployToken, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectDeployTokensOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/deploy_tokens
+		apiObjs, resp, err := c.c.Client().DeployTokens.ListProjectDeployTokens(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		tokens := make([]gitprovider.DeployToken, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			// filter for active tokens
+			if apiObj.Expired || apiObj.Revoked {
+				continue
+			}
+			if err := validateDeployTokenAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			tokens = append(tokens, newDeployToken(c, apiObj))
+		}
+		// The total includes the expired and revoked tokens, which aren't listed
+		return tokens, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
 }
 
-func (c *DeployTokenClient) list() ([]*deployToken, error) {
+func (c *DeployTokenClient) list(ctx context.Context) ([]*deployToken, error) {
 	// GET /repos/{owner}/{repo}/tokens
-	apiObjs, err := c.c.ListTokens(getRepoPath(c.ref))
+	apiObjs, err := c.c.ListTokens(ctx, getRepoPath(c.ref))
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +118,8 @@ func (c *DeployTokenClient) list() ([]*deployToken, error) {
 // Create creates a deploy token with the given specifications.
 //
 // ErrAlreadyExists will be returned if the resource already exists.
-func (c *DeployTokenClient) Create(_ context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, error) {
-	apiObj, err := createDeployToken(c.c, c.ref, req)
+func (c *DeployTokenClient) Create(ctx context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, error) {
+	apiObj, err := createDeployToken(ctx, c.c, c.ref, req)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +143,7 @@ func (c *DeployTokenClient) Reconcile(ctx context.Context, req gitprovider.Deplo
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating deploy token, as it doesn't exist", "repository", c.ref.String(), "deployToken", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -125,32 +152,26 @@ func (c *DeployTokenClient) Reconcile(ctx context.Context, req gitprovider.Deplo
 		return nil, false, err
 	}
 
+	// Populate the desired state to the current-actual object, so that the recreated
+	// token gets the requested scopes and expiry
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+
 	actionTaken, err := actual.Reconcile(ctx)
 	if err != nil {
 		return nil, false, err
 	}
 
 	return actual, actionTaken, nil
-	//
-	// // If the desired matches the actual state, just return the actual state
-	// if req.Equals(actual.Get()) {
-	// 	return actual, false, nil
-	// }
-	//
-	// // Populate the desired state to the current-actual object
-	// if err := actual.Set(req); err != nil {
-	// 	return actual, false, err
-	// }
-	// // Apply the desired state by running Update
-	// return actual, true, actual.Update(ctx)
 }
 
-func createDeployToken(c gitlabClient, ref gitprovider.RepositoryRef, req gitprovider.DeployTokenInfo) (*gitlab.DeployToken, error) {
+func createDeployToken(ctx context.Context, c gitlabClient, ref gitprovider.RepositoryRef, req gitprovider.DeployTokenInfo) (*gitlab.DeployToken, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
 		return nil, err
 	}
 
-	return c.CreateToken(fmt.Sprintf("%s/%s", ref.GetIdentity(), ref.GetRepository()), deployTokenToAPI(&req))
+	return c.CreateToken(ctx, fmt.Sprintf("%s/%s", ref.GetIdentity(), ref.GetRepository()), deployTokenToAPI(&req))
 }
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestDeployTokenClient_Reconcile_existing(t *testing.T) {
+	var deleted bool
+	var created struct {
+		Name      string   `json:"name"`
+		Scopes    []string `json:"scopes"`
+		ExpiresAt string   `json:"expires_at"`
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/deploy_tokens", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":3,"name":"flux","username":"gitlab+deploy-token-3","scopes":["read_repository"]}]`))
+	})
+	mux.HandleFunc("DELETE /api/v4/projects/fluxcd%2Fflux2/deploy_tokens/3", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /api/v4/projects/fluxcd%2Fflux2/deploy_tokens", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Errorf("decoding create request: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"id":4,"name":"flux","username":"gitlab+deploy-token-4","token":"secret","scopes":["read_repository","read_registry"],"expires_at":"2027-01-31T00:00:00Z"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient("", "", "token", TokenTypePat, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens := &DeployTokenClient{
+		clientContext: c.(*Client).clientContext,
+		ref: gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+			RepositoryName:  "flux2",
+		},
+	}
+
+	expiresAt := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	token, actionTaken, err := tokens.Reconcile(context.Background(), gitprovider.DeployTokenInfo{
+		Name:      "flux",
+		Scopes:    []gitprovider.DeployTokenScope{gitprovider.DeployTokenScopeReadRepository, gitprovider.DeployTokenScopeReadRegistry},
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if !actionTaken {
+		t.Error("Reconcile() actionTaken = false, want true")
+	}
+	if !deleted {
+		t.Error("Reconcile() didn't delete the existing token")
+	}
+	if want := []string{"read_repository", "read_registry"}; !reflect.DeepEqual(created.Scopes, want) {
+		t.Errorf("create request scopes = %v, want %v", created.Scopes, want)
+	}
+	if created.ExpiresAt == "" {
+		t.Error("create request has no expiry")
+	} else if got, err := time.Parse(time.RFC3339, created.ExpiresAt); err != nil || !got.Equal(expiresAt) {
+		t.Errorf("create request expiry = %q, want %s", created.ExpiresAt, expiresAt.Format(time.RFC3339))
+	}
+	if got := token.Get().Token; got != "secret" {
+		t.Errorf("Reconcile() token = %q, want %q", got, "secret")
+	}
+}
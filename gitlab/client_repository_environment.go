@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// EnvironmentClient implements the gitprovider.EnvironmentClient interface.
+var _ gitprovider.EnvironmentClient = &EnvironmentClient{}
+
+// EnvironmentClient operates on the environments of a specific repository.
+// Environments with required reviewers are protected, allowing maintainers to deploy
+// once one of the reviewers has approved. Protected environments require GitLab Premium.
+type EnvironmentClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the environment with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *EnvironmentClient) Get(ctx context.Context, name string) (gitprovider.Environment, error) {
+	return c.get(ctx, name)
+}
+
+func (c *EnvironmentClient) get(ctx context.Context, name string) (*environment, error) {
+	apiObjs, err := c.listEnvironments(gitprovider.WithoutListOptions(ctx), &name)
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if apiObj.Name == name {
+			return c.newEnvironment(ctx, apiObj)
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all environments of the repository.
+//
+// List returns all available environments, using multiple paginated requests if needed.
+func (c *EnvironmentClient) List(ctx context.Context) ([]gitprovider.Environment, error) {
+	apiObjs, err := c.listEnvironments(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	environments := make([]gitprovider.Environment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		env, err := c.newEnvironment(ctx, apiObj)
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, env)
+	}
+	return gitprovider.LimitItems(ctx, environments), nil
+}
+
+// ListIter returns an iterator over the environments List returns, fetching them a page at a time.
+func (c *EnvironmentClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Environment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Environment, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListEnvironmentsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		// GET /projects/{project}/environments
+		apiObjs, resp, err := c.c.Client().Environments.ListEnvironments(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		environments := make([]gitprovider.Environment, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			env, err := c.newEnvironment(ctx, apiObj)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			environments = append(environments, env)
+		}
+		return environments, pageInfo(resp), nil
+	})
+}
+
+// Create creates an environment with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *EnvironmentClient) Create(ctx context.Context, req gitprovider.EnvironmentInfo) (gitprovider.Environment, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if req.WaitTimer != nil {
+		return nil, fmt.Errorf("environment wait timers: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	opts := &gitlab.CreateEnvironmentOptions{
+		Name:        &req.Name,
+		ExternalURL: req.ExternalURL,
+	}
+	// POST /projects/{project}/environments
+	apiObj, _, err := c.c.Client().Environments.CreateEnvironment(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := c.protect(ctx, req); err != nil {
+		return nil, err
+	}
+	return &environment{e: *apiObj, info: req, c: c}, nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *EnvironmentClient) Reconcile(ctx context.Context, req gitprovider.EnvironmentInfo) (gitprovider.Environment, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating environment, as it doesn't exist", "repository", c.ref.String(), "environment", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("environment is up to date", "repository", c.ref.String(), "environment", req.Name)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating environment to the desired state", "repository", c.ref.String(), "environment", req.Name)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// newEnvironment wraps apiObj, and looks up its required reviewers.
+func (c *EnvironmentClient) newEnvironment(ctx context.Context, apiObj *gitlab.Environment) (*environment, error) {
+	info := gitprovider.EnvironmentInfo{
+		Name: apiObj.Name,
+	}
+	if apiObj.ExternalURL != "" {
+		info.ExternalURL = gitprovider.StringVar(apiObj.ExternalURL)
+	}
+	reviewers, err := c.getReviewers(ctx, apiObj.Name)
+	if err != nil {
+		return nil, err
+	}
+	info.Reviewers = reviewers
+	return &environment{e: *apiObj, info: info, c: c}, nil
+}
+
+func (c *EnvironmentClient) listEnvironments(ctx context.Context, name *string) ([]*gitlab.Environment, error) {
+	opts := &gitlab.ListEnvironmentsOptions{Name: name}
+	apiObjs := []*gitlab.Environment{}
+	err := allEnvironmentPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/environments
+		pageObjs, resp, listErr := c.c.Client().Environments.ListEnvironments(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+// getReviewers returns the approvers of the protected environment with the given name,
+// or nil if the environment isn't protected.
+func (c *EnvironmentClient) getReviewers(ctx context.Context, name string) ([]gitprovider.EnvironmentReviewer, error) {
+	// GET /projects/{project}/protected_environments/{name}
+	protected, _, err := c.c.Client().ProtectedEnvironments.GetProtectedEnvironment(getRepoPath(c.ref), name, gitlab.WithContext(ctx))
+	if err != nil {
+		if err = handleHTTPError(err); errors.Is(err, gitprovider.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var reviewers []gitprovider.EnvironmentReviewer
+	for _, rule := range protected.ApprovalRules {
+		switch {
+		case rule.UserID != 0:
+			// GET /users/{id}
+			user, _, err := c.c.Client().Users.GetUser(rule.UserID, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, handleHTTPError(err)
+			}
+			reviewers = append(reviewers, gitprovider.EnvironmentReviewer{
+				Type: gitprovider.EnvironmentReviewerTypeUser,
+				Name: user.Username,
+			})
+		case rule.GroupID != 0:
+			// GET /groups/{id}
+			group, _, err := c.c.Client().Groups.GetGroup(rule.GroupID, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, handleHTTPError(err)
+			}
+			reviewers = append(reviewers, gitprovider.EnvironmentReviewer{
+				Type: gitprovider.EnvironmentReviewerTypeTeam,
+				Name: group.FullPath,
+			})
+		}
+	}
+	return reviewers, nil
+}
+
+// protect protects the environment with an approval rule per reviewer, if there are any.
+func (c *EnvironmentClient) protect(ctx context.Context, req gitprovider.EnvironmentInfo) error {
+	if len(req.Reviewers) == 0 {
+		return nil
+	}
+
+	rules := make([]*gitlab.EnvironmentApprovalRuleOptions, 0, len(req.Reviewers))
+	for _, reviewer := range req.Reviewers {
+		rule, err := c.resolveReviewer(ctx, reviewer)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+	opts := &gitlab.ProtectRepositoryEnvironmentsOptions{
+		Name: &req.Name,
+		DeployAccessLevels: &[]*gitlab.EnvironmentAccessOptions{
+			{AccessLevel: gitlab.AccessLevel(gitlab.MaintainerPermissions)},
+		},
+		ApprovalRules: &rules,
+	}
+	// POST /projects/{project}/protected_environments
+	_, _, err := c.c.Client().ProtectedEnvironments.ProtectRepositoryEnvironments(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// unprotect removes the protection of the environment, if any.
+func (c *EnvironmentClient) unprotect(ctx context.Context, name string) error {
+	// DELETE /projects/{project}/protected_environments/{name}
+	_, err := c.c.Client().ProtectedEnvironments.UnprotectEnvironment(getRepoPath(c.ref), name, gitlab.WithContext(ctx))
+	if err = handleHTTPError(err); errors.Is(err, gitprovider.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// resolveReviewer looks up the ID of the given user or group.
+func (c *EnvironmentClient) resolveReviewer(ctx context.Context, reviewer gitprovider.EnvironmentReviewer) (*gitlab.EnvironmentApprovalRuleOptions, error) {
+	if reviewer.Type == gitprovider.EnvironmentReviewerTypeTeam {
+		// GET /groups/{path}
+		group, _, err := c.c.Client().Groups.GetGroup(reviewer.Name, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		return &gitlab.EnvironmentApprovalRuleOptions{GroupID: &group.ID}, nil
+	}
+	// GET /users?username={username}
+	users, _, err := c.c.Client().Users.ListUsers(&gitlab.ListUsersOptions{Username: &reviewer.Name}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %q: %w", reviewer.Name, gitprovider.ErrNotFound)
+	}
+	return &gitlab.EnvironmentApprovalRuleOptions{UserID: &users[0].ID}, nil
+}
@@ -39,6 +39,10 @@ type FileClient struct {
 // If a file path is given, the contents of the file are returned
 // If a directory path is given, the contents of the files in the path's root are returned
 func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...gitprovider.FilesGetOption) ([]*gitprovider.CommitFile, error) {
+	path, err := gitprovider.NormalizePath(path)
+	if err != nil {
+		return nil, err
+	}
 	filesGetOpts := gitprovider.FilesGetOptions{}
 
 	for _, opt := range optFns {
@@ -51,7 +55,7 @@ func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...git
 		Recursive: &filesGetOpts.Recursive,
 	}
 
-	listFiles, _, err := c.c.Client().Repositories.ListTree(getRepoPath(c.ref), opts)
+	listFiles, _, err := c.c.Client().Repositories.ListTree(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +69,7 @@ func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...git
 		if file.Type == "tree" {
 			continue
 		}
-		fileDownloaded, _, err := c.c.Client().RepositoryFiles.GetFile(getRepoPath(c.ref), file.Path, fileOpts)
+		fileDownloaded, _, err := c.c.Client().RepositoryFiles.GetFile(getRepoPath(c.ref), file.Path, fileOpts, gitlab.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +87,7 @@ func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...git
 	}
 
 	if len(listFiles) <= 0 {
-		fileDownloaded, _, err := c.c.Client().RepositoryFiles.GetFile(getRepoPath(c.ref), path, fileOpts)
+		fileDownloaded, _, err := c.c.Client().RepositoryFiles.GetFile(getRepoPath(c.ref), path, fileOpts, gitlab.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+// FreezePeriodClient implements the experimental.FreezePeriodClient interface.
+var _ experimental.FreezePeriodClient = &FreezePeriodClient{}
+
+// FreezePeriodClient operates on the deploy freeze periods of a specific project.
+type FreezePeriodClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ExperimentalAPI implements experimental.API.
+func (c *FreezePeriodClient) ExperimentalAPI() {}
+
+// Get returns the freeze period with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *FreezePeriodClient) Get(ctx context.Context, id int) (experimental.FreezePeriod, error) {
+	// GET /projects/{project}/freeze_periods/{freeze_period_id}
+	apiObj, _, err := c.c.Client().FreezePeriods.GetFreezePeriod(getRepoPath(c.ref), id, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newFreezePeriod(c, apiObj), nil
+}
+
+// List lists all freeze periods of the project.
+//
+// List returns all available freeze periods, using multiple paginated requests if needed.
+func (c *FreezePeriodClient) List(ctx context.Context) ([]experimental.FreezePeriod, error) {
+	opts := &gitlab.ListFreezePeriodsOptions{}
+	periods := []experimental.FreezePeriod{}
+	err := allFreezePeriodPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/freeze_periods
+		pageObjs, resp, listErr := c.c.Client().FreezePeriods.ListFreezePeriods(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			periods = append(periods, newFreezePeriod(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, periods), nil
+}
+
+// ListIter returns an iterator over the freeze periods List returns, fetching them a page at a time.
+func (c *FreezePeriodClient) ListIter(ctx context.Context) *gitprovider.Iterator[experimental.FreezePeriod] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]experimental.FreezePeriod, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListFreezePeriodsOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/freeze_periods
+		apiObjs, resp, err := c.c.Client().FreezePeriods.ListFreezePeriods(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		periods := make([]experimental.FreezePeriod, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			periods = append(periods, newFreezePeriod(c, apiObj))
+		}
+		return periods, pageInfo(resp), nil
+	})
+}
+
+// Create creates a freeze period with the given specifications.
+func (c *FreezePeriodClient) Create(ctx context.Context, req experimental.FreezePeriodInfo) (experimental.FreezePeriod, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	opts := &gitlab.CreateFreezePeriodOptions{
+		FreezeStart: gitlab.Ptr(req.FreezeStart),
+		FreezeEnd:   gitlab.Ptr(req.FreezeEnd),
+	}
+	if req.CronTimezone != "" {
+		opts.CronTimezone = gitlab.Ptr(req.CronTimezone)
+	}
+	// POST /projects/{project}/freeze_periods
+	apiObj, _, err := c.c.Client().FreezePeriods.CreateFreezePeriodOptions(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newFreezePeriod(c, apiObj), nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific project. Issues are identified by their
+// project-specific IID.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given IID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *IssueClient) Get(ctx context.Context, number int) (gitprovider.Issue, error) {
+	// GET /projects/{project}/issues/{iid}
+	apiObj, _, err := c.c.Client().Issues.GetIssue(getRepoPath(c.ref), number, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// List lists all open issues of the project.
+//
+// List returns all available issues, using multiple paginated requests if needed.
+func (c *IssueClient) List(ctx context.Context) ([]gitprovider.Issue, error) {
+	opts := &gitlab.ListProjectIssuesOptions{
+		State: gitlab.Ptr("opened"),
+	}
+	issues := []gitprovider.Issue{}
+	err := allProjectIssuePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/issues
+		pageObjs, resp, listErr := c.c.Client().Issues.ListProjectIssues(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			issues = append(issues, newIssue(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, issues), nil
+}
+
+// ListIter returns an iterator over the issues List returns, fetching them a page at a time.
+func (c *IssueClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Issue] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Issue, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectIssuesOptions{
+			State:       gitlab.Ptr("opened"),
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		// GET /projects/{project}/issues
+		apiObjs, resp, err := c.c.Client().Issues.ListProjectIssues(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		issues := make([]gitprovider.Issue, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			issues = append(issues, newIssue(c, apiObj))
+		}
+		return issues, pageInfo(resp), nil
+	})
+}
+
+// Create creates an issue with the given specifications.
+func (c *IssueClient) Create(ctx context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	opts := &gitlab.CreateIssueOptions{
+		Title:       gitlab.Ptr(req.Title),
+		Description: gitlab.Ptr(req.Description),
+	}
+	if req.Labels != nil {
+		opts.Labels = gitlab.Ptr(gitlab.LabelOptions(req.Labels))
+	}
+	if req.Assignees != nil {
+		ids, err := c.userIDs(ctx, req.Assignees)
+		if err != nil {
+			return nil, err
+		}
+		opts.AssigneeIDs = &ids
+	}
+	// POST /projects/{project}/issues
+	apiObj, _, err := c.c.Client().Issues.CreateIssue(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Issues can't be created closed
+	if req.State == gitprovider.IssueStateClosed {
+		issue := newIssue(c, apiObj)
+		return issue, issue.Close(ctx)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// userIDs looks up the IDs of the users with the given usernames.
+func (c *IssueClient) userIDs(ctx context.Context, usernames []string) ([]int, error) {
+	ids := make([]int, 0, len(usernames))
+	for _, username := range usernames {
+		// GET /users?username={username}
+		users, _, err := c.c.Client().Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr(username)}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("user %q: %w", username, gitprovider.ErrNotFound)
+		}
+		ids = append(ids, users[0].ID)
+	}
+	return ids, nil
+}
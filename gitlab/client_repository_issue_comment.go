@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommentClient implements the gitprovider.CommentClient interface.
+var _ gitprovider.CommentClient = &CommentClient{}
+
+// CommentClient operates on the notes of a specific issue.
+type CommentClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+	iid int
+}
+
+// List lists all notes of the issue, oldest first. System notes, e.g. for label changes,
+// are skipped.
+//
+// List returns all available comments, using multiple paginated requests if needed.
+func (c *CommentClient) List(ctx context.Context) ([]gitprovider.Comment, error) {
+	opts := &gitlab.ListIssueNotesOptions{
+		OrderBy: gitlab.Ptr("created_at"),
+		Sort:    gitlab.Ptr("asc"),
+	}
+	comments := []gitprovider.Comment{}
+	err := allIssueNotePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/issues/{iid}/notes
+		pageObjs, resp, listErr := c.c.Client().Notes.ListIssueNotes(getRepoPath(c.ref), c.iid, opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			if !apiObj.System {
+				comments = append(comments, newComment(c, apiObj))
+			}
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, comments), nil
+}
+
+// ListIter returns an iterator over the comments List returns, fetching them a page at a time.
+func (c *CommentClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Comment] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Comment, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListIssueNotesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+			OrderBy:     gitlab.Ptr("created_at"),
+			Sort:        gitlab.Ptr("asc"),
+		}
+		// GET /projects/{project}/issues/{iid}/notes
+		apiObjs, resp, err := c.c.Client().Notes.ListIssueNotes(getRepoPath(c.ref), c.iid, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		comments := make([]gitprovider.Comment, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if !apiObj.System {
+				comments = append(comments, newComment(c, apiObj))
+			}
+		}
+		// The total includes the system notes, which aren't comments
+		return comments, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+// Create creates a note with the given specifications.
+func (c *CommentClient) Create(ctx context.Context, req gitprovider.CommentInfo) (gitprovider.Comment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /projects/{project}/issues/{iid}/notes
+	apiObj, _, err := c.c.Client().Notes.CreateIssueNote(getRepoPath(c.ref), c.iid, &gitlab.CreateIssueNoteOptions{
+		Body: gitlab.Ptr(req.Body),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newComment(c, apiObj), nil
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// pullMirrorID is the ID of the pull mirror of a project, as there can only be one.
+const pullMirrorID = "pull"
+
+// MirrorClient implements the gitprovider.MirrorClient interface.
+var _ gitprovider.MirrorClient = &MirrorClient{}
+
+// MirrorClient operates on the mirrors of a specific repository.
+// Push mirrors are GitLab remote mirrors. The pull mirror is part of the project settings,
+// and requires GitLab Premium.
+type MirrorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the mirror with the given ID, which is "pull" for the pull mirror.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *MirrorClient) Get(ctx context.Context, id string) (gitprovider.Mirror, error) {
+	return c.get(ctx, id)
+}
+
+func (c *MirrorClient) get(ctx context.Context, id string) (*mirror, error) {
+	if id == pullMirrorID {
+		project, err := c.getProject(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !project.Mirror {
+			return nil, gitprovider.ErrNotFound
+		}
+		return newPullMirror(c, project), nil
+	}
+
+	mirrorID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mirror ID %q: %w", id, gitprovider.ErrInvalidArgument)
+	}
+	// GET /projects/{project}/remote_mirrors/{id}
+	apiObj, _, err := c.c.Client().ProjectMirrors.GetProjectMirror(getRepoPath(c.ref), mirrorID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newPushMirror(c, apiObj), nil
+}
+
+// List lists all mirrors of the repository.
+//
+// List returns all available mirrors, using multiple paginated requests if needed.
+func (c *MirrorClient) List(ctx context.Context) ([]gitprovider.Mirror, error) {
+	mirrors, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]gitprovider.Mirror, 0, len(mirrors))
+	for _, m := range mirrors {
+		result = append(result, m)
+	}
+	return gitprovider.LimitItems(ctx, result), nil
+}
+
+// ListIter returns an iterator over the mirrors List returns, fetching them a page at a time.
+// The pull mirror, if any, comes first on the first page.
+func (c *MirrorClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Mirror] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Mirror, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectMirrorOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/remote_mirrors
+		apiObjs, resp, err := c.c.Client().ProjectMirrors.ListProjectMirror(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		mirrors := make([]gitprovider.Mirror, 0, len(apiObjs)+1)
+		if page == 1 {
+			project, err := c.getProject(ctx)
+			if err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			if project.Mirror {
+				mirrors = append(mirrors, newPullMirror(c, project))
+			}
+		}
+		for _, apiObj := range apiObjs {
+			mirrors = append(mirrors, newPushMirror(c, apiObj))
+		}
+		// The total only counts the push mirrors
+		return mirrors, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
+}
+
+func (c *MirrorClient) list(ctx context.Context) ([]*mirror, error) {
+	opts := &gitlab.ListProjectMirrorOptions{}
+	apiObjs := []*gitlab.ProjectMirror{}
+	err := allProjectMirrorPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/remote_mirrors
+		pageObjs, resp, listErr := c.c.Client().ProjectMirrors.ListProjectMirror(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mirrors := make([]*mirror, 0, len(apiObjs)+1)
+	project, err := c.getProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if project.Mirror {
+		mirrors = append(mirrors, newPullMirror(c, project))
+	}
+	for _, apiObj := range apiObjs {
+		mirrors = append(mirrors, newPushMirror(c, apiObj))
+	}
+	return mirrors, nil
+}
+
+// Create creates a mirror with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *MirrorClient) Create(ctx context.Context, req gitprovider.MirrorInfo) (gitprovider.Mirror, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := validateMirrorInfo(req); err != nil {
+		return nil, err
+	}
+
+	if req.Direction == gitprovider.MirrorDirectionPull {
+		project, err := c.getProject(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if project.Mirror {
+			return nil, fmt.Errorf("project %s already has a pull mirror: %w", getRepoPath(c.ref), gitprovider.ErrAlreadyExists)
+		}
+		return c.setPullMirror(ctx, req)
+	}
+
+	opts := &gitlab.AddProjectMirrorOptions{
+		URL:                   gitlab.Ptr(mirrorURL(req)),
+		Enabled:               req.Enabled,
+		OnlyProtectedBranches: req.OnlyProtectedBranches,
+	}
+	// Mirrors are enabled by default
+	if opts.Enabled == nil {
+		opts.Enabled = gitlab.Ptr(true)
+	}
+	// POST /projects/{project}/remote_mirrors
+	apiObj, _, err := c.c.Client().ProjectMirrors.AddProjectMirror(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newPushMirror(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *MirrorClient) Reconcile(ctx context.Context, req gitprovider.MirrorInfo) (gitprovider.Mirror, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.find(ctx, req)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating mirror, as it doesn't exist", "repository", c.ref.String(), "direction", req.Direction)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, find should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("mirror is up to date", "repository", c.ref.String(), "direction", req.Direction)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating mirror to the desired state", "repository", c.ref.String(), "direction", req.Direction)
+	// Populate the desired state to the current-actual object
+	req.ID = actual.info.ID
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// find returns the mirror with the same direction and URL as req.
+func (c *MirrorClient) find(ctx context.Context, req gitprovider.MirrorInfo) (*mirror, error) {
+	mirrors, err := c.list(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range mirrors {
+		if m.info.Direction == req.Direction && m.info.URL == req.URL {
+			return m, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+func (c *MirrorClient) getProject(ctx context.Context) (*gitlab.Project, error) {
+	// GET /projects/{project}
+	project, _, err := c.c.Client().Projects.GetProject(getRepoPath(c.ref), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return project, nil
+}
+
+// setPullMirror configures the project to pull from the mirror described by req.
+func (c *MirrorClient) setPullMirror(ctx context.Context, req gitprovider.MirrorInfo) (*mirror, error) {
+	opts := &gitlab.EditProjectOptions{
+		ImportURL:                   gitlab.Ptr(mirrorURL(req)),
+		Mirror:                      gitlab.Ptr(true),
+		OnlyMirrorProtectedBranches: req.OnlyProtectedBranches,
+	}
+	// PUT /projects/{project}
+	project, _, err := c.c.Client().Projects.EditProject(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newPullMirror(c, project), nil
+}
+
+// validateMirrorInfo makes sure req only uses fields GitLab supports.
+func validateMirrorInfo(req gitprovider.MirrorInfo) error {
+	if req.Interval != nil {
+		return fmt.Errorf("mirror sync intervals: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.Direction == gitprovider.MirrorDirectionPull && req.Enabled != nil {
+		return fmt.Errorf("disabling pull mirrors: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
+
+// mirrorURL returns the URL of the mirror, including its credentials.
+func mirrorURL(info gitprovider.MirrorInfo) string {
+	if info.Username == nil {
+		return info.URL
+	}
+	u, err := url.Parse(info.URL)
+	if err != nil {
+		// The URL has been validated already
+		return info.URL
+	}
+	if info.Password != nil {
+		u.User = url.UserPassword(*info.Username, *info.Password)
+	} else {
+		u.User = url.User(*info.Username)
+	}
+	return u.String()
+}
+
+// stripMirrorCredentials removes the (masked) credentials GitLab returns as part of mirror URLs.
+func stripMirrorCredentials(mirrorURL string) string {
+	u, err := url.Parse(mirrorURL)
+	if err != nil {
+		return mirrorURL
+	}
+	u.User = nil
+	return u.String()
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PackageClient implements the gitprovider.PackageClient interface.
+var _ gitprovider.PackageClient = &PackageClient{}
+
+// PackageClient operates on the package registry and the container registry of a specific project.
+type PackageClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists the container images in the container registry of the project, followed by
+// the packages in its package registry. GitLab stores every package version separately;
+// they are grouped by package type and name.
+//
+// List returns all available packages, using multiple paginated requests if needed.
+func (c *PackageClient) List(ctx context.Context) ([]gitprovider.Package, error) {
+	packages := []gitprovider.Package{}
+
+	registryOpts := &gitlab.ListRegistryRepositoriesOptions{}
+	err := allRegistryRepositoryPages(ctx, registryOpts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/registry/repositories
+		pageObjs, resp, listErr := c.c.Client().ContainerRegistry.ListProjectRegistryRepositories(getRepoPath(c.ref), registryOpts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			packages = append(packages, newContainerRepository(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := map[gitprovider.PackageInfo]*pkg{}
+	opts := &gitlab.ListProjectPackagesOptions{}
+	err = allPackagePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/packages
+		pageObjs, resp, listErr := c.c.Client().Packages.ListProjectPackages(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			key := gitprovider.PackageInfo{Name: apiObj.Name, Type: gitprovider.PackageType(apiObj.PackageType)}
+			p, ok := grouped[key]
+			if !ok {
+				p = &pkg{info: key, c: c}
+				grouped[key] = p
+				packages = append(packages, p)
+			}
+			p.p = append(p.p, apiObj)
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, packages), nil
+}
+
+// ListIter returns an iterator over the packages List returns. GitLab lists every version of a
+// package on its own, and the versions of a package may be spread over several pages, so they're
+// all fetched and grouped by a single call to List.
+func (c *PackageClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Package] {
+	return gitprovider.ListIterator(ctx, c.List)
+}
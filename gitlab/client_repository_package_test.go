@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTestPackageClient(t *testing.T) gitprovider.PackageClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/registry/repositories", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":5,"name":"","path":"fluxcd/flux2"}]`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/registry/repositories/5/tags", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"name":"v2.0.0","path":"fluxcd/flux2:v2.0.0"},{"name":"latest","path":"fluxcd/flux2:latest"}]`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/packages", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id":1,"name":"flux-js","version":"1.0.0","package_type":"npm"},
+			{"id":2,"name":"manifests","version":"v2.0.0","package_type":"generic"},
+			{"id":3,"name":"flux-js","version":"1.1.0","package_type":"npm","tags":[{"name":"latest"}]}
+		]`))
+	})
+	p := newTestProject(t, mux)
+	packages, err := p.Packages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return packages
+}
+
+func TestPackageClient_List(t *testing.T) {
+	packages, err := newTestPackageClient(t).List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	got := make([]gitprovider.PackageInfo, 0, len(packages))
+	for _, p := range packages {
+		got = append(got, p.Get())
+	}
+	// Container images come first, the package versions are grouped by type and name
+	want := []gitprovider.PackageInfo{
+		{Name: "fluxcd/flux2", Type: gitprovider.PackageTypeContainer},
+		{Name: "flux-js", Type: gitprovider.PackageTypeNPM},
+		{Name: "manifests", Type: gitprovider.PackageTypeGeneric},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("List() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPackage_Versions(t *testing.T) {
+	packages, err := newTestPackageClient(t).List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	tests := []struct {
+		name string
+		want []gitprovider.PackageVersionInfo
+	}{
+		{
+			name: "fluxcd/flux2",
+			want: []gitprovider.PackageVersionInfo{
+				{Version: "v2.0.0", Tags: []string{"v2.0.0"}},
+				{Version: "latest", Tags: []string{"latest"}},
+			},
+		},
+		{
+			name: "flux-js",
+			want: []gitprovider.PackageVersionInfo{
+				{Version: "1.0.0"},
+				{Version: "1.1.0", Tags: []string{"latest"}},
+			},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, err := packages[i].Versions(context.Background())
+			if err != nil {
+				t.Fatalf("Versions() error = %v", err)
+			}
+			got := make([]gitprovider.PackageVersionInfo, 0, len(versions))
+			for _, v := range versions {
+				got = append(got, v.Get())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Versions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
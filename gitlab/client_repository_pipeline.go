@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PipelineClient implements the gitprovider.PipelineClient interface.
+var _ gitprovider.PipelineClient = &PipelineClient{}
+
+// PipelineClient operates on the CI/CD pipelines of a specific project.
+type PipelineClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Trigger creates a pipeline for the given ref, with the variables as pipeline variables.
+// Unlike a pipeline trigger token, this runs the pipeline as the authenticated user.
+func (c *PipelineClient) Trigger(ctx context.Context, ref string, variables map[string]string) error {
+	if ref == "" {
+		return fmt.Errorf("ref is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	opts := &gitlab.CreatePipelineOptions{
+		Ref: &ref,
+	}
+	if len(variables) > 0 {
+		keys := make([]string, 0, len(variables))
+		for key := range variables {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		vars := make([]*gitlab.PipelineVariableOptions, 0, len(keys))
+		for _, key := range keys {
+			vars = append(vars, &gitlab.PipelineVariableOptions{
+				Key:          gitlab.Ptr(key),
+				Value:        gitlab.Ptr(variables[key]),
+				VariableType: gitlab.Ptr(gitlab.EnvVariableType),
+			})
+		}
+		opts.Variables = &vars
+	}
+	// POST /projects/{project}/pipeline
+	_, _, err := c.c.Client().Pipelines.CreatePipeline(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Get returns the pipeline with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *PipelineClient) Get(ctx context.Context, id int64) (gitprovider.Pipeline, error) {
+	// GET /projects/{project}/pipelines/{pipeline_id}
+	apiObj, _, err := c.c.Client().Pipelines.GetPipeline(getRepoPath(c.ref), int(id), gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newPipeline(c, apiObj), nil
+}
+
+// List lists the pipelines for the given branch, tag or full commit SHA, most recent first.
+//
+// List returns all available pipelines, using multiple paginated requests if needed.
+func (c *PipelineClient) List(ctx context.Context, ref string) ([]gitprovider.Pipeline, error) {
+	if ref == "" {
+		return nil, fmt.Errorf("ref is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	opts := &gitlab.ListProjectPipelinesOptions{}
+	if gitprovider.IsCommitSHA(ref) {
+		opts.SHA = &ref
+	} else {
+		opts.Ref = &ref
+	}
+	pipelines := []gitprovider.Pipeline{}
+	err := allProjectPipelinePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/pipelines
+		pageObjs, resp, listErr := c.c.Client().Pipelines.ListProjectPipelines(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			pipelines = append(pipelines, newPipelineFromInfo(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, pipelines), nil
+}
+
+// ListIter returns an iterator over the pipelines List returns, fetching them a page at a time.
+func (c *PipelineClient) ListIter(ctx context.Context, ref string) *gitprovider.Iterator[gitprovider.Pipeline] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Pipeline, gitprovider.PageInfo, error) {
+		if ref == "" {
+			return nil, gitprovider.PageInfo{}, fmt.Errorf("ref is required: %w", gitprovider.ErrInvalidArgument)
+		}
+		opts := &gitlab.ListProjectPipelinesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		if gitprovider.IsCommitSHA(ref) {
+			opts.SHA = &ref
+		} else {
+			opts.Ref = &ref
+		}
+		// GET /projects/{project}/pipelines
+		apiObjs, resp, err := c.c.Client().Pipelines.ListProjectPipelines(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		pipelines := make([]gitprovider.Pipeline, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			pipelines = append(pipelines, newPipelineFromInfo(c, apiObj))
+		}
+		return pipelines, pageInfo(resp), nil
+	})
+}
@@ -38,8 +38,8 @@ type PullRequestClient struct {
 }
 
 // List lists all pull requests in the repository
-func (c *PullRequestClient) List(_ context.Context) ([]gitprovider.PullRequest, error) {
-	mrs, _, err := c.c.Client().MergeRequests.ListProjectMergeRequests(getRepoPath(c.ref), nil)
+func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest, error) {
+	mrs, _, err := c.c.Client().MergeRequests.ListProjectMergeRequests(getRepoPath(c.ref), nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -50,11 +50,30 @@ func (c *PullRequestClient) List(_ context.Context) ([]gitprovider.PullRequest,
 		requests[idx] = newPullRequest(c.clientContext, mr)
 	}
 
-	return requests, nil
+	return gitprovider.LimitItems(ctx, requests), nil
+}
+
+// ListIter returns an iterator over the merge requests of the project, fetching them a page at
+// a time.
+func (c *PullRequestClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.PullRequest] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.PullRequest, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectMergeRequestsOptions{ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}}
+		// GET /projects/{project}/merge_requests
+		mrs, resp, err := c.c.Client().MergeRequests.ListProjectMergeRequests(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		requests := make([]gitprovider.PullRequest, 0, len(mrs))
+		for _, mr := range mrs {
+			requests = append(requests, newPullRequest(c.clientContext, mr))
+		}
+		return requests, pageInfo(resp), nil
+	})
 }
 
 // Create creates a pull request with the given specifications.
-func (c *PullRequestClient) Create(_ context.Context, title, branch, baseBranch, description string) (gitprovider.PullRequest, error) {
+func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranch, description string) (gitprovider.PullRequest, error) {
 
 	prOpts := &gitlab.CreateMergeRequestOptions{
 		Title:        &title,
@@ -63,7 +82,7 @@ func (c *PullRequestClient) Create(_ context.Context, title, branch, baseBranch,
 		Description:  &description,
 	}
 
-	mr, _, err := c.c.Client().MergeRequests.CreateMergeRequest(getRepoPath(c.ref), prOpts)
+	mr, _, err := c.c.Client().MergeRequests.CreateMergeRequest(getRepoPath(c.ref), prOpts, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +95,7 @@ func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovid
 	mrUpdate := &gitlab.UpdateMergeRequestOptions{
 		Title: opts.Title,
 	}
-	editedMR, _, err := c.c.Client().MergeRequests.UpdateMergeRequest(getRepoPath(c.ref), number, mrUpdate)
+	editedMR, _, err := c.c.Client().MergeRequests.UpdateMergeRequest(getRepoPath(c.ref), number, mrUpdate, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -84,9 +103,9 @@ func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovid
 }
 
 // Get retrieves an existing pull request by number
-func (c *PullRequestClient) Get(_ context.Context, number int) (gitprovider.PullRequest, error) {
+func (c *PullRequestClient) Get(ctx context.Context, number int) (gitprovider.PullRequest, error) {
 
-	mr, _, err := c.c.Client().MergeRequests.GetMergeRequest(getRepoPath(c.ref), number, &gitlab.GetMergeRequestsOptions{})
+	mr, _, err := c.c.Client().MergeRequests.GetMergeRequest(getRepoPath(c.ref), number, &gitlab.GetMergeRequestsOptions{}, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -95,8 +114,8 @@ func (c *PullRequestClient) Get(_ context.Context, number int) (gitprovider.Pull
 }
 
 // Merge merges a pull request with the given specifications.
-func (c *PullRequestClient) Merge(_ context.Context, number int, mergeMethod gitprovider.MergeMethod, message string) error {
-	if err := c.waitForMergeRequestToBeMergeable(number); err != nil {
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string) error {
+	if err := c.waitForMergeRequestToBeMergeable(ctx, number); err != nil {
 		return err
 	}
 
@@ -124,7 +143,7 @@ func (c *PullRequestClient) Merge(_ context.Context, number int, mergeMethod git
 		SHA:                       nil,
 	}
 
-	_, _, err := c.c.Client().MergeRequests.AcceptMergeRequest(getRepoPath(c.ref), number, amrOpts)
+	_, _, err := c.c.Client().MergeRequests.AcceptMergeRequest(getRepoPath(c.ref), number, amrOpts, gitlab.WithContext(ctx))
 	if err != nil {
 		return err
 	}
@@ -132,12 +151,16 @@ func (c *PullRequestClient) Merge(_ context.Context, number int, mergeMethod git
 	return nil
 }
 
-func (c *PullRequestClient) waitForMergeRequestToBeMergeable(number int) error {
+func (c *PullRequestClient) waitForMergeRequestToBeMergeable(ctx context.Context, number int) error {
 	// gitlab says to poll for merge status
 	for retries := 0; retries < 10; retries++ {
-		mr, _, err := c.c.Client().MergeRequests.GetMergeRequest(getRepoPath(c.ref), number, &gitlab.GetMergeRequestsOptions{})
+		mr, _, err := c.c.Client().MergeRequests.GetMergeRequest(getRepoPath(c.ref), number, &gitlab.GetMergeRequestsOptions{}, gitlab.WithContext(ctx))
 		if err != nil || mr.MergeStatus == mergeStatusChecking {
-			time.Sleep(time.Second * 2)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second * 2):
+			}
 			continue
 		}
 
@@ -146,3 +169,45 @@ func (c *PullRequestClient) waitForMergeRequestToBeMergeable(number int) error {
 
 	return fmt.Errorf("merge status unavailable for pull request number: %d", number)
 }
+
+// EnableAutoMerge sets the merge request to merge when its pipeline succeeds. If merge trains
+// are enabled for the project, the merge request is added to the merge train instead.
+func (c *PullRequestClient) EnableAutoMerge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	var squash bool
+	switch mergeMethod {
+	case gitprovider.MergeMethodSquash:
+		squash = true
+	case gitprovider.MergeMethodMerge:
+	default:
+		return fmt.Errorf("unknown merge method: %s", mergeMethod)
+	}
+
+	// GET /projects/{project}
+	project, _, err := c.c.Client().Projects.GetProject(getRepoPath(c.ref), &gitlab.GetProjectOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	if project.MergeTrainsEnabled {
+		// POST /projects/{project}/merge_trains/merge_requests/{merge_request_iid}
+		_, _, err = c.c.Client().MergeTrains.AddMergeRequestToMergeTrain(getRepoPath(c.ref), number, &gitlab.AddMergeRequestToMergeTrainOptions{
+			WhenPipelineSucceeds: gitlab.Ptr(true),
+			Squash:               &squash,
+		}, gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	}
+
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/merge
+	_, _, err = c.c.Client().MergeRequests.AcceptMergeRequest(getRepoPath(c.ref), number, &gitlab.AcceptMergeRequestOptions{
+		Squash:                    &squash,
+		MergeWhenPipelineSucceeds: gitlab.Ptr(true),
+	}, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// DisableAutoMerge cancels merging when the pipeline succeeds, which also takes
+// the merge request off the merge train.
+func (c *PullRequestClient) DisableAutoMerge(ctx context.Context, number int) error {
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/cancel_merge_when_pipeline_succeeds
+	_, _, err := c.c.Client().MergeRequests.CancelMergeWhenPipelineSucceeds(getRepoPath(c.ref), number, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
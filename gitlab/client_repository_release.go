@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+// ReleaseClient implements the experimental.ReleaseClient interface.
+var _ experimental.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific project.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ExperimentalAPI implements experimental.API.
+func (c *ReleaseClient) ExperimentalAPI() {}
+
+// Get returns the release for the given tag.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ReleaseClient) Get(ctx context.Context, tag string) (experimental.Release, error) {
+	// GET /projects/{project}/releases/{tag_name}
+	apiObj, _, err := c.c.Client().Releases.GetRelease(getRepoPath(c.ref), tag, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// List lists all releases of the project, newest first.
+//
+// List returns all available releases, using multiple paginated requests if needed.
+func (c *ReleaseClient) List(ctx context.Context) ([]experimental.Release, error) {
+	opts := &gitlab.ListReleasesOptions{}
+	releases := []experimental.Release{}
+	err := allReleasePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/releases
+		pageObjs, resp, listErr := c.c.Client().Releases.ListReleases(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			releases = append(releases, newRelease(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, releases), nil
+}
+
+// ListIter returns an iterator over the releases List returns, fetching them a page at a time.
+func (c *ReleaseClient) ListIter(ctx context.Context) *gitprovider.Iterator[experimental.Release] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]experimental.Release, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListReleasesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+		}
+		// GET /projects/{project}/releases
+		apiObjs, resp, err := c.c.Client().Releases.ListReleases(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		releases := make([]experimental.Release, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			releases = append(releases, newRelease(c, apiObj))
+		}
+		return releases, pageInfo(resp), nil
+	})
+}
+
+// Create creates a release with the given specifications. GitLab has no draft releases or
+// pre-releases, so setting Draft or Prerelease returns ErrNoProviderSupport.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *ReleaseClient) Create(ctx context.Context, req experimental.ReleaseInfo) (experimental.Release, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.Draft || req.Prerelease {
+		return nil, fmt.Errorf("draft releases and pre-releases: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if _, err := c.Get(ctx, req.TagName); err == nil {
+		return nil, fmt.Errorf("release %q: %w", req.TagName, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	opts := &gitlab.CreateReleaseOptions{
+		TagName:     gitlab.Ptr(req.TagName),
+		Ref:         req.Target,
+		Description: gitlab.Ptr(req.Description),
+	}
+	if req.Name != "" {
+		opts.Name = gitlab.Ptr(req.Name)
+	}
+	// The tag is created from the default branch if no ref is given
+	if opts.Ref == nil {
+		project, _, err := c.c.Client().Projects.GetProject(getRepoPath(c.ref), nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		opts.Ref = gitlab.Ptr(project.DefaultBranch)
+	}
+	// POST /projects/{project}/releases
+	apiObj, _, err := c.c.Client().Releases.CreateRelease(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newRelease(c, apiObj), nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ScheduleClient implements the gitprovider.ScheduleClient interface.
+var _ gitprovider.ScheduleClient = &ScheduleClient{}
+
+// ScheduleClient operates on the pipeline schedules of a specific repository.
+type ScheduleClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the pipeline schedule with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *ScheduleClient) Get(ctx context.Context, id string) (gitprovider.Schedule, error) {
+	scheduleID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule ID %q: %w", id, gitprovider.ErrInvalidArgument)
+	}
+	// GET /projects/{project}/pipeline_schedules/{id}
+	apiObj, _, err := c.c.Client().PipelineSchedules.GetPipelineSchedule(getRepoPath(c.ref), scheduleID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newSchedule(c, apiObj), nil
+}
+
+// List lists all pipeline schedules of the repository.
+//
+// List returns all available schedules, using multiple paginated requests if needed.
+func (c *ScheduleClient) List(ctx context.Context) ([]gitprovider.Schedule, error) {
+	schedules, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]gitprovider.Schedule, 0, len(schedules))
+	for _, s := range schedules {
+		result = append(result, s)
+	}
+	return gitprovider.LimitItems(ctx, result), nil
+}
+
+// ListIter returns an iterator over the schedules List returns, fetching them a page at a time.
+func (c *ScheduleClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Schedule] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Schedule, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListPipelineSchedulesOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/pipeline_schedules
+		apiObjs, resp, err := c.c.Client().PipelineSchedules.ListPipelineSchedules(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		schedules := make([]gitprovider.Schedule, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			schedules = append(schedules, newSchedule(c, apiObj))
+		}
+		return schedules, pageInfo(resp), nil
+	})
+}
+
+func (c *ScheduleClient) list(ctx context.Context) ([]*schedule, error) {
+	opts := &gitlab.ListPipelineSchedulesOptions{}
+	apiObjs := []*gitlab.PipelineSchedule{}
+	err := allPipelineSchedulePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/pipeline_schedules
+		pageObjs, resp, listErr := c.c.Client().PipelineSchedules.ListPipelineSchedules(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*schedule, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		schedules = append(schedules, newSchedule(c, apiObj))
+	}
+	return schedules, nil
+}
+
+// Create creates a pipeline schedule with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *ScheduleClient) Create(ctx context.Context, req gitprovider.ScheduleInfo) (gitprovider.Schedule, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// GitLab allows several schedules with the same description, which would break Reconcile
+	if _, err := c.find(ctx, req.Description); err == nil {
+		return nil, fmt.Errorf("schedule %q: %w", req.Description, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	opts := &gitlab.CreatePipelineScheduleOptions{
+		Description:  gitlab.Ptr(req.Description),
+		Ref:          gitlab.Ptr(req.Ref),
+		Cron:         gitlab.Ptr(req.Cron),
+		CronTimezone: req.CronTimezone,
+		Active:       req.Active,
+	}
+	// POST /projects/{project}/pipeline_schedules
+	apiObj, _, err := c.c.Client().PipelineSchedules.CreatePipelineSchedule(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newSchedule(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *ScheduleClient) Reconcile(ctx context.Context, req gitprovider.ScheduleInfo) (gitprovider.Schedule, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.find(ctx, req.Description)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating schedule, as it doesn't exist", "repository", c.ref.String(), "schedule", req.Description)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, find should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("schedule is up to date", "repository", c.ref.String(), "schedule", req.Description)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating schedule to the desired state", "repository", c.ref.String(), "schedule", req.Description)
+	// Populate the desired state to the current-actual object
+	req.ID = actual.info.ID
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// find returns the schedule with the given description.
+func (c *ScheduleClient) find(ctx context.Context, description string) (*schedule, error) {
+	schedules, err := c.list(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schedules {
+		if s.info.Description == description {
+			return s, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// SnippetClient implements the experimental.SnippetClient interface.
+var _ experimental.SnippetClient = &SnippetClient{}
+
+// SnippetClient operates on the snippets of a specific project.
+type SnippetClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ExperimentalAPI implements experimental.API.
+func (c *SnippetClient) ExperimentalAPI() {}
+
+// Get returns the snippet with the given ID.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *SnippetClient) Get(ctx context.Context, id int) (experimental.Snippet, error) {
+	// GET /projects/{project}/snippets/{snippet_id}
+	apiObj, _, err := c.c.Client().ProjectSnippets.GetSnippet(getRepoPath(c.ref), id, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newSnippet(c, apiObj), nil
+}
+
+// List lists all snippets of the project.
+//
+// List returns all available snippets, using multiple paginated requests if needed.
+func (c *SnippetClient) List(ctx context.Context) ([]experimental.Snippet, error) {
+	opts := &gitlab.ListProjectSnippetsOptions{}
+	snippets := []experimental.Snippet{}
+	err := allProjectSnippetPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/snippets
+		pageObjs, resp, listErr := c.c.Client().ProjectSnippets.ListSnippets(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			snippets = append(snippets, newSnippet(c, apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitprovider.LimitItems(ctx, snippets), nil
+}
+
+// ListIter returns an iterator over the snippets List returns, fetching them a page at a time.
+func (c *SnippetClient) ListIter(ctx context.Context) *gitprovider.Iterator[experimental.Snippet] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]experimental.Snippet, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectSnippetsOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/snippets
+		apiObjs, resp, err := c.c.Client().ProjectSnippets.ListSnippets(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		snippets := make([]experimental.Snippet, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			snippets = append(snippets, newSnippet(c, apiObj))
+		}
+		return snippets, pageInfo(resp), nil
+	})
+}
+
+// Create creates a snippet with the given specifications.
+func (c *SnippetClient) Create(ctx context.Context, req experimental.SnippetInfo) (experimental.Snippet, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if len(req.Content) == 0 {
+		validator := validation.New("Snippet")
+		validator.Required("Content")
+		return nil, validator.Error()
+	}
+	opts := &gitlab.CreateProjectSnippetOptions{
+		Title:       gitlab.Ptr(req.Title),
+		FileName:    gitlab.Ptr(req.FileName),
+		Description: gitlab.Ptr(req.Description),
+		Content:     gitlab.Ptr(req.Content),
+	}
+	if req.Visibility != nil {
+		opts.Visibility = gitlab.Ptr(gitlab.VisibilityValue(*req.Visibility))
+	}
+	// POST /projects/{project}/snippets
+	apiObj, _, err := c.c.Client().ProjectSnippets.CreateSnippet(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return newSnippet(c, apiObj), nil
+}
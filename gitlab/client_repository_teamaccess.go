@@ -97,7 +97,14 @@ func (c *TeamAccessClient) List(ctx context.Context) ([]gitprovider.TeamAccess,
 		}))
 	}
 
-	return result, nil
+	return gitprovider.LimitItems(ctx, result), nil
+}
+
+// ListIter returns an iterator over the team accesses List returns. The groups a project is
+// shared with are part of the project rather than a paginated list, so they're all fetched by
+// a single call to List.
+func (c *TeamAccessClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.TeamAccess] {
+	return gitprovider.ListIterator(ctx, c.List)
 }
 
 // Create adds a given team to the repo's team access control list.
@@ -118,7 +125,7 @@ func (c *TeamAccessClient) Create(ctx context.Context, req gitprovider.TeamAcces
 	if err != nil {
 		return nil, err
 	}
-	if err := c.c.ShareProject(getRepoPath(c.ref), group.ID, gitlabPermission); err != nil {
+	if err := c.c.ShareProject(ctx, getRepoPath(c.ref), group.ID, gitlabPermission); err != nil {
 		return nil, err
 	}
 
@@ -143,6 +150,7 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team access, as it doesn't exist", "repository", c.ref.String(), "team", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -153,9 +161,11 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team access is up to date", "repository", c.ref.String(), "team", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating team access to the desired state", "repository", c.ref.String(), "team", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
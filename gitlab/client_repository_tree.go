@@ -41,13 +41,17 @@ func (c *TreeClient) Get(ctx context.Context, sha string, recursive bool) (*gitp
 
 // List files (blob) in a tree, sha is represented by the branch name
 func (c *TreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
+	path, err := gitprovider.NormalizePath(path)
+	if err != nil {
+		return nil, err
+	}
 	opts := &gitlab.ListTreeOptions{
 		Path:      &path,
 		Ref:       &sha,
 		Recursive: &recursive,
 	}
 
-	treeFiles, _, err := c.c.Client().Repositories.ListTree(getRepoPath(c.ref), opts)
+	treeFiles, _, err := c.c.Client().Repositories.ListTree(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -66,5 +70,40 @@ func (c *TreeClient) List(ctx context.Context, sha string, path string, recursiv
 		}
 	}
 
-	return treeEntries, nil
+	return gitprovider.LimitItems(ctx, treeEntries), nil
+}
+
+// ListIter returns an iterator over the tree entries List returns, fetching them a page at a time.
+func (c *TreeClient) ListIter(ctx context.Context, sha string, path string, recursive bool) *gitprovider.Iterator[*gitprovider.TreeEntry] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]*gitprovider.TreeEntry, gitprovider.PageInfo, error) {
+		path, err := gitprovider.NormalizePath(path)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		opts := &gitlab.ListTreeOptions{
+			ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+			Path:        &path,
+			Ref:         &sha,
+			Recursive:   &recursive,
+		}
+		// GET /projects/{project}/repository/tree
+		treeFiles, resp, err := c.c.Client().Repositories.ListTree(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		treeEntries := make([]*gitprovider.TreeEntry, 0, len(treeFiles))
+		for _, treeEntry := range treeFiles {
+			if treeEntry.Type == "blob" {
+				treeEntries = append(treeEntries, &gitprovider.TreeEntry{
+					Path: treeEntry.Path,
+					Mode: treeEntry.Mode,
+					Type: treeEntry.Type,
+					ID:   treeEntry.ID,
+				})
+			}
+		}
+		// The total includes the trees, which aren't listed
+		return treeEntries, gitprovider.PageInfo{NextPage: resp.NextPage}, nil
+	})
 }
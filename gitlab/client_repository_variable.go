@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// VariableClient implements the gitprovider.VariableClient interface.
+var _ gitprovider.VariableClient = &VariableClient{}
+
+// VariableClient operates on the CI/CD variables of a specific repository.
+type VariableClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the variable with the given key, available in all environments.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *VariableClient) Get(ctx context.Context, key string) (gitprovider.Variable, error) {
+	return c.get(ctx, key, "")
+}
+
+func (c *VariableClient) get(ctx context.Context, key, environment string) (*variable, error) {
+	variables, err := c.list(gitprovider.WithoutListOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// Loop through variables once we find one with the right key and environment scope
+	for _, v := range variables {
+		if v.v.Key == key && v.Get().Environment == environment {
+			return v, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all variables of the repository.
+//
+// List returns all available variables, using multiple paginated requests if needed.
+func (c *VariableClient) List(ctx context.Context) ([]gitprovider.Variable, error) {
+	vs, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.Variable
+	variables := make([]gitprovider.Variable, 0, len(vs))
+	for _, v := range vs {
+		variables = append(variables, v)
+	}
+	return gitprovider.LimitItems(ctx, variables), nil
+}
+
+// ListIter returns an iterator over the variables List returns, fetching them a page at a time.
+func (c *VariableClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Variable] {
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Variable, gitprovider.PageInfo, error) {
+		opts := &gitlab.ListProjectVariablesOptions{PerPage: iteratorPageSize(ctx), Page: page}
+		// GET /projects/{project}/variables
+		apiObjs, resp, err := c.c.Client().ProjectVariables.ListVariables(getRepoPath(c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+		}
+
+		variables := make([]gitprovider.Variable, 0, len(apiObjs))
+		for _, apiObj := range apiObjs {
+			if err := validateVariableAPI(apiObj); err != nil {
+				return nil, gitprovider.PageInfo{}, err
+			}
+			variables = append(variables, newVariable(c, apiObj))
+		}
+		return variables, pageInfo(resp), nil
+	})
+}
+
+func (c *VariableClient) list(ctx context.Context) ([]*variable, error) {
+	// GET /projects/{project}/variables
+	apiObjs, err := c.c.ListVariables(ctx, getRepoPath(c.ref))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our variable type
+	variables := make([]*variable, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListVariables
+		variables = append(variables, newVariable(c, apiObj))
+	}
+
+	return variables, nil
+}
+
+// Create creates a variable with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *VariableClient) Create(ctx context.Context, req gitprovider.VariableInfo) (gitprovider.Variable, error) {
+	apiObj, err := createVariable(ctx, c.c, c.ref, req)
+	if err != nil {
+		return nil, err
+	}
+	return newVariable(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *VariableClient) Reconcile(ctx context.Context, req gitprovider.VariableInfo) (gitprovider.Variable, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the variable with the desired key and environment
+	actual, err := c.get(ctx, req.Key, req.Environment)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating variable, as it doesn't exist", "repository", c.ref.String(), "variable", req.Key)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("variable is up to date", "repository", c.ref.String(), "variable", req.Key)
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating variable to the desired state", "repository", c.ref.String(), "variable", req.Key)
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+func createVariable(ctx context.Context, c gitlabClient, ref gitprovider.RepositoryRef, req gitprovider.VariableInfo) (*gitlab.ProjectVariable, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	return c.CreateVariable(ctx, getRepoPath(ref), variableToAPI(&req))
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// RunnerClient implements the gitprovider.RunnerClient interface.
+var _ gitprovider.RunnerClient = &RunnerClient{}
+
+// RunnerClient enrolls GitLab runners in a group, project or the instance.
+type RunnerClient struct {
+	*clientContext
+	// ref is either an OrganizationRef or a RepositoryRef, or nil for the instance
+	ref gitprovider.IdentityRef
+}
+
+// CreateToken creates a group, project or instance runner, and returns its authentication token
+// ("glrt-..."), which is passed to "gitlab-runner register --token".
+// Untagged jobs are only picked up by runners without tags.
+func (c *RunnerClient) CreateToken(ctx context.Context, req gitprovider.RunnerTokenInfo) (*gitprovider.RunnerTokenInfo, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	opts := &gitlab.CreateUserRunnerOptions{
+		Description: req.Description,
+		RunUntagged: gitlab.Ptr(len(req.Tags) == 0),
+	}
+	if len(req.Tags) != 0 {
+		opts.TagList = &req.Tags
+	}
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// GET /projects/{project}
+		project, _, err := c.c.Client().Projects.GetProject(getRepoPath(repoRef), nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		opts.RunnerType = gitlab.Ptr("project_type")
+		opts.ProjectID = gitlab.Ptr(project.ID)
+	} else if c.ref == nil {
+		opts.RunnerType = gitlab.Ptr("instance_type")
+	} else {
+		// GET /groups/{group}
+		group, err := c.c.GetGroup(ctx, c.ref.GetIdentity())
+		if err != nil {
+			return nil, err
+		}
+		opts.RunnerType = gitlab.Ptr("group_type")
+		opts.GroupID = gitlab.Ptr(group.ID)
+	}
+
+	// POST /user/runners
+	apiObj, _, err := c.c.Client().Users.CreateUserRunner(opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return &gitprovider.RunnerTokenInfo{
+		Description: req.Description,
+		Tags:        req.Tags,
+		Token:       apiObj.Token,
+		RunnerID:    int64(apiObj.ID),
+		ExpiresAt:   apiObj.TokenExpiresAt,
+	}, nil
+}
+
+// RegistrationToken returns the registration token of the group or project. GitLab doesn't
+// return the instance registration token, which can only be rotated.
+func (c *RunnerClient) RegistrationToken(ctx context.Context) (*gitprovider.RunnerTokenInfo, error) {
+	var token string
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// GET /projects/{project}
+		project, _, err := c.c.Client().Projects.GetProject(getRepoPath(repoRef), nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, handleHTTPError(err)
+		}
+		token = project.RunnersToken
+	} else if c.ref == nil {
+		return nil, fmt.Errorf("reading the instance runner registration token: %w", gitprovider.ErrNoProviderSupport)
+	} else {
+		// GET /groups/{group}
+		group, err := c.c.GetGroup(ctx, c.ref.GetIdentity())
+		if err != nil {
+			return nil, err
+		}
+		token = group.RunnersToken
+	}
+	// The token is omitted when runner registration tokens are disabled, or when the
+	// user isn't allowed to register runners.
+	if token == "" {
+		return nil, fmt.Errorf("runner registration token: %w", gitprovider.ErrNotFound)
+	}
+	return &gitprovider.RunnerTokenInfo{Token: token}, nil
+}
+
+// RotateRegistrationToken resets the registration token of the group, project or instance.
+func (c *RunnerClient) RotateRegistrationToken(ctx context.Context) (*gitprovider.RunnerTokenInfo, error) {
+	var apiObj *gitlab.RunnerRegistrationToken
+	var err error
+	if repoRef, ok := c.ref.(gitprovider.RepositoryRef); ok {
+		// POST /projects/{project}/runners/reset_registration_token
+		apiObj, _, err = c.c.Client().Runners.ResetProjectRunnerRegistrationToken(getRepoPath(repoRef), gitlab.WithContext(ctx))
+	} else if c.ref == nil {
+		// POST /runners/reset_registration_token
+		apiObj, _, err = c.c.Client().Runners.ResetInstanceRunnerRegistrationToken(gitlab.WithContext(ctx))
+	} else {
+		// POST /groups/{group}/runners/reset_registration_token
+		apiObj, _, err = c.c.Client().Runners.ResetGroupRunnerRegistrationToken(c.ref.GetIdentity(), gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	token := &gitprovider.RunnerTokenInfo{
+		ExpiresAt: apiObj.TokenExpiresAt,
+	}
+	if apiObj.Token != nil {
+		token.Token = *apiObj.Token
+	}
+	return token, nil
+}
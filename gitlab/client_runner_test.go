@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// newTestRunnerClient returns a RunnerClient for the given ref, talking to a server with the given handler.
+func newTestRunnerClient(t *testing.T, handler http.Handler, ref func(domain string) gitprovider.IdentityRef) *RunnerClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("", "", "token", TokenTypePat, gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runners := &RunnerClient{clientContext: c.(*Client).clientContext}
+	if ref != nil {
+		runners.ref = ref(c.SupportedDomain())
+	}
+	return runners
+}
+
+func groupRef(domain string) gitprovider.IdentityRef {
+	return gitprovider.OrganizationRef{Domain: domain, Organization: "fluxcd"}
+}
+
+func projectRunnersRef(domain string) gitprovider.IdentityRef {
+	return gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+}
+
+func TestRunnerClient_RegistrationToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		ref         func(domain string) gitprovider.IdentityRef
+		path        string
+		body        string
+		want        *gitprovider.RunnerTokenInfo
+		expectedErr error
+	}{
+		{
+			name: "group",
+			ref:  groupRef,
+			path: "/api/v4/groups/fluxcd",
+			body: `{"id":1,"path":"fluxcd","runners_token":"GR1348941group"}`,
+			want: &gitprovider.RunnerTokenInfo{Token: "GR1348941group"},
+		},
+		{
+			name: "project",
+			ref:  projectRunnersRef,
+			path: "/api/v4/projects/fluxcd%2Fflux2",
+			body: `{"id":2,"path":"flux2","runners_token":"GR1348941project"}`,
+			want: &gitprovider.RunnerTokenInfo{Token: "GR1348941project"},
+		},
+		{
+			name:        "registration tokens are disabled",
+			ref:         groupRef,
+			path:        "/api/v4/groups/fluxcd",
+			body:        `{"id":1,"path":"fluxcd"}`,
+			expectedErr: gitprovider.ErrNotFound,
+		},
+		{
+			name:        "instance",
+			expectedErr: gitprovider.ErrNoProviderSupport,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			if tt.path != "" {
+				mux.HandleFunc("GET "+tt.path, func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(tt.body))
+				})
+			}
+			runners := newTestRunnerClient(t, mux, tt.ref)
+
+			got, err := runners.RegistrationToken(context.Background())
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("RegistrationToken() error = %v, want %v", err, tt.expectedErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("RegistrationToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunnerClient_RotateRegistrationToken(t *testing.T) {
+	expiresAt := time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		ref  func(domain string) gitprovider.IdentityRef
+		path string
+	}{
+		{
+			name: "group",
+			ref:  groupRef,
+			path: "/api/v4/groups/fluxcd/runners/reset_registration_token",
+		},
+		{
+			name: "project",
+			ref:  projectRunnersRef,
+			path: "/api/v4/projects/fluxcd%2Fflux2/runners/reset_registration_token",
+		},
+		{
+			name: "instance",
+			path: "/api/v4/runners/reset_registration_token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST "+tt.path, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"token":"GR1348941rotated","token_expires_at":"2026-10-24T00:00:00Z"}`))
+			})
+			runners := newTestRunnerClient(t, mux, tt.ref)
+
+			got, err := runners.RotateRegistrationToken(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := &gitprovider.RunnerTokenInfo{Token: "GR1348941rotated", ExpiresAt: &expiresAt}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("RotateRegistrationToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -62,6 +62,14 @@ type gitlabClient interface {
 	// ListUserProjects is a wrapper for "GET /users/{username}/projects".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListUserProjects(ctx context.Context, username string) ([]*gitlab.Project, error)
+	// ListGroupProjectsPage and ListUserProjectsPage fetch a single page of ListGroupProjects
+	// and ListUserProjects respectively.
+	// These functions handle HTTP error wrapping, and validate the server result.
+	ListGroupProjectsPage(ctx context.Context, groupName string, page int) ([]*gitlab.Project, gitprovider.PageInfo, error)
+	ListUserProjectsPage(ctx context.Context, username string, page int) ([]*gitlab.Project, gitprovider.PageInfo, error)
+	// SearchProjects is a wrapper for "GET /projects", searching for query.
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	SearchProjects(ctx context.Context, query string) ([]*gitlab.Project, error)
 	// ListProjectUsers is a wrapper for "GET /projects/{project}/users".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListProjectUsers(ctx context.Context, projectName string) ([]*gitlab.ProjectUser, error)
@@ -83,40 +91,55 @@ type gitlabClient interface {
 
 	// ListKeys is a wrapper for "GET /projects/{project}/deploy_keys".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
-	ListKeys(projectName string) ([]*gitlab.ProjectDeployKey, error)
+	ListKeys(ctx context.Context, projectName string) ([]*gitlab.ProjectDeployKey, error)
 	// CreateProjectKey is a wrapper for "POST /projects/{project}/deploy_keys".
 	// This function handles HTTP error wrapping, and validates the server result.
-	CreateKey(projectName string, req *gitlab.ProjectDeployKey) (*gitlab.ProjectDeployKey, error)
+	CreateKey(ctx context.Context, projectName string, req *gitlab.ProjectDeployKey) (*gitlab.ProjectDeployKey, error)
 	// DeleteKey is a wrapper for "DELETE /projects/{project}/deploy_keys/{key_id}".
 	// This function handles HTTP error wrapping.
-	DeleteKey(projectName string, keyID int) error
+	DeleteKey(ctx context.Context, projectName string, keyID int) error
 
 	// Deploy token methods
 
 	// ListTokens is a wrapper for "GET /projects/{project}/deploy_tokens".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
-	ListTokens(projectName string) ([]*gitlab.DeployToken, error)
+	ListTokens(ctx context.Context, projectName string) ([]*gitlab.DeployToken, error)
 	// CreateProjectKey is a wrapper for "POST /projects/{project}/deploy_tokens".
 	// This function handles HTTP error wrapping, and validates the server result.
-	CreateToken(projectName string, req *gitlab.DeployToken) (*gitlab.DeployToken, error)
+	CreateToken(ctx context.Context, projectName string, req *gitlab.DeployToken) (*gitlab.DeployToken, error)
 	// DeleteKey is a wrapper for "DELETE /projects/{project}/deploy_tokens/{key_id}".
 	// This function handles HTTP error wrapping.
-	DeleteToken(projectName string, keyID int) error
+	DeleteToken(ctx context.Context, projectName string, keyID int) error
+
+	// Variable methods
+
+	// ListVariables is a wrapper for "GET /projects/{project}/variables".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListVariables(ctx context.Context, projectName string) ([]*gitlab.ProjectVariable, error)
+	// CreateVariable is a wrapper for "POST /projects/{project}/variables".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateVariable(ctx context.Context, projectName string, req *gitlab.ProjectVariable) (*gitlab.ProjectVariable, error)
+	// UpdateVariable is a wrapper for "PUT /projects/{project}/variables/{key}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdateVariable(ctx context.Context, projectName string, req *gitlab.ProjectVariable) (*gitlab.ProjectVariable, error)
+	// DeleteVariable is a wrapper for "DELETE /projects/{project}/variables/{key}".
+	// This function handles HTTP error wrapping.
+	DeleteVariable(ctx context.Context, projectName string, key, environmentScope string) error
 
 	// Team related methods
 
 	// ShareGroup is a wrapper for ""
 	// This function handles HTTP error wrapping, and validates the server result.
-	ShareProject(projectName string, groupID, groupAccess int) error
+	ShareProject(ctx context.Context, projectName string, groupID, groupAccess int) error
 	// UnshareProject is a wrapper for ""
 	// This function handles HTTP error wrapping, and validates the server result.
-	UnshareProject(projectName string, groupID int) error
+	UnshareProject(ctx context.Context, projectName string, groupID int) error
 
 	// Commits
 
 	// ListCommitsPage is a wrapper for "GET /projects/{project}/repository/commits".
 	// This function handles pagination, HTTP error wrapping.
-	ListCommitsPage(projectName, branch string, perPage int, page int) ([]*gitlab.Commit, error)
+	ListCommitsPage(ctx context.Context, projectName, branch string, perPage int, page int) ([]*gitlab.Commit, error)
 }
 
 // gitlabClientImpl is a wrapper around *gitlab.Client, which implements higher-level methods,
@@ -138,7 +161,7 @@ func (c *gitlabClientImpl) Client() *gitlab.Client {
 func (c *gitlabClientImpl) GetGroup(ctx context.Context, groupID interface{}) (*gitlab.Group, error) {
 	apiObj, _, err := c.c.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
 	if err != nil {
-		return nil, err
+		return nil, handleHTTPError(err)
 	}
 	// Validate the API object
 	if err := validateGroupAPI(apiObj); err != nil {
@@ -150,7 +173,7 @@ func (c *gitlabClientImpl) GetGroup(ctx context.Context, groupID interface{}) (*
 func (c *gitlabClientImpl) ListGroups(ctx context.Context) ([]*gitlab.Group, error) {
 	apiObjs := []*gitlab.Group{}
 	opts := &gitlab.ListGroupsOptions{}
-	err := allGroupPages(opts, func() (*gitlab.Response, error) {
+	err := allGroupPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /groups
 		pageObjs, resp, listErr := c.c.Groups.ListGroups(opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
@@ -171,7 +194,7 @@ func (c *gitlabClientImpl) ListGroups(ctx context.Context) ([]*gitlab.Group, err
 func (c *gitlabClientImpl) ListSubgroups(ctx context.Context, groupName string) ([]*gitlab.Group, error) {
 	var apiObjs []*gitlab.Group
 	opts := &gitlab.ListSubGroupsOptions{}
-	err := allSubgroupPages(opts, func() (*gitlab.Response, error) {
+	err := allSubgroupPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /groups
 		pageObjs, resp, listErr := c.c.Groups.ListSubGroups(groupName, opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
@@ -190,15 +213,18 @@ func (c *gitlabClientImpl) ListSubgroups(ctx context.Context, groupName string)
 }
 
 func (c *gitlabClientImpl) GetGroupProject(ctx context.Context, groupName string, projectName string) (*gitlab.Project, error) {
-	opts := &gitlab.GetProjectOptions{}
+	opts := getProjectOptions(ctx)
 	apiObj, _, err := c.c.Projects.GetProject(fmt.Sprintf("%s/%s", strings.ToLower(groupName), projectName), opts, gitlab.WithContext(ctx))
 	return validateProjectAPIResp(apiObj, err)
 }
 
 func (c *gitlabClientImpl) ListGroupProjects(ctx context.Context, groupName string) ([]*gitlab.Project, error) {
 	var apiObjs []*gitlab.Project
-	opts := &gitlab.ListGroupProjectsOptions{}
-	err := allGroupProjectPages(opts, func() (*gitlab.Response, error) {
+	opts, err := groupProjectsOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = allGroupProjectPages(ctx, opts, func() (*gitlab.Response, error) {
 		pageObjs, resp, listErr := c.c.Groups.ListGroupProjects(groupName, opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
 		return resp, listErr
@@ -209,6 +235,88 @@ func (c *gitlabClientImpl) ListGroupProjects(ctx context.Context, groupName stri
 	return validateProjectObjects(apiObjs)
 }
 
+func (c *gitlabClientImpl) ListGroupProjectsPage(ctx context.Context, groupName string, page int) ([]*gitlab.Project, gitprovider.PageInfo, error) {
+	opts, err := groupProjectsOptions(ctx)
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, err
+	}
+	opts.ListOptions = gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page}
+	// GET /groups/{group}/projects
+	apiObjs, resp, err := c.c.Groups.ListGroupProjects(groupName, opts, gitlab.WithContext(ctx))
+	return projectPage(apiObjs, resp, err)
+}
+
+// groupProjectsOptions returns the options listing the projects of a group, honoring the
+// call options of ctx.
+func groupProjectsOptions(ctx context.Context) (*gitlab.ListGroupProjectsOptions, error) {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	opts := &gitlab.ListGroupProjectsOptions{
+		IncludeSubGroups: gitlab.Ptr(callOpts.ShouldIncludeSubgroups()),
+	}
+	if !callOpts.ShouldIncludeArchived() {
+		opts.Archived = gitlab.Ptr(false)
+	}
+	if filter := callOpts.RepositoryFilter(); !filter.IsZero() {
+		if filter.Name != "" {
+			opts.Search = gitlab.Ptr(filter.Name)
+		}
+		if filter.Topic != "" {
+			opts.Topic = gitlab.Ptr(filter.Topic)
+		}
+		if filter.Visibility != nil {
+			opts.Visibility = gitlab.Ptr(gitlab.VisibilityValue(*filter.Visibility))
+		}
+	}
+	sort, err := callOpts.RepositorySort()
+	if err != nil {
+		return nil, err
+	}
+	if sort != nil {
+		opts.OrderBy = gitlab.Ptr(projectOrderBy(sort.By))
+		opts.Sort = gitlab.Ptr("asc")
+		if sort.Descending {
+			opts.Sort = gitlab.Ptr("desc")
+		}
+	}
+	return opts, nil
+}
+
+// projectPage validates a page of projects, and describes it using resp. GitLab omits the
+// total number of projects for large lists.
+func projectPage(apiObjs []*gitlab.Project, resp *gitlab.Response, err error) ([]*gitlab.Project, gitprovider.PageInfo, error) {
+	if err != nil {
+		return nil, gitprovider.PageInfo{}, handleHTTPError(err)
+	}
+	if _, err := validateProjectObjects(apiObjs); err != nil {
+		return nil, gitprovider.PageInfo{}, err
+	}
+	return apiObjs, pageInfo(resp), nil
+}
+
+// pageInfo describes the page of resp, including the total number of items if GitLab reports it.
+func pageInfo(resp *gitlab.Response) gitprovider.PageInfo {
+	var info gitprovider.PageInfo
+	if resp != nil {
+		info.NextPage = resp.NextPage
+		if resp.Header.Get("X-Total") != "" {
+			info.Total = gitlab.Ptr(resp.TotalItems)
+		}
+	}
+	return info
+}
+
+// projectOrderBy returns the field projects are ordered by for the given sort field.
+func projectOrderBy(field gitprovider.RepositorySortField) string {
+	switch field {
+	case gitprovider.RepositorySortFieldCreated:
+		return "created_at"
+	case gitprovider.RepositorySortFieldPushed:
+		return "last_activity_at"
+	default:
+		return "name"
+	}
+}
+
 func validateProjectObjects(apiObjs []*gitlab.Project) ([]*gitlab.Project, error) {
 	for _, apiObj := range apiObjs {
 		// Make sure apiObj is valid
@@ -222,7 +330,7 @@ func validateProjectObjects(apiObjs []*gitlab.Project) ([]*gitlab.Project, error
 func (c *gitlabClientImpl) ListGroupMembers(ctx context.Context, groupName string) ([]*gitlab.GroupMember, error) {
 	var apiObjs []*gitlab.GroupMember
 	opts := &gitlab.ListGroupMembersOptions{}
-	err := allGroupMemberPages(opts, func() (*gitlab.Response, error) {
+	err := allGroupMemberPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /groups/{group}/members
 		pageObjs, resp, listErr := c.c.Groups.ListGroupMembers(groupName, opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
@@ -235,11 +343,25 @@ func (c *gitlabClientImpl) ListGroupMembers(ctx context.Context, groupName strin
 }
 
 func (c *gitlabClientImpl) GetUserProject(ctx context.Context, projectName string) (*gitlab.Project, error) {
-	opts := &gitlab.GetProjectOptions{}
+	opts := getProjectOptions(ctx)
 	apiObj, _, err := c.c.Projects.GetProject(projectName, opts, gitlab.WithContext(ctx))
 	return validateProjectAPIResp(apiObj, err)
 }
 
+// getProjectOptions requests the statistics and license of a project, if requested through
+// the call options.
+func getProjectOptions(ctx context.Context) *gitlab.GetProjectOptions {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	opts := &gitlab.GetProjectOptions{}
+	if callOpts.ShouldIncludeStatistics() {
+		opts.Statistics = gitlab.Ptr(true)
+	}
+	if callOpts.ShouldIncludeMetadata() {
+		opts.License = gitlab.Ptr(true)
+	}
+	return opts
+}
+
 func validateProjectAPIResp(apiObj *gitlab.Project, err error) (*gitlab.Project, error) {
 	// If the response contained an error, return
 	if err != nil {
@@ -255,7 +377,7 @@ func validateProjectAPIResp(apiObj *gitlab.Project, err error) (*gitlab.Project,
 func (c *gitlabClientImpl) ListProjects(ctx context.Context) ([]*gitlab.Project, error) {
 	var apiObjs []*gitlab.Project
 	opts := &gitlab.ListProjectsOptions{}
-	err := allProjectPages(opts, func() (*gitlab.Response, error) {
+	err := allProjectPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /projects
 		pageObjs, resp, listErr := c.c.Projects.ListProjects(opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
@@ -270,7 +392,7 @@ func (c *gitlabClientImpl) ListProjects(ctx context.Context) ([]*gitlab.Project,
 func (c *gitlabClientImpl) ListProjectUsers(ctx context.Context, projectName string) ([]*gitlab.ProjectUser, error) {
 	var apiObjs []*gitlab.ProjectUser
 	opts := &gitlab.ListProjectUserOptions{}
-	err := allProjectUserPages(opts, func() (*gitlab.Response, error) {
+	err := allProjectUserPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /projects/{project}/users
 		pageObjs, resp, listErr := c.c.Projects.ListProjectsUsers(projectName, opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
@@ -285,7 +407,10 @@ func (c *gitlabClientImpl) ListProjectUsers(ctx context.Context, projectName str
 func (c *gitlabClientImpl) ListUserProjects(ctx context.Context, username string) ([]*gitlab.Project, error) {
 	var apiObjs []*gitlab.Project
 	opts := &gitlab.ListProjectsOptions{}
-	err := allProjectPages(opts, func() (*gitlab.Response, error) {
+	if !gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived() {
+		opts.Archived = gitlab.Ptr(false)
+	}
+	err := allProjectPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /projects/{project}/users
 		pageObjs, resp, listErr := c.c.Projects.ListUserProjects(username, opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
@@ -297,6 +422,47 @@ func (c *gitlabClientImpl) ListUserProjects(ctx context.Context, username string
 	return apiObjs, nil
 }
 
+func (c *gitlabClientImpl) ListUserProjectsPage(ctx context.Context, username string, page int) ([]*gitlab.Project, gitprovider.PageInfo, error) {
+	opts := &gitlab.ListProjectsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: iteratorPageSize(ctx), Page: page},
+	}
+	if !gitprovider.CallOptionsFromContext(ctx).ShouldIncludeArchived() {
+		opts.Archived = gitlab.Ptr(false)
+	}
+	// GET /users/{username}/projects
+	apiObjs, resp, err := c.c.Projects.ListUserProjects(username, opts, gitlab.WithContext(ctx))
+	return projectPage(apiObjs, resp, err)
+}
+
+func (c *gitlabClientImpl) SearchProjects(ctx context.Context, query string) ([]*gitlab.Project, error) {
+	var apiObjs []*gitlab.Project
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	filter := callOpts.RepositoryFilter()
+	opts := &gitlab.ListProjectsOptions{
+		Search:            gitlab.Ptr(query),
+		LastActivityAfter: filter.ActiveSince,
+	}
+	if !callOpts.ShouldIncludeArchived() {
+		opts.Archived = gitlab.Ptr(false)
+	}
+	if filter.Topic != "" {
+		opts.Topic = gitlab.Ptr(filter.Topic)
+	}
+	if filter.Visibility != nil {
+		opts.Visibility = gitlab.Ptr(gitlab.VisibilityValue(*filter.Visibility))
+	}
+	err := allProjectPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects
+		pageObjs, resp, listErr := c.c.Projects.ListProjects(opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return validateProjectObjects(apiObjs)
+}
+
 func (c *gitlabClientImpl) CreateProject(ctx context.Context, req *gitlab.Project, extraOpts *gitlab.CreateProjectOptions) (*gitlab.Project, error) {
 	var namespaceID int
 	// If the project doesn't belong to a user set its namespace ID
@@ -316,6 +482,9 @@ func (c *gitlabClientImpl) CreateProject(ctx context.Context, req *gitlab.Projec
 	opts.DefaultBranch = &req.DefaultBranch
 	opts.Description = &req.Description
 	opts.Visibility = &req.Visibility
+	if len(req.Topics) != 0 {
+		opts.Topics = &req.Topics
+	}
 	if namespaceID != 0 {
 		opts.NamespaceID = &namespaceID
 	}
@@ -329,6 +498,13 @@ func (c *gitlabClientImpl) UpdateProject(ctx context.Context, req *gitlab.Projec
 		Name:        &req.Name,
 		Description: &req.Description,
 		Visibility:  &req.Visibility,
+		LFSEnabled:  &req.LFSEnabled,
+	}
+	if req.Topics != nil {
+		opts.Topics = &req.Topics
+	}
+	if req.WikiAccessLevel != "" {
+		opts.WikiAccessLevel = &req.WikiAccessLevel
 	}
 	apiObj, _, err := c.c.Projects.EditProject(req.ID, opts, gitlab.WithContext(ctx))
 	return validateProjectAPIResp(apiObj, err)
@@ -340,7 +516,7 @@ func (c *gitlabClientImpl) DeleteProject(ctx context.Context, projectName string
 		return fmt.Errorf("cannot delete repository: %w", gitprovider.ErrDestructiveCallDisallowed)
 	}
 	// DELETE /projects/{project}
-	_, err := c.c.Projects.DeleteProject(projectName, nil)
+	_, err := c.c.Projects.DeleteProject(projectName, nil, gitlab.WithContext(ctx))
 	return err
 }
 
@@ -350,12 +526,12 @@ func (c *gitlabClientImpl) GetUser(ctx context.Context) (*gitlab.User, error) {
 	return proj, err
 }
 
-func (c *gitlabClientImpl) ListKeys(projectName string) ([]*gitlab.ProjectDeployKey, error) {
+func (c *gitlabClientImpl) ListKeys(ctx context.Context, projectName string) ([]*gitlab.ProjectDeployKey, error) {
 	apiObjs := []*gitlab.ProjectDeployKey{}
 	opts := &gitlab.ListProjectDeployKeysOptions{}
-	err := allDeployKeyPages(opts, func() (*gitlab.Response, error) {
+	err := allDeployKeyPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /projects/{project}/deploy_keys
-		pageObjs, resp, listErr := c.c.DeployKeys.ListProjectDeployKeys(projectName, opts)
+		pageObjs, resp, listErr := c.c.DeployKeys.ListProjectDeployKeys(projectName, opts, gitlab.WithContext(ctx))
 		apiObjs = append(apiObjs, pageObjs...)
 		return resp, listErr
 	})
@@ -371,14 +547,14 @@ func (c *gitlabClientImpl) ListKeys(projectName string) ([]*gitlab.ProjectDeploy
 	return apiObjs, nil
 }
 
-func (c *gitlabClientImpl) CreateKey(projectName string, req *gitlab.ProjectDeployKey) (*gitlab.ProjectDeployKey, error) {
+func (c *gitlabClientImpl) CreateKey(ctx context.Context, projectName string, req *gitlab.ProjectDeployKey) (*gitlab.ProjectDeployKey, error) {
 	opts := &gitlab.AddDeployKeyOptions{
 		Title:   &req.Title,
 		Key:     &req.Key,
 		CanPush: &req.CanPush,
 	}
 	// POST /projects/{project}/deploy_keys
-	apiObj, _, err := c.c.DeployKeys.AddDeployKey(projectName, opts)
+	apiObj, _, err := c.c.DeployKeys.AddDeployKey(projectName, opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, handleHTTPError(err)
 	}
@@ -388,18 +564,18 @@ func (c *gitlabClientImpl) CreateKey(projectName string, req *gitlab.ProjectDepl
 	return apiObj, nil
 }
 
-func (c *gitlabClientImpl) DeleteKey(projectName string, keyID int) error {
+func (c *gitlabClientImpl) DeleteKey(ctx context.Context, projectName string, keyID int) error {
 	// DELETE /projects/{project}/deploy_keys
-	_, err := c.c.DeployKeys.DeleteDeployKey(projectName, keyID)
+	_, err := c.c.DeployKeys.DeleteDeployKey(projectName, keyID, gitlab.WithContext(ctx))
 	return handleHTTPError(err)
 }
 
-func (c *gitlabClientImpl) ListTokens(projectName string) ([]*gitlab.DeployToken, error) {
+func (c *gitlabClientImpl) ListTokens(ctx context.Context, projectName string) ([]*gitlab.DeployToken, error) {
 	apiObjs := []*gitlab.DeployToken{}
 	opts := &gitlab.ListProjectDeployTokensOptions{}
-	err := allDeployTokenPages(opts, func() (*gitlab.Response, error) {
+	err := allDeployTokenPages(ctx, opts, func() (*gitlab.Response, error) {
 		// GET /projects/{project}/deploy_tokens
-		pageObjs, resp, listErr := c.c.DeployTokens.ListProjectDeployTokens(projectName, opts)
+		pageObjs, resp, listErr := c.c.DeployTokens.ListProjectDeployTokens(projectName, opts, gitlab.WithContext(ctx))
 		// filter for active tokens
 		for _, apiObj := range pageObjs {
 			if !apiObj.Expired && !apiObj.Revoked {
@@ -420,13 +596,18 @@ func (c *gitlabClientImpl) ListTokens(projectName string) ([]*gitlab.DeployToken
 	return apiObjs, nil
 }
 
-func (c *gitlabClientImpl) CreateToken(projectName string, req *gitlab.DeployToken) (*gitlab.DeployToken, error) {
+func (c *gitlabClientImpl) CreateToken(ctx context.Context, projectName string, req *gitlab.DeployToken) (*gitlab.DeployToken, error) {
+	scopes := req.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{string(gitprovider.DeployTokenScopeReadRepository)}
+	}
 	opts := &gitlab.CreateProjectDeployTokenOptions{
-		Name:   &req.Name,
-		Scopes: &[]string{"read_repository"},
+		Name:      &req.Name,
+		ExpiresAt: req.ExpiresAt,
+		Scopes:    &scopes,
 	}
 	// POST /projects/{project}/deploy_tokens
-	apiObj, _, err := c.c.DeployTokens.CreateProjectDeployToken(projectName, opts)
+	apiObj, _, err := c.c.DeployTokens.CreateProjectDeployToken(projectName, opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, handleHTTPError(err)
 	}
@@ -436,13 +617,81 @@ func (c *gitlabClientImpl) CreateToken(projectName string, req *gitlab.DeployTok
 	return apiObj, nil
 }
 
-func (c *gitlabClientImpl) DeleteToken(projectName string, keyID int) error {
+func (c *gitlabClientImpl) DeleteToken(ctx context.Context, projectName string, keyID int) error {
 	// DELETE /projects/{project}/deploy_tokens/{deploy_token_id}
-	_, err := c.c.DeployTokens.DeleteProjectDeployToken(projectName, keyID)
+	_, err := c.c.DeployTokens.DeleteProjectDeployToken(projectName, keyID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListVariables(ctx context.Context, projectName string) ([]*gitlab.ProjectVariable, error) {
+	apiObjs := []*gitlab.ProjectVariable{}
+	opts := &gitlab.ListProjectVariablesOptions{}
+	err := allVariablePages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/variables
+		pageObjs, resp, listErr := c.c.ProjectVariables.ListVariables(projectName, opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateVariableAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) CreateVariable(ctx context.Context, projectName string, req *gitlab.ProjectVariable) (*gitlab.ProjectVariable, error) {
+	opts := &gitlab.CreateProjectVariableOptions{
+		Key:              &req.Key,
+		Value:            &req.Value,
+		Masked:           &req.Masked,
+		Protected:        &req.Protected,
+		EnvironmentScope: &req.EnvironmentScope,
+	}
+	// POST /projects/{project}/variables
+	apiObj, _, err := c.c.ProjectVariables.CreateVariable(projectName, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateVariableAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UpdateVariable(ctx context.Context, projectName string, req *gitlab.ProjectVariable) (*gitlab.ProjectVariable, error) {
+	opts := &gitlab.UpdateProjectVariableOptions{
+		Value:     &req.Value,
+		Masked:    &req.Masked,
+		Protected: &req.Protected,
+		// The key is only unique per environment scope
+		Filter: &gitlab.VariableFilter{EnvironmentScope: req.EnvironmentScope},
+	}
+	// PUT /projects/{project}/variables/{key}
+	apiObj, _, err := c.c.ProjectVariables.UpdateVariable(projectName, req.Key, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateVariableAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteVariable(ctx context.Context, projectName string, key, environmentScope string) error {
+	opts := &gitlab.RemoveProjectVariableOptions{
+		Filter: &gitlab.VariableFilter{EnvironmentScope: environmentScope},
+	}
+	// DELETE /projects/{project}/variables/{key}
+	_, err := c.c.ProjectVariables.RemoveVariable(projectName, key, opts, gitlab.WithContext(ctx))
 	return handleHTTPError(err)
 }
 
-func (c *gitlabClientImpl) ShareProject(projectName string, groupIDObj, groupAccessObj int) error {
+func (c *gitlabClientImpl) ShareProject(ctx context.Context, projectName string, groupIDObj, groupAccessObj int) error {
 	groupAccess := gitlab.AccessLevel(gitlab.AccessLevelValue(groupAccessObj))
 	groupID := &groupIDObj
 	opt := &gitlab.ShareWithGroupOptions{
@@ -450,16 +699,16 @@ func (c *gitlabClientImpl) ShareProject(projectName string, groupIDObj, groupAcc
 		GroupAccess: groupAccess,
 	}
 
-	_, err := c.c.Projects.ShareProjectWithGroup(projectName, opt)
+	_, err := c.c.Projects.ShareProjectWithGroup(projectName, opt, gitlab.WithContext(ctx))
 	return handleHTTPError(err)
 }
 
-func (c *gitlabClientImpl) UnshareProject(projectName string, groupID int) error {
-	_, err := c.c.Projects.DeleteSharedProjectFromGroup(projectName, groupID)
+func (c *gitlabClientImpl) UnshareProject(ctx context.Context, projectName string, groupID int) error {
+	_, err := c.c.Projects.DeleteSharedProjectFromGroup(projectName, groupID, gitlab.WithContext(ctx))
 	return handleHTTPError(err)
 }
 
-func (c *gitlabClientImpl) ListCommitsPage(projectName string, branch string, perPage int, page int) ([]*gitlab.Commit, error) {
+func (c *gitlabClientImpl) ListCommitsPage(ctx context.Context, projectName string, branch string, perPage int, page int) ([]*gitlab.Commit, error) {
 	apiObjs := make([]*gitlab.Commit, 0)
 
 	opts := gitlab.ListCommitsOptions{
@@ -471,7 +720,7 @@ func (c *gitlabClientImpl) ListCommitsPage(projectName string, branch string, pe
 	}
 
 	// GET /projects/{id}/repository/commits
-	pageObjs, _, listErr := c.c.Commits.ListCommits(projectName, &opts)
+	pageObjs, _, listErr := c.c.Commits.ListCommits(projectName, &opts, gitlab.WithContext(ctx))
 	for _, c := range pageObjs {
 		apiObjs = append(apiObjs, &gitlab.Commit{
 			ID:         c.ID,
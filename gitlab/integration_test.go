@@ -247,6 +247,7 @@ var _ = Describe("GitLab Provider", func() {
 			gitlabToken, "",
 			gitprovider.WithDomain(testBaseUrl),
 			gitprovider.WithDestructiveAPICalls(true),
+			gitprovider.WithAllowInsecureHTTP(true),
 			gitprovider.WithConditionalRequests(true),
 			gitprovider.WithPreChainTransportHook(customTransportFactory),
 		)
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newAutolink(c *AutolinkClient, apiObj *gitlab.CustomIssueTrackerService) *autolink {
+	return &autolink{
+		s: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Autolink = &autolink{}
+
+type autolink struct {
+	s gitlab.CustomIssueTrackerService
+	c *AutolinkClient
+}
+
+func (a *autolink) Get() gitprovider.AutolinkInfo {
+	info := gitprovider.AutolinkInfo{
+		ID:             int64(a.s.ID),
+		IsAlphanumeric: gitprovider.BoolVar(false),
+	}
+	if a.s.Properties != nil {
+		info.URLTemplate = strings.ReplaceAll(a.s.Properties.IssuesURL, gitlabIssueIDPlaceholder, gitprovider.AutolinkNumberPlaceholder)
+	}
+	return info
+}
+
+func (a *autolink) APIObject() interface{} {
+	return &a.s
+}
+
+func (a *autolink) Repository() gitprovider.RepositoryRef {
+	return a.c.ref
+}
+
+// Delete disables the custom issue tracker of the project.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (a *autolink) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/integrations/custom-issue-tracker
+	_, err := a.c.c.Client().Services.DeleteCustomIssueTrackerService(getRepoPath(a.c.ref), gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
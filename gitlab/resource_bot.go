@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Bot = &bot{}
+
+type bot struct {
+	// apiObj is a *gitlab.GroupServiceAccount for group bots, and the
+	// *gitlab.ProjectAccessToken for project bots
+	apiObj interface{}
+	info   gitprovider.BotInfo
+	c      *BotClient
+}
+
+func newServiceAccountBot(c *BotClient, apiObj *gitlab.GroupServiceAccount) *bot {
+	return &bot{
+		apiObj: apiObj,
+		info: gitprovider.BotInfo{
+			ID:       strconv.Itoa(apiObj.ID),
+			Name:     apiObj.Name,
+			Username: apiObj.UserName,
+		},
+		c: c,
+	}
+}
+
+func newProjectBot(c *BotClient, apiObj *gitlab.ProjectAccessToken) *bot {
+	info := gitprovider.BotInfo{
+		ID:        strconv.Itoa(apiObj.ID),
+		Name:      apiObj.Name,
+		Scopes:    apiObj.Scopes,
+		ExpiresAt: (*time.Time)(apiObj.ExpiresAt),
+		Token:     apiObj.Token,
+	}
+	if permission, err := getGitProviderPermission(int(apiObj.AccessLevel)); err == nil {
+		info.Permission = permission
+	}
+	return &bot{
+		apiObj: apiObj,
+		info:   info,
+		c:      c,
+	}
+}
+
+func (b *bot) Get() gitprovider.BotInfo {
+	return b.info
+}
+
+func (b *bot) APIObject() interface{} {
+	return b.apiObj
+}
+
+// Delete revokes the access token of a project bot, which also removes the bot user,
+// or deletes the service account of a group.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (b *bot) Delete(ctx context.Context) error {
+	if token, ok := b.apiObj.(*gitlab.ProjectAccessToken); ok {
+		// DELETE /projects/{project}/access_tokens/{id}
+		_, err := b.c.c.Client().ProjectAccessTokens.RevokeProjectAccessToken(getRepoPath(b.c.ref.(gitprovider.RepositoryRef)), token.ID, gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	}
+
+	// DELETE /groups/{group}/service_accounts/{id}
+	_, err := b.c.c.Client().Groups.DeleteServiceAccount(b.c.ref.GetIdentity(), b.apiObj.(*gitlab.GroupServiceAccount).ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
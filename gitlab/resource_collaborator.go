@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, apiObj interface{}) (*collaborator, error) {
+	var info gitprovider.CollaboratorInfo
+	var accessLevel gitlab.AccessLevelValue
+	switch obj := apiObj.(type) {
+	case *gitlab.ProjectMember:
+		info.Username = obj.Username
+		accessLevel = obj.AccessLevel
+	case *gitlab.PendingInvite:
+		// Invitations of people without an account only carry the email address
+		info.Username = obj.UserName
+		if info.Username == "" {
+			info.Username = obj.InviteEmail
+		}
+		info.Pending = true
+		accessLevel = obj.AccessLevel
+	}
+	permission, err := getGitProviderPermission(int(accessLevel))
+	if err != nil {
+		return nil, err
+	}
+	info.Permission = permission
+	return &collaborator{
+		info:   info,
+		apiObj: apiObj,
+		c:      c,
+	}, nil
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	info gitprovider.CollaboratorInfo
+	// apiObj is a *gitlab.ProjectMember, or a *gitlab.PendingInvite for pending invitations
+	apiObj interface{}
+	c      *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	info.Pending = c.info.Pending
+	c.info = info
+	return nil
+}
+
+func (c *collaborator) APIObject() interface{} {
+	return c.apiObj
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Update changes the access level of the project member, or of the pending invitation.
+func (c *collaborator) Update(ctx context.Context) error {
+	accessLevel, err := getGitlabPermission(*c.info.Permission)
+	if err != nil {
+		return err
+	}
+
+	switch apiObj := c.apiObj.(type) {
+	case *gitlab.ProjectMember:
+		// PUT /projects/{id}/members/{user_id}
+		updated, _, err := c.c.c.Client().ProjectMembers.EditProjectMember(getRepoPath(c.c.ref), apiObj.ID, &gitlab.EditProjectMemberOptions{
+			AccessLevel: gitlab.Ptr(gitlab.AccessLevelValue(accessLevel)),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		c.apiObj = updated
+	case *gitlab.PendingInvite:
+		if err := c.c.updateInvitation(ctx, apiObj.InviteEmail, *c.info.Permission); err != nil {
+			return err
+		}
+		apiObj.AccessLevel = gitlab.AccessLevelValue(accessLevel)
+	}
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.Get(ctx, req.Username)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			c.info = resp.Get()
+			c.apiObj = resp.APIObject()
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Continue from the actual object, which knows whether the user is still invited
+	c.info.Pending = actual.Get().Pending
+	c.apiObj = actual.APIObject()
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+	return true, c.Update(ctx)
+}
+
+// Delete removes the member from the project, or withdraws the pending invitation.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	switch apiObj := c.apiObj.(type) {
+	case *gitlab.ProjectMember:
+		// DELETE /projects/{id}/members/{user_id}
+		_, err := c.c.c.Client().ProjectMembers.DeleteProjectMember(getRepoPath(c.c.ref), apiObj.ID, gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	case *gitlab.PendingInvite:
+		return c.c.deleteInvitation(ctx, apiObj.InviteEmail)
+	}
+	return nil
+}
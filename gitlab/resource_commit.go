@@ -17,6 +17,8 @@ limitations under the License.
 package gitlab
 
 import (
+	"strconv"
+
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -53,3 +55,52 @@ func commitFromAPI(apiObj *gitlab.Commit) gitprovider.CommitInfo {
 		URL:       apiObj.WebURL,
 	}
 }
+
+// signatureAPI is the signature of a commit or tag. Depending on the signature type, the GPG key,
+// SSH key or X.509 certificate fields are set.
+type signatureAPI struct {
+	SignatureType      string `json:"signature_type"`
+	VerificationStatus string `json:"verification_status"`
+	GPGKeyPrimaryKeyID string `json:"gpg_key_primary_keyid"`
+	GPGKeyUserName     string `json:"gpg_key_user_name"`
+	GPGKeyUserEmail    string `json:"gpg_key_user_email"`
+	Key                *struct {
+		ID int `json:"id"`
+	} `json:"key"`
+	X509Certificate *struct {
+		Subject              string `json:"subject"`
+		SubjectKeyIdentifier string `json:"subject_key_identifier"`
+		Email                string `json:"email"`
+	} `json:"x509_certificate"`
+}
+
+//nolint:gochecknoglobals
+var signatureTypes = map[string]gitprovider.SignatureType{
+	"PGP":  gitprovider.SignatureTypeGPG,
+	"SSH":  gitprovider.SignatureTypeSSH,
+	"X509": gitprovider.SignatureTypeX509,
+}
+
+func signatureFromAPI(apiObj *signatureAPI) *gitprovider.SignatureVerification {
+	sig := &gitprovider.SignatureVerification{
+		// Commits made through the web UI are signed by GitLab itself
+		Verified: apiObj.VerificationStatus == "verified" || apiObj.VerificationStatus == "verified_system",
+		Reason:   apiObj.VerificationStatus,
+	}
+	if t, ok := signatureTypes[apiObj.SignatureType]; ok {
+		sig.Type = gitprovider.SignatureTypeVar(t)
+	}
+	switch {
+	case apiObj.X509Certificate != nil:
+		sig.SignerName = apiObj.X509Certificate.Subject
+		sig.SignerEmail = apiObj.X509Certificate.Email
+		sig.KeyID = apiObj.X509Certificate.SubjectKeyIdentifier
+	case apiObj.Key != nil:
+		sig.KeyID = strconv.Itoa(apiObj.Key.ID)
+	default:
+		sig.SignerName = apiObj.GPGKeyUserName
+		sig.SignerEmail = apiObj.GPGKeyUserEmail
+		sig.KeyID = apiObj.GPGKeyPrimaryKeyID
+	}
+	return sig
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// deploymentStatuses maps the gitprovider deployment states onto the GitLab ones.
+//
+//nolint:gochecknoglobals
+var deploymentStatuses = map[gitprovider.DeploymentState]gitlab.DeploymentStatusValue{
+	gitprovider.DeploymentStatePending:    gitlab.DeploymentStatusCreated,
+	gitprovider.DeploymentStateQueued:     gitlab.DeploymentStatusCreated,
+	gitprovider.DeploymentStateInProgress: gitlab.DeploymentStatusRunning,
+	gitprovider.DeploymentStateSuccess:    gitlab.DeploymentStatusSuccess,
+	gitprovider.DeploymentStateFailure:    gitlab.DeploymentStatusFailed,
+	gitprovider.DeploymentStateError:      gitlab.DeploymentStatusFailed,
+	gitprovider.DeploymentStateInactive:   gitlab.DeploymentStatusCanceled,
+}
+
+// deploymentStates maps the GitLab deployment statuses onto the gitprovider states.
+//
+//nolint:gochecknoglobals
+var deploymentStates = map[string]gitprovider.DeploymentState{
+	string(gitlab.DeploymentStatusCreated):  gitprovider.DeploymentStatePending,
+	"blocked":                               gitprovider.DeploymentStatePending,
+	string(gitlab.DeploymentStatusRunning):  gitprovider.DeploymentStateInProgress,
+	string(gitlab.DeploymentStatusSuccess):  gitprovider.DeploymentStateSuccess,
+	string(gitlab.DeploymentStatusFailed):   gitprovider.DeploymentStateFailure,
+	string(gitlab.DeploymentStatusCanceled): gitprovider.DeploymentStateInactive,
+	"skipped":                               gitprovider.DeploymentStateInactive,
+}
+
+func newDeployment(c *DeploymentClient, apiObj *gitlab.Deployment) *deployment {
+	return &deployment{
+		d: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Deployment = &deployment{}
+
+type deployment struct {
+	d gitlab.Deployment
+	c *DeploymentClient
+}
+
+func (d *deployment) Get() gitprovider.DeploymentInfo {
+	return deploymentFromAPI(&d.d)
+}
+
+func (d *deployment) APIObject() interface{} {
+	return &d.d
+}
+
+func (d *deployment) Repository() gitprovider.RepositoryRef {
+	return d.c.ref
+}
+
+// CreateStatus updates the status of the deployment. Descriptions and URLs aren't supported,
+// the URL of the environment can be set using the EnvironmentClient instead.
+//
+// The internal API object will be overridden with the received server data.
+func (d *deployment) CreateStatus(ctx context.Context, req gitprovider.DeploymentStatusInfo) error {
+	if err := req.ValidateInfo(); err != nil {
+		return err
+	}
+	if req.Description != nil || req.EnvironmentURL != nil || req.LogURL != nil {
+		return fmt.Errorf("deployment status descriptions and URLs: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	opts := &gitlab.UpdateProjectDeploymentOptions{
+		Status: gitlab.DeploymentStatus(deploymentStatuses[req.State]),
+	}
+	// PUT /projects/{project}/deployments/{id}
+	apiObj, _, err := d.c.c.Client().Deployments.UpdateProjectDeployment(getRepoPath(d.c.ref), d.d.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	d.d = *apiObj
+	return nil
+}
+
+// ListStatuses returns the current status of the deployment, as GitLab doesn't keep
+// a history of them.
+//
+// The internal API object will be overridden with the received server data.
+func (d *deployment) ListStatuses(ctx context.Context) ([]gitprovider.DeploymentStatusInfo, error) {
+	// GET /projects/{project}/deployments/{id}
+	apiObj, _, err := d.c.c.Client().Deployments.GetProjectDeployment(getRepoPath(d.c.ref), d.d.ID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	d.d = *apiObj
+
+	state, ok := deploymentStates[d.d.Status]
+	if !ok {
+		return nil, fmt.Errorf("unknown deployment status %q: %w", d.d.Status, gitprovider.ErrInvalidServerData)
+	}
+	return []gitprovider.DeploymentStatusInfo{{State: state}}, nil
+}
+
+func deploymentFromAPI(apiObj *gitlab.Deployment) gitprovider.DeploymentInfo {
+	info := gitprovider.DeploymentInfo{
+		ID:  int64(apiObj.ID),
+		Ref: apiObj.Ref,
+		SHA: apiObj.SHA,
+	}
+	if apiObj.Environment != nil {
+		info.Environment = apiObj.Environment.Name
+	}
+	return info
+}
@@ -75,20 +75,20 @@ func (dk *deployToken) Update(ctx context.Context) error {
 	if err := dk.Delete(ctx); err != nil {
 		return err
 	}
-	return dk.createIntoSelf()
+	return dk.createIntoSelf(ctx)
 }
 
 // Delete deletes a deploy token from the repository.
 //
 // ErrNotFound is returned if the resource does not exist.
-func (dk *deployToken) Delete(_ context.Context) error {
+func (dk *deployToken) Delete(ctx context.Context) error {
 	// We can use the same DeployToken ID that we got from the GET calls. Make sure it's non-nil.
 	// This _should never_ happen, but just check for it anyways to avoid panicing.
 	if dk.k.ID == 0 {
 		return fmt.Errorf("didn't expect ID to be 0: %w", gitprovider.ErrUnexpectedEvent)
 	}
 
-	return dk.c.c.DeleteToken(getRepoPath(dk.c.ref), dk.k.ID)
+	return dk.c.c.DeleteToken(ctx, getRepoPath(dk.c.ref), dk.k.ID)
 }
 
 // Reconcile makes sure the desired state in this object (called "req" here) becomes
@@ -106,9 +106,9 @@ func (dk *deployToken) Reconcile(ctx context.Context) (bool, error) {
 	return true, dk.Update(ctx)
 }
 
-func (dk *deployToken) createIntoSelf() error {
+func (dk *deployToken) createIntoSelf(ctx context.Context) error {
 	// POST /repos/{owner}/{repo}/tokens
-	apiObj, err := dk.c.c.CreateToken(getRepoPath(dk.c.ref), &dk.k)
+	apiObj, err := dk.c.c.CreateToken(ctx, getRepoPath(dk.c.ref), &dk.k)
 	if err != nil {
 		return err
 	}
@@ -128,11 +128,16 @@ func validateDeployTokenAPI(apiObj *gitlab.DeployToken) error {
 }
 
 func deployTokenFromAPI(apiObj *gitlab.DeployToken) gitprovider.DeployTokenInfo {
-	return gitprovider.DeployTokenInfo{
-		Name:     apiObj.Name,
-		Username: apiObj.Username,
-		Token:    apiObj.Token,
+	info := gitprovider.DeployTokenInfo{
+		Name:      apiObj.Name,
+		Username:  apiObj.Username,
+		Token:     apiObj.Token,
+		ExpiresAt: apiObj.ExpiresAt,
 	}
+	for _, scope := range apiObj.Scopes {
+		info.Scopes = append(info.Scopes, gitprovider.DeployTokenScope(scope))
+	}
+	return info
 }
 
 func deployTokenToAPI(info *gitprovider.DeployTokenInfo) *gitlab.DeployToken {
@@ -145,6 +150,14 @@ func deployTokenInfoToAPIObj(info *gitprovider.DeployTokenInfo, apiObj *gitlab.D
 	// Required fields, we assume info is validated, and hence these are set
 	apiObj.Name = info.Name
 	apiObj.Username = info.Username
+	// optional fields
+	apiObj.ExpiresAt = info.ExpiresAt
+	if len(info.Scopes) != 0 {
+		apiObj.Scopes = make([]string, 0, len(info.Scopes))
+		for _, scope := range info.Scopes {
+			apiObj.Scopes = append(apiObj.Scopes, string(scope))
+		}
+	}
 }
 
 // This function copies over the fields that are part of create request of a deploy
@@ -153,7 +166,9 @@ func newGitlabTokenSpec(token *gitlab.DeployToken) *gitlabTokenSpec {
 	return &gitlabTokenSpec{
 		&gitlab.DeployToken{
 			// Create-specific parameters
-			Name: token.Name,
+			Name:      token.Name,
+			Scopes:    token.Scopes,
+			ExpiresAt: token.ExpiresAt,
 		},
 	}
 }
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Environment = &environment{}
+
+type environment struct {
+	e gitlab.Environment
+	// info holds the desired state, as the protection rules are a separate API object
+	info gitprovider.EnvironmentInfo
+	c    *EnvironmentClient
+}
+
+func (e *environment) Get() gitprovider.EnvironmentInfo {
+	return e.info
+}
+
+func (e *environment) Set(info gitprovider.EnvironmentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	e.info = info
+	return nil
+}
+
+func (e *environment) APIObject() interface{} {
+	return &e.e
+}
+
+func (e *environment) Repository() gitprovider.RepositoryRef {
+	return e.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (e *environment) Update(ctx context.Context) error {
+	if e.info.WaitTimer != nil {
+		return fmt.Errorf("environment wait timers: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	externalURL := ""
+	if e.info.ExternalURL != nil {
+		externalURL = *e.info.ExternalURL
+	}
+	opts := &gitlab.EditEnvironmentOptions{
+		ExternalURL: &externalURL,
+	}
+	// PUT /projects/{project}/environments/{id}
+	apiObj, _, err := e.c.c.Client().Environments.EditEnvironment(getRepoPath(e.c.ref), e.e.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	e.e = *apiObj
+
+	// Re-create the protection if the reviewers changed
+	actual, err := e.c.getReviewers(ctx, e.info.Name)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(actual, e.info.Reviewers) {
+		return nil
+	}
+	if err := e.c.unprotect(ctx, e.info.Name); err != nil {
+		return err
+	}
+	return e.c.protect(ctx, e.info)
+}
+
+// Delete stops and deletes the environment.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (e *environment) Delete(ctx context.Context) error {
+	if err := e.c.unprotect(ctx, e.e.Name); err != nil {
+		return err
+	}
+	// Only stopped environments can be deleted
+	// POST /projects/{project}/environments/{id}/stop
+	if _, _, err := e.c.c.Client().Environments.StopEnvironment(getRepoPath(e.c.ref), e.e.ID, nil, gitlab.WithContext(ctx)); err != nil {
+		return handleHTTPError(err)
+	}
+	// DELETE /projects/{project}/environments/{id}
+	_, err := e.c.c.Client().Environments.DeleteEnvironment(getRepoPath(e.c.ref), e.e.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (e *environment) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := e.c.get(ctx, e.info.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			created, err := e.c.Create(ctx, e.info)
+			if err != nil {
+				return false, err
+			}
+			*e = *created.(*environment)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if e.info.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	e.e = actual.e
+	return true, e.Update(ctx)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+func newFreezePeriod(c *FreezePeriodClient, apiObj *gitlab.FreezePeriod) *freezePeriod {
+	return &freezePeriod{
+		f: *apiObj,
+		c: c,
+	}
+}
+
+var _ experimental.FreezePeriod = &freezePeriod{}
+
+type freezePeriod struct {
+	f gitlab.FreezePeriod
+	c *FreezePeriodClient
+}
+
+func (f *freezePeriod) Get() experimental.FreezePeriodInfo {
+	return experimental.FreezePeriodInfo{
+		ID:           f.f.ID,
+		FreezeStart:  f.f.FreezeStart,
+		FreezeEnd:    f.f.FreezeEnd,
+		CronTimezone: f.f.CronTimezone,
+	}
+}
+
+func (f *freezePeriod) Set(info experimental.FreezePeriodInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	f.f.FreezeStart = info.FreezeStart
+	f.f.FreezeEnd = info.FreezeEnd
+	f.f.CronTimezone = info.CronTimezone
+	return nil
+}
+
+func (f *freezePeriod) APIObject() interface{} {
+	return &f.f
+}
+
+func (f *freezePeriod) Repository() gitprovider.RepositoryRef {
+	return f.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (f *freezePeriod) Update(ctx context.Context) error {
+	opts := &gitlab.UpdateFreezePeriodOptions{
+		FreezeStart: gitlab.Ptr(f.f.FreezeStart),
+		FreezeEnd:   gitlab.Ptr(f.f.FreezeEnd),
+	}
+	if f.f.CronTimezone != "" {
+		opts.CronTimezone = gitlab.Ptr(f.f.CronTimezone)
+	}
+	// PUT /projects/{project}/freeze_periods/{freeze_period_id}
+	apiObj, _, err := f.c.c.Client().FreezePeriods.UpdateFreezePeriodOptions(getRepoPath(f.c.ref), f.f.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	f.f = *apiObj
+	return nil
+}
+
+// Delete deletes the freeze period.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (f *freezePeriod) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/freeze_periods/{freeze_period_id}
+	_, err := f.c.c.Client().FreezePeriods.DeleteFreezePeriod(getRepoPath(f.c.ref), f.f.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
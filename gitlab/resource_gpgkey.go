@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newGPGKey(c *GPGKeyClient, apiObj *gitlab.GPGKey) *gpgKey {
+	return &gpgKey{
+		k: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.GPGKey = &gpgKey{}
+
+type gpgKey struct {
+	k gitlab.GPGKey
+	c *GPGKeyClient
+}
+
+// Get returns high-level information about the GPG key. GitLab only returns the armored key,
+// so the key ID and email addresses are parsed from it.
+func (k *gpgKey) Get() gitprovider.GPGKeyInfo {
+	info := gitprovider.GPGKeyInfo{
+		ArmoredPublicKey: k.k.Key,
+	}
+	if keyID, emails, err := gitprovider.ParseGPGPublicKey(k.k.Key); err == nil {
+		info.KeyID = keyID
+		info.Emails = emails
+	}
+	return info
+}
+
+func (k *gpgKey) APIObject() interface{} {
+	return &k.k
+}
+
+// Delete removes the GPG key from the authenticated user.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (k *gpgKey) Delete(ctx context.Context) error {
+	// DELETE /user/gpg_keys/{key_id}
+	_, err := k.c.c.Client().Users.DeleteGPGKey(k.k.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, apiObj *gitlab.Issue) *issue {
+	return &issue{
+		i:    *apiObj,
+		info: issueFromAPI(apiObj),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i    gitlab.Issue
+	info gitprovider.IssueInfo
+	c    *IssueClient
+}
+
+func (i *issue) Get() gitprovider.IssueInfo {
+	return i.info
+}
+
+func (i *issue) Set(info gitprovider.IssueInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	i.info = info
+	return nil
+}
+
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+func (i *issue) Repository() gitprovider.RepositoryRef {
+	return i.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (i *issue) Update(ctx context.Context) error {
+	opts := &gitlab.UpdateIssueOptions{
+		Title:       gitlab.Ptr(i.info.Title),
+		Description: gitlab.Ptr(i.info.Description),
+	}
+	if i.info.Labels != nil {
+		opts.Labels = gitlab.Ptr(gitlab.LabelOptions(i.info.Labels))
+	}
+	if i.info.Assignees != nil {
+		ids, err := i.c.userIDs(ctx, i.info.Assignees)
+		if err != nil {
+			return err
+		}
+		opts.AssigneeIDs = &ids
+	}
+	switch i.info.State {
+	case gitprovider.IssueStateOpen:
+		opts.StateEvent = gitlab.Ptr("reopen")
+	case gitprovider.IssueStateClosed:
+		opts.StateEvent = gitlab.Ptr("close")
+	}
+	return i.update(ctx, opts)
+}
+
+// Close closes the issue.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (i *issue) Close(ctx context.Context) error {
+	return i.update(ctx, &gitlab.UpdateIssueOptions{
+		StateEvent: gitlab.Ptr("close"),
+	})
+}
+
+func (i *issue) update(ctx context.Context, opts *gitlab.UpdateIssueOptions) error {
+	// PUT /projects/{project}/issues/{iid}
+	apiObj, _, err := i.c.c.Client().Issues.UpdateIssue(getRepoPath(i.c.ref), i.i.IID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*i = *newIssue(i.c, apiObj)
+	return nil
+}
+
+// Comments gives access to the comments of this issue.
+func (i *issue) Comments() gitprovider.CommentClient {
+	return &CommentClient{
+		clientContext: i.c.clientContext,
+		ref:           i.c.ref,
+		iid:           i.i.IID,
+	}
+}
+
+func issueFromAPI(apiObj *gitlab.Issue) gitprovider.IssueInfo {
+	info := gitprovider.IssueInfo{
+		Number:      apiObj.IID,
+		Title:       apiObj.Title,
+		Description: apiObj.Description,
+		Labels:      append([]string{}, apiObj.Labels...),
+		Assignees:   make([]string, 0, len(apiObj.Assignees)),
+		State:       gitprovider.IssueStateOpen,
+		WebURL:      apiObj.WebURL,
+	}
+	// GitLab calls open issues "opened"
+	if apiObj.State == "closed" {
+		info.State = gitprovider.IssueStateClosed
+	}
+	for _, assignee := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, assignee.Username)
+	}
+	return info
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newComment(c *CommentClient, apiObj *gitlab.Note) *comment {
+	return &comment{
+		n: *apiObj,
+		info: gitprovider.CommentInfo{
+			ID:     int64(apiObj.ID),
+			Body:   apiObj.Body,
+			Author: apiObj.Author.Username,
+		},
+		c: c,
+	}
+}
+
+var _ gitprovider.Comment = &comment{}
+
+type comment struct {
+	n    gitlab.Note
+	info gitprovider.CommentInfo
+	c    *CommentClient
+}
+
+func (cm *comment) Get() gitprovider.CommentInfo {
+	return cm.info
+}
+
+func (cm *comment) Set(info gitprovider.CommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	cm.info = info
+	return nil
+}
+
+func (cm *comment) APIObject() interface{} {
+	return &cm.n
+}
+
+// Update will apply the desired state in this object to the server.
+// Only the body of a note can be changed.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (cm *comment) Update(ctx context.Context) error {
+	// PUT /projects/{project}/issues/{iid}/notes/{note_id}
+	apiObj, _, err := cm.c.c.Client().Notes.UpdateIssueNote(getRepoPath(cm.c.ref), cm.c.iid, cm.n.ID, &gitlab.UpdateIssueNoteOptions{
+		Body: gitlab.Ptr(cm.info.Body),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*cm = *newComment(cm.c, apiObj)
+	return nil
+}
+
+// Delete deletes the note.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (cm *comment) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/issues/{iid}/notes/{note_id}
+	_, err := cm.c.c.Client().Notes.DeleteIssueNote(getRepoPath(cm.c.ref), cm.c.iid, cm.n.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newProjectMilestone(c *MilestoneClient, apiObj *gitlab.Milestone) *milestone {
+	return &milestone{
+		apiObj: apiObj,
+		id:     apiObj.ID,
+		info:   milestoneFromAPI(apiObj),
+		c:      c,
+	}
+}
+
+func newGroupMilestone(c *MilestoneClient, apiObj *gitlab.GroupMilestone) *milestone {
+	// Group milestones have the same fields, apart from the web URL
+	info := milestoneFromAPI(&gitlab.Milestone{
+		ID:          apiObj.ID,
+		Title:       apiObj.Title,
+		Description: apiObj.Description,
+		DueDate:     apiObj.DueDate,
+		State:       apiObj.State,
+	})
+	return &milestone{
+		apiObj: apiObj,
+		id:     apiObj.ID,
+		info:   info,
+		c:      c,
+	}
+}
+
+var _ gitprovider.Milestone = &milestone{}
+
+type milestone struct {
+	// apiObj is either a *gitlab.Milestone or a *gitlab.GroupMilestone
+	apiObj interface{}
+	id     int
+	info   gitprovider.MilestoneInfo
+	c      *MilestoneClient
+}
+
+func (m *milestone) Get() gitprovider.MilestoneInfo {
+	return m.info
+}
+
+func (m *milestone) Set(info gitprovider.MilestoneInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	m.info = info
+	return nil
+}
+
+func (m *milestone) APIObject() interface{} {
+	return m.apiObj
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *milestone) Update(ctx context.Context) error {
+	var stateEvent *string
+	switch m.info.State {
+	case gitprovider.MilestoneStateOpen:
+		stateEvent = gitlab.Ptr("activate")
+	case gitprovider.MilestoneStateClosed:
+		stateEvent = gitlab.Ptr("close")
+	}
+	return m.update(ctx, gitlab.Ptr(m.info.Title), gitlab.Ptr(m.info.Description), dueDateToAPI(m.info.DueDate), stateEvent)
+}
+
+// Close closes the milestone.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *milestone) Close(ctx context.Context) error {
+	return m.update(ctx, nil, nil, nil, gitlab.Ptr("close"))
+}
+
+func (m *milestone) update(ctx context.Context, title, description *string, dueDate *gitlab.ISOTime, stateEvent *string) error {
+	if repoRef, ok := m.c.ref.(gitprovider.RepositoryRef); ok {
+		// PUT /projects/{project}/milestones/{milestone_id}
+		apiObj, _, err := m.c.c.Client().Milestones.UpdateMilestone(getRepoPath(repoRef), m.id, &gitlab.UpdateMilestoneOptions{
+			Title:       title,
+			Description: description,
+			DueDate:     dueDate,
+			StateEvent:  stateEvent,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		*m = *newProjectMilestone(m.c, apiObj)
+		return nil
+	}
+	// PUT /groups/{group}/milestones/{milestone_id}
+	apiObj, _, err := m.c.c.Client().GroupMilestones.UpdateGroupMilestone(m.c.ref.GetIdentity(), m.id, &gitlab.UpdateGroupMilestoneOptions{
+		Title:       title,
+		Description: description,
+		DueDate:     dueDate,
+		StateEvent:  stateEvent,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*m = *newGroupMilestone(m.c, apiObj)
+	return nil
+}
+
+func milestoneFromAPI(apiObj *gitlab.Milestone) gitprovider.MilestoneInfo {
+	info := gitprovider.MilestoneInfo{
+		ID:          apiObj.ID,
+		Title:       apiObj.Title,
+		Description: apiObj.Description,
+		State:       gitprovider.MilestoneStateOpen,
+		WebURL:      apiObj.WebURL,
+	}
+	// GitLab calls open milestones "active"
+	if apiObj.State == "closed" {
+		info.State = gitprovider.MilestoneStateClosed
+	}
+	if apiObj.DueDate != nil {
+		t := time.Time(*apiObj.DueDate)
+		info.DueDate = &t
+	}
+	return info
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Mirror = &mirror{}
+
+type mirror struct {
+	// apiObj is a *gitlab.ProjectMirror for push mirrors, and the *gitlab.Project for the pull mirror
+	apiObj interface{}
+	info   gitprovider.MirrorInfo
+	c      *MirrorClient
+}
+
+func newPushMirror(c *MirrorClient, apiObj *gitlab.ProjectMirror) *mirror {
+	return &mirror{
+		apiObj: apiObj,
+		info: gitprovider.MirrorInfo{
+			ID:                    strconv.Itoa(apiObj.ID),
+			Direction:             gitprovider.MirrorDirectionPush,
+			URL:                   stripMirrorCredentials(apiObj.URL),
+			Enabled:               gitlab.Ptr(apiObj.Enabled),
+			OnlyProtectedBranches: gitlab.Ptr(apiObj.OnlyProtectedBranches),
+		},
+		c: c,
+	}
+}
+
+func newPullMirror(c *MirrorClient, project *gitlab.Project) *mirror {
+	return &mirror{
+		apiObj: project,
+		info: gitprovider.MirrorInfo{
+			ID:                    pullMirrorID,
+			Direction:             gitprovider.MirrorDirectionPull,
+			URL:                   stripMirrorCredentials(project.ImportURL),
+			OnlyProtectedBranches: gitlab.Ptr(project.OnlyMirrorProtectedBranches),
+		},
+		c: c,
+	}
+}
+
+func (m *mirror) Get() gitprovider.MirrorInfo {
+	return m.info
+}
+
+func (m *mirror) Set(info gitprovider.MirrorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	m.info = info
+	return nil
+}
+
+func (m *mirror) APIObject() interface{} {
+	return m.apiObj
+}
+
+func (m *mirror) Repository() gitprovider.RepositoryRef {
+	return m.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function. The URL of a push mirror can't be changed, so push mirrors are re-created
+// with a new ID if their URL or credentials change.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (m *mirror) Update(ctx context.Context) error {
+	if err := validateMirrorInfo(m.info); err != nil {
+		return err
+	}
+
+	if project, ok := m.apiObj.(*gitlab.Project); ok {
+		opts := &gitlab.EditProjectOptions{
+			OnlyMirrorProtectedBranches: m.info.OnlyProtectedBranches,
+		}
+		// Don't drop the stored credentials, unless the URL changes
+		if m.info.Username != nil || m.info.URL != stripMirrorCredentials(project.ImportURL) {
+			opts.ImportURL = gitlab.Ptr(mirrorURL(m.info))
+		}
+		// PUT /projects/{project}
+		apiObj, _, err := m.c.c.Client().Projects.EditProject(getRepoPath(m.c.ref), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		*m = *newPullMirror(m.c, apiObj)
+		return nil
+	}
+
+	pm := m.apiObj.(*gitlab.ProjectMirror)
+	if m.info.Username != nil || m.info.URL != stripMirrorCredentials(pm.URL) {
+		if err := m.Delete(ctx); err != nil {
+			return err
+		}
+		created, err := m.c.Create(ctx, m.info)
+		if err != nil {
+			return err
+		}
+		*m = *created.(*mirror)
+		return nil
+	}
+	opts := &gitlab.EditProjectMirrorOptions{
+		Enabled:               m.info.Enabled,
+		OnlyProtectedBranches: m.info.OnlyProtectedBranches,
+	}
+	// PUT /projects/{project}/remote_mirrors/{id}
+	apiObj, _, err := m.c.c.Client().ProjectMirrors.EditProjectMirror(getRepoPath(m.c.ref), pm.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*m = *newPushMirror(m.c, apiObj)
+	return nil
+}
+
+// Delete removes the push mirror, or stops the project from pulling from the pull mirror.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (m *mirror) Delete(ctx context.Context) error {
+	if _, ok := m.apiObj.(*gitlab.Project); ok {
+		opts := &gitlab.EditProjectOptions{
+			Mirror: gitlab.Ptr(false),
+		}
+		// PUT /projects/{project}
+		_, _, err := m.c.c.Client().Projects.EditProject(getRepoPath(m.c.ref), opts, gitlab.WithContext(ctx))
+		return handleHTTPError(err)
+	}
+
+	// DELETE /projects/{project}/remote_mirrors/{id}
+	_, err := m.c.c.Client().ProjectMirrors.DeleteProjectMirror(getRepoPath(m.c.ref), m.apiObj.(*gitlab.ProjectMirror).ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (m *mirror) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := m.c.find(ctx, m.info)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			created, err := m.c.Create(ctx, m.info)
+			if err != nil {
+				return false, err
+			}
+			*m = *created.(*mirror)
+			return true, nil
+		}
+
+		// Unexpected path, find should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if m.info.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	m.apiObj = actual.apiObj
+	m.info.ID = actual.info.ID
+	return true, m.Update(ctx)
+}
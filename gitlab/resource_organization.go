@@ -17,6 +17,9 @@ limitations under the License.
 package gitlab
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -32,6 +35,50 @@ func newOrganization(ctx *clientContext, apiObj *gitlab.Group, ref gitprovider.O
 			clientContext: ctx,
 			ref:           ref,
 		},
+		runners: &RunnerClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		bots: &BotClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		accessTokens: &AccessTokenClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		milestones: &MilestoneClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		members: &OrganizationMemberClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		variables: &OrganizationVariableClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		auditLog: &AuditLogClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		scim: &SCIMClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		sshCAs: &SSHCertificateAuthorityClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		ipAllowList: &IPAllowListClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
@@ -43,7 +90,18 @@ type organization struct {
 	g   gitlab.Group
 	ref gitprovider.OrganizationRef
 
-	teams *TeamsClient
+	teams        *TeamsClient
+	runners      *RunnerClient
+	bots         *BotClient
+	accessTokens *AccessTokenClient
+	labels       *LabelClient
+	milestones   *MilestoneClient
+	members      *OrganizationMemberClient
+	variables    *OrganizationVariableClient
+	auditLog     *AuditLogClient
+	scim         *SCIMClient
+	sshCAs       *SSHCertificateAuthorityClient
+	ipAllowList  *IPAllowListClient
 }
 
 func (o *organization) Get() gitprovider.OrganizationInfo {
@@ -62,10 +120,135 @@ func (o *organization) Teams() gitprovider.TeamsClient {
 	return o.teams
 }
 
+func (o *organization) Runners() (gitprovider.RunnerClient, error) {
+	return o.runners, nil
+}
+
+func (o *organization) Bots() (gitprovider.BotClient, error) {
+	return o.bots, nil
+}
+
+func (o *organization) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return o.accessTokens, nil
+}
+
+func (o *organization) Labels() (gitprovider.LabelClient, error) {
+	return o.labels, nil
+}
+
+func (o *organization) Milestones() (gitprovider.MilestoneClient, error) {
+	return o.milestones, nil
+}
+
+func (o *organization) Members() (gitprovider.OrganizationMemberClient, error) {
+	return o.members, nil
+}
+
+func (o *organization) Variables() (gitprovider.OrganizationVariableClient, error) {
+	return o.variables, nil
+}
+
+func (o *organization) AuditLog() (gitprovider.AuditLogClient, error) {
+	if err := o.checkCapability(gitprovider.CapabilityAuditLog); err != nil {
+		return nil, err
+	}
+	return o.auditLog, nil
+}
+
+func (o *organization) SCIM() (gitprovider.SCIMClient, error) {
+	if err := o.checkCapability(gitprovider.CapabilitySCIM); err != nil {
+		return nil, err
+	}
+	return o.scim, nil
+}
+
+func (o *organization) SSHCertificateAuthorities() (gitprovider.SSHCertificateAuthorityClient, error) {
+	return o.sshCAs, nil
+}
+
+func (o *organization) IPAllowList() (gitprovider.IPAllowListClient, error) {
+	return o.ipAllowList, nil
+}
+
+// Set sets the desired state of the group. In order to apply these changes, run .Update()
+// or .Reconcile().
+func (o *organization) Set(info gitprovider.OrganizationInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.DefaultRepositoryPermission != nil {
+		return errNoDefaultRepositoryPermission
+	}
+	organizationInfoToAPIObj(&info, &o.g)
+	return nil
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a *gitlab.Group and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (o *organization) Update(ctx context.Context) error {
+	opts := &gitlab.UpdateGroupOptions{
+		Name:                 gitlab.Ptr(o.g.Name),
+		Description:          gitlab.Ptr(o.g.Description),
+		Visibility:           gitlab.Ptr(o.g.Visibility),
+		RequireTwoFactorAuth: gitlab.Ptr(o.g.RequireTwoFactorAuth),
+	}
+	// PUT /groups/{group}
+	apiObj, _, err := o.c.Client().Groups.UpdateGroup(o.g.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	o.g = *apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (o *organization) Reconcile(ctx context.Context) (bool, error) {
+	orgs := &OrganizationsClient{clientContext: o.clientContext}
+	resp, actionTaken, err := orgs.Reconcile(ctx, o.ref, o.Get())
+	if err != nil {
+		return actionTaken, err
+	}
+	*o = *resp.(*organization)
+	return actionTaken, nil
+}
+
+// errNoDefaultRepositoryPermission is returned when setting the DefaultRepositoryPermission
+// of a group, as GitLab grants project access through group membership only.
+var errNoDefaultRepositoryPermission = fmt.Errorf("groups have no default repository permission: %w", gitprovider.ErrNoProviderSupport)
+
 func organizationFromAPI(apiObj *gitlab.Group) gitprovider.OrganizationInfo {
 	return gitprovider.OrganizationInfo{
-		Name:        &apiObj.Name,
-		Description: &apiObj.Description,
+		Name:             &apiObj.Name,
+		Description:      &apiObj.Description,
+		Visibility:       gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility(apiObj.Visibility)),
+		RequireTwoFactor: &apiObj.RequireTwoFactorAuth,
+	}
+}
+
+func organizationInfoToAPIObj(info *gitprovider.OrganizationInfo, apiObj *gitlab.Group) {
+	if info.Name != nil {
+		apiObj.Name = *info.Name
+	}
+	if info.Description != nil {
+		apiObj.Description = *info.Description
+	}
+	if info.Visibility != nil {
+		apiObj.Visibility = gitlab.VisibilityValue(*info.Visibility)
+	}
+	if info.RequireTwoFactor != nil {
+		apiObj.RequireTwoFactorAuth = *info.RequireTwoFactor
 	}
 }
 
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// newTestSubgroup returns the fluxcd/team subgroup of a client talking to a server with the given
+// mux, which gets the handler of the subgroup added.
+func newTestSubgroup(t *testing.T, mux *http.ServeMux) gitprovider.Organization {
+	t.Helper()
+	mux.HandleFunc("GET /api/v4/groups/fluxcd%2Fteam", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":8,"name":"team","path":"team","full_path":"fluxcd/team","description":"Subgroup","visibility":"private"}`))
+	})
+	c := newTestOrganizationsClient(t, mux)
+
+	ref := gitprovider.OrganizationRef{Domain: c.(*OrganizationsClient).domain, Organization: "fluxcd/team"}
+	org, err := c.Get(context.Background(), ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return org
+}
+
+func TestOrganization_Set(t *testing.T) {
+	org := newTestSubgroup(t, http.NewServeMux())
+
+	if err := org.Set(gitprovider.OrganizationInfo{Name: gitprovider.StringVar("")}); err == nil {
+		t.Error("Set() with an empty name succeeded, want an error")
+	}
+	perm := gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull)
+	if err := org.Set(gitprovider.OrganizationInfo{DefaultRepositoryPermission: perm}); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Set() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+
+	if err := org.Set(gitprovider.OrganizationInfo{Description: gitprovider.StringVar("Updated")}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	// Unset fields keep their value
+	want := gitprovider.OrganizationInfo{
+		Name:             gitprovider.StringVar("team"),
+		Description:      gitprovider.StringVar("Updated"),
+		Visibility:       gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate),
+		RequireTwoFactor: gitprovider.BoolVar(false),
+	}
+	if diff := cmp.Diff(want, org.Get()); diff != "" {
+		t.Errorf("Get() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOrganization_Update(t *testing.T) {
+	var got map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/v4/groups/8", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"id":8,"name":"Team","path":"team","full_path":"fluxcd/team","description":"Updated","visibility":"internal","require_two_factor_authentication":true}`))
+	})
+	org := newTestSubgroup(t, mux)
+
+	if err := org.Set(gitprovider.OrganizationInfo{
+		Name:             gitprovider.StringVar("Team"),
+		Description:      gitprovider.StringVar("Updated"),
+		Visibility:       gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityInternal),
+		RequireTwoFactor: gitprovider.BoolVar(true),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := org.Update(context.Background()); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	want := map[string]interface{}{
+		"name":                              "Team",
+		"description":                       "Updated",
+		"visibility":                        "internal",
+		"require_two_factor_authentication": true,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Update() request mismatch (-want +got):\n%s", diff)
+	}
+	if org.Organization().Organization != "fluxcd/team" {
+		t.Errorf("Update() = %s, want fluxcd/team", org.Organization().Organization)
+	}
+}
+
+func TestOrganization_Reconcile(t *testing.T) {
+	tests := []struct {
+		name            string
+		info            gitprovider.OrganizationInfo
+		wantActionTaken bool
+	}{
+		{
+			name: "up to date",
+			info: gitprovider.OrganizationInfo{Description: gitprovider.StringVar("Subgroup")},
+		},
+		{
+			name:            "update",
+			info:            gitprovider.OrganizationInfo{Description: gitprovider.StringVar("Updated")},
+			wantActionTaken: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /api/v4/groups/8", func(w http.ResponseWriter, r *http.Request) {
+				updated = true
+				_, _ = w.Write([]byte(`{"id":8,"name":"team","path":"team","full_path":"fluxcd/team","description":"Updated","visibility":"private"}`))
+			})
+			org := newTestSubgroup(t, mux)
+
+			if err := org.Set(tt.info); err != nil {
+				t.Fatal(err)
+			}
+			actionTaken, err := org.Reconcile(context.Background())
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if actionTaken != tt.wantActionTaken || updated != tt.wantActionTaken {
+				t.Errorf("Reconcile() actionTaken = %t, updated = %t, want %t", actionTaken, updated, tt.wantActionTaken)
+			}
+			if desc := org.Get().Description; desc == nil || *desc != *tt.info.Description {
+				t.Errorf("Reconcile() description = %v, want %q", desc, *tt.info.Description)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newOrganizationVariable(c *OrganizationVariableClient, v *gitlab.GroupVariable) *organizationVariable {
+	return &organizationVariable{
+		v: *v,
+		c: c,
+	}
+}
+
+var _ gitprovider.OrganizationVariable = &organizationVariable{}
+
+type organizationVariable struct {
+	v gitlab.GroupVariable
+	c *OrganizationVariableClient
+}
+
+func (v *organizationVariable) Get() gitprovider.OrganizationVariableInfo {
+	return organizationVariableFromAPI(&v.v)
+}
+
+func (v *organizationVariable) Set(info gitprovider.OrganizationVariableInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return organizationVariableInfoToAPIObj(&info, &v.v)
+}
+
+func (v *organizationVariable) APIObject() interface{} {
+	return &v.v
+}
+
+func (v *organizationVariable) Organization() gitprovider.OrganizationRef {
+	return v.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a *gitlab.GroupVariable and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (v *organizationVariable) Update(ctx context.Context) error {
+	opts := &gitlab.UpdateGroupVariableOptions{
+		Value:            &v.v.Value,
+		Masked:           &v.v.Masked,
+		Protected:        &v.v.Protected,
+		EnvironmentScope: &v.v.EnvironmentScope,
+	}
+	// PUT /groups/{group}/variables/{key}
+	apiObj, _, err := v.c.c.Client().GroupVariables.UpdateVariable(v.c.ref.Organization, v.v.Key, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	v.v = *apiObj
+	return nil
+}
+
+// Delete deletes the variable from the group.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *organizationVariable) Delete(ctx context.Context) error {
+	// DELETE /groups/{group}/variables/{key}
+	_, err := v.c.c.Client().GroupVariables.RemoveVariable(v.c.ref.Organization, v.v.Key, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (v *organizationVariable) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := v.c.get(ctx, v.v.Key, v.Get().Environment)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, v.createIntoSelf(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if v.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	return true, v.Update(ctx)
+}
+
+func (v *organizationVariable) createIntoSelf(ctx context.Context) error {
+	opts := &gitlab.CreateGroupVariableOptions{
+		Key:              &v.v.Key,
+		Value:            &v.v.Value,
+		Masked:           &v.v.Masked,
+		Protected:        &v.v.Protected,
+		EnvironmentScope: &v.v.EnvironmentScope,
+	}
+	// POST /groups/{group}/variables
+	apiObj, _, err := v.c.c.Client().GroupVariables.CreateVariable(v.c.ref.Organization, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	v.v = *apiObj
+	return nil
+}
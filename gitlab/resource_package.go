@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Package = &pkg{}
+
+// pkg is a package in the package registry of a project. Its versions are
+// returned along with the package list, so they are kept as the API object.
+type pkg struct {
+	info gitprovider.PackageInfo
+	p    []*gitlab.Package
+	c    *PackageClient
+}
+
+func (p *pkg) Get() gitprovider.PackageInfo {
+	return p.info
+}
+
+func (p *pkg) APIObject() interface{} {
+	return p.p
+}
+
+func (p *pkg) Repository() gitprovider.RepositoryRef {
+	return p.c.ref
+}
+
+// Versions returns the versions of the package, as fetched by PackageClient.List.
+func (p *pkg) Versions(_ context.Context) ([]gitprovider.PackageVersion, error) {
+	versions := make([]gitprovider.PackageVersion, 0, len(p.p))
+	for _, apiObj := range p.p {
+		versions = append(versions, &packageVersion{v: *apiObj, c: p.c})
+	}
+	return versions, nil
+}
+
+var _ gitprovider.PackageVersion = &packageVersion{}
+
+type packageVersion struct {
+	v gitlab.Package
+	c *PackageClient
+}
+
+func (v *packageVersion) Get() gitprovider.PackageVersionInfo {
+	info := gitprovider.PackageVersionInfo{
+		Version:   v.v.Version,
+		CreatedAt: v.v.CreatedAt,
+	}
+	for _, tag := range v.v.Tags {
+		info.Tags = append(info.Tags, tag.Name)
+	}
+	return info
+}
+
+func (v *packageVersion) APIObject() interface{} {
+	return &v.v
+}
+
+// Delete removes the package version, including all of its files.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *packageVersion) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/packages/{package_id}
+	_, err := v.c.c.Client().Packages.DeleteProjectPackage(getRepoPath(v.c.ref), v.v.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func newContainerRepository(c *PackageClient, apiObj *gitlab.RegistryRepository) *containerRepository {
+	return &containerRepository{
+		r: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Package = &containerRepository{}
+
+// containerRepository is an image repository in the container registry of a project.
+type containerRepository struct {
+	r gitlab.RegistryRepository
+	c *PackageClient
+}
+
+func (r *containerRepository) Get() gitprovider.PackageInfo {
+	return gitprovider.PackageInfo{
+		Name: r.r.Path,
+		Type: gitprovider.PackageTypeContainer,
+	}
+}
+
+func (r *containerRepository) APIObject() interface{} {
+	return &r.r
+}
+
+func (r *containerRepository) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Versions lists the tags of the image, using multiple paginated requests if needed.
+func (r *containerRepository) Versions(ctx context.Context) ([]gitprovider.PackageVersion, error) {
+	opts := &gitlab.ListRegistryRepositoryTagsOptions{}
+	versions := []gitprovider.PackageVersion{}
+	err := allRegistryRepositoryTagPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/registry/repositories/{repository_id}/tags
+		pageObjs, resp, listErr := r.c.c.Client().ContainerRegistry.ListRegistryRepositoryTags(getRepoPath(r.c.ref), r.r.ID, opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			versions = append(versions, &containerTag{t: *apiObj, r: r})
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+var _ gitprovider.PackageVersion = &containerTag{}
+
+// containerTag is a tag of an image in the container registry.
+type containerTag struct {
+	t gitlab.RegistryRepositoryTag
+	r *containerRepository
+}
+
+func (t *containerTag) Get() gitprovider.PackageVersionInfo {
+	return gitprovider.PackageVersionInfo{
+		Version:   t.t.Name,
+		Tags:      []string{t.t.Name},
+		CreatedAt: t.t.CreatedAt,
+	}
+}
+
+func (t *containerTag) APIObject() interface{} {
+	return &t.t
+}
+
+// Delete removes the tag from the image. The manifest it points to is removed
+// by the garbage collection of the registry once it isn't tagged anymore.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *containerTag) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/registry/repositories/{repository_id}/tags/{tag_name}
+	_, err := t.r.c.c.Client().ContainerRegistry.DeleteRegistryRepositoryTag(getRepoPath(t.r.c.ref), t.r.r.ID, t.t.Name, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
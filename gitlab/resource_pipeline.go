@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// pipelineConclusions maps the final states of GitLab pipelines and jobs
+// to gitprovider.PipelineConclusion.
+//
+//nolint:gochecknoglobals
+var pipelineConclusions = map[string]gitprovider.PipelineConclusion{
+	"success":  gitprovider.PipelineConclusionSuccess,
+	"failed":   gitprovider.PipelineConclusionFailure,
+	"canceled": gitprovider.PipelineConclusionCancelled,
+	"skipped":  gitprovider.PipelineConclusionSkipped,
+	"manual":   gitprovider.PipelineConclusionActionRequired,
+}
+
+func newPipeline(c *PipelineClient, apiObj *gitlab.Pipeline) *pipeline {
+	return &pipeline{
+		p: *apiObj,
+		c: c,
+	}
+}
+
+// newPipelineFromInfo returns the pipeline of an item of the pipelines list, which only has
+// some of the fields of a pipeline.
+func newPipelineFromInfo(c *PipelineClient, apiObj *gitlab.PipelineInfo) *pipeline {
+	return newPipeline(c, &gitlab.Pipeline{
+		ID:        apiObj.ID,
+		IID:       apiObj.IID,
+		ProjectID: apiObj.ProjectID,
+		Status:    apiObj.Status,
+		Source:    apiObj.Source,
+		Ref:       apiObj.Ref,
+		SHA:       apiObj.SHA,
+		WebURL:    apiObj.WebURL,
+		UpdatedAt: apiObj.UpdatedAt,
+		CreatedAt: apiObj.CreatedAt,
+	})
+}
+
+var _ gitprovider.Pipeline = &pipeline{}
+
+// pipeline is a GitLab CI pipeline. Pipelines returned by PipelineClient.List
+// only have the fields of the list response set in the API object.
+type pipeline struct {
+	p gitlab.Pipeline
+	c *PipelineClient
+}
+
+func (p *pipeline) Get() gitprovider.PipelineInfo {
+	status, conclusion := pipelineStatusFromAPI(p.p.Status)
+	info := gitprovider.PipelineInfo{
+		ID:         int64(p.p.ID),
+		Name:       p.p.Name,
+		Ref:        p.p.Ref,
+		SHA:        p.p.SHA,
+		Status:     status,
+		Conclusion: conclusion,
+		WebURL:     p.p.WebURL,
+	}
+	if p.p.CreatedAt != nil {
+		info.CreatedAt = *p.p.CreatedAt
+	}
+	if p.p.UpdatedAt != nil {
+		info.UpdatedAt = *p.p.UpdatedAt
+	}
+	return info
+}
+
+func (p *pipeline) APIObject() interface{} {
+	return &p.p
+}
+
+func (p *pipeline) Repository() gitprovider.RepositoryRef {
+	return p.c.ref
+}
+
+// Jobs lists the jobs of the pipeline, without retried jobs and trigger jobs of
+// downstream pipelines, using multiple paginated requests if needed.
+func (p *pipeline) Jobs(ctx context.Context) ([]gitprovider.PipelineJobInfo, error) {
+	opts := &gitlab.ListJobsOptions{}
+	jobs := []gitprovider.PipelineJobInfo{}
+	err := allPipelineJobPages(ctx, opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/pipelines/{pipeline_id}/jobs
+		pageObjs, resp, listErr := p.c.c.Client().Jobs.ListPipelineJobs(getRepoPath(p.c.ref), p.p.ID, opts, gitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			jobs = append(jobs, pipelineJobFromAPI(apiObj))
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func pipelineJobFromAPI(apiObj *gitlab.Job) gitprovider.PipelineJobInfo {
+	status, conclusion := pipelineStatusFromAPI(apiObj.Status)
+	return gitprovider.PipelineJobInfo{
+		ID:         int64(apiObj.ID),
+		Name:       apiObj.Name,
+		Stage:      apiObj.Stage,
+		Status:     status,
+		Conclusion: conclusion,
+		StartedAt:  apiObj.StartedAt,
+		FinishedAt: apiObj.FinishedAt,
+		WebURL:     apiObj.WebURL,
+	}
+}
+
+// pipelineStatusFromAPI maps the status of a pipeline or job. Pipelines and jobs
+// waiting for a manual action are completed, and require action to continue.
+func pipelineStatusFromAPI(status string) (gitprovider.PipelineStatus, *gitprovider.PipelineConclusion) {
+	if c, ok := pipelineConclusions[status]; ok {
+		return gitprovider.PipelineStatusCompleted, gitprovider.PipelineConclusionVar(c)
+	}
+	if status == "running" {
+		return gitprovider.PipelineStatusInProgress, nil
+	}
+	return gitprovider.PipelineStatusQueued, nil
+}
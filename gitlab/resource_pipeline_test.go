@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_pipelineStatusFromAPI(t *testing.T) {
+	tests := []struct {
+		status         string
+		wantStatus     gitprovider.PipelineStatus
+		wantConclusion *gitprovider.PipelineConclusion
+	}{
+		{"created", gitprovider.PipelineStatusQueued, nil},
+		{"waiting_for_resource", gitprovider.PipelineStatusQueued, nil},
+		{"pending", gitprovider.PipelineStatusQueued, nil},
+		{"running", gitprovider.PipelineStatusInProgress, nil},
+		{"success", gitprovider.PipelineStatusCompleted, gitprovider.PipelineConclusionVar(gitprovider.PipelineConclusionSuccess)},
+		{"failed", gitprovider.PipelineStatusCompleted, gitprovider.PipelineConclusionVar(gitprovider.PipelineConclusionFailure)},
+		{"canceled", gitprovider.PipelineStatusCompleted, gitprovider.PipelineConclusionVar(gitprovider.PipelineConclusionCancelled)},
+		{"manual", gitprovider.PipelineStatusCompleted, gitprovider.PipelineConclusionVar(gitprovider.PipelineConclusionActionRequired)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			status, conclusion := pipelineStatusFromAPI(tt.status)
+			if status != tt.wantStatus {
+				t.Errorf("pipelineStatusFromAPI() status = %v, want %v", status, tt.wantStatus)
+			}
+			if (conclusion == nil) != (tt.wantConclusion == nil) || (conclusion != nil && *conclusion != *tt.wantConclusion) {
+				t.Errorf("pipelineStatusFromAPI() conclusion = %v, want %v", conclusion, tt.wantConclusion)
+			}
+		})
+	}
+}
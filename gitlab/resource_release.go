@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+func newRelease(c *ReleaseClient, apiObj *gitlab.Release) *release {
+	return &release{
+		r: *apiObj,
+		c: c,
+	}
+}
+
+var _ experimental.Release = &release{}
+
+type release struct {
+	r gitlab.Release
+	c *ReleaseClient
+}
+
+func (r *release) Get() experimental.ReleaseInfo {
+	return experimental.ReleaseInfo{
+		TagName:     r.r.TagName,
+		Name:        r.r.Name,
+		Description: r.r.Description,
+		WebURL:      r.r.Links.Self,
+	}
+}
+
+func (r *release) APIObject() interface{} {
+	return &r.r
+}
+
+func (r *release) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Delete deletes the release. The tag is kept.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (r *release) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/releases/{tag_name}
+	_, _, err := r.c.c.Client().Releases.DeleteRelease(getRepoPath(r.c.ref), r.r.TagName, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
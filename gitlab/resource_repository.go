@@ -19,11 +19,14 @@ package gitlab
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	gogitlab "github.com/xanzy/go-gitlab"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
 )
 
 func newUserProject(ctx *clientContext, apiObj *gogitlab.Project, ref gitprovider.RepositoryRef) *userProject {
@@ -39,6 +42,70 @@ func newUserProject(ctx *clientContext, apiObj *gogitlab.Project, ref gitprovide
 			clientContext: ctx,
 			ref:           ref,
 		},
+		variables: &VariableClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		artifacts: &ArtifactClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		packages: &PackageClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		environments: &EnvironmentClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		deployments: &DeploymentClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		mirrors: &MirrorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		runners: &RunnerClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		bots: &BotClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		accessTokens: &AccessTokenClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		schedules: &ScheduleClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		pipelines: &PipelineClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		milestones: &MilestoneClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		autolinks: &AutolinkClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		commits: &CommitClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -69,18 +136,40 @@ type userProject struct {
 
 	p   gogitlab.Project
 	ref gitprovider.RepositoryRef
-
-	deployKeys   *DeployKeyClient
-	deployTokens *DeployTokenClient
-	commits      *CommitClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	files        *FileClient
-	trees        *TreeClient
+	// statistics and metadata are only fetched if requested through the call options
+	statistics *gitprovider.RepositoryStatistics
+	metadata   *gitprovider.RepositoryMetadata
+
+	deployKeys    *DeployKeyClient
+	deployTokens  *DeployTokenClient
+	variables     *VariableClient
+	artifacts     *ArtifactClient
+	packages      *PackageClient
+	environments  *EnvironmentClient
+	deployments   *DeploymentClient
+	mirrors       *MirrorClient
+	runners       *RunnerClient
+	bots          *BotClient
+	accessTokens  *AccessTokenClient
+	schedules     *ScheduleClient
+	pipelines     *PipelineClient
+	issues        *IssueClient
+	labels        *LabelClient
+	milestones    *MilestoneClient
+	autolinks     *AutolinkClient
+	collaborators *CollaboratorClient
+	commits       *CommitClient
+	branches      *BranchClient
+	pullRequests  *PullRequestClient
+	files         *FileClient
+	trees         *TreeClient
 }
 
 func (p *userProject) Get() gitprovider.RepositoryInfo {
-	return repositoryFromAPI(&p.p)
+	info := repositoryFromAPI(&p.p)
+	info.Statistics = p.statistics
+	info.Metadata = p.metadata
+	return info
 }
 
 func (p *userProject) Set(info gitprovider.RepositoryInfo) error {
@@ -95,6 +184,12 @@ func (p *userProject) APIObject() interface{} {
 	return &p.p
 }
 
+// SSHEndpoint returns the SSH endpoint reported by GitLab, which reflects the gitlab_shell_ssh_port
+// and SSH host of the instance.
+func (p *userProject) SSHEndpoint() (gitprovider.SSHEndpoint, error) {
+	return gitprovider.GetSSHEndpoint(p.p.SSHURLToRepo, p.ref)
+}
+
 func (p *userProject) Repository() gitprovider.RepositoryRef {
 	return p.ref
 }
@@ -107,6 +202,302 @@ func (p *userProject) DeployTokens() (gitprovider.DeployTokenClient, error) {
 	return p.deployTokens, nil
 }
 
+func (p *userProject) Variables() (gitprovider.VariableClient, error) {
+	return p.variables, nil
+}
+
+func (p *userProject) Artifacts() (gitprovider.ArtifactClient, error) {
+	return p.artifacts, nil
+}
+
+func (p *userProject) Packages() (gitprovider.PackageClient, error) {
+	return p.packages, nil
+}
+
+func (p *userProject) Environments() (gitprovider.EnvironmentClient, error) {
+	return p.environments, nil
+}
+
+func (p *userProject) Deployments() (gitprovider.DeploymentClient, error) {
+	return p.deployments, nil
+}
+
+func (p *userProject) Mirrors() (gitprovider.MirrorClient, error) {
+	return p.mirrors, nil
+}
+
+func (p *userProject) Runners() (gitprovider.RunnerClient, error) {
+	return p.runners, nil
+}
+
+func (p *userProject) Issues() (gitprovider.IssueClient, error) {
+	return p.issues, nil
+}
+
+func (p *userProject) Labels() (gitprovider.LabelClient, error) {
+	return p.labels, nil
+}
+
+func (p *userProject) Milestones() (gitprovider.MilestoneClient, error) {
+	return p.milestones, nil
+}
+
+func (p *userProject) Autolinks() (gitprovider.AutolinkClient, error) {
+	return p.autolinks, nil
+}
+
+func (p *userProject) Collaborators() (gitprovider.CollaboratorClient, error) {
+	return p.collaborators, nil
+}
+
+// Releases returns the experimental ReleaseClient of the project.
+// Use experimental.Releases to access it through the gitprovider interfaces.
+func (p *userProject) Releases() (experimental.ReleaseClient, error) {
+	return &ReleaseClient{
+		clientContext: p.clientContext,
+		ref:           p.ref,
+	}, nil
+}
+
+// Snippets returns the experimental SnippetClient of the project.
+// Use experimental.Snippets to access it through the gitprovider interfaces.
+func (p *userProject) Snippets() (experimental.SnippetClient, error) {
+	return &SnippetClient{
+		clientContext: p.clientContext,
+		ref:           p.ref,
+	}, nil
+}
+
+// FreezePeriods returns the experimental FreezePeriodClient of the project.
+// Use experimental.FreezePeriods to access it through the gitprovider interfaces.
+func (p *userProject) FreezePeriods() (experimental.FreezePeriodClient, error) {
+	return &FreezePeriodClient{
+		clientContext: p.clientContext,
+		ref:           p.ref,
+	}, nil
+}
+
+func (p *userProject) Bots() (gitprovider.BotClient, error) {
+	return p.bots, nil
+}
+
+func (p *userProject) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return p.accessTokens, nil
+}
+
+func (p *userProject) Schedules() (gitprovider.ScheduleClient, error) {
+	return p.schedules, nil
+}
+
+func (p *userProject) Pipelines() (gitprovider.PipelineClient, error) {
+	return p.pipelines, nil
+}
+
+func (p *userProject) LFSUsage(ctx context.Context) (int64, error) {
+	// GET /projects/{project}?statistics=true
+	apiObj, _, err := p.c.Client().Projects.GetProject(getRepoPath(p.ref), &gogitlab.GetProjectOptions{
+		Statistics: gogitlab.Ptr(true),
+	}, gogitlab.WithContext(ctx))
+	if err != nil {
+		return 0, handleHTTPError(err)
+	}
+	// Statistics are only returned to users with at least the Reporter role
+	if apiObj.Statistics == nil {
+		return 0, fmt.Errorf("project statistics not returned: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return apiObj.Statistics.LFSObjectsSize, nil
+}
+
+func (p *userProject) Languages(ctx context.Context) (map[string]float64, error) {
+	// GET /projects/{project}/languages
+	apiObj, _, err := p.c.Client().Projects.GetProjectLanguages(getRepoPath(p.ref), gogitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// GitLab already returns percentages
+	languages := make(map[string]float64, len(*apiObj))
+	for lang, percentage := range *apiObj {
+		languages[lang] = float64(percentage)
+	}
+	return languages, nil
+}
+
+func (p *userProject) GetUserPermission(ctx context.Context, username string) (*gitprovider.RepositoryPermission, error) {
+	userID, err := p.collaborators.userID(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	// GET /projects/{id}/members/all/{user_id}
+	apiObj, _, err := p.c.Client().ProjectMembers.GetInheritedProjectMember(getRepoPath(p.ref), userID, gogitlab.WithContext(ctx))
+	if err != nil {
+		if err = handleHTTPError(err); errors.Is(err, gitprovider.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// Minimal access doesn't grant access to the project itself
+	if apiObj.AccessLevel < gogitlab.GuestPermissions {
+		return nil, nil
+	}
+	return getGitProviderPermission(int(apiObj.AccessLevel))
+}
+
+// loadOptionalInfo fetches the statistics and metadata of the project, if requested through
+// the call options.
+func (p *userProject) loadOptionalInfo(ctx context.Context) error {
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	if callOpts.ShouldIncludeStatistics() {
+		if err := p.loadStatistics(ctx); err != nil {
+			return err
+		}
+	}
+	if callOpts.ShouldIncludeMetadata() {
+		return p.loadMetadata(ctx)
+	}
+	return nil
+}
+
+// loadStatistics fetches the statistics of the project.
+// The size and commit count are only reported to members with at least the Reporter role.
+func (p *userProject) loadStatistics(ctx context.Context) error {
+	languages, err := p.Languages(ctx)
+	if err != nil {
+		return err
+	}
+	// GET /projects/{id}/merge_requests
+	_, resp, err := p.c.Client().MergeRequests.ListProjectMergeRequests(getRepoPath(p.ref), &gogitlab.ListProjectMergeRequestsOptions{
+		ListOptions: gogitlab.ListOptions{PerPage: 1},
+		State:       gogitlab.Ptr("opened"),
+	}, gogitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	openMergeRequests := resp.TotalItems
+
+	statistics := &gitprovider.RepositoryStatistics{
+		Languages:        languages,
+		OpenIssues:       gogitlab.Ptr(p.p.OpenIssuesCount),
+		OpenPullRequests: &openMergeRequests,
+	}
+	// The statistics were requested when getting the project
+	if p.p.Statistics != nil {
+		statistics.Size = p.p.Statistics.RepositorySize
+		statistics.CommitCount = gogitlab.Ptr(int(p.p.Statistics.CommitCount))
+	}
+	p.statistics = statistics
+	return nil
+}
+
+// loadMetadata detects the license and README of the project.
+func (p *userProject) loadMetadata(ctx context.Context) error {
+	readme, err := gitprovider.DetectReadme(ctx, p.listDir)
+	if err != nil {
+		return err
+	}
+	metadata := &gitprovider.RepositoryMetadata{
+		ReadmePath: readme,
+	}
+	// The license was requested when getting the project
+	if p.p.License != nil {
+		metadata.LicenseSPDXID = licenseKeyToSPDXID(p.p.License.Key)
+	}
+	p.metadata = metadata
+	return nil
+}
+
+// licenseKeyToSPDXID converts the lowercase license keys reported by GitLab into SPDX
+// identifiers. Unknown keys are returned as-is.
+func licenseKeyToSPDXID(key string) string {
+	if id, ok := spdxIDs[key]; ok {
+		return id
+	}
+	return key
+}
+
+// spdxIDs maps the license keys reported by GitLab to SPDX identifiers.
+//
+//nolint:gochecknoglobals
+var spdxIDs = map[string]string{
+	"agpl-3.0":     "AGPL-3.0",
+	"apache-2.0":   "Apache-2.0",
+	"bsd-2-clause": "BSD-2-Clause",
+	"bsd-3-clause": "BSD-3-Clause",
+	"bsl-1.0":      "BSL-1.0",
+	"cc0-1.0":      "CC0-1.0",
+	"epl-2.0":      "EPL-2.0",
+	"gpl-2.0":      "GPL-2.0",
+	"gpl-3.0":      "GPL-3.0",
+	"isc":          "ISC",
+	"lgpl-2.1":     "LGPL-2.1",
+	"lgpl-3.0":     "LGPL-3.0",
+	"mit":          "MIT",
+	"mpl-2.0":      "MPL-2.0",
+	"unlicense":    "Unlicense",
+	// GitLab reports licenses it doesn't recognize as "other"
+	"other": "NOASSERTION",
+}
+
+// GetWatchLevel returns the notification level of the authenticated user for the project.
+// The "global", "mention" and "custom" levels are reported as participating.
+func (p *userProject) GetWatchLevel(ctx context.Context) (gitprovider.WatchLevel, error) {
+	// GET /projects/{project}/notification_settings
+	apiObj, _, err := p.c.Client().NotificationSettings.GetSettingsForProject(getRepoPath(p.ref), gogitlab.WithContext(ctx))
+	if err != nil {
+		return "", handleHTTPError(err)
+	}
+	switch apiObj.Level {
+	case gogitlab.WatchNotificationLevel:
+		return gitprovider.WatchLevelWatching, nil
+	case gogitlab.DisabledNotificationLevel:
+		return gitprovider.WatchLevelIgnoring, nil
+	default:
+		return gitprovider.WatchLevelParticipating, nil
+	}
+}
+
+func (p *userProject) SetWatchLevel(ctx context.Context, level gitprovider.WatchLevel) error {
+	if err := gitprovider.ValidateWatchLevel(level); err != nil {
+		return fmt.Errorf("watch level %q: %w", level, err)
+	}
+	apiLevel := gogitlab.ParticipatingNotificationLevel
+	switch level {
+	case gitprovider.WatchLevelWatching:
+		apiLevel = gogitlab.WatchNotificationLevel
+	case gitprovider.WatchLevelIgnoring:
+		apiLevel = gogitlab.DisabledNotificationLevel
+	}
+	// PUT /projects/{project}/notification_settings
+	_, _, err := p.c.Client().NotificationSettings.UpdateSettingsForProject(getRepoPath(p.ref), &gogitlab.NotificationSettingsOptions{
+		Level: &apiLevel,
+	}, gogitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func (p *userProject) DetectCI(ctx context.Context) (*gitprovider.CIInfo, error) {
+	return gitprovider.DetectCI(ctx, p.listDir)
+}
+
+// listDir lists the paths of the entries of dir on the default branch.
+func (p *userProject) listDir(ctx context.Context, dir string) ([]string, error) {
+	opts := &gogitlab.ListTreeOptions{}
+	if dir != "" {
+		opts.Path = &dir
+	}
+	paths := []string{}
+	err := allTreePages(ctx, opts, func() (*gogitlab.Response, error) {
+		// GET /projects/{project}/repository/tree
+		pageObjs, resp, listErr := p.c.Client().Repositories.ListTree(getRepoPath(p.ref), opts, gogitlab.WithContext(ctx))
+		for _, apiObj := range pageObjs {
+			paths = append(paths, apiObj.Path)
+		}
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
 func (p *userProject) Commits() gitprovider.CommitClient {
 	return p.commits
 }
@@ -129,12 +520,47 @@ func (p *userProject) Trees() gitprovider.TreeClient {
 
 // The internal API object will be overridden with the received server data.
 func (p *userProject) Update(ctx context.Context) error {
+	// The archival state can't be set when editing the project
+	archived := p.p.Archived
 	// PATCH /repos/{owner}/{repo}
 	apiObj, err := p.c.UpdateProject(ctx, &p.p)
 	if err != nil {
 		return err
 	}
 	p.p = *apiObj
+	if p.p.Archived != archived {
+		return p.setArchived(ctx, archived)
+	}
+	return nil
+}
+
+// Archive archives the project, after which its repository is read-only.
+func (p *userProject) Archive(ctx context.Context) error {
+	return p.setArchived(ctx, true)
+}
+
+// Unarchive unarchives the project.
+func (p *userProject) Unarchive(ctx context.Context) error {
+	return p.setArchived(ctx, false)
+}
+
+func (p *userProject) setArchived(ctx context.Context, archived bool) error {
+	var apiObj *gogitlab.Project
+	var err error
+	if archived {
+		// POST /projects/{project}/archive
+		apiObj, _, err = p.c.Client().Projects.ArchiveProject(getRepoPath(p.ref), gogitlab.WithContext(ctx))
+	} else {
+		// POST /projects/{project}/unarchive
+		apiObj, _, err = p.c.Client().Projects.UnarchiveProject(getRepoPath(p.ref), gogitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	if err := validateProjectAPI(apiObj); err != nil {
+		return err
+	}
+	p.p = *apiObj
 	return nil
 }
 
@@ -178,6 +604,82 @@ func (p *userProject) Reconcile(ctx context.Context) (bool, error) {
 	return true, p.Update(ctx)
 }
 
+// Fork forks the project into the namespace of the given user or group. If target is nil,
+// the project is forked into the namespace of the authenticated user.
+func (p *userProject) Fork(ctx context.Context, target gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	opts := &gogitlab.ForkProjectOptions{}
+	if target != nil {
+		// Unlike elsewhere, subgroups are fine here
+		if !gitprovider.DomainsEqual(target.GetDomain(), p.domain) {
+			return nil, fmt.Errorf("domain %q not supported by this client: %w", target.GetDomain(), gitprovider.ErrDomainUnsupported)
+		}
+		opts.NamespacePath = gogitlab.Ptr(target.GetIdentity())
+	}
+
+	// POST /projects/{project}/fork
+	apiObj, _, err := p.c.Client().Projects.ForkProject(getRepoPath(p.ref), opts, gogitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateProjectAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newProjectFromAPI(p.clientContext, apiObj), nil
+}
+
+// ListForks lists the forks of the project.
+//
+// ListForks returns all available forks, using multiple paginated requests if needed.
+func (p *userProject) ListForks(ctx context.Context) ([]gitprovider.UserRepository, error) {
+	opts := &gogitlab.ListProjectsOptions{}
+	apiObjs := []*gogitlab.Project{}
+	err := allProjectPages(ctx, opts, func() (*gogitlab.Response, error) {
+		// GET /projects/{project}/forks
+		pageObjs, resp, listErr := p.c.Client().Projects.ListProjectForks(getRepoPath(p.ref), opts, gogitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, handleHTTPError(listErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	forks := make([]gitprovider.UserRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateProjectAPI(apiObj); err != nil {
+			return nil, err
+		}
+		forks = append(forks, newProjectFromAPI(p.clientContext, apiObj))
+	}
+	return forks, nil
+}
+
+// Transfer moves the project to the given user namespace or group, which happens immediately on GitLab.
+func (p *userProject) Transfer(ctx context.Context, newOwner gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	// Don't allow transferring repositories if the user didn't explicitly allow dangerous API calls.
+	if !p.destructiveActions {
+		return nil, fmt.Errorf("cannot transfer repository: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	if newOwner == nil {
+		return nil, fmt.Errorf("new owner is required: %w", gitprovider.ErrInvalidArgument)
+	}
+	// Subgroups are fine here too
+	if !gitprovider.DomainsEqual(newOwner.GetDomain(), p.domain) {
+		return nil, fmt.Errorf("domain %q not supported by this client: %w", newOwner.GetDomain(), gitprovider.ErrDomainUnsupported)
+	}
+
+	// PUT /projects/{project}/transfer
+	apiObj, _, err := p.c.Client().Projects.TransferProject(getRepoPath(p.ref), &gogitlab.TransferProjectOptions{
+		Namespace: newOwner.GetIdentity(),
+	}, gogitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateProjectAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newProjectFromAPI(p.clientContext, apiObj), nil
+}
+
 // Delete deletes the current resource irreversibly.
 //
 // ErrNotFound is returned if the resource doesn't exist anymore.
@@ -195,6 +697,48 @@ func newGroupProject(ctx *clientContext, apiObj *gogitlab.Project, ref gitprovid
 	}
 }
 
+// newProjectFromAPI wraps a project that may be in the namespace of any user or group, e.g. a fork.
+func newProjectFromAPI(ctx *clientContext, apiObj *gogitlab.Project) gitprovider.UserRepository {
+	if apiObj.Namespace != nil && apiObj.Namespace.Kind == "group" {
+		groups := strings.Split(apiObj.Namespace.FullPath, "/")
+		orgRef := gitprovider.OrganizationRef{
+			Domain:       ctx.domain,
+			Organization: groups[0],
+		}
+		if len(groups) > 1 {
+			orgRef.SubOrganizations = groups[1:]
+		}
+		return newGroupProject(ctx, apiObj, gitprovider.OrgRepositoryRef{
+			OrganizationRef: orgRef,
+			RepositoryName:  apiObj.Path,
+		})
+	}
+	userLogin := ""
+	if apiObj.Namespace != nil {
+		userLogin = apiObj.Namespace.Path
+	}
+	return newUserProject(ctx, apiObj, gitprovider.UserRepositoryRef{
+		UserRef: gitprovider.UserRef{
+			Domain:    ctx.domain,
+			UserLogin: userLogin,
+		},
+		RepositoryName: apiObj.Path,
+	})
+}
+
+// projectMoved returns the project at its canonical location, and true, if apiObj, as returned
+// when getting ref, shows that the project has been renamed or transferred.
+func projectMoved(ctx *clientContext, ref gitprovider.RepositoryRef, apiObj *gogitlab.Project) (gitprovider.UserRepository, bool) {
+	if apiObj.Namespace == nil {
+		return nil, false
+	}
+	project := newProjectFromAPI(ctx, apiObj)
+	if gitprovider.RepositoryRefsEqual(ref, project.Repository(), caseSensitiveNames) {
+		return nil, false
+	}
+	return project, true
+}
+
 var _ gitprovider.OrgRepository = &orgRepository{}
 
 type orgRepository struct {
@@ -257,6 +801,12 @@ func repositoryFromAPI(apiObj *gogitlab.Project) gitprovider.RepositoryInfo {
 		DefaultBranch: &apiObj.DefaultBranch,
 	}
 	repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility(apiObj.Visibility))
+	if len(apiObj.Topics) != 0 {
+		repo.Topics = apiObj.Topics
+	}
+	repo.Archived = gitprovider.BoolVar(apiObj.Archived)
+	repo.LFSEnabled = gitprovider.BoolVar(apiObj.LFSEnabled)
+	repo.WikiEnabled = gitprovider.BoolVar(wikiEnabled(apiObj))
 	return repo
 }
 
@@ -278,12 +828,41 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *gogitlab.P
 	if repo.Visibility != nil {
 		apiObj.Visibility = gitlabVisibilityMap[*repo.Visibility]
 	}
+	if repo.Topics != nil {
+		apiObj.Topics = repo.Topics
+	}
+	if repo.Archived != nil {
+		apiObj.Archived = *repo.Archived
+	}
+	if repo.LFSEnabled != nil {
+		apiObj.LFSEnabled = *repo.LFSEnabled
+	}
+	// Keep a wiki that is only visible to project members enabled
+	if repo.WikiEnabled != nil && *repo.WikiEnabled != wikiEnabled(apiObj) {
+		apiObj.WikiAccessLevel = wikiAccessLevel(*repo.WikiEnabled)
+	}
+}
+
+// wikiEnabled returns whether the wiki of the project is enabled, for any audience.
+func wikiEnabled(apiObj *gogitlab.Project) bool {
+	if apiObj.WikiAccessLevel == "" {
+		return apiObj.WikiEnabled
+	}
+	return apiObj.WikiAccessLevel != gogitlab.DisabledAccessControl
+}
+
+// wikiAccessLevel returns the wiki access level for enabling or disabling the wiki.
+func wikiAccessLevel(enabled bool) gogitlab.AccessControlValue {
+	if enabled {
+		return gogitlab.EnabledAccessControl
+	}
+	return gogitlab.DisabledAccessControl
 }
 
 // This function copies over the fields that are part of create/update requests of a project
 // i.e. the desired spec of the repository. This allows us to separate "spec" from "status" fields.
 func newGitlabProjectSpec(project *gogitlab.Project) *gitlabProjectSpec {
-	return &gitlabProjectSpec{
+	spec := &gitlabProjectSpec{
 		&gogitlab.Project{
 			// Generic
 			Name:        project.Name,
@@ -292,9 +871,17 @@ func newGitlabProjectSpec(project *gogitlab.Project) *gitlabProjectSpec {
 			Visibility:  project.Visibility,
 
 			// Update-specific parameters
-			DefaultBranch: project.DefaultBranch,
+			DefaultBranch:   project.DefaultBranch,
+			Archived:        project.Archived,
+			LFSEnabled:      project.LFSEnabled,
+			WikiAccessLevel: project.WikiAccessLevel,
 		},
 	}
+	// The server returns an empty list if there are no topics, which equals having none set
+	if len(project.Topics) != 0 {
+		spec.Topics = project.Topics
+	}
+	return spec
 }
 
 type gitlabProjectSpec struct {
@@ -302,7 +889,12 @@ type gitlabProjectSpec struct {
 }
 
 func (s *gitlabProjectSpec) Equals(other *gitlabProjectSpec) bool {
-	return cmp.Equal(s, other)
+	// Names only differing in case refer to the same project
+	desired := *s.Project
+	if gitprovider.NamesEqual(desired.Name, other.Name, caseSensitiveNames) {
+		desired.Name = other.Name
+	}
+	return cmp.Equal(&desired, other.Project)
 }
 
 // nolint
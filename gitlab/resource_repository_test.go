@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gogitlab "github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// newTestProject returns the fluxcd/flux2 project of a client talking to a server with the given handler.
+func newTestProject(t *testing.T, handler http.Handler, opts ...gitprovider.ClientOption) *userProject {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("", "", "token", TokenTypePat, append([]gitprovider.ClientOption{gitprovider.WithDomain(server.URL), gitprovider.WithAllowInsecureHTTP(true)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: c.SupportedDomain(), Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	return newUserProject(c.(*Client).clientContext, &gogitlab.Project{Name: "flux2", Path: "flux2"}, ref)
+}
+
+// projectRef returns the identity and name of the repository, and whether it's owned by a group.
+func projectRef(repo gitprovider.UserRepository) (string, bool) {
+	_, isOrg := repo.(gitprovider.OrgRepository)
+	return repo.Repository().GetIdentity() + "/" + repo.Repository().GetRepository(), isOrg
+}
+
+func Test_userProject_Fork(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        func(domain string) gitprovider.IdentityRef
+		wantNamespace string
+		wantRef       string
+		wantOrg       bool
+	}{
+		{
+			name:    "into the authenticated user",
+			target:  func(string) gitprovider.IdentityRef { return nil },
+			wantRef: "alice/flux2",
+		},
+		{
+			name: "into a user",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.UserRef{Domain: domain, UserLogin: "alice"}
+			},
+			wantNamespace: "alice",
+			wantRef:       "alice/flux2",
+		},
+		{
+			name: "into a subgroup",
+			target: func(domain string) gitprovider.IdentityRef {
+				return gitprovider.OrganizationRef{Domain: domain, Organization: "my-org", SubOrganizations: []string{"team"}}
+			},
+			wantNamespace: "my-org/team",
+			wantRef:       "my-org/team/flux2",
+			wantOrg:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forkReq *gogitlab.ForkProjectOptions
+			p := newTestProject(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.EscapedPath() != "POST /api/v4/projects/fluxcd%2Fflux2/fork" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				forkReq = &gogitlab.ForkProjectOptions{}
+				_ = json.NewDecoder(r.Body).Decode(forkReq)
+				namespace := `{"kind":"user","path":"alice","full_path":"alice"}`
+				if forkReq.NamespacePath != nil && *forkReq.NamespacePath != "alice" {
+					namespace = `{"kind":"group","path":"team","full_path":"` + *forkReq.NamespacePath + `"}`
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"name":"flux2","path":"flux2","namespace":` + namespace + `,"forked_from_project":{"path_with_namespace":"fluxcd/flux2"}}`))
+			}))
+
+			fork, err := p.Fork(context.Background(), tt.target(p.domain))
+			if err != nil {
+				t.Fatalf("Fork() error = %v", err)
+			}
+			if forkReq == nil {
+				t.Fatal("Fork() didn't send a fork request")
+			}
+			got := ""
+			if forkReq.NamespacePath != nil {
+				got = *forkReq.NamespacePath
+			}
+			if got != tt.wantNamespace {
+				t.Errorf("fork request namespace = %q, want %q", got, tt.wantNamespace)
+			}
+			if ref, isOrg := projectRef(fork); ref != tt.wantRef || isOrg != tt.wantOrg {
+				t.Errorf("Fork() = %s (group %t), want %s (group %t)", ref, isOrg, tt.wantRef, tt.wantOrg)
+			}
+		})
+	}
+}
+
+func Test_userProject_ListForks(t *testing.T) {
+	p := newTestProject(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.EscapedPath() != "GET /api/v4/projects/fluxcd%2Fflux2/forks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"name":"flux2","path":"flux2","namespace":{"kind":"user","path":"alice","full_path":"alice"}},
+			{"name":"flux2","path":"flux2","namespace":{"kind":"group","path":"team","full_path":"my-org/team"}}
+		]`))
+	}))
+
+	forks, err := p.ListForks(context.Background())
+	if err != nil {
+		t.Fatalf("ListForks() error = %v", err)
+	}
+	want := []struct {
+		ref   string
+		isOrg bool
+	}{
+		{"alice/flux2", false},
+		{"my-org/team/flux2", true},
+	}
+	if len(forks) != len(want) {
+		t.Fatalf("ListForks() returned %d forks, want %d", len(forks), len(want))
+	}
+	for i, w := range want {
+		if ref, isOrg := projectRef(forks[i]); ref != w.ref || isOrg != w.isOrg {
+			t.Errorf("ListForks()[%d] = %s (group %t), want %s (group %t)", i, ref, isOrg, w.ref, w.isOrg)
+		}
+	}
+}
+
+func Test_userProject_Transfer(t *testing.T) {
+	tests := []struct {
+		name        string
+		destructive bool
+		wantRef     string
+		expectedErr error
+	}{
+		{
+			name:        "to a subgroup",
+			destructive: true,
+			wantRef:     "my-org/team/flux2",
+		},
+		{
+			name:        "destructive calls are off",
+			expectedErr: gitprovider.ErrDestructiveCallDisallowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var transferReq *gogitlab.TransferProjectOptions
+			p := newTestProject(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.EscapedPath() != "PUT /api/v4/projects/fluxcd%2Fflux2/transfer" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				transferReq = &gogitlab.TransferProjectOptions{}
+				_ = json.NewDecoder(r.Body).Decode(transferReq)
+				_, _ = w.Write([]byte(`{"name":"flux2","path":"flux2","namespace":{"kind":"group","path":"team","full_path":"my-org/team"}}`))
+			}), gitprovider.WithDestructiveAPICalls(tt.destructive))
+
+			newOwner := gitprovider.OrganizationRef{Domain: p.domain, Organization: "my-org", SubOrganizations: []string{"team"}}
+			repo, err := p.Transfer(context.Background(), newOwner)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Transfer() error = %v, want %v", err, tt.expectedErr)
+			}
+			if err != nil {
+				if transferReq != nil {
+					t.Error("Transfer() sent a transfer request")
+				}
+				return
+			}
+			if transferReq == nil || transferReq.Namespace != "my-org/team" {
+				t.Errorf("transfer request = %+v, want namespace %q", transferReq, "my-org/team")
+			}
+			if ref, isOrg := projectRef(repo); ref != tt.wantRef || !isOrg {
+				t.Errorf("Transfer() = %s (group %t), want %s (group true)", ref, isOrg, tt.wantRef)
+			}
+		})
+	}
+}
+
+func Test_userProject_GetUserPermission(t *testing.T) {
+	tests := []struct {
+		name        string
+		accessLevel gogitlab.AccessLevelValue
+		want        *gitprovider.RepositoryPermission
+	}{
+		{name: "guest", accessLevel: gogitlab.GuestPermissions, want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull)},
+		{name: "reporter", accessLevel: gogitlab.ReporterPermissions, want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionTriage)},
+		{name: "developer", accessLevel: gogitlab.DeveloperPermissions, want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush)},
+		{name: "maintainer", accessLevel: gogitlab.MaintainerPermissions, want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionMaintain)},
+		{name: "owner", accessLevel: gogitlab.OwnerPermissions, want: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin)},
+		{name: "minimal access", accessLevel: gogitlab.MinimalAccessPermissions},
+		{name: "not a member"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`[{"id":7,"username":"alice"}]`))
+			})
+			mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/members/all/7", func(w http.ResponseWriter, r *http.Request) {
+				if tt.accessLevel == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message":"404 Not found"}`))
+					return
+				}
+				_, _ = fmt.Fprintf(w, `{"id":7,"username":"alice","access_level":%d}`, tt.accessLevel)
+			})
+			p := newTestProject(t, mux)
+
+			got, err := p.GetUserPermission(context.Background(), "alice")
+			if err != nil {
+				t.Fatalf("GetUserPermission() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetUserPermission() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_userProject_GetWatchLevel(t *testing.T) {
+	tests := []struct {
+		level string
+		want  gitprovider.WatchLevel
+	}{
+		{level: "watch", want: gitprovider.WatchLevelWatching},
+		{level: "disabled", want: gitprovider.WatchLevelIgnoring},
+		{level: "participating", want: gitprovider.WatchLevelParticipating},
+		{level: "global", want: gitprovider.WatchLevelParticipating},
+		{level: "mention", want: gitprovider.WatchLevelParticipating},
+	}
+	for _, tt := range tests {
+		t.Run(tt.level, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v4/projects/fluxcd%2Fflux2/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"level":"` + tt.level + `"}`))
+			})
+			p := newTestProject(t, mux)
+
+			got, err := p.GetWatchLevel(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("GetWatchLevel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_userProject_SetWatchLevel(t *testing.T) {
+	tests := []struct {
+		level gitprovider.WatchLevel
+		want  string
+	}{
+		{level: gitprovider.WatchLevelWatching, want: "watch"},
+		{level: gitprovider.WatchLevelIgnoring, want: "disabled"},
+		{level: gitprovider.WatchLevelParticipating, want: "participating"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.level), func(t *testing.T) {
+			var got string
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /api/v4/projects/fluxcd%2Fflux2/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Level string `json:"level"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				got = body.Level
+				_, _ = w.Write([]byte(`{"level":"` + body.Level + `"}`))
+			})
+			p := newTestProject(t, mux)
+
+			if err := p.SetWatchLevel(context.Background(), tt.level); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("SetWatchLevel() sent level %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	p := &userProject{}
+	if err := p.SetWatchLevel(context.Background(), "bogus"); !errors.Is(err, validation.ErrFieldEnumInvalid) {
+		t.Errorf("SetWatchLevel() error = %v, want %v", err, validation.ErrFieldEnumInvalid)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var _ gitprovider.Schedule = &schedule{}
+
+type schedule struct {
+	s    gitlab.PipelineSchedule
+	info gitprovider.ScheduleInfo
+	c    *ScheduleClient
+}
+
+func newSchedule(c *ScheduleClient, apiObj *gitlab.PipelineSchedule) *schedule {
+	return &schedule{
+		s:    *apiObj,
+		info: scheduleFromAPI(apiObj),
+		c:    c,
+	}
+}
+
+func scheduleFromAPI(apiObj *gitlab.PipelineSchedule) gitprovider.ScheduleInfo {
+	return gitprovider.ScheduleInfo{
+		ID:           strconv.Itoa(apiObj.ID),
+		Description:  apiObj.Description,
+		Ref:          apiObj.Ref,
+		Cron:         apiObj.Cron,
+		CronTimezone: gitlab.Ptr(apiObj.CronTimezone),
+		Active:       gitlab.Ptr(apiObj.Active),
+	}
+}
+
+func (s *schedule) Get() gitprovider.ScheduleInfo {
+	return s.info
+}
+
+func (s *schedule) Set(info gitprovider.ScheduleInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	s.info = info
+	return nil
+}
+
+func (s *schedule) APIObject() interface{} {
+	return &s.s
+}
+
+func (s *schedule) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (s *schedule) Update(ctx context.Context) error {
+	opts := &gitlab.EditPipelineScheduleOptions{
+		Description:  gitlab.Ptr(s.info.Description),
+		Ref:          gitlab.Ptr(s.info.Ref),
+		Cron:         gitlab.Ptr(s.info.Cron),
+		CronTimezone: s.info.CronTimezone,
+		Active:       s.info.Active,
+	}
+	// PUT /projects/{project}/pipeline_schedules/{id}
+	apiObj, _, err := s.c.c.Client().PipelineSchedules.EditPipelineSchedule(getRepoPath(s.c.ref), s.s.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	*s = *newSchedule(s.c, apiObj)
+	return nil
+}
+
+// Delete deletes the pipeline schedule from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (s *schedule) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/pipeline_schedules/{id}
+	_, err := s.c.c.Client().PipelineSchedules.DeletePipelineSchedule(getRepoPath(s.c.ref), s.s.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (s *schedule) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := s.c.find(ctx, s.info.Description)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			created, err := s.c.Create(ctx, s.info)
+			if err != nil {
+				return false, err
+			}
+			*s = *created.(*schedule)
+			return true, nil
+		}
+
+		// Unexpected path, find should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if s.info.Equals(actual.info) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	s.s = actual.s
+	s.info.ID = actual.info.ID
+	return true, s.Update(ctx)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/experimental"
+)
+
+func newSnippet(c *SnippetClient, apiObj *gitlab.Snippet) *snippet {
+	return &snippet{
+		s: *apiObj,
+		c: c,
+	}
+}
+
+var _ experimental.Snippet = &snippet{}
+
+type snippet struct {
+	s gitlab.Snippet
+	c *SnippetClient
+	// content is the content to upload at the next Update, if set
+	content string
+}
+
+func (s *snippet) Get() experimental.SnippetInfo {
+	return experimental.SnippetInfo{
+		ID:          s.s.ID,
+		Title:       s.s.Title,
+		FileName:    s.s.FileName,
+		Description: s.s.Description,
+		Visibility:  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility(s.s.Visibility)),
+		WebURL:      s.s.WebURL,
+	}
+}
+
+func (s *snippet) Set(info experimental.SnippetInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	s.s.Title = info.Title
+	s.s.FileName = info.FileName
+	s.s.Description = info.Description
+	if info.Visibility != nil {
+		s.s.Visibility = string(*info.Visibility)
+	}
+	s.content = info.Content
+	return nil
+}
+
+func (s *snippet) APIObject() interface{} {
+	return &s.s
+}
+
+func (s *snippet) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+// Content downloads the content of the snippet.
+func (s *snippet) Content(ctx context.Context) ([]byte, error) {
+	// GET /projects/{project}/snippets/{snippet_id}/raw
+	content, _, err := s.c.c.Client().ProjectSnippets.SnippetContent(getRepoPath(s.c.ref), s.s.ID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return content, nil
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (s *snippet) Update(ctx context.Context) error {
+	opts := &gitlab.UpdateProjectSnippetOptions{
+		Title:       gitlab.Ptr(s.s.Title),
+		FileName:    gitlab.Ptr(s.s.FileName),
+		Description: gitlab.Ptr(s.s.Description),
+		Visibility:  gitlab.Ptr(gitlab.VisibilityValue(s.s.Visibility)),
+	}
+	if s.content != "" {
+		opts.Content = gitlab.Ptr(s.content)
+	}
+	// PUT /projects/{project}/snippets/{snippet_id}
+	apiObj, _, err := s.c.c.Client().ProjectSnippets.UpdateSnippet(getRepoPath(s.c.ref), s.s.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return handleHTTPError(err)
+	}
+	s.s = *apiObj
+	s.content = ""
+	return nil
+}
+
+// Delete deletes the snippet.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (s *snippet) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/snippets/{snippet_id}
+	_, err := s.c.c.Client().ProjectSnippets.DeleteSnippet(getRepoPath(s.c.ref), s.s.ID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newVariable(c *VariableClient, v *gitlab.ProjectVariable) *variable {
+	return &variable{
+		v: *v,
+		c: c,
+	}
+}
+
+var _ gitprovider.Variable = &variable{}
+
+type variable struct {
+	v gitlab.ProjectVariable
+	c *VariableClient
+}
+
+func (v *variable) Get() gitprovider.VariableInfo {
+	return variableFromAPI(&v.v)
+}
+
+func (v *variable) Set(info gitprovider.VariableInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	variableInfoToAPIObj(&info, &v.v)
+	return nil
+}
+
+func (v *variable) APIObject() interface{} {
+	return &v.v
+}
+
+func (v *variable) Repository() gitprovider.RepositoryRef {
+	return v.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (v *variable) Update(ctx context.Context) error {
+	// PUT /projects/{project}/variables/{key}
+	apiObj, err := v.c.c.UpdateVariable(ctx, getRepoPath(v.c.ref), &v.v)
+	if err != nil {
+		return err
+	}
+	v.v = *apiObj
+	return nil
+}
+
+// Delete deletes the variable from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (v *variable) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/variables/{key}
+	return v.c.c.DeleteVariable(ctx, getRepoPath(v.c.ref), v.v.Key, v.v.EnvironmentScope)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (v *variable) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := v.c.get(ctx, v.v.Key, v.Get().Environment)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, v.createIntoSelf(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if v.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	return true, v.Update(ctx)
+}
+
+func (v *variable) createIntoSelf(ctx context.Context) error {
+	// POST /projects/{project}/variables
+	apiObj, err := v.c.c.CreateVariable(ctx, getRepoPath(v.c.ref), &v.v)
+	if err != nil {
+		return err
+	}
+	v.v = *apiObj
+	return nil
+}
+
+// allEnvironmentsScope is the GitLab environment scope of variables available in all environments.
+const allEnvironmentsScope = "*"
+
+func validateVariableAPI(apiObj *gitlab.ProjectVariable) error {
+	return validateAPIObject("GitLab.ProjectVariable", func(validator validation.Validator) {
+		if apiObj.Key == "" {
+			validator.Required("Key")
+		}
+	})
+}
+
+func variableFromAPI(apiObj *gitlab.ProjectVariable) gitprovider.VariableInfo {
+	info := gitprovider.VariableInfo{
+		Key:       apiObj.Key,
+		Value:     apiObj.Value,
+		Masked:    gitprovider.BoolVar(apiObj.Masked),
+		Protected: gitprovider.BoolVar(apiObj.Protected),
+	}
+	if apiObj.EnvironmentScope != allEnvironmentsScope {
+		info.Environment = apiObj.EnvironmentScope
+	}
+	return info
+}
+
+func variableToAPI(info *gitprovider.VariableInfo) *gitlab.ProjectVariable {
+	v := &gitlab.ProjectVariable{}
+	variableInfoToAPIObj(info, v)
+	return v
+}
+
+func variableInfoToAPIObj(info *gitprovider.VariableInfo, apiObj *gitlab.ProjectVariable) {
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.Key = info.Key
+	apiObj.Value = info.Value
+	apiObj.EnvironmentScope = allEnvironmentsScope
+	// optional fields
+	if info.Environment != "" {
+		apiObj.EnvironmentScope = info.Environment
+	}
+	if info.Masked != nil {
+		apiObj.Masked = *info.Masked
+	}
+	if info.Protected != nil {
+		apiObj.Protected = *info.Protected
+	}
+}
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -16,118 +17,172 @@ const (
 	alreadyExistsMagicString = "name: [has already been taken]"
 	alreadySharedWithGroup   = "already shared with this group"
 	defaultBranchName        = "main"
+	// caseSensitiveNames is false, as GitLab routes project and group paths case-insensitively.
+	caseSensitiveNames = false
+	// defaultPageSize is the number of items GitLab returns per page by default.
+	defaultPageSize = 20
+	// listPageSize is the number of items requested per page by the List iterators,
+	// the maximum GitLab allows.
+	listPageSize = 100
 )
 
 func getRepoPath(ref gitprovider.RepositoryRef) string {
 	return fmt.Sprintf("%s/%s", ref.GetIdentity(), ref.GetRepository())
 }
 
+// iteratorPageSize returns the number of items the List iterators request per page.
+func iteratorPageSize(ctx context.Context) int {
+	return gitprovider.CallOptionsFromContext(ctx).ListOptions().PageSize(listPageSize, listPageSize)
+}
+
 // allPages runs fn for each page, expecting a HTTP request to be made and returned during that call.
 // allPages expects that the data is saved in fn to an outer variable.
 // allPages calls fn as many times as needed to get all pages, and modifies opts for each call.
-// There is no need to wrap the resulting error in handleHTTPError(err), as that's already done.
-func allGroupPages(opts *gitlab.ListGroupsOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
-			return handleHTTPError(err)
-		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
+// The page size, first page and number of pages honor the ListOptions of ctx.
+// The typed helpers below call allPages for the options of each list.
+func allPages(ctx context.Context, opts *gitlab.ListOptions, fn func() (*gitlab.Response, error)) error {
+	listOpts := gitprovider.CallOptionsFromContext(ctx).ListOptions()
+	if listOpts.PerPage > 0 {
+		opts.PerPage = listOpts.PageSize(defaultPageSize, listPageSize)
 	}
-}
-
-func allSubgroupPages(opts *gitlab.ListSubGroupsOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
+	if listOpts.Page > 0 {
+		opts.Page = listOpts.Page
+	}
+	pageSize := opts.PerPage
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	for fetched := pageSize; ; fetched += pageSize {
+		// Stop between pages once the call is cancelled or past its deadline.
+		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
-	}
-}
-
-func allGroupProjectPages(opts *gitlab.ListGroupProjectsOptions, fn func() (*gitlab.Response, error)) error {
-	for {
 		resp, err := fn()
 		if err != nil {
 			return err
 		}
-		if resp.NextPage == 0 {
+		if resp.NextPage == 0 || listOpts.Reached(fetched) {
 			return nil
 		}
 		opts.Page = resp.NextPage
 	}
 }
 
-func allGroupMemberPages(opts *gitlab.ListGroupMembersOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
-			return err
-		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
-	}
+// allGroupPages wraps the resulting error in handleHTTPError(err), so there is no need to do so again.
+func allGroupPages(ctx context.Context, opts *gitlab.ListGroupsOptions, fn func() (*gitlab.Response, error)) error {
+	return handleHTTPError(allPages(ctx, &opts.ListOptions, fn))
 }
 
-func allProjectPages(opts *gitlab.ListProjectsOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
-			return err
-		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
-	}
+func allSubgroupPages(ctx context.Context, opts *gitlab.ListSubGroupsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
 }
 
-func allProjectUserPages(opts *gitlab.ListProjectUserOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
-			return err
-		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
-	}
+func allGroupProjectPages(ctx context.Context, opts *gitlab.ListGroupProjectsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
 }
 
-func allDeployKeyPages(opts *gitlab.ListProjectDeployKeysOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
-			return err
-		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
-	}
+func allGroupMemberPages(ctx context.Context, opts *gitlab.ListGroupMembersOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
 }
 
-func allDeployTokenPages(opts *gitlab.ListProjectDeployTokensOptions, fn func() (*gitlab.Response, error)) error {
-	for {
-		resp, err := fn()
-		if err != nil {
-			return err
-		}
-		if resp.NextPage == 0 {
-			return nil
-		}
-		opts.Page = resp.NextPage
-	}
+func allProjectPages(ctx context.Context, opts *gitlab.ListProjectsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allProjectUserPages(ctx context.Context, opts *gitlab.ListProjectUserOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allDeployKeyPages(ctx context.Context, opts *gitlab.ListProjectDeployKeysOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allDeployTokenPages(ctx context.Context, opts *gitlab.ListProjectDeployTokensOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allVariablePages(ctx context.Context, opts *gitlab.ListProjectVariablesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allGroupVariablePages(ctx context.Context, opts *gitlab.ListGroupVariablesOptions, fn func() (*gitlab.Response, error)) error {
+	return handleHTTPError(allPages(ctx, (*gitlab.ListOptions)(opts), fn))
+}
+
+func allPackagePages(ctx context.Context, opts *gitlab.ListProjectPackagesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allPackageFilePages(ctx context.Context, opts *gitlab.ListPackageFilesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allEnvironmentPages(ctx context.Context, opts *gitlab.ListEnvironmentsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allDeploymentPages(ctx context.Context, opts *gitlab.ListProjectDeploymentsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allTreePages(ctx context.Context, opts *gitlab.ListTreeOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allProjectMirrorPages(ctx context.Context, opts *gitlab.ListProjectMirrorOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allProjectAccessTokenPages(ctx context.Context, opts *gitlab.ListProjectAccessTokensOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allGroupAccessTokenPages(ctx context.Context, opts *gitlab.ListGroupAccessTokensOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allServiceAccountPages(ctx context.Context, opts *gitlab.ListServiceAccountsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allProjectIssuePages(ctx context.Context, opts *gitlab.ListProjectIssuesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allIssueNotePages(ctx context.Context, opts *gitlab.ListIssueNotesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allReleasePages(ctx context.Context, opts *gitlab.ListReleasesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allLabelPages(ctx context.Context, opts *gitlab.ListLabelsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allGroupLabelPages(ctx context.Context, opts *gitlab.ListGroupLabelsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allProjectMemberPages(ctx context.Context, opts *gitlab.ListProjectMembersOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allPendingInvitationPages(ctx context.Context, opts *gitlab.ListPendingInvitationsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allMilestonePages(ctx context.Context, opts *gitlab.ListMilestonesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allGroupMilestonePages(ctx context.Context, opts *gitlab.ListGroupMilestonesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allPipelineSchedulePages(ctx context.Context, opts *gitlab.ListPipelineSchedulesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
 }
 
 // validateUserRepositoryRef makes sure the UserRepositoryRef is valid for GitHub's usage.
@@ -206,7 +261,7 @@ func validateOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain str
 func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string) error {
 	// Make sure the expected domain is used
 
-	if ref.GetDomain() != expectedDomain {
+	if !gitprovider.DomainsEqual(ref.GetDomain(), expectedDomain) {
 		return fmt.Errorf("domain %q not supported by this client, expectedDomain %q: %w", ref.GetDomain(), expectedDomain, gitprovider.ErrDomainUnsupported)
 	}
 	// Make sure the right type of identityref is used
@@ -260,3 +315,44 @@ func handleHTTPError(err error) error {
 	// Do nothing, just pipe through the unknown err
 	return err
 }
+
+func allSSHKeyPages(ctx context.Context, opts *gitlab.ListSSHKeysOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allProjectSnippetPages(ctx context.Context, opts *gitlab.ListProjectSnippetsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allRegistryRepositoryPages(ctx context.Context, opts *gitlab.ListRegistryRepositoriesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allRegistryRepositoryTagPages(ctx context.Context, opts *gitlab.ListRegistryRepositoryTagsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+func allProjectPipelinePages(ctx context.Context, opts *gitlab.ListProjectPipelinesOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allPipelineJobPages(ctx context.Context, opts *gitlab.ListJobsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, &opts.ListOptions, fn)
+}
+
+func allFreezePeriodPages(ctx context.Context, opts *gitlab.ListFreezePeriodsOptions, fn func() (*gitlab.Response, error)) error {
+	return allPages(ctx, (*gitlab.ListOptions)(opts), fn)
+}
+
+// getUserID looks up the ID of the user with the given username.
+func getUserID(ctx context.Context, c *gitlab.Client, username string) (int, error) {
+	// GET /users?username={username}
+	users, _, err := c.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.Ptr(username)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, handleHTTPError(err)
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("user %q: %w", username, gitprovider.ErrNotFound)
+	}
+	return users[0].ID, nil
+}
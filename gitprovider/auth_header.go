@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"fmt"
+	"net/http"
+	"net/textproto"
+)
+
+// HeaderValueFunc returns the value of a header for the given request, e.g. a token issued by
+// an SSO proxy, or a JWT signed over the method and URL. It must not modify the request. If the
+// value is empty, the header isn't set.
+type HeaderValueFunc func(req *http.Request) (string, error)
+
+// authHeader is a header set through WithAuthHeader.
+type authHeader struct {
+	name    string
+	valueFn HeaderValueFunc
+}
+
+// WithAuthHeader sets the given header on every API request, with the value returned by
+// valueFn for the request, e.g. X-Forwarded-Access-Token for instances behind SSO proxies. It can
+// be combined with the other authentication options, and be given once per header. The header is
+// set after those options, so using it for Authorization replaces their credentials.
+// Git operations don't go through the API transport, and don't get the header.
+func WithAuthHeader(name string, valueFn HeaderValueFunc) ClientOption {
+	if name == "" || valueFn == nil {
+		return optionError(fmt.Errorf("name and valueFn are required: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{
+		authHeaders: []authHeader{{name: textproto.CanonicalMIMEHeaderKey(name), valueFn: valueFn}},
+	}
+}
+
+// authHeaderTransport sets the headers configured through WithAuthHeader.
+type authHeaderTransport struct {
+	headers []authHeader
+	base    http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the given request
+	clone := req.Clone(req.Context())
+	for _, h := range t.headers {
+		value, err := h.valueFn(req)
+		if err != nil {
+			// A RoundTripper must always close the body
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+			return nil, fmt.Errorf("failed to get the %s header: %w", h.name, err)
+		}
+		if value != "" {
+			clone.Header.Set(h.name, value)
+		}
+	}
+	return t.base.RoundTrip(clone)
+}
+
+// authHeaderChainTransport returns the ChainableRoundTripperFunc of authHeaderTransport.
+func (opts *ClientOptions) authHeaderChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &authHeaderTransport{headers: opts.authHeaders, base: in}
+}
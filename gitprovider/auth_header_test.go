@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithAuthHeader(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+	}))
+	defer srv.Close()
+
+	signed := func(req *http.Request) (string, error) {
+		if req.URL.Path == "/fail" {
+			return "", errors.New("signer unavailable")
+		}
+		return "signed:" + req.Method + " " + req.URL.Path, nil
+	}
+	opts, err := MakeClientOptions(
+		WithOAuth2Token("token"),
+		WithAuthHeader("x-forwarded-access-token", func(*http.Request) (string, error) { return "sso", nil }),
+		WithAuthHeader("X-Signature", signed),
+		WithAuthHeader("X-Empty", func(*http.Request) (string, error) { return "", nil }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get(srv.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	for name, want := range map[string]string{
+		"Authorization":            "Bearer token",
+		"X-Forwarded-Access-Token": "sso",
+		"X-Signature":              "signed:GET /api",
+	} {
+		if got.Get(name) != want {
+			t.Errorf("%s = %q, want %q", name, got.Get(name), want)
+		}
+	}
+	if _, ok := got["X-Empty"]; ok {
+		t.Error("X-Empty header is set, want empty values to be skipped")
+	}
+	if _, err := c.Get(srv.URL + "/fail"); err == nil {
+		t.Error("Get() with a failing header succeeded, want an error")
+	}
+
+	_, err = MakeClientOptions(
+		WithAuthHeader("X-Token", signed),
+		WithAuthHeader("x-token", signed),
+	)
+	if !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("duplicate WithAuthHeader error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+	if _, err := MakeClientOptions(WithAuthHeader("X-Token", nil)); !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("WithAuthHeader(nil) error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	defaultBatchConcurrency = 4
+	// batchRateLimitRetries is how many times a rate limited operation is run again.
+	batchRateLimitRetries = 3
+)
+
+// RepositoryFunc is an operation ForEachRepository runs on a repository.
+type RepositoryFunc func(ctx context.Context, repo UserRepository) error
+
+// BatchOption configures ForEachRepository.
+type BatchOption func(*batchOptions)
+
+// batchOptions are the options set through BatchOption.
+type batchOptions struct {
+	concurrency int
+}
+
+// BatchConcurrency sets how many operations ForEachRepository runs in parallel. Values below 1
+// fall back to the default of 4.
+func BatchConcurrency(n int) BatchOption {
+	return func(opts *batchOptions) {
+		if n > 0 {
+			opts.concurrency = n
+		}
+	}
+}
+
+// ForEachRepository gets each repository of refs with c, and runs fn on it, running a bounded
+// number of operations in parallel; see BatchConcurrency. refs must be OrgRepositoryRefs or
+// UserRepositoryRefs, or pointers to them.
+//
+// When an operation fails with a *RateLimitError, no further operations start until the rate
+// limit resets, and the operation is run again, so fn must be safe to run again. Once ctx is
+// done, no further operations start.
+//
+// The errors of all operations are returned in a *validation.MultiError, each wrapped with
+// the repository it occurred for, in the order of refs. nil is returned if all succeeded.
+func ForEachRepository(ctx context.Context, c Client, refs []RepositoryRef, fn RepositoryFunc, opts ...BatchOption) error {
+	o := batchOptions{concurrency: defaultBatchConcurrency}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	b := &batch{}
+	errs := make([]error, len(refs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < o.concurrency && worker < len(refs); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := b.run(ctx, c, refs[i], fn); err != nil {
+					errs[i] = fmt.Errorf("repository %s: %w", refs[i].String(), err)
+				}
+			}
+		}()
+	}
+	for i := range refs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return validation.NewMultiError(failed...)
+}
+
+// batch holds the state shared by the operations of ForEachRepository.
+type batch struct {
+	mu sync.Mutex
+	// pausedUntil is when the rate limit an operation ran into resets.
+	pausedUntil time.Time
+}
+
+// run runs fn on the repository of ref, once the rate limit allows.
+func (b *batch) run(ctx context.Context, c Client, ref RepositoryRef, fn RepositoryFunc) error {
+	for attempt := 0; ; attempt++ {
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+		err := runRepository(ctx, c, ref, fn)
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) || attempt >= batchRateLimitRetries {
+			return err
+		}
+		b.pause(rateLimitErr.Reset)
+	}
+}
+
+// wait blocks until the rate limit resets, or ctx is done. As other operations may run into
+// the rate limit again while waiting, it checks again when to resume after sleeping.
+func (b *batch) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		wait := time.Until(b.pausedUntil)
+		b.mu.Unlock()
+		if wait <= 0 {
+			return ctx.Err()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pause holds off further operations until reset, or for the default rate limit wait if the
+// provider didn't tell when the rate limit resets.
+func (b *batch) pause(reset time.Time) {
+	if !reset.After(time.Now()) {
+		reset = time.Now().Add(defaultRateLimitWait)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if reset.After(b.pausedUntil) {
+		b.pausedUntil = reset
+	}
+}
+
+// runRepository gets the repository of ref, and runs fn on it.
+func runRepository(ctx context.Context, c Client, ref RepositoryRef, fn RepositoryFunc) error {
+	var repo UserRepository
+	var err error
+	switch r := ref.(type) {
+	case OrgRepositoryRef:
+		repo, err = c.OrgRepositories().Get(ctx, r)
+	case *OrgRepositoryRef:
+		repo, err = c.OrgRepositories().Get(ctx, *r)
+	case UserRepositoryRef:
+		repo, err = c.UserRepositories().Get(ctx, r)
+	case *UserRepositoryRef:
+		repo, err = c.UserRepositories().Get(ctx, *r)
+	default:
+		return fmt.Errorf("unsupported repository reference %T: %w", ref, ErrInvalidArgument)
+	}
+	if err != nil {
+		return err
+	}
+	return fn(ctx, repo)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// fakeBatchRepo is a repository, only implementing Repository.
+type fakeBatchRepo struct {
+	OrgRepository
+	ref RepositoryRef
+}
+
+func (r *fakeBatchRepo) Repository() RepositoryRef { return r.ref }
+
+// fakeBatchClient is a client, only implementing getting repositories.
+type fakeBatchClient struct {
+	Client
+}
+
+func (c *fakeBatchClient) OrgRepositories() OrgRepositoriesClient { return &fakeBatchOrgRepos{} }
+
+func (c *fakeBatchClient) UserRepositories() UserRepositoriesClient { return &fakeBatchUserRepos{} }
+
+type fakeBatchOrgRepos struct {
+	OrgRepositoriesClient
+}
+
+func (c *fakeBatchOrgRepos) Get(_ context.Context, ref OrgRepositoryRef) (OrgRepository, error) {
+	if ref.RepositoryName == "missing" {
+		return nil, ErrNotFound
+	}
+	return &fakeBatchRepo{ref: ref}, nil
+}
+
+type fakeBatchUserRepos struct {
+	UserRepositoriesClient
+}
+
+func (c *fakeBatchUserRepos) Get(_ context.Context, ref UserRepositoryRef) (UserRepository, error) {
+	return &fakeBatchRepo{ref: ref}, nil
+}
+
+func TestForEachRepository(t *testing.T) {
+	org := OrganizationRef{Domain: "github.com", Organization: "fluxcd"}
+	refs := []RepositoryRef{
+		OrgRepositoryRef{OrganizationRef: org, RepositoryName: "flux2"},
+		OrgRepositoryRef{OrganizationRef: org, RepositoryName: "missing"},
+		UserRepositoryRef{UserRef: UserRef{Domain: "github.com", UserLogin: "user"}, RepositoryName: "repo"},
+		OrgRepositoryRef{OrganizationRef: org, RepositoryName: "failing"},
+		&OrgRepositoryRef{OrganizationRef: org, RepositoryName: "flagger"},
+		&UserRepositoryRef{UserRef: UserRef{Domain: "github.com", UserLogin: "user"}, RepositoryName: "other"},
+	}
+	errFailing := errors.New("failing")
+
+	var mu sync.Mutex
+	var running, maxRunning int
+	visited := map[string]bool{}
+	err := ForEachRepository(context.Background(), &fakeBatchClient{}, refs, func(_ context.Context, repo UserRepository) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		visited[repo.Repository().String()] = true
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		if repo.Repository().GetRepository() == "failing" {
+			return errFailing
+		}
+		return nil
+	}, BatchConcurrency(2))
+
+	multiErr := &validation.MultiError{}
+	if !errors.As(err, &multiErr) || len(multiErr.Errors) != 2 {
+		t.Fatalf("ForEachRepository() error = %v, want 2 errors", err)
+	}
+	if !errors.Is(multiErr.Errors[0], ErrNotFound) || !errors.Is(multiErr.Errors[1], errFailing) {
+		t.Errorf("ForEachRepository() errors = %v, want not found, then failing", multiErr.Errors)
+	}
+	if len(visited) != 5 {
+		t.Errorf("visited %d repositories, want 5", len(visited))
+	}
+	if maxRunning > 2 {
+		t.Errorf("ran %d operations in parallel, want at most 2", maxRunning)
+	}
+}
+
+func TestForEachRepository_RateLimit(t *testing.T) {
+	org := OrganizationRef{Domain: "github.com", Organization: "fluxcd"}
+	refs := []RepositoryRef{
+		OrgRepositoryRef{OrganizationRef: org, RepositoryName: "flux2"},
+		OrgRepositoryRef{OrganizationRef: org, RepositoryName: "flagger"},
+	}
+	var calls atomic.Int32
+	reset := time.Now().Add(50 * time.Millisecond)
+	var afterReset atomic.Int32
+	err := ForEachRepository(context.Background(), &fakeBatchClient{}, refs, func(_ context.Context, repo UserRepository) error {
+		if calls.Add(1) == 1 {
+			return &RateLimitError{Reset: reset}
+		}
+		if !time.Now().Before(reset) {
+			afterReset.Add(1)
+		}
+		return nil
+	}, BatchConcurrency(1))
+	if err != nil {
+		t.Fatalf("ForEachRepository() error = %v", err)
+	}
+	if calls.Load() != 3 || afterReset.Load() != 2 {
+		t.Errorf("ran %d operations, %d after the reset, want 3 with the 2 last after the reset", calls.Load(), afterReset.Load())
+	}
+}
+
+func Test_batch_wait(t *testing.T) {
+	b := &batch{}
+	b.pause(time.Now().Add(20 * time.Millisecond))
+	// Another operation runs into the rate limit while waiting, and extends the pause
+	resume := time.Now().Add(80 * time.Millisecond)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		b.pause(resume)
+	}()
+	if err := b.wait(context.Background()); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if now := time.Now(); now.Before(resume) {
+		t.Errorf("wait() returned %v before the extended pause ended", resume.Sub(now))
+	}
+}
+
+func TestForEachRepository_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	refs := []RepositoryRef{OrgRepositoryRef{OrganizationRef: OrganizationRef{Domain: "github.com", Organization: "fluxcd"}, RepositoryName: "flux2"}}
+	err := ForEachRepository(ctx, &fakeBatchClient{}, refs, func(context.Context, UserRepository) error {
+		t.Error("the operation ran after the context was cancelled")
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ForEachRepository() error = %v, want %v", err, context.Canceled)
+	}
+}
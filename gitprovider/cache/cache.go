@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache stores values by key, e.g. the responses of conditional requests. Implementations must
+// be safe for concurrent use. Besides the in-memory and on-disk implementations of this
+// package, large installations can share a cache between replicas, e.g. backed by Redis.
+type Cache interface {
+	// Get returns the value stored for key, and whether it was found and hasn't expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value for key, expiring after ttl. A zero ttl never expires.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the value stored for key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// memoryEntry is a value stored in Memory.
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// Memory is an in-memory Cache, bounded to a maximum number of entries.
+type Memory struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+// Memory implements Cache.
+var _ Cache = &Memory{}
+
+// NewMemory returns an in-memory Cache holding up to maxEntries values, or an unbounded number
+// if maxEntries isn't positive. Once full, expired entries are evicted, or an arbitrary one.
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{maxEntries: maxEntries, entries: map[string]memoryEntry{}}
+}
+
+// Get implements Cache.
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set implements Cache.
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[key]; !ok && m.maxEntries > 0 && len(m.entries) >= m.maxEntries {
+		m.evict()
+	}
+	m.entries[key] = entry
+	return nil
+}
+
+// Delete implements Cache.
+func (m *Memory) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+// evict deletes the expired entries, or an arbitrary one if none expired. m.mu must be held.
+func (m *Memory) evict() {
+	now := time.Now()
+	evicted := false
+	for key, entry := range m.entries {
+		if !entry.expires.IsZero() && now.After(entry.expires) {
+			delete(m.entries, key)
+			evicted = true
+		}
+	}
+	if evicted {
+		return
+	}
+	for key := range m.entries {
+		delete(m.entries, key)
+		return
+	}
+}
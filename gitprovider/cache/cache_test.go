@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func testCache(t *testing.T, c Cache) {
+	ctx := context.Background()
+	if _, ok, err := c.Get(ctx, "missing"); ok || err != nil {
+		t.Errorf("Get(missing) = %v, %v, want a miss", ok, err)
+	}
+	if err := c.Set(ctx, "key", []byte("value"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if value, ok, err := c.Get(ctx, "key"); !ok || err != nil || string(value) != "value" {
+		t.Errorf("Get(key) = %q, %v, %v, want the value", value, ok, err)
+	}
+	if err := c.Set(ctx, "expiring", []byte("value"), time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, ok, err := c.Get(ctx, "expiring"); ok || err != nil {
+		t.Errorf("Get(expiring) = %v, %v, want the value to be expired", ok, err)
+	}
+	if err := c.Delete(ctx, "key"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := c.Get(ctx, "key"); ok {
+		t.Error("Get(key) found a deleted value")
+	}
+	if err := c.Delete(ctx, "key"); err != nil {
+		t.Errorf("Delete() of a missing key error = %v", err)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	testCache(t, NewMemory(0))
+
+	// Full caches evict entries
+	c := NewMemory(2)
+	ctx := context.Background()
+	for _, key := range []string{"a", "b", "c"} {
+		if err := c.Set(ctx, key, []byte(key), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(c.entries) != 2 {
+		t.Errorf("got %d entries, want at most 2", len(c.entries))
+	}
+	if _, ok, _ := c.Get(ctx, "c"); !ok {
+		t.Error("the last value was evicted")
+	}
+}
+
+func TestDisk(t *testing.T) {
+	c, err := NewDisk(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCache(t, c)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Disk is a Cache storing values as files in a directory, so that they survive restarts. Keys
+// are hashed into file names. Expired entries are deleted when they're read.
+type Disk struct {
+	dir string
+}
+
+// Disk implements Cache.
+var _ Cache = &Disk{}
+
+// NewDisk returns a Cache storing values in dir, which is created if it doesn't exist.
+func NewDisk(dir string) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &Disk{dir: dir}, nil
+}
+
+func (d *Disk) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements Cache. Every file starts with the expiry of the value in Unix nanoseconds,
+// zero if it doesn't expire.
+func (d *Disk) Get(_ context.Context, key string) ([]byte, bool, error) {
+	data, err := os.ReadFile(d.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if len(data) < 8 {
+		// A corrupt entry is a miss
+		_ = os.Remove(d.path(key))
+		return nil, false, nil
+	}
+	if expires := int64(binary.BigEndian.Uint64(data)); expires != 0 && time.Now().UnixNano() > expires {
+		_ = os.Remove(d.path(key))
+		return nil, false, nil
+	}
+	return data[8:], true, nil
+}
+
+// Set implements Cache. The file is replaced atomically, so that concurrent readers never see a
+// partially written value.
+func (d *Disk) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	var expires int64
+	if ttl > 0 {
+		expires = time.Now().Add(ttl).UnixNano()
+	}
+	data := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(expires))
+	data = append(data, value...)
+
+	f, err := os.CreateTemp(d.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), d.path(key))
+}
+
+// Delete implements Cache.
+func (d *Disk) Delete(_ context.Context, key string) error {
+	if err := os.Remove(d.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
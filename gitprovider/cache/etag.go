@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// XFromCache is the header set on responses served from the cache, the same as set by
+	// github.com/gregjones/httpcache.
+	XFromCache = "X-From-Cache"
+
+	// maxETagEntries bounds the number of responses in the default in-memory cache.
+	maxETagEntries = 4096
+	// maxETagEntrySize bounds the size of cached response bodies, larger ones aren't cached.
+	maxETagEntrySize = 1 << 20
+	// etagEntryTTL is how long unused responses are kept.
+	etagEntryTTL = 24 * time.Hour
+)
+
+// NewETagTransport is a gitprovider.ChainableRoundTripperFunc which revalidates every GET
+// request with the ETag or Last-Modified of the cached response, and serves the cached response
+// if the server replies 304 Not Modified. Unlike NewHTTPCacheTransport, it never serves cached
+// responses without asking the server, so changes are seen right away. GitHub doesn't count
+// 304 responses against the rate limit, which helps controllers polling many repositories.
+// The responses are cached in memory, see NewETagTransportWithCache to use another Cache.
+func NewETagTransport(in http.RoundTripper) http.RoundTripper {
+	return NewETagTransportWithCache(NewMemory(maxETagEntries))(in)
+}
+
+// NewETagTransportWithCache returns a gitprovider.ChainableRoundTripperFunc like
+// NewETagTransport, which caches the responses in c. Errors of c are treated as cache misses,
+// so that an unavailable cache doesn't fail requests.
+func NewETagTransportWithCache(c Cache) func(http.RoundTripper) http.RoundTripper {
+	return func(in http.RoundTripper) http.RoundTripper {
+		if in == nil {
+			in = http.DefaultTransport
+		}
+		return &etagTransport{base: in, cache: c}
+	}
+}
+
+// etagEntry is a cached response.
+type etagEntry struct {
+	// Accept is the Accept header of the request, as providers serve different representations
+	// depending on it, e.g. raw file contents.
+	Accept string      `json:"accept"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// etagTransport implements NewETagTransport.
+type etagTransport struct {
+	base  http.RoundTripper
+	cache Cache
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.URL.String()
+	if req.Method != http.MethodGet {
+		// Requests modifying the resource invalidate its cached representation
+		if req.Method != http.MethodHead {
+			_ = t.cache.Delete(req.Context(), key)
+		}
+		return t.base.RoundTrip(req)
+	}
+	// Leave conditional requests of the caller, and range requests alone
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" || req.Header.Get("Range") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	entry := t.get(req, key)
+	if entry != nil {
+		// A RoundTripper must not modify the given request
+		req = req.Clone(req.Context())
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := entry.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && entry != nil:
+		return cachedResponse(req, resp, entry), nil
+	case resp.StatusCode == http.StatusOK && (resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""):
+		return t.store(req, key, resp)
+	case resp.StatusCode != http.StatusOK:
+		_ = t.cache.Delete(req.Context(), key)
+	}
+	return resp, nil
+}
+
+// get returns the cached response for req, or nil.
+func (t *etagTransport) get(req *http.Request, key string) *etagEntry {
+	data, ok, err := t.cache.Get(req.Context(), key)
+	if err != nil || !ok {
+		return nil
+	}
+	entry := &etagEntry{}
+	if err := json.Unmarshal(data, entry); err != nil || entry.Accept != req.Header.Get("Accept") {
+		return nil
+	}
+	return entry
+}
+
+// cachedResponse returns the cached response of a 304 Not Modified response, updated with its
+// headers, e.g. the current rate limit.
+func cachedResponse(req *http.Request, notModified *http.Response, entry *etagEntry) *http.Response {
+	_, _ = io.Copy(io.Discard, notModified.Body)
+	_ = notModified.Body.Close()
+
+	header := entry.Header.Clone()
+	for k, v := range notModified.Header {
+		header[k] = v
+	}
+	header.Set(XFromCache, "1")
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+		TLS:           notModified.TLS,
+	}
+}
+
+// store caches resp, unless its body is too large, and returns a response with the same body.
+func (t *etagTransport) store(req *http.Request, key string, resp *http.Response) (*http.Response, error) {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxETagEntrySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxETagEntrySize {
+		// Stream the rest of the body without caching it
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		_ = t.cache.Delete(req.Context(), key)
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	data, err := json.Marshal(etagEntry{Accept: req.Header.Get("Accept"), Header: resp.Header, Body: body})
+	if err == nil {
+		_ = t.cache.Set(req.Context(), key, data, etagEntryTTL)
+	}
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestETagTransport(t *testing.T) {
+	var (
+		version     = "v1"
+		notModified int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			version = "v2"
+			return
+		}
+		etag := `"` + version + `"`
+		w.Header().Set("X-RateLimit-Remaining", version)
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"` + version + `"}`))
+	}))
+	defer srv.Close()
+
+	c := &http.Client{Transport: NewETagTransport(nil)}
+	get := func(wantBody string, wantCached bool) {
+		t.Helper()
+		resp, err := c.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK || string(body) != wantBody {
+			t.Errorf("got %d %q, want 200 %q", resp.StatusCode, body, wantBody)
+		}
+		if _, cached := resp.Header[XFromCache]; cached != wantCached {
+			t.Errorf("served from cache = %v, want %v", cached, wantCached)
+		}
+		if resp.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Content-Type = %q, want the cached header", resp.Header.Get("Content-Type"))
+		}
+	}
+
+	get(`{"version":"v1"}`, false)
+	get(`{"version":"v1"}`, true)
+	get(`{"version":"v1"}`, true)
+	if notModified != 2 {
+		t.Errorf("got %d 304 responses, want every request to be revalidated", notModified)
+	}
+
+	// Modifying the resource invalidates the cache
+	req, _ := http.NewRequest(http.MethodPatch, srv.URL, strings.NewReader("{}"))
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	get(`{"version":"v2"}`, false)
+	get(`{"version":"v2"}`, true)
+}
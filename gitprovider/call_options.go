@@ -0,0 +1,354 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// callOptionsKey is the context key for the CallOptions of a call.
+type callOptionsKey struct{}
+
+// CallOption toggles a provider behavior for a single call, without reconfiguring the client.
+// Call options are passed to client methods through their context, using WithCallOption.
+type CallOption func(*CallOptions)
+
+// CallOptions contains the behaviors toggled for a single call. Unset fields fall back to the
+// client configuration, or the provider default.
+type CallOptions struct {
+	// FollowRedirects overrides the FollowRedirects client option when getting a repository.
+	FollowRedirects *bool
+
+	// IncludeArchived specifies whether listing repositories returns archived repositories.
+	// Default: true
+	IncludeArchived *bool
+
+	// IncludeSubgroups specifies whether listing the repositories of an organization also returns
+	// the repositories of its subgroups. Only supported by GitLab.
+	// Default: false
+	IncludeSubgroups *bool
+
+	// Filter narrows down the repositories returned when listing the repositories of an
+	// organization, or searching repositories. Not supported by Stash.
+	// Default: no filter
+	Filter *RepositoryFilter
+
+	// Sort orders the repositories returned when listing the repositories of an organization.
+	// Not supported by Stash.
+	// Default: the provider order
+	Sort *RepositorySort
+
+	// IncludeStatistics specifies whether getting a repository also fetches its statistics into
+	// RepositoryInfo.Statistics. This takes additional requests. Not supported by Stash.
+	// Default: false
+	IncludeStatistics *bool
+
+	// IncludeMetadata specifies whether getting a repository also detects its license and README
+	// into RepositoryInfo.Metadata. This takes additional requests. Not supported by Stash.
+	// Default: false
+	IncludeMetadata *bool
+
+	// CommitSizeLimits overrides the CommitSizeLimits client option when creating a commit.
+	CommitSizeLimits *SizeLimits
+
+	// List controls the pagination of List calls.
+	// Default: the provider page size, listing all items
+	List *ListOptions
+
+	// Deadline bounds the duration of a call, in addition to the deadline of its context.
+	// Default: no deadline
+	Deadline *time.Time
+
+	// Timeout bounds the duration of a call like Deadline, starting anew with every call. It's
+	// turned into a Deadline when the call starts; see CallContext.
+	// Default: no timeout
+	Timeout *time.Duration
+}
+
+// CallFollowRedirects returns a CallOption overriding the FollowRedirects client option.
+func CallFollowRedirects(follow bool) CallOption {
+	return func(opts *CallOptions) {
+		opts.FollowRedirects = &follow
+	}
+}
+
+// CallCommitSizeLimits returns a CallOption overriding the CommitSizeLimits client option.
+func CallCommitSizeLimits(limits SizeLimits) CallOption {
+	return func(opts *CallOptions) {
+		opts.CommitSizeLimits = &limits
+	}
+}
+
+// CallIncludeArchived returns a CallOption specifying whether archived repositories are listed.
+func CallIncludeArchived(include bool) CallOption {
+	return func(opts *CallOptions) {
+		opts.IncludeArchived = &include
+	}
+}
+
+// CallIncludeSubgroups returns a CallOption specifying whether the repositories of subgroups
+// are listed.
+func CallIncludeSubgroups(include bool) CallOption {
+	return func(opts *CallOptions) {
+		opts.IncludeSubgroups = &include
+	}
+}
+
+// CallFilterRepositories returns a CallOption narrowing down the listed repositories.
+func CallFilterRepositories(filter RepositoryFilter) CallOption {
+	return func(opts *CallOptions) {
+		opts.Filter = &filter
+	}
+}
+
+// CallSortRepositories returns a CallOption ordering the listed repositories.
+func CallSortRepositories(sort RepositorySort) CallOption {
+	return func(opts *CallOptions) {
+		opts.Sort = &sort
+	}
+}
+
+// CallIncludeStatistics returns a CallOption specifying whether the statistics of a repository
+// are fetched when getting it.
+func CallIncludeStatistics(include bool) CallOption {
+	return func(opts *CallOptions) {
+		opts.IncludeStatistics = &include
+	}
+}
+
+// CallIncludeMetadata returns a CallOption specifying whether the license and README of a
+// repository are detected when getting it.
+func CallIncludeMetadata(include bool) CallOption {
+	return func(opts *CallOptions) {
+		opts.IncludeMetadata = &include
+	}
+}
+
+// CallTimeout returns a CallOption bounding the duration of a call to d, in addition to the
+// deadline of the context of the call. The timeout starts anew with every call the context is
+// passed to, so that the context can be reused for several calls. An Iterator is bounded from
+// its creation on, across all pages it fetches. Other calls bound every request they send to d,
+// so listing several pages may take longer than d in total; use CallDeadline to bound them as a
+// whole.
+//
+// The Gitea SDK doesn't take a context per request, so the timeout only stops Gitea calls
+// between the pages they list, and not while a single request is in flight.
+func CallTimeout(d time.Duration) CallOption {
+	return func(opts *CallOptions) {
+		opts.Timeout = &d
+	}
+}
+
+// CallDeadline returns a CallOption bounding a call to finish by deadline. Like CallTimeout,
+// it doesn't stop a single Gitea request in flight.
+func CallDeadline(deadline time.Time) CallOption {
+	return func(opts *CallOptions) {
+		opts.Deadline = &deadline
+	}
+}
+
+// WithCallOption returns a copy of ctx carrying the given call options, on top of the call
+// options ctx already carries. Pass the returned context to a client method, e.g.:
+//
+//	ctx = gitprovider.WithCallOption(ctx, gitprovider.CallIncludeArchived(false))
+//	repos, err := c.OrgRepositories().List(ctx, orgRef)
+//
+// A deadline of the call options doesn't cancel the returned context itself, the client applies
+// it to the requests of the call instead; see CallContext.
+func WithCallOption(ctx context.Context, opts ...CallOption) context.Context {
+	callOpts := CallOptionsFromContext(ctx)
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+	return withCallOptions(ctx, callOpts)
+}
+
+// withCallOptions returns a copy of ctx carrying callOpts.
+func withCallOptions(ctx context.Context, callOpts CallOptions) context.Context {
+	return context.WithValue(ctx, callOptionsKey{}, callOpts)
+}
+
+// startTimeout returns a copy of opts with the timeout turned into a deadline starting now,
+// whichever of both comes first.
+func (opts CallOptions) startTimeout() CallOptions {
+	if opts.Timeout == nil {
+		return opts
+	}
+	deadline := time.Now().Add(*opts.Timeout)
+	if opts.Deadline == nil || deadline.Before(*opts.Deadline) {
+		opts.Deadline = &deadline
+	}
+	opts.Timeout = nil
+	return opts
+}
+
+// CallContext returns a copy of ctx bounded by the deadline of its call options, and the function
+// releasing it, which must be called once the work bounded by it is done. A timeout of the call
+// options starts now, and is carried on as a deadline by the returned context, so that the work
+// it's passed to shares it. If the call options set neither, or ctx ends earlier, ctx isn't
+// bounded further. Clients bound every request they send with it, Iterator every page it
+// fetches.
+func CallContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	callOpts := CallOptionsFromContext(ctx)
+	if callOpts.Timeout != nil {
+		callOpts = callOpts.startTimeout()
+		ctx = withCallOptions(ctx, callOpts)
+	}
+	if callOpts.Deadline == nil {
+		return ctx, func() {}
+	}
+	if current, ok := ctx.Deadline(); ok && !current.After(*callOpts.Deadline) {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, *callOpts.Deadline)
+}
+
+// callDeadlineTransport bounds every request by the deadline of the call options of its context.
+type callDeadlineTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper. The deadline is released once the response body is
+// closed, as it also bounds reading the body.
+func (t *callDeadlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := CallContext(req.Context())
+	if ctx == req.Context() {
+		return t.base.RoundTrip(req)
+	}
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// callDeadlineChainTransport returns the ChainableRoundTripperFunc of callDeadlineTransport.
+func callDeadlineChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &callDeadlineTransport{base: in}
+}
+
+// cancelBody releases the context of a request once its response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// WithoutListOptions returns a copy of ctx carrying its call options without the ListOptions.
+// Methods pass it to the List calls they make internally, e.g. to look up an item or to find the
+// items to prune, which need all items rather than the page the caller asked for.
+func WithoutListOptions(ctx context.Context) context.Context {
+	callOpts := CallOptionsFromContext(ctx)
+	if callOpts.List == nil {
+		return ctx
+	}
+	callOpts.List = nil
+	return context.WithValue(ctx, callOptionsKey{}, callOpts)
+}
+
+// CallOptionsFromContext returns the call options ctx carries. Providers use this to honor
+// the call options of a call.
+func CallOptionsFromContext(ctx context.Context) CallOptions {
+	if opts, ok := ctx.Value(callOptionsKey{}).(CallOptions); ok {
+		return opts
+	}
+	return CallOptions{}
+}
+
+// ShouldFollowRedirects returns whether to follow redirects, given the configuration of the client.
+func (opts CallOptions) ShouldFollowRedirects(clientDefault bool) bool {
+	if opts.FollowRedirects == nil {
+		return clientDefault
+	}
+	return *opts.FollowRedirects
+}
+
+// SizeLimits returns the size limits to check a commit against, given those of the client.
+func (opts CallOptions) SizeLimits(clientDefault SizeLimits) SizeLimits {
+	if opts.CommitSizeLimits == nil {
+		return clientDefault
+	}
+	return *opts.CommitSizeLimits
+}
+
+// ShouldIncludeArchived returns whether to list archived repositories.
+func (opts CallOptions) ShouldIncludeArchived() bool {
+	return opts.IncludeArchived == nil || *opts.IncludeArchived
+}
+
+// ShouldIncludeSubgroups returns whether to list the repositories of subgroups.
+func (opts CallOptions) ShouldIncludeSubgroups() bool {
+	return opts.IncludeSubgroups != nil && *opts.IncludeSubgroups
+}
+
+// ShouldIncludeStatistics returns whether to fetch the statistics of a repository.
+func (opts CallOptions) ShouldIncludeStatistics() bool {
+	return opts.IncludeStatistics != nil && *opts.IncludeStatistics
+}
+
+// ShouldIncludeMetadata returns whether to detect the license and README of a repository.
+func (opts CallOptions) ShouldIncludeMetadata() bool {
+	return opts.IncludeMetadata != nil && *opts.IncludeMetadata
+}
+
+// CallListOptions returns a CallOption controlling the pagination of a List call.
+func CallListOptions(list ListOptions) CallOption {
+	return func(opts *CallOptions) {
+		opts.List = &list
+	}
+}
+
+// ListOptions returns the pagination of List calls, which is zero if unset.
+func (opts CallOptions) ListOptions() ListOptions {
+	if opts.List == nil {
+		return ListOptions{}
+	}
+	return *opts.List
+}
+
+// RepositoryFilter returns the filter for listed repositories, which is zero if unset.
+func (opts CallOptions) RepositoryFilter() RepositoryFilter {
+	if opts.Filter == nil {
+		return RepositoryFilter{}
+	}
+	return *opts.Filter
+}
+
+// RepositorySort returns the validated order of listed repositories, which is nil if unset.
+func (opts CallOptions) RepositorySort() (*RepositorySort, error) {
+	if opts.Sort == nil {
+		return nil, nil
+	}
+	if err := opts.Sort.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	return opts.Sort, nil
+}
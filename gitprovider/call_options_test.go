@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func TestWithCallOption(t *testing.T) {
+	ctx := context.Background()
+	if opts := CallOptionsFromContext(ctx); !opts.ShouldIncludeArchived() || opts.ShouldIncludeSubgroups() || opts.ShouldIncludeStatistics() || opts.ShouldIncludeMetadata() || !opts.ShouldFollowRedirects(true) || !opts.RepositoryFilter().IsZero() {
+		t.Errorf("CallOptionsFromContext() = %+v, want the defaults", opts)
+	}
+
+	ctx = WithCallOption(ctx, CallIncludeArchived(false))
+	ctx = WithCallOption(ctx, CallIncludeSubgroups(true), CallFollowRedirects(false), CallIncludeStatistics(true))
+	ctx = WithCallOption(ctx, CallIncludeMetadata(true), CallFilterRepositories(RepositoryFilter{Topic: "gitops"}))
+	opts := CallOptionsFromContext(ctx)
+	if opts.ShouldIncludeArchived() {
+		t.Error("ShouldIncludeArchived() = true, want false")
+	}
+	if !opts.ShouldIncludeSubgroups() {
+		t.Error("ShouldIncludeSubgroups() = false, want true")
+	}
+	if !opts.ShouldIncludeStatistics() {
+		t.Error("ShouldIncludeStatistics() = false, want true")
+	}
+	if !opts.ShouldIncludeMetadata() {
+		t.Error("ShouldIncludeMetadata() = false, want true")
+	}
+	if opts.ShouldFollowRedirects(true) {
+		t.Error("ShouldFollowRedirects(true) = true, want false")
+	}
+	clientLimits := SizeLimits{MaxFileSize: 1}
+	if limits := opts.SizeLimits(clientLimits); limits != clientLimits {
+		t.Errorf("SizeLimits() = %+v, want the client limits %+v", limits, clientLimits)
+	}
+	if limits := CallOptionsFromContext(WithCallOption(ctx, CallCommitSizeLimits(SizeLimits{}))).SizeLimits(clientLimits); limits != (SizeLimits{}) {
+		t.Errorf("SizeLimits() = %+v, want no limits", limits)
+	}
+	if filter := opts.RepositoryFilter(); filter.Topic != "gitops" {
+		t.Errorf("RepositoryFilter() = %+v, want topic gitops", filter)
+	}
+	if sort, err := opts.RepositorySort(); sort != nil || err != nil {
+		t.Errorf("RepositorySort() = %+v, %v, want nil", sort, err)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	ctx := WithCallOption(context.Background(), CallTimeout(time.Hour))
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("WithCallOption() returned a context with a deadline, want it only carried by the call options")
+	}
+	if opts := CallOptionsFromContext(ctx); opts.Timeout == nil || *opts.Timeout != time.Hour || opts.Deadline != nil {
+		t.Errorf("CallOptions = %+v, want a timeout of an hour", opts)
+	}
+	callCtx, cancel := CallContext(ctx)
+	deadline, ok := callCtx.Deadline()
+	if !ok || time.Until(deadline) > time.Hour {
+		t.Fatalf("Deadline() = %v, %v, want within an hour", deadline, ok)
+	}
+	// The work of the call shares its deadline
+	if opts := CallOptionsFromContext(callCtx); opts.Timeout != nil || opts.Deadline == nil || !opts.Deadline.Equal(deadline) {
+		t.Errorf("CallOptions = %+v, want the deadline %v", opts, deadline)
+	}
+	nestedCtx, cancelNested := CallContext(callCtx)
+	if nested, _ := nestedCtx.Deadline(); !nested.Equal(deadline) {
+		t.Errorf("nested Deadline() = %v, want %v", nested, deadline)
+	}
+	cancelNested()
+	cancel()
+	if !errors.Is(callCtx.Err(), context.Canceled) {
+		t.Errorf("Err() = %v after cancel, want %v", callCtx.Err(), context.Canceled)
+	}
+
+	// An earlier deadline of the parent context wins.
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelParent()
+	parentDeadline, _ := parent.Deadline()
+	callCtx, cancel = CallContext(WithCallOption(parent, CallTimeout(time.Hour)))
+	defer cancel()
+	if deadline, _ := callCtx.Deadline(); !deadline.Equal(parentDeadline) {
+		t.Errorf("Deadline() = %v, want the parent deadline %v", deadline, parentDeadline)
+	}
+
+	// The timeout starts anew with every call, so the context can be reused.
+	ctx = WithCallOption(context.Background(), CallTimeout(100*time.Millisecond))
+	for i := 0; i < 2; i++ {
+		callCtx, cancel = CallContext(ctx)
+		if err := callCtx.Err(); err != nil {
+			t.Fatalf("call %d: Err() = %v right after starting the call", i, err)
+		}
+		<-callCtx.Done()
+		cancel()
+	}
+}
+
+func TestCallTimeout_Requests(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-release
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	c, err := BuildClientFromTransportChain((&ClientOptions{}).GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithCallOption(context.Background(), CallTimeout(50*time.Millisecond))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/slow", nil)
+	if _, err := c.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The deadline of a request is released once its response body is closed
+	ctx = WithCallOption(context.Background(), CallTimeout(time.Hour))
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	_ = res.Body.Close()
+	if err := res.Request.Context().Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("request Err() = %v after closing the body, want %v", err, context.Canceled)
+	}
+}
+
+func TestWithoutListOptions(t *testing.T) {
+	ctx := WithCallOption(context.Background(), CallListOptions(ListOptions{MaxItems: 1}), CallIncludeArchived(false))
+	opts := CallOptionsFromContext(WithoutListOptions(ctx))
+	if opts.List != nil {
+		t.Errorf("ListOptions() = %+v, want none", opts.ListOptions())
+	}
+	if opts.ShouldIncludeArchived() {
+		t.Error("the other call options were dropped")
+	}
+}
+
+func TestCallSortRepositories(t *testing.T) {
+	ctx := WithCallOption(context.Background(), CallSortRepositories(RepositorySort{By: RepositorySortFieldPushed, Descending: true}))
+	sort, err := CallOptionsFromContext(ctx).RepositorySort()
+	if err != nil {
+		t.Fatalf("RepositorySort() error = %v", err)
+	}
+	if sort.By != RepositorySortFieldPushed || !sort.Descending {
+		t.Errorf("RepositorySort() = %+v, want pushed, descending", *sort)
+	}
+
+	ctx = WithCallOption(ctx, CallSortRepositories(RepositorySort{By: "stars"}))
+	if _, err := CallOptionsFromContext(ctx).RepositorySort(); !errors.Is(err, validation.ErrFieldEnumInvalid) {
+		t.Errorf("RepositorySort() error = %v, want ErrFieldEnumInvalid", err)
+	}
+}
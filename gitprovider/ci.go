@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sort"
+	"strings"
+)
+
+// CIInfo is the result of detecting the CI/CD systems a repository is configured for.
+type CIInfo struct {
+	// Systems lists the detected CI/CD systems, without duplicates.
+	Systems []CISystem `json:"systems"`
+	// ConfigFiles lists the paths of the detected configuration files, sorted.
+	ConfigFiles []string `json:"configFiles"`
+}
+
+// Has returns whether the given CI/CD system was detected.
+func (ci CIInfo) Has(system CISystem) bool {
+	for _, s := range ci.Systems {
+		if s == system {
+			return true
+		}
+	}
+	return false
+}
+
+// DirLister lists the paths of the files and directories directly inside dir, relative to the
+// root of a repository. The root directory is "". ErrNotFound is returned if dir doesn't exist.
+type DirLister func(ctx context.Context, dir string) ([]string, error)
+
+// ciConfigFiles maps the configuration files in the root of a repository to their CI/CD system.
+//
+//nolint:gochecknoglobals
+var ciConfigFiles = []struct {
+	name   string
+	system CISystem
+}{
+	{".gitlab-ci.yml", CISystemGitLabCI},
+	{"Jenkinsfile", CISystemJenkins},
+	{".travis.yml", CISystemTravisCI},
+	{"azure-pipelines.yml", CISystemAzurePipelines},
+	{"bitbucket-pipelines.yml", CISystemBitbucketPipelines},
+	{".drone.yml", CISystemDrone},
+}
+
+// ciConfigDirs maps directories with YAML configuration files to their CI/CD system.
+// If file is set, only that file in the directory counts.
+//
+//nolint:gochecknoglobals
+var ciConfigDirs = []struct {
+	dir    string
+	file   string
+	system CISystem
+}{
+	{".github/workflows", "", CISystemGitHubActions},
+	{".gitea/workflows", "", CISystemGiteaActions},
+	{".forgejo/workflows", "", CISystemGiteaActions},
+	{".circleci", "config.yml", CISystemCircleCI},
+}
+
+// DetectCI detects the CI/CD systems a repository is configured for, using listDir to look
+// for known configuration files. Only the directories that may contain such files are listed.
+// Providers use this to implement Repository.DetectCI.
+func DetectCI(ctx context.Context, listDir DirLister) (*CIInfo, error) {
+	root, err := listDirIfExists(ctx, listDir, "")
+	if err != nil {
+		return nil, err
+	}
+	rootEntries := make(map[string]struct{}, len(root))
+	for _, p := range root {
+		rootEntries[p] = struct{}{}
+	}
+
+	ci := &CIInfo{}
+	found := func(system CISystem, file string) {
+		if !ci.Has(system) {
+			ci.Systems = append(ci.Systems, system)
+		}
+		ci.ConfigFiles = append(ci.ConfigFiles, file)
+	}
+	for _, cfg := range ciConfigFiles {
+		if _, ok := rootEntries[cfg.name]; ok {
+			found(cfg.system, cfg.name)
+		}
+	}
+	for _, cfg := range ciConfigDirs {
+		// Don't list directories whose top-level directory doesn't exist
+		if _, ok := rootEntries[strings.SplitN(cfg.dir, "/", 2)[0]]; !ok {
+			continue
+		}
+		entries, err := listDirIfExists(ctx, listDir, cfg.dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range entries {
+			name := path.Base(p)
+			if cfg.file != "" && name != cfg.file {
+				continue
+			}
+			if ext := path.Ext(name); ext == ".yml" || ext == ".yaml" {
+				found(cfg.system, path.Join(cfg.dir, name))
+			}
+		}
+	}
+	sort.Strings(ci.ConfigFiles)
+	return ci, nil
+}
+
+// listDirIfExists is like listDir, but returns no entries if dir doesn't exist.
+func listDirIfExists(ctx context.Context, listDir DirLister, dir string) ([]string, error) {
+	entries, err := listDir(ctx, dir)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return entries, err
+}
+
+// LanguagePercentages converts the number of bytes of code per language, as returned by some
+// providers, into the share of each language in percent.
+func LanguagePercentages(bytes map[string]int64) map[string]float64 {
+	var total int64
+	for _, b := range bytes {
+		total += b
+	}
+	percentages := make(map[string]float64, len(bytes))
+	for lang, b := range bytes {
+		if total > 0 {
+			percentages[lang] = float64(b) * 100 / float64(total)
+		}
+	}
+	return percentages
+}
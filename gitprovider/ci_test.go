@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestDetectCI(t *testing.T) {
+	tests := []struct {
+		name   string
+		tree   map[string][]string
+		want   *CIInfo
+		listed []string
+	}{
+		{
+			name: "empty repository",
+			tree: map[string][]string{},
+			want: &CIInfo{},
+		},
+		{
+			name: "several systems",
+			tree: map[string][]string{
+				"":                  {".github", ".gitlab-ci.yml", "Jenkinsfile", "README.md", "docs"},
+				".github":           {".github/workflows", ".github/CODEOWNERS"},
+				".github/workflows": {".github/workflows/ci.yaml", ".github/workflows/release.yml", ".github/workflows/README.md"},
+			},
+			want: &CIInfo{
+				Systems:     []CISystem{CISystemGitLabCI, CISystemJenkins, CISystemGitHubActions},
+				ConfigFiles: []string{".github/workflows/ci.yaml", ".github/workflows/release.yml", ".gitlab-ci.yml", "Jenkinsfile"},
+			},
+			listed: []string{"", ".github/workflows"},
+		},
+		{
+			name: "circleci and empty workflow directory",
+			tree: map[string][]string{
+				"":          {".circleci", ".gitea"},
+				".circleci": {".circleci/config.yml", ".circleci/orbs.yml"},
+			},
+			want: &CIInfo{
+				Systems:     []CISystem{CISystemCircleCI},
+				ConfigFiles: []string{".circleci/config.yml"},
+			},
+			listed: []string{"", ".gitea/workflows", ".circleci"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed := []string{}
+			listDir := func(_ context.Context, dir string) ([]string, error) {
+				listed = append(listed, dir)
+				entries, ok := tt.tree[dir]
+				if !ok {
+					return nil, ErrNotFound
+				}
+				return entries, nil
+			}
+			got, err := DetectCI(context.Background(), listDir)
+			if err != nil {
+				t.Fatalf("DetectCI() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectCI() = %+v, want %+v", got, tt.want)
+			}
+			if tt.listed != nil && !reflect.DeepEqual(listed, tt.listed) {
+				t.Errorf("DetectCI() listed %v, want %v", listed, tt.listed)
+			}
+		})
+	}
+}
+
+func TestLanguagePercentages(t *testing.T) {
+	got := LanguagePercentages(map[string]int64{"Go": 750, "Makefile": 250})
+	want := map[string]float64{"Go": 75, "Makefile": 25}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LanguagePercentages() = %v, want %v", got, want)
+	}
+}
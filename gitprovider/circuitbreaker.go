@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultCircuitBreakerFailureThreshold = 5
+	defaultCircuitBreakerOpenTimeout      = 30 * time.Second
+)
+
+// defaultCircuitBreakerFailureStatusCodes are the status codes of a degraded server.
+//
+//nolint:gochecknoglobals
+var defaultCircuitBreakerFailureStatusCodes = []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// CircuitBreakerPolicy configures when a Client stops sending requests to a failing provider.
+type CircuitBreakerPolicy struct {
+	// FailureThreshold is the number of consecutive failed requests which trips the circuit
+	// breaker. Requests fail with connection errors, or the FailureStatusCodes. Default: 5.
+	FailureThreshold int
+	// OpenTimeout is how long requests fail fast once the circuit breaker tripped. After that, a
+	// single request is let through to probe the provider: the circuit breaker closes if it
+	// succeeds, and trips again otherwise. Default: 30s.
+	OpenTimeout time.Duration
+	// FailureStatusCodes are the status codes of failed requests.
+	// Default: 500, 502, 503 and 504.
+	FailureStatusCodes []int
+}
+
+// WithCircuitBreaker stops sending requests to the provider once they failed repeatedly, to
+// protect a degraded instance. While the circuit breaker is open, requests fail fast with
+// ErrProviderUnavailable. Requests given up on by WithRetryPolicy count as a single failure.
+func WithCircuitBreaker(policy CircuitBreakerPolicy) ClientOption {
+	if policy.FailureThreshold < 0 || policy.OpenTimeout < 0 {
+		return optionError(fmt.Errorf("FailureThreshold and OpenTimeout cannot be negative: %w", ErrInvalidClientOptions))
+	}
+	if policy.FailureThreshold == 0 {
+		policy.FailureThreshold = defaultCircuitBreakerFailureThreshold
+	}
+	if policy.OpenTimeout == 0 {
+		policy.OpenTimeout = defaultCircuitBreakerOpenTimeout
+	}
+	if policy.FailureStatusCodes == nil {
+		policy.FailureStatusCodes = defaultCircuitBreakerFailureStatusCodes
+	}
+	return &ClientOptions{circuitBreakerPolicy: &policy}
+}
+
+// circuitBreakerTransport fails requests fast while the provider is failing.
+type circuitBreakerTransport struct {
+	policy CircuitBreakerPolicy
+	log    logr.Logger
+	base   http.RoundTripper
+	now    func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	// openUntil is when the open circuit breaker lets a probe through, zero if it's closed.
+	openUntil time.Time
+	// probing is set while the probe is in flight, failing other requests fast.
+	probing bool
+}
+
+// circuitBreakerChainTransport returns the ChainableRoundTripperFunc of circuitBreakerTransport.
+func (opts *ClientOptions) circuitBreakerChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &circuitBreakerTransport{policy: *opts.circuitBreakerPolicy, log: opts.GetLogger(), base: in, now: time.Now}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	probe, err := t.allow()
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	res, err := t.base.RoundTrip(req)
+	if err != nil && (req.Context().Err() != nil || errors.Is(err, context.Canceled)) {
+		// Requests canceled by the caller say nothing about the provider, let another probe through
+		t.cancel(probe)
+		return res, err
+	}
+	t.record(probe, t.failed(res, err))
+	return res, err
+}
+
+// allow returns nil if a request may be sent, and whether it probes the open circuit breaker.
+func (t *circuitBreakerTransport) allow() (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.openUntil.IsZero() {
+		return false, nil
+	}
+	if t.probing || t.now().Before(t.openUntil) {
+		return false, fmt.Errorf("%d consecutive requests failed: %w", t.failures, ErrProviderUnavailable)
+	}
+	t.probing = true
+	return true, nil
+}
+
+// cancel records a request canceled by the caller, which leaves the circuit breaker as it is.
+func (t *circuitBreakerTransport) cancel(probe bool) {
+	if !probe {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.probing = false
+}
+
+// record records the outcome of a request, tripping or closing the circuit breaker.
+func (t *circuitBreakerTransport) record(probe, failed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if probe {
+		t.probing = false
+	}
+	if !failed {
+		if !t.openUntil.IsZero() {
+			t.log.Info("provider recovered, closing the circuit breaker")
+		}
+		t.failures = 0
+		t.openUntil = time.Time{}
+		return
+	}
+	t.failures++
+	if probe || (t.openUntil.IsZero() && t.failures >= t.policy.FailureThreshold) {
+		t.openUntil = t.now().Add(t.policy.OpenTimeout)
+		t.log.Info("provider is failing, opening the circuit breaker", "failures", t.failures, "openTimeout", t.policy.OpenTimeout)
+	}
+}
+
+// failed returns whether the request failed because of the provider.
+func (t *circuitBreakerTransport) failed(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	for _, code := range t.policy.FailureStatusCodes {
+		if res.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithCircuitBreaker(t *testing.T) {
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	opts, err := MakeClientOptions(WithCircuitBreaker(CircuitBreakerPolicy{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func() (int, error) {
+		t.Helper()
+		resp, err := c.Get(srv.URL)
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if status, err := get(); err != nil || status != http.StatusServiceUnavailable {
+			t.Fatalf("request %d = %d, %v, want 503", i, status, err)
+		}
+	}
+	if _, err := get(); !errors.Is(err, ErrProviderUnavailable) {
+		t.Fatalf("request after the threshold error = %v, want %v", err, ErrProviderUnavailable)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("server got %d requests, want 2", requests.Load())
+	}
+
+	// A failing probe trips the circuit breaker again
+	time.Sleep(60 * time.Millisecond)
+	if status, err := get(); err != nil || status != http.StatusServiceUnavailable {
+		t.Fatalf("probe = %d, %v, want 503", status, err)
+	}
+	if _, err := get(); !errors.Is(err, ErrProviderUnavailable) {
+		t.Fatalf("request after the failed probe error = %v, want %v", err, ErrProviderUnavailable)
+	}
+
+	// A probe canceled by the caller leaves it open, and lets the next probe through
+	time.Sleep(60 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled probe error = %v, want %v", err, context.Canceled)
+	}
+	if status, err := get(); err != nil || status != http.StatusServiceUnavailable {
+		t.Fatalf("probe after the canceled probe = %d, %v, want 503", status, err)
+	}
+	if _, err := get(); !errors.Is(err, ErrProviderUnavailable) {
+		t.Fatalf("request after the failed probe error = %v, want %v", err, ErrProviderUnavailable)
+	}
+
+	// A succeeding probe closes it
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if status, err := get(); err != nil || status != http.StatusOK {
+			t.Fatalf("request %d after recovery = %d, %v, want 200", i, status, err)
+		}
+	}
+	if requests.Load() != 7 {
+		t.Errorf("server got %d requests, want 7", requests.Load())
+	}
+}
+
+func TestWithCircuitBreaker_Invalid(t *testing.T) {
+	if _, err := MakeClientOptions(WithCircuitBreaker(CircuitBreakerPolicy{FailureThreshold: -1})); !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("MakeClientOptions() error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+}
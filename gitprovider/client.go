@@ -16,7 +16,11 @@ limitations under the License.
 
 package gitprovider
 
-import "context"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 // Client is an interface that allows talking to a Git provider.
 type Client interface {
@@ -37,6 +41,23 @@ type Client interface {
 	// permission. Permissions should be coarse-grained and applicable to *all* providers.
 	HasTokenPermission(ctx context.Context, permission TokenPermission) (bool, error)
 
+	// CurrentUser returns the identity the client is authenticated as, including the scopes and
+	// expiry of the token where the provider reports them. It's useful for diagnostics, and for
+	// choosing the author identity of commits.
+	CurrentUser(ctx context.Context) (CurrentUserInfo, error)
+
+	// CheckCapability returns a *CapabilityError if the credentials of the client don't allow the
+	// operations of the given capability, e.g. because the token type can't access them, so that
+	// callers can report that or fall back before running into authorization errors. Returns nil
+	// if the capability is available as far as the token type tells, permissions of the user or
+	// token are only checked by the operations themselves.
+	CheckCapability(ctx context.Context, capability Capability) error
+
+	// SSHHostKeys returns the public SSH host keys of the instance, so that known_hosts files can
+	// be seeded without trusting the keys on first use. Returns "ErrNoProviderSupport" if the
+	// provider doesn't publish them through its API.
+	SSHHostKeys(ctx context.Context) ([]SSHHostKey, error)
+
 	// Raw returns the Go client used under the hood to access the Git provider.
 	Raw() interface{}
 }
@@ -51,6 +72,23 @@ type ResourceClient interface {
 
 	// UserRepositories returns the UserRepositoriesClient handling sets of repositories for a user.
 	UserRepositories() UserRepositoriesClient
+
+	// GPGKeys returns the GPGKeyClient handling the GPG keys of the authenticated user.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	GPGKeys() (GPGKeyClient, error)
+
+	// SSHKeys returns the SSHKeyClient handling the SSH keys of the authenticated user.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	SSHKeys() (SSHKeyClient, error)
+
+	// Runners returns the RunnerClient handling the instance-wide CI runners, which requires
+	// administrator access. Only supported by GitLab.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Runners() (RunnerClient, error)
+
+	// Instance returns the InstanceClient handling the administration of a self-hosted
+	// instance as a whole.
+	Instance() InstanceClient
 }
 
 //
@@ -70,6 +108,9 @@ type OrganizationsClient interface {
 	// List returns all available organizations, using multiple paginated requests if needed.
 	List(ctx context.Context) ([]Organization, error)
 
+	// ListIter returns an iterator over the organizations List returns.
+	ListIter(ctx context.Context) *Iterator[Organization]
+
 	// Children returns the immediate child-organizations for the specific OrganizationRef o.
 	// The OrganizationRef may point to any existing sub-organization.
 	//
@@ -78,7 +119,28 @@ type OrganizationsClient interface {
 	// Children returns all available organizations, using multiple paginated requests if needed.
 	Children(ctx context.Context, o OrganizationRef) ([]Organization, error)
 
-	// Possibly add Create/Update/Delete methods later
+	// Create an organization. In GitLab, o may point to a top-level group or to a subgroup,
+	// of which the parent must already exist. In Gitea, o must be a top-level organization.
+	//
+	// This is not supported in GitHub and Stash.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, o OrganizationRef, req OrganizationInfo) (Organization, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, o OrganizationRef, req OrganizationInfo) (resp Organization, actionTaken bool, err error)
+
+	// Delete an organization, including all repositories and sub-organizations within it.
+	// This is only allowed if destructive actions are enabled for the client.
+	//
+	// This is not supported in GitHub and Stash.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Delete(ctx context.Context, o OrganizationRef) error
 }
 
 // OrgRepositoriesClient operates on repositories for organizations.
@@ -88,11 +150,27 @@ type OrgRepositoriesClient interface {
 	// ErrNotFound is returned if the resource does not exist.
 	Get(ctx context.Context, r OrgRepositoryRef) (OrgRepository, error)
 
-	// List all repositories in the given organization.
+	// List all repositories in the given organization. Use the CallFilterRepositories call option
+	// to only list the repositories matching a filter, the CallIncludeArchived call option to
+	// exclude archived repositories, and the CallSortRepositories call option to order them.
 	//
 	// List returns all available repositories, using multiple paginated requests if needed.
+	// Like all List methods, it honors the page size and limits of the CallListOptions call option.
 	List(ctx context.Context, o OrganizationRef) ([]OrgRepository, error)
 
+	// ListIter returns an iterator over the repositories List returns, which fetches them a page
+	// at a time instead of buffering them all. The call options are taken from ctx, while the
+	// context passed to Next bounds the requests.
+	ListIter(ctx context.Context, o OrganizationRef) *Iterator[OrgRepository]
+
+	// Search searches the repositories of all organizations the user can see for repositories
+	// whose name contains query. The CallFilterRepositories and CallIncludeArchived call options
+	// narrow down the results further. GitHub only matches whole words, and returns at most
+	// 1000 repositories. Returns "ErrNoProviderSupport" if the provider doesn't support searching.
+	//
+	// Search returns all matching repositories, using multiple paginated requests if needed.
+	Search(ctx context.Context, query string) ([]OrgRepository, error)
+
 	// Create creates a repository for the given organization, with the data and options.
 	//
 	// ErrAlreadyExists will be returned if the resource already exists.
@@ -118,6 +196,10 @@ type UserRepositoriesClient interface {
 	// List returns all available repositories, using multiple paginated requests if needed.
 	List(ctx context.Context, o UserRef) ([]UserRepository, error)
 
+	// ListIter returns an iterator over the repositories List returns, which fetches them a page
+	// at a time instead of buffering them all.
+	ListIter(ctx context.Context, o UserRef) *Iterator[UserRepository]
+
 	// Create creates a repository for the given user, with the data and options
 	//
 	// ErrAlreadyExists will be returned if the resource already exists.
@@ -134,11 +216,55 @@ type UserRepositoriesClient interface {
 	Reconcile(ctx context.Context, r UserRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (resp UserRepository, actionTaken bool, err error)
 }
 
+// GPGKeyClient operates on the GPG keys of the authenticated user, which the provider uses to
+// verify the signatures of the user's commits and tags.
+type GPGKeyClient interface {
+	// Get a GPG key by its key ID, see GPGKeyInfo.KeyID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, keyID string) (GPGKey, error)
+
+	// List all GPG keys of the authenticated user.
+	//
+	// List returns all available GPG keys, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]GPGKey, error)
+
+	// ListIter returns an iterator over the GPG keys List returns.
+	ListIter(ctx context.Context) *Iterator[GPGKey]
+
+	// Create adds a GPG key with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req GPGKeyInfo) (GPGKey, error)
+}
+
+// SSHKeyClient operates on the SSH keys of the authenticated user, which give access to all
+// repositories the user has access to, unlike deploy keys.
+type SSHKeyClient interface {
+	// Get an SSH key by its name.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, name string) (SSHKey, error)
+
+	// List all SSH keys of the authenticated user.
+	//
+	// List returns all available SSH keys, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]SSHKey, error)
+
+	// ListIter returns an iterator over the SSH keys List returns.
+	ListIter(ctx context.Context) *Iterator[SSHKey]
+
+	// Create adds an SSH key with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req SSHKeyInfo) (SSHKey, error)
+}
+
 //
 //	Clients accessed through resource objects.
 //
 
-// TeamsClient allows reading teams for a specific organization.
+// TeamsClient allows reading and managing teams for a specific organization.
 // This client can be accessed through Organization.Teams().
 type TeamsClient interface {
 	// Get a team within the specific organization.
@@ -154,7 +280,218 @@ type TeamsClient interface {
 	// List returns all available organizations, using multiple paginated requests if needed.
 	List(ctx context.Context) ([]Team, error)
 
-	// Possibly add Create/Update/Delete methods later
+	// ListIter returns an iterator over the teams List returns, which fetches them a page at a
+	// time along with their details, so callers can stop early instead of loading every team.
+	ListIter(ctx context.Context) *Iterator[Team]
+
+	// Create a team within the specific organization. Teams are sub-groups in GitLab.
+	// req.Members is ignored; team membership is managed separately.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req TeamInfo) (Team, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req TeamInfo) (resp Team, actionTaken bool, err error)
+}
+
+// OrganizationMemberClient operates on the members of a specific organization.
+// This client can be accessed through Organization.Members().
+type OrganizationMemberClient interface {
+	// List the members of the organization, including their role. Pending invitations
+	// are listed with Pending set, if the provider supports invitations.
+	//
+	// List returns all members, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]OrganizationMemberInfo, error)
+
+	// ListIter returns an iterator over the members List returns.
+	ListIter(ctx context.Context) *Iterator[OrganizationMemberInfo]
+
+	// Invite adds the user to the organization with the given role (default: member), or
+	// changes the role if the user is already a member. Depending on the provider, the user
+	// first has to accept an invitation before becoming a member.
+	//
+	// ErrNoProviderSupport is returned if the provider doesn't support the role.
+	Invite(ctx context.Context, req OrganizationMemberInfo) error
+
+	// Remove removes the user from the organization, or cancels the pending invitation
+	// of the user.
+	//
+	// ErrNotFound is returned if the user isn't a member of the organization.
+	Remove(ctx context.Context, username string) error
+}
+
+// OrganizationVariableClient operates on the CI/CD variables shared by the repositories of a
+// specific organization. This client can be accessed through Organization.Variables().
+type OrganizationVariableClient interface {
+	// Get an organization-level Variable by its key.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, key string) (OrganizationVariable, error)
+
+	// List all variables of the given organization.
+	//
+	// List returns all available variables, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]OrganizationVariable, error)
+
+	// ListIter returns an iterator over the variables List returns.
+	ListIter(ctx context.Context) *Iterator[OrganizationVariable]
+
+	// Create a variable with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req OrganizationVariableInfo) (OrganizationVariable, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	// Variables are identified by both their key and environment.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req OrganizationVariableInfo) (resp OrganizationVariable, actionTaken bool, err error)
+}
+
+// AuditLogClient reads the audit log of a specific organization, i.e. the events about
+// security-relevant changes like membership, permissions and settings.
+// This client can be accessed through Organization.AuditLog().
+type AuditLogClient interface {
+	// Stream calls fn for each audit event within the time range given by opts, newest first.
+	// Pages are fetched while streaming, so events can be processed without holding the whole
+	// audit log in memory. Streaming stops at the first error, which is returned, either from
+	// the provider or from fn.
+	Stream(ctx context.Context, opts AuditLogOptions, fn func(AuditEventInfo) error) error
+}
+
+// InstanceClient operates on a self-hosted instance as a whole, which requires an
+// administrator token.
+// This client can be accessed through Client.Instance().
+type InstanceClient interface {
+	// Users returns the AdminUserClient handling the user accounts of the instance.
+	// Only supported by GitLab and Gitea.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Users() (AdminUserClient, error)
+}
+
+// AdminUserClient manages the user accounts of a self-hosted instance as an administrator,
+// e.g. to bootstrap bot accounts.
+// This client can be accessed through Client.Instance().Users().
+type AdminUserClient interface {
+	// Get the user with the given username.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, username string) (*UserInfo, error)
+
+	// Create a user with the given specifications. Depending on the provider, the user
+	// doesn't need to confirm the email address.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req UserInfo) (*UserInfo, error)
+
+	// Block prevents the user from signing in, and from using its tokens.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Block(ctx context.Context, username string) error
+
+	// Unblock allows a blocked user to sign in again.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Unblock(ctx context.Context, username string) error
+
+	// Delete the user, and the repositories and groups only owned by the user.
+	// This is only allowed if destructive actions are enabled for the client.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Delete(ctx context.Context, username string) error
+
+	// CreateImpersonationToken creates a token to act as the given user with. The token is
+	// only returned by CreateImpersonationToken, so store it right away.
+	//
+	// ErrNoProviderSupport is returned if the provider doesn't support it.
+	CreateImpersonationToken(ctx context.Context, username string, req ImpersonationTokenInfo) (*ImpersonationTokenInfo, error)
+}
+
+// SCIMClient provisions and deprovisions the users of a specific organization through SCIM,
+// the way an identity provider does. Users are identified by the ID the provider assigned
+// to their SCIM identity.
+// This client can be accessed through Organization.SCIM().
+type SCIMClient interface {
+	// Get the provisioned user with the given SCIM identity ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id string) (*SCIMUserInfo, error)
+
+	// List the users provisioned for the organization.
+	//
+	// List returns all provisioned users, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]SCIMUserInfo, error)
+
+	// ListIter returns an iterator over the provisioned users List returns.
+	ListIter(ctx context.Context) *Iterator[SCIMUserInfo]
+
+	// Provision creates a SCIM identity for the user, and invites the user to the
+	// organization.
+	//
+	// ErrAlreadyExists is returned if the user is already provisioned.
+	Provision(ctx context.Context, req SCIMUserInfo) (*SCIMUserInfo, error)
+
+	// Deprovision deletes the SCIM identity with the given ID, and removes the user from
+	// the organization.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Deprovision(ctx context.Context, id string) error
+}
+
+// SSHCertificateAuthorityClient operates on the SSH certificate authorities of a specific
+// organization. Members can clone over SSH with short-lived certificates signed by one of
+// them, instead of registering their own keys.
+// This client can be accessed through Organization.SSHCertificateAuthorities().
+type SSHCertificateAuthorityClient interface {
+	// List all SSH certificate authorities of the organization.
+	List(ctx context.Context) ([]SSHCertificateAuthorityInfo, error)
+
+	// ListIter returns an iterator over the certificate authorities List returns.
+	ListIter(ctx context.Context) *Iterator[SSHCertificateAuthorityInfo]
+
+	// Create uploads the public key of an SSH certificate authority.
+	//
+	// ErrAlreadyExists is returned if the key or title is already in use.
+	Create(ctx context.Context, req SSHCertificateAuthorityInfo) (*SSHCertificateAuthorityInfo, error)
+
+	// Delete removes the SSH certificate authority with the given ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Delete(ctx context.Context, id int64) error
+}
+
+// IPAllowListClient operates on the IP allow list of a specific organization. Entries are
+// matched by their Value when reconciling.
+// This client can be accessed through Organization.IPAllowList().
+type IPAllowListClient interface {
+	// List all entries of the IP allow list.
+	List(ctx context.Context) ([]IPAllowListEntryInfo, error)
+
+	// ListIter returns an iterator over the allow list entries List returns.
+	ListIter(ctx context.Context) *Iterator[IPAllowListEntryInfo]
+
+	// Create adds an entry to the IP allow list.
+	//
+	// ErrAlreadyExists is returned if an entry with the same value exists.
+	Create(ctx context.Context, req IPAllowListEntryInfo) (*IPAllowListEntryInfo, error)
+
+	// Delete removes the entry with the given ID from the IP allow list.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Delete(ctx context.Context, id string) error
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If an entry with the same value doesn't exist, it is created.
+	// If the entry exists but differs from the desired state, it is updated.
+	// If the actual state equals the desired state, no action is taken.
+	Reconcile(ctx context.Context, req IPAllowListEntryInfo) (resp *IPAllowListEntryInfo, actionTaken bool, err error)
 }
 
 // TeamAccessClient operates on the teams list for a specific repository.
@@ -170,6 +507,9 @@ type TeamAccessClient interface {
 	// List returns all available team access lists, using multiple paginated requests if needed.
 	List(ctx context.Context) ([]TeamAccess, error)
 
+	// ListIter returns an iterator over the team accesses List returns.
+	ListIter(ctx context.Context) *Iterator[TeamAccess]
+
 	// Create adds a given team to the repository's team access control list.
 	//
 	// ErrAlreadyExists will be returned if the resource already exists.
@@ -183,6 +523,44 @@ type TeamAccessClient interface {
 	Reconcile(ctx context.Context, req TeamAccessInfo) (resp TeamAccess, actionTaken bool, err error)
 }
 
+// CollaboratorClient operates on the individual users that have access to a specific repository.
+// This client can be accessed through UserRepository.Collaborators().
+type CollaboratorClient interface {
+	// Get a user's permission level on this repository. Users that haven't accepted
+	// their invitation yet are returned as pending.
+	//
+	// ErrNotFound is returned if the user is neither a collaborator nor invited.
+	Get(ctx context.Context, username string) (Collaborator, error)
+
+	// List the users that have been granted access to this repository directly,
+	// not through a team or organization.
+	//
+	// List returns all available collaborators, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Collaborator, error)
+
+	// ListIter returns an iterator over the collaborators List returns.
+	ListIter(ctx context.Context) *Iterator[Collaborator]
+
+	// ListInvitations lists the users that have been invited, but haven't accepted yet.
+	// Providers that add collaborators without an invitation always return an empty list.
+	//
+	// ListInvitations returns all available invitations, using multiple paginated requests if needed.
+	ListInvitations(ctx context.Context) ([]Collaborator, error)
+
+	// Create invites the given user to the repository, or adds them directly if the
+	// provider doesn't use invitations.
+	//
+	// ErrAlreadyExists will be returned if the user already is a collaborator or invited.
+	Create(ctx context.Context, req CollaboratorInfo) (Collaborator, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req CollaboratorInfo) (resp Collaborator, actionTaken bool, err error)
+}
+
 // DeployKeyClient operates on the access credential list for a specific repository.
 // This client can be accessed through Repository.DeployKeys().
 type DeployKeyClient interface {
@@ -197,6 +575,9 @@ type DeployKeyClient interface {
 	// using multiple paginated requests if needed.
 	List(ctx context.Context) ([]DeployKey, error)
 
+	// ListIter returns an iterator over the deploy keys List returns.
+	ListIter(ctx context.Context) *Iterator[DeployKey]
+
 	// Create a deploy key with the given specifications.
 	//
 	// ErrAlreadyExists will be returned if the resource already exists.
@@ -224,6 +605,9 @@ type DeployTokenClient interface {
 	// using multiple paginated requests if needed.
 	List(ctx context.Context) ([]DeployToken, error)
 
+	// ListIter returns an iterator over the deploy tokens List returns.
+	ListIter(ctx context.Context) *Iterator[DeployToken]
+
 	// Create a deploy token with the given specifications.
 	//
 	// ErrAlreadyExists will be returned if the resource already exists.
@@ -237,14 +621,403 @@ type DeployTokenClient interface {
 	Reconcile(ctx context.Context, req DeployTokenInfo) (resp DeployToken, actionTaken bool, err error)
 }
 
+// VariableClient operates on the CI/CD variables of a specific repository.
+// This client can be accessed through Repository.Variables().
+type VariableClient interface {
+	// Get a repository-wide Variable by its key.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, key string) (Variable, error)
+
+	// List all variables for the given repository, including environment-scoped ones.
+	//
+	// List returns all available variables, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Variable, error)
+
+	// ListIter returns an iterator over the variables List returns.
+	ListIter(ctx context.Context) *Iterator[Variable]
+
+	// Create a variable with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req VariableInfo) (Variable, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	// Variables are identified by both their key and environment.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req VariableInfo) (resp Variable, actionTaken bool, err error)
+}
+
+// EnvironmentClient operates on the deployment environments of a specific repository.
+// This client can be accessed through Repository.Environments().
+type EnvironmentClient interface {
+	// Get an Environment by its name.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, name string) (Environment, error)
+
+	// List all environments of the given repository.
+	//
+	// List returns all available environments, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Environment, error)
+
+	// ListIter returns an iterator over the environments List returns.
+	ListIter(ctx context.Context) *Iterator[Environment]
+
+	// Create an environment with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req EnvironmentInfo) (Environment, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req EnvironmentInfo) (resp Environment, actionTaken bool, err error)
+}
+
+// MirrorClient operates on the mirrors of a specific repository.
+// This client can be accessed through Repository.Mirrors().
+type MirrorClient interface {
+	// Get a Mirror by its ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id string) (Mirror, error)
+
+	// List all mirrors of the given repository.
+	//
+	// List returns all available mirrors, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Mirror, error)
+
+	// ListIter returns an iterator over the mirrors List returns.
+	ListIter(ctx context.Context) *Iterator[Mirror]
+
+	// Create a mirror with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req MirrorInfo) (Mirror, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	// Mirrors are identified by both their direction and URL.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req MirrorInfo) (resp Mirror, actionTaken bool, err error)
+}
+
+// ScheduleClient operates on the scheduled pipelines of a specific repository.
+// This client can be accessed through Repository.Schedules().
+type ScheduleClient interface {
+	// Get a Schedule by its ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id string) (Schedule, error)
+
+	// List all schedules of the given repository.
+	//
+	// List returns all available schedules, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Schedule, error)
+
+	// ListIter returns an iterator over the schedules List returns.
+	ListIter(ctx context.Context) *Iterator[Schedule]
+
+	// Create a schedule with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req ScheduleInfo) (Schedule, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	// Schedules are identified by their description.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req ScheduleInfo) (resp Schedule, actionTaken bool, err error)
+}
+
+// PipelineClient operates on the CI/CD pipelines of a specific repository, i.e. GitLab CI
+// pipelines and GitHub Actions workflow runs.
+// This client can be accessed through Repository.Pipelines().
+type PipelineClient interface {
+	// Trigger starts the pipelines of the given branch or tag, passing variables to them.
+	// On GitHub, every active workflow with a workflow_dispatch trigger is dispatched, and
+	// receives the variables it declares as inputs.
+	//
+	// ErrNotFound is returned if there is nothing to trigger on the ref.
+	Trigger(ctx context.Context, ref string, variables map[string]string) error
+
+	// Get returns the pipeline with the given ID. On GitHub, this is a workflow run ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int64) (Pipeline, error)
+
+	// List lists the pipelines that ran for the given branch, tag or full commit SHA,
+	// most recent first.
+	//
+	// List returns all available pipelines, using multiple paginated requests if needed.
+	List(ctx context.Context, ref string) ([]Pipeline, error)
+	// ListIter returns an iterator over the pipelines List returns.
+	ListIter(ctx context.Context, ref string) *Iterator[Pipeline]
+}
+
+// RunnerClient operates on the self-hosted CI runners of an organization, repository or
+// the whole instance.
+// This client can be accessed through Organization.Runners(), Repository.Runners() and
+// Client.Runners().
+type RunnerClient interface {
+	// CreateToken creates a token a CI runner can use to register with the organization or
+	// repository. On GitHub, this is a short-lived Actions runner registration token. On GitLab,
+	// the runner is created upfront, and its authentication token is returned.
+	CreateToken(ctx context.Context, req RunnerTokenInfo) (*RunnerTokenInfo, error)
+
+	// RegistrationToken returns the registration token runners can register themselves with.
+	// GitHub registration tokens are short-lived, so a new one is created on every call. GitLab
+	// returns the current (deprecated) registration token of the group or project, and returns
+	// ErrNotFound if registration tokens are disabled for it. GitLab doesn't expose the
+	// instance registration token, use RotateRegistrationToken instead.
+	RegistrationToken(ctx context.Context) (*RunnerTokenInfo, error)
+
+	// RotateRegistrationToken replaces the registration token, invalidating the previous one,
+	// and returns the new token. Runners already registered keep working. GitHub can't revoke
+	// registration tokens, so a new one is created, and previous ones stay valid until they
+	// expire.
+	RotateRegistrationToken(ctx context.Context) (*RunnerTokenInfo, error)
+}
+
+// IssueClient operates on the issues of a specific repository.
+// This client can be accessed through Repository.Issues().
+type IssueClient interface {
+	// Get an Issue by its number.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, number int) (Issue, error)
+
+	// List all open issues of the given repository. Pull requests aren't included.
+	//
+	// List returns all available issues, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Issue, error)
+
+	// ListIter returns an iterator over the issues List returns.
+	ListIter(ctx context.Context) *Iterator[Issue]
+
+	// Create an issue with the given specifications.
+	Create(ctx context.Context, req IssueInfo) (Issue, error)
+}
+
+// AutolinkClient operates on the autolink references of a specific repository.
+// This client can be accessed through Repository.Autolinks().
+type AutolinkClient interface {
+	// List all autolinks of the repository.
+	List(ctx context.Context) ([]Autolink, error)
+
+	// ListIter returns an iterator over the autolinks List returns.
+	ListIter(ctx context.Context) *Iterator[Autolink]
+
+	// Create an autolink with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if an autolink with the same key prefix exists, or on
+	// providers that only support one autolink per repository, if any autolink exists.
+	Create(ctx context.Context, req AutolinkInfo) (Autolink, error)
+}
+
+// MilestoneClient operates on the milestones of a specific repository or organization.
+// This client can be accessed through Repository.Milestones() or Organization.Milestones().
+type MilestoneClient interface {
+	// Get a milestone by its ID, see MilestoneInfo.ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int) (Milestone, error)
+
+	// List all open and closed milestones. For repositories, milestones inherited from the
+	// organization (GitLab group milestones) are not included.
+	//
+	// List returns all available milestones, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Milestone, error)
+
+	// ListIter returns an iterator over the milestones List returns.
+	ListIter(ctx context.Context) *Iterator[Milestone]
+
+	// Create a milestone with the given specifications.
+	Create(ctx context.Context, req MilestoneInfo) (Milestone, error)
+}
+
+// LabelClient operates on the issue labels of a specific repository or organization.
+// This client can be accessed through Repository.Labels() or Organization.Labels().
+type LabelClient interface {
+	// Get a label by its name.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, name string) (Label, error)
+
+	// List all labels. For repositories, labels inherited from the organization (GitLab group
+	// labels) are not included.
+	//
+	// List returns all available labels, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Label, error)
+
+	// ListIter returns an iterator over the labels List returns.
+	ListIter(ctx context.Context) *Iterator[Label]
+
+	// Create a label with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req LabelInfo) (Label, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	// Labels are identified by their name.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req LabelInfo) (resp Label, actionTaken bool, err error)
+}
+
+// CommentClient operates on the comments of a specific issue.
+// This client can be accessed through Issue.Comments().
+type CommentClient interface {
+	// List all comments of the issue, oldest first. Comments generated by the provider, e.g.
+	// for label changes, aren't included.
+	//
+	// List returns all available comments, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Comment, error)
+
+	// ListIter returns an iterator over the comments List returns.
+	ListIter(ctx context.Context) *Iterator[Comment]
+
+	// Create a comment with the given specifications.
+	Create(ctx context.Context, req CommentInfo) (Comment, error)
+}
+
+// BotClient operates on the bot users of an organization or repository.
+// This client can be accessed through Organization.Bots() and Repository.Bots().
+type BotClient interface {
+	// Get a Bot by its ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id string) (Bot, error)
+
+	// List all bots of the organization or repository.
+	//
+	// List returns all available bots, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Bot, error)
+
+	// ListIter returns an iterator over the bots List returns.
+	ListIter(ctx context.Context) *Iterator[Bot]
+
+	// Create a bot with the given specifications, and a token for it. The token is only
+	// returned by Create, so store it right away.
+	Create(ctx context.Context, req BotInfo) (Bot, error)
+}
+
+// AccessTokenClient operates on the access tokens of an organization or repository. Unlike
+// personal access tokens, they authenticate as a bot user which only has access to the
+// organization or repository, so they can be given the least privileges needed.
+// This client can be accessed through Organization.AccessTokens() and Repository.AccessTokens().
+type AccessTokenClient interface {
+	// List all access tokens of the organization or repository. The Token field isn't set.
+	//
+	// List returns all available tokens, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]AccessTokenInfo, error)
+
+	// ListIter returns an iterator over the access tokens List returns.
+	ListIter(ctx context.Context) *Iterator[AccessTokenInfo]
+
+	// Create an access token with the given specifications. The token is only returned by
+	// Create and Rotate, so store it right away.
+	Create(ctx context.Context, req AccessTokenInfo) (*AccessTokenInfo, error)
+
+	// Rotate revokes the access token with the given ID, and returns a new one with the same
+	// name, scopes and permission. The new token expires at expiresAt, or after the provider
+	// default lifetime if nil.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Rotate(ctx context.Context, id int64, expiresAt *time.Time) (*AccessTokenInfo, error)
+
+	// Revoke the access token with the given ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Revoke(ctx context.Context, id int64) error
+}
+
+// DeploymentClient operates on the deployments of a specific repository.
+// This client can be accessed through Repository.Deployments().
+type DeploymentClient interface {
+	// Get a Deployment by its ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int64) (Deployment, error)
+
+	// List the deployments of the given repository, most recent first. If environment
+	// isn't empty, only the deployments to that environment are listed.
+	//
+	// List returns all available deployments, using multiple paginated requests if needed.
+	List(ctx context.Context, environment string) ([]Deployment, error)
+
+	// ListIter returns an iterator over the deployments List returns.
+	ListIter(ctx context.Context, environment string) *Iterator[Deployment]
+
+	// Create a deployment with the given specifications. Report its progress
+	// using Deployment.CreateStatus.
+	Create(ctx context.Context, req DeploymentInfo) (Deployment, error)
+}
+
+// ArtifactClient operates on the binary build artifacts stored alongside a specific repository,
+// e.g. in a package registry or as release assets. Artifacts are identified by a version
+// (e.g. a release tag) and a file name.
+// This client can be accessed through Repository.Artifacts().
+type ArtifactClient interface {
+	// Upload uploads content as the artifact with the given name, under the given version.
+	Upload(ctx context.Context, version, name string, content io.Reader) (*ArtifactInfo, error)
+
+	// Download returns the content of the artifact with the given name and version.
+	// The caller is responsible for closing the returned reader.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Download(ctx context.Context, version, name string) (io.ReadCloser, error)
+
+	// List lists all artifacts stored under the given version,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context, version string) ([]*ArtifactInfo, error)
+	// ListIter returns an iterator over the artifacts List returns.
+	ListIter(ctx context.Context, version string) *Iterator[*ArtifactInfo]
+}
+
+// PackageClient operates on the packages and container images published from a specific
+// repository, e.g. to clean up old versions.
+// This client can be accessed through Repository.Packages().
+type PackageClient interface {
+	// List lists all packages of the repository, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Package, error)
+	// ListIter returns an iterator over the packages List returns.
+	ListIter(ctx context.Context) *Iterator[Package]
+}
+
 // CommitClient operates on the commits list for a specific repository.
 // This client can be accessed through Repository.Commits().
 type CommitClient interface {
 
 	// ListPage lists repository commits of the given page and page size.
 	ListPage(ctx context.Context, branch string, perPage int, page int) ([]Commit, error)
+	// ListIter returns an iterator over the commits of the given branch, newest first, which
+	// fetches them a page at a time. The page size is taken from the CallListOptions call option.
+	ListIter(ctx context.Context, branch string) *Iterator[Commit]
 	// Create creates a commit with the given specifications.
 	Create(ctx context.Context, branch string, message string, files []CommitFile) (Commit, error)
+	// GetSignature returns the signature verification of the commit with the given sha.
+	// Unsigned commits have SignatureVerificationReasonUnsigned as reason.
+	//
+	// ErrNotFound is returned if the commit does not exist.
+	GetSignature(ctx context.Context, sha string) (*SignatureVerification, error)
+	// GetTagSignature returns the signature verification of the tag with the given name.
+	// Lightweight tags can't be signed, and are returned as unsigned.
+	//
+	// ErrNotFound is returned if the tag does not exist.
+	GetTagSignature(ctx context.Context, tag string) (*SignatureVerification, error)
 }
 
 // BranchClient operates on the branches for a specific repository.
@@ -259,6 +1032,8 @@ type BranchClient interface {
 type PullRequestClient interface {
 	// List lists all pull requests in the repository
 	List(ctx context.Context) ([]PullRequest, error)
+	// ListIter returns an iterator over the pull requests List returns.
+	ListIter(ctx context.Context) *Iterator[PullRequest]
 	// Create creates a pull request with the given specifications.
 	Create(ctx context.Context, title, branch, baseBranch, description string) (PullRequest, error)
 	// Edit allows for changing an existing pull request using the given options. Please refer to "EditOptions" for details on which data can be
@@ -268,6 +1043,13 @@ type PullRequestClient interface {
 	Get(ctx context.Context, number int) (PullRequest, error)
 	// Merge merges a pull request with via either the "Squash" or "Merge" method
 	Merge(ctx context.Context, number int, mergeMethod MergeMethod, message string) error
+	// EnableAutoMerge makes the provider merge the pull request with the given method once
+	// its required checks pass, i.e. GitHub auto-merge or GitLab's merge when pipeline succeeds.
+	// On GitLab projects with merge trains, the merge request is added to the merge train.
+	EnableAutoMerge(ctx context.Context, number int, mergeMethod MergeMethod) error
+	// DisableAutoMerge cancels a previous EnableAutoMerge, removing the pull request
+	// from the merge train if needed.
+	DisableAutoMerge(ctx context.Context, number int) error
 }
 
 // EditOptions is provided to a PullRequestClient's "Edit" method for updating an existing pull request.
@@ -290,4 +1072,6 @@ type TreeClient interface {
 	Get(ctx context.Context, sha string, recursive bool) (*TreeInfo, error)
 	// List retrieves list of tree files (files/blob) from given tree sha/id or path+branch
 	List(ctx context.Context, sha string, path string, recursive bool) ([]*TreeEntry, error)
+	// ListIter returns an iterator over the tree entries List returns.
+	ListIter(ctx context.Context, sha string, path string, recursive bool) *Iterator[*TreeEntry]
 }
@@ -17,10 +17,17 @@ limitations under the License.
 package gitprovider
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/gitprovider/cache"
 	"github.com/go-logr/logr"
@@ -45,6 +52,21 @@ type CommonClientOptions struct {
 	// deleting a repository) are allowed in the Client. Default: false
 	EnableDestructiveAPICalls *bool
 
+	// AllowInsecureHTTP is a flag specifying whether a Domain with the http:// scheme may be used,
+	// e.g. for a self-hosted instance running on plain HTTP behind a gateway. Default: false
+	AllowInsecureHTTP *bool
+
+	// FollowRedirects is a flag specifying whether getting a renamed or transferred repository by
+	// its old reference returns the repository under its new, canonical reference. If false, a
+	// *RepositoryMovedError is returned instead. Default: true
+	FollowRedirects *bool
+
+	// CommitSizeLimits overrides the size limits commits are checked against before they're sent,
+	// e.g. for a self-hosted instance with raised limits. A zero limit disables its check.
+	// Not used by Stash, which doesn't check the size of commits.
+	// Default: the default limits of the provider
+	CommitSizeLimits *SizeLimits
+
 	// PreChainTransportHook is a function to get a custom RoundTripper that is given as the Transport
 	// to the *http.Client given to the provider-specific Client. It can be set for doing arbitrary
 	// modifications to HTTP requests. "in" might be nil, if so http.DefaultTransport is recommended.
@@ -64,6 +86,10 @@ type CommonClientOptions struct {
 
 	// CABundle is a []byte containing the CA bundle to use for the client.
 	CABundle []byte
+
+	// InsecureSkipTLSVerify is a flag specifying whether the TLS certificate of the server is not
+	// verified. It must only be used for testing. Default: false
+	InsecureSkipTLSVerify *bool
 }
 
 // ApplyToCommonClientOptions applies the currently set fields in opts to target. If both opts and
@@ -78,7 +104,12 @@ func (opts *CommonClientOptions) ApplyToCommonClientOptions(target *CommonClient
 		if len(*opts.Domain) == 0 {
 			return fmt.Errorf("option Domain cannot be an empty string: %w", ErrInvalidClientOptions)
 		}
-		target.Domain = opts.Domain
+		// Internationalized domains must be converted to punycode to be used in the base URLs
+		domain, err := NormalizeDomain(*opts.Domain)
+		if err != nil {
+			return fmt.Errorf("option Domain is invalid: %v: %w", err, ErrInvalidClientOptions)
+		}
+		target.Domain = &domain
 	}
 
 	if opts.EnableDestructiveAPICalls != nil {
@@ -89,6 +120,30 @@ func (opts *CommonClientOptions) ApplyToCommonClientOptions(target *CommonClient
 		target.EnableDestructiveAPICalls = opts.EnableDestructiveAPICalls
 	}
 
+	if opts.AllowInsecureHTTP != nil {
+		// Make sure the user didn't specify the AllowInsecureHTTP twice
+		if target.AllowInsecureHTTP != nil {
+			return fmt.Errorf("option AllowInsecureHTTP already configured: %w", ErrInvalidClientOptions)
+		}
+		target.AllowInsecureHTTP = opts.AllowInsecureHTTP
+	}
+
+	if opts.FollowRedirects != nil {
+		// Make sure the user didn't specify the FollowRedirects twice
+		if target.FollowRedirects != nil {
+			return fmt.Errorf("option FollowRedirects already configured: %w", ErrInvalidClientOptions)
+		}
+		target.FollowRedirects = opts.FollowRedirects
+	}
+
+	if opts.CommitSizeLimits != nil {
+		// Make sure the user didn't specify the CommitSizeLimits twice
+		if target.CommitSizeLimits != nil {
+			return fmt.Errorf("option CommitSizeLimits already configured: %w", ErrInvalidClientOptions)
+		}
+		target.CommitSizeLimits = opts.CommitSizeLimits
+	}
+
 	if opts.PreChainTransportHook != nil {
 		// Make sure the user didn't specify the PreChainTransportHook twice
 		if target.PreChainTransportHook != nil {
@@ -119,9 +174,35 @@ func (opts *CommonClientOptions) ApplyToCommonClientOptions(target *CommonClient
 		target.CABundle = opts.CABundle
 	}
 
+	if opts.InsecureSkipTLSVerify != nil {
+		if target.InsecureSkipTLSVerify != nil {
+			return fmt.Errorf("option InsecureSkipTLSVerify already configured: %w", ErrInvalidClientOptions)
+		}
+		target.InsecureSkipTLSVerify = opts.InsecureSkipTLSVerify
+	}
+
 	return nil
 }
 
+// DomainURL returns the base URL of the instance set in Domain, e.g. "https://my-gitlab.dev.com:6443".
+// Domain may contain a scheme and a custom port, https:// is used if no scheme is given.
+// The http:// scheme is only allowed if AllowInsecureHTTP is set, otherwise ErrInvalidClientOptions is returned.
+// Domain must be set.
+func (opts *CommonClientOptions) DomainURL() (string, error) {
+	if opts.Domain == nil {
+		return "", fmt.Errorf("option Domain is not set: %w", ErrInvalidClientOptions)
+	}
+	domainURL := GetDomainURL(*opts.Domain)
+	u, err := url.Parse(domainURL)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("option Domain %q is not a valid host: %w", *opts.Domain, ErrInvalidClientOptions)
+	}
+	if u.Scheme == "http" && (opts.AllowInsecureHTTP == nil || !*opts.AllowInsecureHTTP) {
+		return "", fmt.Errorf("option Domain %q uses plain HTTP, which requires AllowInsecureHTTP: %w", *opts.Domain, ErrInvalidClientOptions)
+	}
+	return strings.TrimSuffix(domainURL, "/"), nil
+}
+
 // BuildClientFromTransportChain builds a *http.Client from a chain of ChainableRoundTripperFuncs.
 // The first function in the chain is called with "in" == nil. "out" of the first function in the chain,
 // is passed as "in" to the second function, and so on. "out" of the last function in the chain is used
@@ -152,8 +233,54 @@ type ClientOptions struct {
 	// authTransport is a ChainableRoundTripperFunc adding authentication credentials to the transport chain.
 	authTransport ChainableRoundTripperFunc
 
+	// tokenSource is the TokenSource of authTransport, if set through WithOAuth2Token,
+	// WithTokenSource or WithOAuth2TokenSource.
+	tokenSource TokenSource
+
+	// negotiate is the NegotiateTokenFunc of authTransport, if set through WithNegotiateAuth.
+	negotiate NegotiateTokenFunc
+
 	// enableConditionalRequests will be set if conditional requests should be used.
 	enableConditionalRequests *bool
+
+	// cache is the Cache set through WithCache.
+	cache Cache
+
+	// enableNetrc will be set if the netrc file should be used when authTransport isn't set.
+	enableNetrc *bool
+
+	// clientCertificate is presented to servers requesting TLS client authentication.
+	clientCertificate *tls.Certificate
+
+	// tlsConfig is the base TLS configuration of the transport.
+	tlsConfig *tls.Config
+
+	// proxy selects the proxy of requests, if set through WithProxy or WithProxyFunc.
+	proxy func(*http.Request) (*url.URL, error)
+
+	// transportOptions tune the connections of the transport, if set through WithTransportOptions.
+	transportOptions *TransportOptions
+
+	// anonymous will be set if the client must not authenticate.
+	anonymous *bool
+
+	// requiredScopes are the scopes the token must have, if set through WithScopeCheck.
+	requiredScopes []string
+
+	// authHeaders are the headers set through WithAuthHeader.
+	authHeaders []authHeader
+
+	// rateLimitPolicy is the policy set through WithRateLimitPolicy.
+	rateLimitPolicy *RateLimitPolicy
+
+	// retryPolicy is the policy set through WithRetryPolicy.
+	retryPolicy *RetryPolicy
+
+	// debugHTTP is the destination of the dumps set through WithDebugHTTP.
+	debugHTTP *debugHTTP
+
+	// circuitBreakerPolicy is the policy set through WithCircuitBreaker.
+	circuitBreakerPolicy *CircuitBreakerPolicy
 }
 
 // ApplyToClientOptions implements ClientOption, and applies the set fields of opts
@@ -170,6 +297,8 @@ func (opts *ClientOptions) ApplyToClientOptions(target *ClientOptions) error {
 			return fmt.Errorf("option authTransport already configured: %w", ErrInvalidClientOptions)
 		}
 		target.authTransport = opts.authTransport
+		target.tokenSource = opts.tokenSource
+		target.negotiate = opts.negotiate
 	}
 
 	if opts.enableConditionalRequests != nil {
@@ -179,26 +308,192 @@ func (opts *ClientOptions) ApplyToClientOptions(target *ClientOptions) error {
 		}
 		target.enableConditionalRequests = opts.enableConditionalRequests
 	}
+
+	if opts.cache != nil {
+		// Make sure the user didn't specify the cache twice
+		if target.cache != nil {
+			return fmt.Errorf("option cache already configured: %w", ErrInvalidClientOptions)
+		}
+		target.cache = opts.cache
+	}
+
+	if opts.enableNetrc != nil {
+		// Make sure the user didn't specify the enableNetrc twice
+		if target.enableNetrc != nil {
+			return fmt.Errorf("option enableNetrc already configured: %w", ErrInvalidClientOptions)
+		}
+		target.enableNetrc = opts.enableNetrc
+	}
+
+	if opts.clientCertificate != nil {
+		// Make sure the user didn't specify the clientCertificate twice
+		if target.clientCertificate != nil {
+			return fmt.Errorf("option clientCertificate already configured: %w", ErrInvalidClientOptions)
+		}
+		target.clientCertificate = opts.clientCertificate
+	}
+
+	if opts.tlsConfig != nil {
+		// Make sure the user didn't specify the tlsConfig twice
+		if target.tlsConfig != nil {
+			return fmt.Errorf("option tlsConfig already configured: %w", ErrInvalidClientOptions)
+		}
+		target.tlsConfig = opts.tlsConfig
+	}
+
+	if opts.proxy != nil {
+		// Make sure the user didn't specify the proxy twice
+		if target.proxy != nil {
+			return fmt.Errorf("option proxy already configured: %w", ErrInvalidClientOptions)
+		}
+		target.proxy = opts.proxy
+	}
+
+	if opts.transportOptions != nil {
+		// Make sure the user didn't specify the transportOptions twice
+		if target.transportOptions != nil {
+			return fmt.Errorf("option transportOptions already configured: %w", ErrInvalidClientOptions)
+		}
+		target.transportOptions = opts.transportOptions
+	}
+
+	if opts.anonymous != nil {
+		// Make sure the user didn't specify the anonymous twice
+		if target.anonymous != nil {
+			return fmt.Errorf("option anonymous already configured: %w", ErrInvalidClientOptions)
+		}
+		target.anonymous = opts.anonymous
+	}
+
+	if opts.requiredScopes != nil {
+		// Make sure the user didn't specify the requiredScopes twice
+		if target.requiredScopes != nil {
+			return fmt.Errorf("option requiredScopes already configured: %w", ErrInvalidClientOptions)
+		}
+		target.requiredScopes = opts.requiredScopes
+	}
+
+	if opts.rateLimitPolicy != nil {
+		// Make sure the user didn't specify the rateLimitPolicy twice
+		if target.rateLimitPolicy != nil {
+			return fmt.Errorf("option rateLimitPolicy already configured: %w", ErrInvalidClientOptions)
+		}
+		target.rateLimitPolicy = opts.rateLimitPolicy
+	}
+
+	if opts.retryPolicy != nil {
+		// Make sure the user didn't specify the retryPolicy twice
+		if target.retryPolicy != nil {
+			return fmt.Errorf("option retryPolicy already configured: %w", ErrInvalidClientOptions)
+		}
+		target.retryPolicy = opts.retryPolicy
+	}
+
+	if opts.circuitBreakerPolicy != nil {
+		// Make sure the user didn't specify the circuitBreakerPolicy twice
+		if target.circuitBreakerPolicy != nil {
+			return fmt.Errorf("option circuitBreakerPolicy already configured: %w", ErrInvalidClientOptions)
+		}
+		target.circuitBreakerPolicy = opts.circuitBreakerPolicy
+	}
+
+	if opts.debugHTTP != nil {
+		// Make sure the user didn't specify the debugHTTP twice
+		if target.debugHTTP != nil {
+			return fmt.Errorf("option debugHTTP already configured: %w", ErrInvalidClientOptions)
+		}
+		target.debugHTTP = opts.debugHTTP
+	}
+
+	for _, h := range opts.authHeaders {
+		// Make sure the user didn't specify the same header twice
+		for _, existing := range target.authHeaders {
+			if existing.name == h.name {
+				return fmt.Errorf("option authHeader %s already configured: %w", h.name, ErrInvalidClientOptions)
+			}
+		}
+		target.authHeaders = append(target.authHeaders, h)
+	}
 	return nil
 }
 
+// Anonymous returns true if the client was configured through WithAnonymous.
+func (opts *ClientOptions) Anonymous() bool {
+	return opts.anonymous != nil && *opts.anonymous
+}
+
+// RequiredScopes returns the scopes set through WithScopeCheck.
+func (opts *ClientOptions) RequiredScopes() []string {
+	return opts.requiredScopes
+}
+
+// TokenSource returns the TokenSource set through WithOAuth2Token, WithTokenSource or
+// WithOAuth2TokenSource, or nil. Providers use it to authenticate Git operations, which don't go
+// through the transport chain, and to tell the type of the token.
+func (opts *ClientOptions) TokenSource() TokenSource {
+	return opts.tokenSource
+}
+
+// Netrc returns true if the client authenticates through the netrc file, i.e. WithNetrc(true)
+// was given without other credentials.
+func (opts *ClientOptions) Netrc() bool {
+	return opts.authTransport == nil && opts.enableNetrc != nil && *opts.enableNetrc
+}
+
+// PostChainTransport returns the transport talking to the API, which applies the TLS, proxy and
+// transport options on top of the PostChainTransportHook, or nil if neither is set. Providers
+// building a separate transport chain, e.g. to authenticate token requests, should start it with
+// this transport.
+func (opts *ClientOptions) PostChainTransport() ChainableRoundTripperFunc {
+	if opts.needsBaseTransport() {
+		return opts.baseTransport
+	}
+	return opts.PostChainTransportHook
+}
+
 // GetTransportChain builds the full chain of transports (from left to right,
 // as per gitprovider.BuildClientFromTransportChain) of the form described in NewClient.
 func (opts *ClientOptions) GetTransportChain() (chain []ChainableRoundTripperFunc) {
-	if opts.PostChainTransportHook != nil {
-		chain = append(chain, opts.PostChainTransportHook)
+	if post := opts.PostChainTransport(); post != nil {
+		chain = append(chain, post)
+	}
+	if opts.Logger != nil {
+		chain = append(chain, opts.loggingChainTransport)
+	}
+	if opts.debugHTTP != nil {
+		chain = append(chain, opts.debugChainTransport)
+	}
+	if len(opts.authHeaders) != 0 {
+		chain = append(chain, opts.authHeaderChainTransport)
 	}
 	if opts.authTransport != nil {
 		chain = append(chain, opts.authTransport)
+	} else if opts.enableNetrc != nil && *opts.enableNetrc {
+		chain = append(chain, netrcTransport)
+	}
+	// Retry and wait above the authentication, so that every attempt is authenticated again, as
+	// tokens and signatures may expire in between
+	if opts.retryPolicy != nil {
+		chain = append(chain, opts.retryChainTransport)
+	}
+	if opts.rateLimitPolicy != nil {
+		chain = append(chain, opts.rateLimitChainTransport)
 	}
-	if opts.enableConditionalRequests != nil && *opts.enableConditionalRequests {
-		// TODO: Provide some kind of debug logging if/when the httpcache is used
-		// One can see if the request hit the cache using: resp.Header[httpcache.XFromCache]
-		chain = append(chain, cache.NewHTTPCacheTransport)
+	if opts.circuitBreakerPolicy != nil {
+		chain = append(chain, opts.circuitBreakerChainTransport)
+	}
+	if opts.cache != nil {
+		// One can see if the request hit the cache using: resp.Header[cache.XFromCache]
+		chain = append(chain, cache.NewETagTransportWithCache(opts.cache))
+	} else if opts.enableConditionalRequests != nil && *opts.enableConditionalRequests {
+		chain = append(chain, cache.NewETagTransport)
 	}
 	if opts.PreChainTransportHook != nil {
 		chain = append(chain, opts.PreChainTransportHook)
 	}
+	// Bound the requests by the deadline of their call options outermost, so that it also
+	// covers retries and rate limit waits
+	chain = append(chain, callDeadlineChainTransport)
 	return
 }
 
@@ -227,12 +522,15 @@ func optionError(err error) ClientOption {
 //
 
 // WithDomain initializes a Client for a custom instance of the given domain.
-// Only host and port information should be present in domain. domain must not be an empty string.
+// Only host and port information, and optionally the scheme, should be present in domain. Using the
+// http:// scheme requires WithAllowInsecureHTTP. domain must not be an empty string.
 func WithDomain(domain string) ClientOption {
 	return buildCommonOption(CommonClientOptions{Domain: &domain})
 }
 
-// WithLogger initializes a Client for a custom Stash instance with a logger.
+// WithLogger initializes a Client with a logger. The client logs rate limit waits at V(0), retries
+// and the create, update or no-op decisions of Reconcile methods at V(1), and every request it
+// sends at V(2). To make the client quieter than the rest of the caller, pass e.g. log.V(1) instead.
 func WithLogger(log *logr.Logger) ClientOption {
 	return buildCommonOption(CommonClientOptions{Logger: log})
 }
@@ -243,6 +541,30 @@ func WithDestructiveAPICalls(destructiveActions bool) ClientOption {
 	return buildCommonOption(CommonClientOptions{EnableDestructiveAPICalls: &destructiveActions})
 }
 
+// WithAllowInsecureHTTP tells the client whether it's allowed to talk to a self-hosted instance
+// over plain HTTP, i.e. if the domain given to WithDomain has the http:// scheme. This applies to
+// all providers. Clients used to accept such a domain without it, and now fail to be created with
+// ErrInvalidClientOptions unless WithAllowInsecureHTTP(true) is given.
+func WithAllowInsecureHTTP(allowInsecureHTTP bool) ClientOption {
+	return buildCommonOption(CommonClientOptions{AllowInsecureHTTP: &allowInsecureHTTP})
+}
+
+// WithFollowRedirects tells the client whether to follow the redirects of renamed or transferred
+// repositories. If enabled, which is the default, Get returns the repository under its canonical
+// reference, which can be compared to the requested one. A repository transferred from an
+// organization to a user keeps the requested reference when got as an OrgRepository. If disabled,
+// a *RepositoryMovedError is returned instead.
+func WithFollowRedirects(followRedirects bool) ClientOption {
+	return buildCommonOption(CommonClientOptions{FollowRedirects: &followRedirects})
+}
+
+// WithCommitSizeLimits overrides the size limits the client checks commits against before sending
+// them, which default to those of a default installation of the provider. Use it for a self-hosted
+// instance with different limits; a zero limit disables its check.
+func WithCommitSizeLimits(limits SizeLimits) ClientOption {
+	return buildCommonOption(CommonClientOptions{CommitSizeLimits: &limits})
+}
+
 // WithPreChainTransportHook registers a ChainableRoundTripperFunc "before" the cache and authentication
 // transports in the chain. For more information, see NewClient, and gitprovider.CommonClientOptions.PreChainTransportHook.
 func WithPreChainTransportHook(preRoundTripperFunc ChainableRoundTripperFunc) ClientOption {
@@ -273,28 +595,262 @@ func WithOAuth2Token(oauth2Token string) ClientOption {
 		return optionError(fmt.Errorf("oauth2Token cannot be empty: %w", ErrInvalidClientOptions))
 	}
 
-	return &ClientOptions{authTransport: oauth2Transport(oauth2Token)}
+	return &ClientOptions{
+		authTransport: oauth2Transport(oauth2Token),
+		tokenSource: func(context.Context) (string, error) {
+			return oauth2Token, nil
+		},
+	}
 }
 
 func oauth2Transport(oauth2Token string) ChainableRoundTripperFunc {
+	// Create a TokenSource of the given access token
+	return oauth2TokenSourceTransport(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: oauth2Token}))
+}
+
+// WithOAuth2TokenSource initializes a Client which authenticates through the OAuth2 tokens
+// returned by ts, e.g. the token source of an oauth2.Config, which uses the refresh token to
+// get a new access token once the current one expires. The tokens are cached until they
+// expire, and are also used for the Git operations of the Client. ts must not be nil.
+func WithOAuth2TokenSource(ts oauth2.TokenSource) ClientOption {
+	// Don't allow an empty value
+	if ts == nil {
+		return optionError(fmt.Errorf("ts cannot be nil: %w", ErrInvalidClientOptions))
+	}
+
+	// Share the cached token between API calls and Git operations, so that it's only refreshed
+	// once. A source which already caches its tokens, e.g. with oauth2.ReuseTokenSourceWithExpiry,
+	// is used as it is, so that it keeps refreshing them as early as it was set up to.
+	ts = oauth2.ReuseTokenSource(nil, ts)
+	return &ClientOptions{
+		authTransport: oauth2TokenSourceTransport(ts),
+		tokenSource:   OAuth2TokenSource(ts),
+	}
+}
+
+// OAuth2TokenSource returns a TokenSource returning the access tokens of ts. It can be used to
+// authenticate Git operations, e.g. clones over HTTPS, with the same token source as a Client
+// set up WithOAuth2TokenSource.
+func OAuth2TokenSource(ts oauth2.TokenSource) TokenSource {
+	return func(context.Context) (string, error) {
+		token, err := ts.Token()
+		if err != nil {
+			return "", err
+		}
+		return token.AccessToken, nil
+	}
+}
+
+func oauth2TokenSourceTransport(ts oauth2.TokenSource) ChainableRoundTripperFunc {
 	return func(in http.RoundTripper) http.RoundTripper {
-		// Create a TokenSource of the given access token
-		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: oauth2Token})
 		// Create a Transport, with "in" as the underlying transport, and the given TokenSource
-		return &oauth2.Transport{
+		return &sameHostAuthTransport{auth: &oauth2.Transport{
 			Base:   in,
-			Source: oauth2.ReuseTokenSource(nil, ts),
+			Source: ts,
+		}, base: in}
+	}
+}
+
+// TokenSource returns the token to authenticate a request with. It's called with the context of
+// every request, so it can return short-lived tokens, e.g. from an OIDC exchange or a Vault
+// lease, and should cache them itself until they expire.
+type TokenSource func(ctx context.Context) (string, error)
+
+// WithTokenSource initializes a Client which authenticates every request with the bearer token
+// returned by ts, which allows rotating credentials without recreating the Client. Providers
+// whose constructors take a token need an empty one to be passed. Use RotatableToken.Token to
+// replace a static token at runtime. ts must not be nil.
+func WithTokenSource(ts TokenSource) ClientOption {
+	// Don't allow an empty value
+	if ts == nil {
+		return optionError(fmt.Errorf("ts cannot be nil: %w", ErrInvalidClientOptions))
+	}
+
+	return &ClientOptions{
+		authTransport: func(in http.RoundTripper) http.RoundTripper {
+			return &sameHostAuthTransport{auth: &tokenSourceTransport{source: ts, base: in}, base: in}
+		},
+		tokenSource: ts,
+	}
+}
+
+// RotatableToken holds a token which can be replaced while Clients are using it, e.g. when a
+// long-running controller picks up a refreshed Kubernetes Secret. Pass its Token method to
+// WithTokenSource; the Client, its sub-clients and caches stay as they are when the token is
+// rotated with SetToken. It's safe for concurrent use.
+type RotatableToken struct {
+	mu    sync.RWMutex
+	token string
+}
+
+// NewRotatableToken returns a RotatableToken holding the given token.
+func NewRotatableToken(token string) *RotatableToken {
+	return &RotatableToken{token: token}
+}
+
+// Token returns the current token, implementing TokenSource. An error is returned if the token
+// is empty.
+func (t *RotatableToken) Token(_ context.Context) (string, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.token == "" {
+		return "", errors.New("token is empty")
+	}
+	return t.token, nil
+}
+
+// SetToken replaces the token, which is used from the next request on.
+func (t *RotatableToken) SetToken(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.token = token
+}
+
+// tokenSourceTransport authenticates every request with the bearer token returned by source.
+type tokenSourceTransport struct {
+	source TokenSource
+	base   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source(req.Context())
+	if err != nil {
+		// A RoundTripper must always close the body
+		if req.Body != nil {
+			_ = req.Body.Close()
 		}
+		return nil, fmt.Errorf("failed to get token: %w", err)
 	}
+	// A RoundTripper must not modify the given request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
 }
 
-// WithConditionalRequests instructs the client to use Conditional Requests to Stash.
-// See: https://gitlab.com/gitlab.org/gitlab.foss/-/issues/26926, and
+// sameHostAuthTransport authenticates requests through auth, unless they follow a redirect to
+// another host than the one of the first request, e.g. to the object storage a file is
+// downloaded from, which must not get the credentials. net/http only drops the credentials set
+// on the request itself, not the ones auth adds to every redirected request.
+type sameHostAuthTransport struct {
+	auth http.RoundTripper
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *sameHostAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The Response of a redirected request is the redirect, which links to the previous request
+	first := req
+	for first.Response != nil && first.Response.Request != nil {
+		first = first.Response.Request
+	}
+	if first.URL.Host == req.URL.Host {
+		return t.auth.RoundTrip(req)
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// WithConditionalRequests instructs the client to use Conditional Requests: GET requests are
+// revalidated with the ETag of the previous response, which is served from an in-memory cache if
+// the resource didn't change. On GitHub, such requests don't count against the rate limit.
+// See: https://docs.github.com/en/rest/using-the-rest-api/best-practices-for-using-the-rest-api#use-conditional-requests-if-appropriate,
+// https://gitlab.com/gitlab.org/gitlab.foss/-/issues/26926, and
 // https://docs.gitlab.com/ee/development/polling.html for more info.
 func WithConditionalRequests(conditionalRequests bool) ClientOption {
 	return &ClientOptions{enableConditionalRequests: &conditionalRequests}
 }
 
+// Cache stores values by key, with an expiry. See the cache package for the in-memory and
+// on-disk implementations.
+type Cache = cache.Cache
+
+// WithCache makes the client use Conditional Requests, like WithConditionalRequests, with the
+// responses stored in c instead of memory, e.g. cache.NewDisk to keep them across restarts, or a
+// cache shared by the replicas of a controller. c must not be nil.
+func WithCache(c Cache) ClientOption {
+	// Don't allow an empty value
+	if c == nil {
+		return optionError(fmt.Errorf("c cannot be nil: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{cache: c}
+}
+
+// Cache returns the Cache set through WithCache, or nil. Providers can use it to cache objects
+// which are expensive to get, besides the responses of conditional requests.
+func (opts *ClientOptions) Cache() Cache {
+	return opts.cache
+}
+
+// WithAnonymous instructs the client to not authenticate, for read-only access to public
+// resources, e.g. getting and listing public repositories and reading their files. Providers
+// taking credentials in their constructors require them to be empty. Combining it with an
+// authentication option returns ErrInvalidClientOptions. Note that anonymous requests are
+// subject to much lower rate limits of most providers.
+func WithAnonymous(anonymous bool) ClientOption {
+	return &ClientOptions{anonymous: &anonymous}
+}
+
+// WithScopeCheck makes NewClient verify that the token has the given scopes, e.g. "repo" on
+// GitHub or "api" on GitLab, so that programs fail fast instead of on the first forbidden call.
+// A *MissingScopesError listing the missing scopes is returned otherwise. Scopes implied by
+// broader ones, e.g. "public_repo" by "repo", count as granted. The check costs one request at
+// construction; providers which can't introspect tokens return ErrNoProviderSupport.
+func WithScopeCheck(requiredScopes ...string) ClientOption {
+	if len(requiredScopes) == 0 {
+		return optionError(fmt.Errorf("requiredScopes cannot be empty: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{requiredScopes: requiredScopes}
+}
+
+// CheckScopes returns a *MissingScopesError if some of the required scopes are neither granted,
+// nor implied by a granted scope. implied maps a scope to the scopes it includes, which are
+// resolved transitively. It's used by the providers to implement WithScopeCheck.
+func CheckScopes(granted, required []string, implied map[string][]string) error {
+	has := map[string]bool{}
+	var grant func(scope string)
+	grant = func(scope string) {
+		if has[scope] {
+			return
+		}
+		has[scope] = true
+		for _, s := range implied[scope] {
+			grant(s)
+		}
+	}
+	for _, scope := range granted {
+		grant(scope)
+	}
+
+	var missing []string
+	for _, scope := range required {
+		if !has[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) != 0 {
+		return &MissingScopesError{Missing: missing, Granted: granted}
+	}
+	return nil
+}
+
+// WithNetrc instructs the client to authenticate with the password of the netrc entry of the API
+// host, e.g. a personal access token, as resolved by NetrcCredentials. It's only used if no other
+// credentials are given, so CLIs can always pass it. Providers whose constructors take a token
+// need an empty one to be passed.
+func WithNetrc(netrc bool) ClientOption {
+	return &ClientOptions{enableNetrc: &netrc}
+}
+
 // MakeClientOptions assembles a clientOptions struct from ClientOption mutator functions.
 func MakeClientOptions(opts ...ClientOption) (*ClientOptions, error) {
 	o := &ClientOptions{}
@@ -303,34 +859,222 @@ func MakeClientOptions(opts ...ClientOption) (*ClientOptions, error) {
 			return nil, err
 		}
 	}
+	if o.Anonymous() && (o.authTransport != nil || (o.enableNetrc != nil && *o.enableNetrc)) {
+		return nil, fmt.Errorf("option anonymous can't be combined with credentials: %w", ErrInvalidClientOptions)
+	}
 	return o, nil
 }
 
-// WithCustomCAPostChainTransportHook registers a ChainableRoundTripperFunc "after" the cache and authentication
-// transports in the chain.
+// WithCustomCAPostChainTransportHook instructs the client to trust the given PEM-encoded CA
+// certificates in addition to the system ones.
+//
+// Deprecated: Use WithCABundle, which this is the same as.
 func WithCustomCAPostChainTransportHook(caBundle []byte) ClientOption {
+	return WithCABundle(caBundle)
+}
+
+// WithClientCertificate instructs the client to authenticate with the given PEM-encoded certificate
+// and private key when the server requests it, e.g. a mutual TLS gateway in front of the API. It
+// can be combined with WithCABundle, or any PostChainTransportHook returning an *http.Transport.
+// Git operations over HTTPS done through go-git don't use the certificate.
+func WithClientCertificate(cert, key []byte) ClientOption {
+	certificate, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return optionError(fmt.Errorf("invalid client certificate: %v: %w", err, ErrInvalidClientOptions))
+	}
+	return &ClientOptions{clientCertificate: &certificate}
+}
+
+// WithCABundle instructs the client to trust the given PEM-encoded CA certificates in addition to
+// the system ones, e.g. for an on-prem instance with a self-signed certificate. It can be combined
+// with any PostChainTransportHook returning an *http.Transport.
+func WithCABundle(caBundle []byte) ClientOption {
 	// Don't allow an empty value
 	if len(caBundle) == 0 {
 		return optionError(fmt.Errorf("caBundle cannot be empty: %w", ErrInvalidClientOptions))
 	}
+	if !x509.NewCertPool().AppendCertsFromPEM(caBundle) {
+		return optionError(fmt.Errorf("caBundle contains no PEM-encoded certificate: %w", ErrInvalidClientOptions))
+	}
+	return buildCommonOption(CommonClientOptions{CABundle: caBundle})
+}
+
+// WithTLSConfig sets the TLS configuration of the transport talking to the API, e.g. to set the
+// minimum TLS version or root CAs. The configuration is cloned before other TLS options are
+// applied on top of it. Git operations done through go-git only use WithCABundle and
+// WithInsecureSkipTLSVerify.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	if config == nil {
+		return optionError(fmt.Errorf("tlsConfig cannot be nil: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{tlsConfig: config}
+}
 
-	return buildCommonOption(CommonClientOptions{CABundle: caBundle, PostChainTransportHook: caCustomTransport(caBundle)})
+// WithProxy sends the requests of the client through the proxy at proxyURL, instead of the one
+// set process-wide with the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables. The
+// http, https and socks5 schemes are supported. It can be combined with any
+// PostChainTransportHook returning an *http.Transport. Git operations done through go-git don't
+// use the proxy.
+func WithProxy(proxyURL string) ClientOption {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return optionError(fmt.Errorf("invalid proxy URL: %v: %w", err, ErrInvalidClientOptions))
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return optionError(fmt.Errorf("unsupported proxy URL scheme %q: %w", u.Scheme, ErrInvalidClientOptions))
+	}
+	if u.Host == "" {
+		return optionError(fmt.Errorf("proxy URL %q has no host: %w", proxyURL, ErrInvalidClientOptions))
+	}
+	return &ClientOptions{proxy: http.ProxyURL(u)}
 }
 
-func caCustomTransport(caBundle []byte) ChainableRoundTripperFunc {
-	return func(_ http.RoundTripper) http.RoundTripper {
-		// discard error, as we're only using it to check if rootCA is empty
-		rootCAs, _ := x509.SystemCertPool()
-		if rootCAs == nil {
-			rootCAs = x509.NewCertPool()
+// WithProxyFunc selects the proxy of each request of the client with proxy, like the Proxy field
+// of http.Transport: a nil URL sends the request without a proxy. Use it to route providers
+// through different proxies, or to ignore the process-wide proxy environment variables.
+func WithProxyFunc(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	if proxy == nil {
+		return optionError(fmt.Errorf("proxy func cannot be nil: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{proxy: proxy}
+}
+
+// TransportOptions tune the connections of the transport talking to the API. Fields left to
+// their zero value keep the value of the base transport, which is http.DefaultTransport unless
+// a PostChainTransportHook is set.
+type TransportOptions struct {
+	// DialTimeout is the maximum amount of time a dial waits for a connection to be established.
+	// Default: 30s.
+	DialTimeout time.Duration
+	// KeepAlive is the interval between TCP keep-alive probes of open connections.
+	// Default: 30s.
+	KeepAlive time.Duration
+	// TLSHandshakeTimeout is the maximum amount of time to wait for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
+	// MaxIdleConns is the maximum number of idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host. The default
+	// of http.Transport, 2, makes clients doing many concurrent calls to one host open a new
+	// connection for most requests.
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits the number of connections per host, including those in use.
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept before being closed.
+	IdleConnTimeout time.Duration
+	// DisableKeepAlives opens a new connection for every request.
+	DisableKeepAlives bool
+}
+
+// WithTransportOptions tunes the connection pool, timeouts and keep-alives of the transport
+// talking to the API, e.g. to keep more idle connections to a single host for controllers making
+// many small API calls. It can be combined with any PostChainTransportHook returning an
+// *http.Transport. Git operations done through go-git don't use it.
+func WithTransportOptions(transportOpts TransportOptions) ClientOption {
+	if transportOpts.DialTimeout < 0 || transportOpts.KeepAlive < 0 || transportOpts.TLSHandshakeTimeout < 0 ||
+		transportOpts.IdleConnTimeout < 0 || transportOpts.MaxIdleConns < 0 ||
+		transportOpts.MaxIdleConnsPerHost < 0 || transportOpts.MaxConnsPerHost < 0 {
+		return optionError(fmt.Errorf("transport options cannot be negative: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{transportOptions: &transportOpts}
+}
+
+// apply sets the non-zero options on transport. The dialer is only replaced if one of its
+// options is set, as the one of the base transport can't be inspected.
+func (o *TransportOptions) apply(transport *http.Transport) {
+	if o.DialTimeout != 0 || o.KeepAlive != 0 {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		if o.DialTimeout != 0 {
+			dialer.Timeout = o.DialTimeout
 		}
+		if o.KeepAlive != 0 {
+			dialer.KeepAlive = o.KeepAlive
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	if o.TLSHandshakeTimeout != 0 {
+		transport.TLSHandshakeTimeout = o.TLSHandshakeTimeout
+	}
+	if o.MaxIdleConns != 0 {
+		transport.MaxIdleConns = o.MaxIdleConns
+	}
+	if o.MaxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = o.MaxIdleConnsPerHost
+	}
+	if o.MaxConnsPerHost != 0 {
+		transport.MaxConnsPerHost = o.MaxConnsPerHost
+	}
+	if o.IdleConnTimeout != 0 {
+		transport.IdleConnTimeout = o.IdleConnTimeout
+	}
+	if o.DisableKeepAlives {
+		transport.DisableKeepAlives = true
+	}
+}
 
-		rootCAs.AppendCertsFromPEM(caBundle)
+// WithInsecureSkipTLSVerify disables the verification of the TLS certificate of the server, which
+// makes the connection vulnerable to man-in-the-middle attacks. It's meant for testing against
+// instances with throwaway certificates only; prefer WithCABundle. A warning is logged to the
+// Logger whenever a client is created with it, or written to stderr if no Logger is set.
+func WithInsecureSkipTLSVerify(insecure bool) ClientOption {
+	return buildCommonOption(CommonClientOptions{InsecureSkipTLSVerify: &insecure})
+}
+
+// needsBaseTransport returns true if any of the TLS, proxy or transport options is set.
+func (opts *ClientOptions) needsBaseTransport() bool {
+	return opts.clientCertificate != nil || opts.tlsConfig != nil || len(opts.CABundle) != 0 ||
+		(opts.InsecureSkipTLSVerify != nil && *opts.InsecureSkipTLSVerify) || opts.proxy != nil ||
+		opts.transportOptions != nil
+}
 
-		return &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs: rootCAs,
-			},
+// baseTransport applies the TLS, proxy and transport options to a clone of the transport returned by the
+// PostChainTransportHook, or of http.DefaultTransport if it isn't set, so the shared default
+// transport isn't modified. nil is returned if the hook doesn't return an *http.Transport, as
+// the options can't be applied.
+func (opts *ClientOptions) baseTransport(_ http.RoundTripper) http.RoundTripper {
+	base := http.DefaultTransport
+	if opts.PostChainTransportHook != nil {
+		base = opts.PostChainTransportHook(nil)
+	}
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return nil
+	}
+	transport = transport.Clone()
+
+	if opts.proxy != nil {
+		transport.Proxy = opts.proxy
+	}
+	if opts.transportOptions != nil {
+		opts.transportOptions.apply(transport)
+	}
+	if opts.tlsConfig != nil {
+		transport.TLSClientConfig = opts.tlsConfig.Clone()
+	} else if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	config := transport.TLSClientConfig
+	if len(opts.CABundle) != 0 {
+		rootCAs := config.RootCAs
+		if rootCAs == nil {
+			// discard error, as we're only using it to check if rootCA is empty
+			rootCAs, _ = x509.SystemCertPool()
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		} else {
+			rootCAs = rootCAs.Clone()
 		}
+		rootCAs.AppendCertsFromPEM(opts.CABundle)
+		config.RootCAs = rootCAs
+	}
+	if opts.clientCertificate != nil {
+		config.Certificates = []tls.Certificate{*opts.clientCertificate}
+	}
+	if opts.InsecureSkipTLSVerify != nil && *opts.InsecureSkipTLSVerify {
+		opts.warn("TLS certificate verification is disabled, connections are vulnerable to man-in-the-middle attacks")
+		config.InsecureSkipVerify = true //nolint:gosec // explicitly requested through WithInsecureSkipTLSVerify
 	}
+	return transport
 }
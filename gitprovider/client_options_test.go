@@ -17,11 +17,28 @@ limitations under the License.
 package gitprovider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
+	"golang.org/x/oauth2"
+
+	"github.com/fluxcd/go-git-providers/gitprovider/cache"
 	"github.com/fluxcd/go-git-providers/validation"
 )
 
@@ -217,6 +234,7 @@ func Test_makeCientOptions(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	memoryCache := cache.NewMemory(0)
 	tests := []struct {
 		name         string
 		opts         []ClientOption
@@ -237,6 +255,36 @@ func Test_makeCientOptions(t *testing.T) {
 			opts:         []ClientOption{WithDomain("")},
 			expectedErrs: []error{ErrInvalidClientOptions},
 		},
+		{
+			name: "WithAllowInsecureHTTP",
+			opts: []ClientOption{WithAllowInsecureHTTP(true)},
+			want: buildCommonOption(CommonClientOptions{AllowInsecureHTTP: BoolVar(true)}),
+		},
+		{
+			name:         "WithAllowInsecureHTTP, duplicate",
+			opts:         []ClientOption{WithAllowInsecureHTTP(true), WithAllowInsecureHTTP(false)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithFollowRedirects",
+			opts: []ClientOption{WithFollowRedirects(true)},
+			want: buildCommonOption(CommonClientOptions{FollowRedirects: BoolVar(true)}),
+		},
+		{
+			name:         "WithFollowRedirects, duplicate",
+			opts:         []ClientOption{WithFollowRedirects(true), WithFollowRedirects(false)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithCommitSizeLimits",
+			opts: []ClientOption{WithCommitSizeLimits(SizeLimits{MaxFileSize: 1 << 30})},
+			want: buildCommonOption(CommonClientOptions{CommitSizeLimits: &SizeLimits{MaxFileSize: 1 << 30}}),
+		},
+		{
+			name:         "WithCommitSizeLimits, duplicate",
+			opts:         []ClientOption{WithCommitSizeLimits(SizeLimits{}), WithCommitSizeLimits(SizeLimits{})},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
 		{
 			name: "WithDestructiveAPICalls",
 			opts: []ClientOption{WithDestructiveAPICalls(true)},
@@ -265,7 +313,7 @@ func Test_makeCientOptions(t *testing.T) {
 		{
 			name: "WithCustomCAPostChainTransportHook",
 			opts: []ClientOption{WithCustomCAPostChainTransportHook(ca)},
-			want: buildCommonOption(CommonClientOptions{CABundle: ca, PostChainTransportHook: caCustomTransport(ca)}),
+			want: buildCommonOption(CommonClientOptions{CABundle: ca}),
 		},
 		{
 			name:         "WithCustomCAPostChainTransportHook, nil",
@@ -282,6 +330,31 @@ func Test_makeCientOptions(t *testing.T) {
 			opts:         []ClientOption{WithOAuth2Token("")},
 			expectedErrs: []error{ErrInvalidClientOptions},
 		},
+		{
+			name: "WithOAuth2TokenSource",
+			opts: []ClientOption{WithOAuth2TokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "foo"}))},
+			want: &ClientOptions{authTransport: oauth2Transport("foo")},
+		},
+		{
+			name:         "WithOAuth2TokenSource, nil",
+			opts:         []ClientOption{WithOAuth2TokenSource(nil)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithOAuth2TokenSource, exclusive with WithOAuth2Token",
+			opts:         []ClientOption{WithOAuth2Token("foo"), WithOAuth2TokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "bar"}))},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithTokenSource, nil",
+			opts:         []ClientOption{WithTokenSource(nil)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithTokenSource, exclusive with WithOAuth2Token",
+			opts:         []ClientOption{WithOAuth2Token("foo"), WithTokenSource(func(context.Context) (string, error) { return "bar", nil })},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
 		{
 			name: "WithConditionalRequests",
 			opts: []ClientOption{WithConditionalRequests(true)},
@@ -292,6 +365,101 @@ func Test_makeCientOptions(t *testing.T) {
 			opts:         []ClientOption{WithConditionalRequests(true), WithConditionalRequests(false)},
 			expectedErrs: []error{ErrInvalidClientOptions},
 		},
+		{
+			name: "WithCache",
+			opts: []ClientOption{WithCache(memoryCache)},
+			want: &ClientOptions{cache: memoryCache},
+		},
+		{
+			name:         "WithCache, exclusive",
+			opts:         []ClientOption{WithCache(memoryCache), WithCache(cache.NewMemory(0))},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithCache, nil",
+			opts:         []ClientOption{WithCache(nil)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithNetrc",
+			opts: []ClientOption{WithNetrc(true)},
+			want: &ClientOptions{enableNetrc: BoolVar(true)},
+		},
+		{
+			name:         "WithNetrc, exclusive",
+			opts:         []ClientOption{WithNetrc(true), WithNetrc(true)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithCABundle",
+			opts: []ClientOption{WithCABundle(ca)},
+			want: buildCommonOption(CommonClientOptions{CABundle: ca}),
+		},
+		{
+			name:         "WithCABundle, empty",
+			opts:         []ClientOption{WithCABundle(nil)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithCABundle, no certificate",
+			opts:         []ClientOption{WithCABundle([]byte("not a certificate"))},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithCABundle, exclusive",
+			opts:         []ClientOption{WithCABundle(ca), WithCustomCAPostChainTransportHook(ca)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithTLSConfig, nil",
+			opts:         []ClientOption{WithTLSConfig(nil)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithInsecureSkipTLSVerify",
+			opts: []ClientOption{WithInsecureSkipTLSVerify(true)},
+			want: buildCommonOption(CommonClientOptions{InsecureSkipTLSVerify: BoolVar(true)}),
+		},
+		{
+			name:         "WithInsecureSkipTLSVerify, exclusive",
+			opts:         []ClientOption{WithInsecureSkipTLSVerify(true), WithInsecureSkipTLSVerify(false)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithAnonymous",
+			opts: []ClientOption{WithAnonymous(true)},
+			want: &ClientOptions{anonymous: BoolVar(true)},
+		},
+		{
+			name:         "WithAnonymous, exclusive",
+			opts:         []ClientOption{WithAnonymous(true), WithAnonymous(true)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithAnonymous, with credentials",
+			opts:         []ClientOption{WithOAuth2Token("foo"), WithAnonymous(true)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithAnonymous, with netrc",
+			opts:         []ClientOption{WithAnonymous(true), WithNetrc(true)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name: "WithScopeCheck",
+			opts: []ClientOption{WithScopeCheck("repo")},
+			want: &ClientOptions{requiredScopes: []string{"repo"}},
+		},
+		{
+			name:         "WithScopeCheck, empty",
+			opts:         []ClientOption{WithScopeCheck()},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
+		{
+			name:         "WithClientCertificate, invalid",
+			opts:         []ClientOption{WithClientCertificate([]byte("cert"), []byte("key"))},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -308,6 +476,7 @@ func Test_makeCientOptions(t *testing.T) {
 				t.Errorf("makeOptions() = %v, want %v", got, tt.want)
 			}
 			got.authTransport = nil
+			got.tokenSource = nil
 			got.PostChainTransportHook = nil
 			got.PreChainTransportHook = nil
 			tt.want.authTransport = nil
@@ -319,3 +488,449 @@ func Test_makeCientOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestWithTokenSource(t *testing.T) {
+	var authHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	calls := 0
+	errExpired := errors.New("lease expired")
+	opts, err := MakeClientOptions(WithTokenSource(func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 3 {
+			return "", errExpired
+		}
+		return "token-" + strconv.Itoa(calls), nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.TokenSource() == nil {
+		t.Error("TokenSource() = nil, want the token source")
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := c.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if want := []string{"Bearer token-1", "Bearer token-2"}; !reflect.DeepEqual(authHeaders, want) {
+		t.Errorf("Authorization headers = %v, want %v", authHeaders, want)
+	}
+	if _, err := c.Get(server.URL); !errors.Is(err, errExpired) {
+		t.Errorf("Get() error = %v, want the error of the token source", err)
+	}
+}
+
+func TestWithTokenSource_Redirect(t *testing.T) {
+	var storageAuth string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageAuth = r.Header.Get("Authorization")
+	}))
+	defer storage.Close()
+	var apiAuth []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiAuth = append(apiAuth, r.Header.Get("Authorization"))
+		if r.URL.Path == "/storage" {
+			http.Redirect(w, r, storage.URL, http.StatusFound)
+			return
+		}
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/", http.StatusFound)
+		}
+	}))
+	defer api.Close()
+
+	opts, err := MakeClientOptions(WithTokenSource(func(ctx context.Context) (string, error) {
+		return "token", nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/moved", "/storage"} {
+		resp, err := c.Get(api.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	// Redirects on the same host are still authenticated
+	if want := []string{"Bearer token", "Bearer token", "Bearer token"}; !reflect.DeepEqual(apiAuth, want) {
+		t.Errorf("Authorization headers = %v, want %v", apiAuth, want)
+	}
+	if storageAuth != "" {
+		t.Errorf("the redirect to another host sent Authorization %q, want none", storageAuth)
+	}
+}
+
+func TestRotatableToken(t *testing.T) {
+	token := NewRotatableToken("old")
+	opts, err := MakeClientOptions(WithTokenSource(token.Token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"old", "new"} {
+		token.SetToken(want)
+		resp, err := c.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if authHeader != "Bearer "+want {
+			t.Errorf("Authorization header = %q, want the rotated token %q", authHeader, want)
+		}
+	}
+
+	token.SetToken("")
+	if _, err := token.Token(context.Background()); err == nil {
+		t.Error("Token() with an empty token succeeded, want an error")
+	}
+}
+
+// refreshingTokenSource returns a new access token on every call, as if it exchanged a refresh
+// token for it. Tokens expire right away, unless valid is set.
+type refreshingTokenSource struct {
+	calls int
+	valid bool
+}
+
+func (s *refreshingTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	expiry := time.Now()
+	if s.valid {
+		expiry = expiry.Add(time.Hour)
+	}
+	return &oauth2.Token{AccessToken: "access-" + strconv.Itoa(s.calls), Expiry: expiry}, nil
+}
+
+func TestWithOAuth2TokenSource(t *testing.T) {
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		valid       bool
+		earlyExpiry time.Duration
+		wantAPI     string
+		wantGit     string
+		wantCalls   int
+	}{
+		{
+			name:      "expired tokens are refreshed",
+			wantAPI:   "Bearer access-1",
+			wantGit:   "access-2",
+			wantCalls: 2,
+		},
+		{
+			name:      "valid tokens are shared",
+			valid:     true,
+			wantAPI:   "Bearer access-1",
+			wantGit:   "access-1",
+			wantCalls: 1,
+		},
+		{
+			name:        "the early expiry of a caching source is kept",
+			valid:       true,
+			earlyExpiry: 2 * time.Hour,
+			wantAPI:     "Bearer access-1",
+			wantGit:     "access-2",
+			wantCalls:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &refreshingTokenSource{valid: tt.valid}
+			var source oauth2.TokenSource = ts
+			if tt.earlyExpiry > 0 {
+				source = oauth2.ReuseTokenSourceWithExpiry(nil, ts, tt.earlyExpiry)
+			}
+			opts, err := MakeClientOptions(WithOAuth2TokenSource(source))
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := c.Get(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if authHeader != tt.wantAPI {
+				t.Errorf("Authorization header = %q, want %q", authHeader, tt.wantAPI)
+			}
+
+			token, err := opts.TokenSource()(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token != tt.wantGit {
+				t.Errorf("TokenSource() = %q, want %q", token, tt.wantGit)
+			}
+			if ts.calls != tt.wantCalls {
+				t.Errorf("got %d token refreshes, want %d", ts.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// newTestClientCertificate returns a self-signed PEM-encoded client certificate and its key.
+func newTestClientCertificate(t *testing.T) (cert, key []byte) {
+	t.Helper()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestWithClientCertificate(t *testing.T) {
+	var clientCN string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCN = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	cert, key := newTestClientCertificate(t)
+	opts, err := MakeClientOptions(WithClientCertificate(cert, key), WithCustomCAPostChainTransportHook(serverCA))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if clientCN != "client" {
+		t.Errorf("got client certificate %q, want %q", clientCN, "client")
+	}
+
+	// The certificate can't be added to a custom transport
+	opts, err = MakeClientOptions(WithClientCertificate(cert, key), WithPostChainTransportHook(dummyRoundTripper1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BuildClientFromTransportChain(opts.GetTransportChain()); !errors.Is(err, ErrInvalidTransportChainReturn) {
+		t.Errorf("BuildClientFromTransportChain() error = %v, want %v", err, ErrInvalidTransportChainReturn)
+	}
+}
+
+func TestTLSOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := []struct {
+		name    string
+		opts    []ClientOption
+		wantErr bool
+	}{
+		{name: "untrusted", wantErr: true},
+		{name: "WithCABundle", opts: []ClientOption{WithCABundle(serverCA)}},
+		{name: "WithInsecureSkipTLSVerify", opts: []ClientOption{WithInsecureSkipTLSVerify(true)}},
+		{
+			name: "WithTLSConfig",
+			opts: []ClientOption{WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}), WithCABundle(serverCA)},
+		},
+		{
+			name:    "WithTLSConfig, unsupported version",
+			opts:    []ClientOption{WithTLSConfig(&tls.Config{MaxVersion: tls.VersionTLS10}), WithCABundle(serverCA)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := MakeClientOptions(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := c.Get(server.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				resp.Body.Close()
+			}
+		})
+	}
+
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil && (config.InsecureSkipVerify || config.RootCAs != nil) {
+		t.Error("http.DefaultTransport was modified")
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+	}))
+	defer proxy.Close()
+	direct := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer direct.Close()
+
+	get := func(opt ClientOption, target string) {
+		t.Helper()
+		opts, err := MakeClientOptions(opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Get(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	get(WithProxy(proxy.URL), "http://gitlab.example.com/api/v4/user")
+	get(WithProxyFunc(func(req *http.Request) (*url.URL, error) {
+		if req.URL.Host == "gitlab.example.com" {
+			return url.Parse(proxy.URL)
+		}
+		return nil, nil
+	}), direct.URL)
+	if want := []string{"http://gitlab.example.com/api/v4/user"}; !reflect.DeepEqual(proxied, want) {
+		t.Errorf("proxied requests = %v, want %v", proxied, want)
+	}
+
+	for _, invalid := range []ClientOption{WithProxy("ftp://proxy:21"), WithProxy("http://"), WithProxy("%"), WithProxyFunc(nil)} {
+		if _, err := MakeClientOptions(invalid); !errors.Is(err, ErrInvalidClientOptions) {
+			t.Errorf("MakeClientOptions() error = %v, want %v", err, ErrInvalidClientOptions)
+		}
+	}
+}
+
+func TestWithTransportOptions(t *testing.T) {
+	opts, err := MakeClientOptions(
+		WithTransportOptions(TransportOptions{
+			DialTimeout:         5 * time.Second,
+			TLSHandshakeTimeout: 3 * time.Second,
+			MaxIdleConnsPerHost: 50,
+			IdleConnTimeout:     time.Minute,
+		}),
+		WithProxy("http://proxy.example.com:3128"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport, ok := opts.PostChainTransport()(nil).(*http.Transport)
+	if !ok {
+		t.Fatal("PostChainTransport() didn't return an *http.Transport")
+	}
+	if transport == http.DefaultTransport {
+		t.Fatal("http.DefaultTransport was modified")
+	}
+	def := http.DefaultTransport.(*http.Transport)
+	if transport.TLSHandshakeTimeout != 3*time.Second || transport.MaxIdleConnsPerHost != 50 ||
+		transport.IdleConnTimeout != time.Minute || transport.DialContext == nil {
+		t.Errorf("transport options weren't applied: %+v", transport)
+	}
+	if transport.MaxIdleConns != def.MaxIdleConns || transport.DisableKeepAlives {
+		t.Errorf("unset transport options changed the defaults: %+v", transport)
+	}
+	if proxyURL, _ := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "gitlab.com"}}); proxyURL == nil || proxyURL.Host != "proxy.example.com:3128" {
+		t.Errorf("proxy = %v, want proxy.example.com:3128", proxyURL)
+	}
+
+	for _, invalid := range []ClientOption{
+		WithTransportOptions(TransportOptions{DialTimeout: -time.Second}),
+		WithTransportOptions(TransportOptions{MaxIdleConnsPerHost: -1}),
+	} {
+		if _, err := MakeClientOptions(invalid); !errors.Is(err, ErrInvalidClientOptions) {
+			t.Errorf("MakeClientOptions() error = %v, want %v", err, ErrInvalidClientOptions)
+		}
+	}
+	if _, err := MakeClientOptions(WithTransportOptions(TransportOptions{}), WithTransportOptions(TransportOptions{})); !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("MakeClientOptions() error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+}
+
+func TestCheckScopes(t *testing.T) {
+	implied := map[string][]string{"admin": {"write"}, "write": {"read"}}
+	tests := []struct {
+		name     string
+		granted  []string
+		required []string
+		want     []string
+	}{
+		{name: "granted", granted: []string{"read", "other"}, required: []string{"read"}},
+		{name: "implied transitively", granted: []string{"admin"}, required: []string{"write", "read"}},
+		{name: "missing", granted: []string{"write"}, required: []string{"admin", "read", "other"}, want: []string{"admin", "other"}},
+		{name: "no scopes", required: []string{"read"}, want: []string{"read"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckScopes(tt.granted, tt.required, implied)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("CheckScopes() error = %v", err)
+				}
+				return
+			}
+			var scopesErr *MissingScopesError
+			if !errors.As(err, &scopesErr) || !errors.Is(err, ErrMissingScopes) {
+				t.Fatalf("CheckScopes() error = %v, want a *MissingScopesError", err)
+			}
+			if !reflect.DeepEqual(scopesErr.Missing, tt.want) {
+				t.Errorf("CheckScopes() missing = %v, want %v", scopesErr.Missing, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CommentMarker returns a Markdown comment identifying a comment maintained by a bot, e.g.
+// "<!-- gitprovider:drift-report -->". It is invisible in the rendered comment.
+func CommentMarker(id string) string {
+	return fmt.Sprintf("<!-- gitprovider:%s -->", id)
+}
+
+// UpsertComment makes sure the issue has a single comment with the given marker (see
+// CommentMarker) and body, so bots can maintain a status comment instead of adding a new
+// comment on every run. The marker is appended to body if body doesn't contain it yet.
+//
+// If there is no comment with the marker yet, it is created (actionTaken == true).
+// If the comment has a different body, it is updated (actionTaken == true).
+// If the comment already has the body, this is a no-op (actionTaken == false).
+func UpsertComment(ctx context.Context, c CommentClient, marker, body string) (resp Comment, actionTaken bool, err error) {
+	if len(marker) == 0 {
+		return nil, false, fmt.Errorf("comment marker is required: %w", ErrInvalidArgument)
+	}
+	if !strings.Contains(body, marker) {
+		body = fmt.Sprintf("%s\n\n%s", body, marker)
+	}
+	desired := CommentInfo{Body: body}
+
+	comments, err := c.List(WithoutListOptions(ctx))
+	if err != nil {
+		return nil, false, err
+	}
+	for _, comment := range comments {
+		actual := comment.Get()
+		if !strings.Contains(actual.Body, marker) {
+			continue
+		}
+		if desired.Equals(actual) {
+			return comment, false, nil
+		}
+		actual.Body = body
+		if err := comment.Set(actual); err != nil {
+			return comment, false, err
+		}
+		return comment, true, comment.Update(ctx)
+	}
+
+	resp, err = c.Create(ctx, desired)
+	return resp, true, err
+}
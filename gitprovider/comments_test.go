@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeComment struct {
+	info    CommentInfo
+	updates int
+}
+
+func (c *fakeComment) APIObject() interface{}         { return &c.info }
+func (c *fakeComment) Get() CommentInfo               { return c.info }
+func (c *fakeComment) Set(info CommentInfo) error     { c.info = info; return nil }
+func (c *fakeComment) Update(_ context.Context) error { c.updates++; return nil }
+func (c *fakeComment) Delete(_ context.Context) error { return nil }
+
+type fakeCommentClient struct {
+	comments []*fakeComment
+}
+
+func (c *fakeCommentClient) List(_ context.Context) ([]Comment, error) {
+	comments := make([]Comment, 0, len(c.comments))
+	for _, comment := range c.comments {
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+func (c *fakeCommentClient) ListIter(ctx context.Context) *Iterator[Comment] {
+	return ListIterator(ctx, c.List)
+}
+
+func (c *fakeCommentClient) Create(_ context.Context, req CommentInfo) (Comment, error) {
+	comment := &fakeComment{info: req}
+	c.comments = append(c.comments, comment)
+	return comment, nil
+}
+
+func TestUpsertComment(t *testing.T) {
+	marker := CommentMarker("status")
+	if marker != "<!-- gitprovider:status -->" {
+		t.Fatalf("CommentMarker() = %q", marker)
+	}
+	tests := []struct {
+		name            string
+		existing        []string
+		body            string
+		wantActionTaken bool
+		wantBodies      []string
+	}{
+		{
+			name:            "create",
+			existing:        []string{"LGTM"},
+			body:            "Reconciled",
+			wantActionTaken: true,
+			wantBodies:      []string{"LGTM", "Reconciled\n\n" + marker},
+		},
+		{
+			name:            "update",
+			existing:        []string{"LGTM", "Failed\n\n" + marker},
+			body:            "Reconciled",
+			wantActionTaken: true,
+			wantBodies:      []string{"LGTM", "Reconciled\n\n" + marker},
+		},
+		{
+			name:            "up to date, marker in body",
+			existing:        []string{marker + " Reconciled"},
+			body:            marker + " Reconciled",
+			wantActionTaken: false,
+			wantBodies:      []string{marker + " Reconciled"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &fakeCommentClient{}
+			for _, body := range tt.existing {
+				c.comments = append(c.comments, &fakeComment{info: CommentInfo{Body: body}})
+			}
+			_, actionTaken, err := UpsertComment(context.Background(), c, marker, tt.body)
+			if err != nil {
+				t.Fatalf("UpsertComment() error = %v", err)
+			}
+			if actionTaken != tt.wantActionTaken {
+				t.Errorf("UpsertComment() actionTaken = %v, want %v", actionTaken, tt.wantActionTaken)
+			}
+			if len(c.comments) != len(tt.wantBodies) {
+				t.Fatalf("got %d comments, want %d", len(c.comments), len(tt.wantBodies))
+			}
+			for i, comment := range c.comments {
+				if comment.info.Body != tt.wantBodies[i] {
+					t.Errorf("comment %d body = %q, want %q", i, comment.info.Body, tt.wantBodies[i])
+				}
+			}
+		})
+	}
+
+	if _, _, err := UpsertComment(context.Background(), &fakeCommentClient{}, "", "body"); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("UpsertComment() with empty marker error = %v, want ErrInvalidArgument", err)
+	}
+}
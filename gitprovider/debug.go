@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redacted replaces the secrets of dumped requests and responses.
+const redacted = "REDACTED"
+
+// secretNameParts are the parts of header, query parameter and JSON field names holding secrets.
+//
+//nolint:gochecknoglobals
+var secretNameParts = []string{"token", "secret", "password", "passphrase", "credential", "signature", "private_key", "authorization", "cookie", "api-key", "apikey"}
+
+// secretNames are the JSON field names holding secrets which don't contain any secretNameParts:
+// the values of CI/CD variables, and the access tokens of Gitea.
+//
+//nolint:gochecknoglobals
+var secretNames = []string{"value", "sha1"}
+
+// jsonStringField matches the string fields of a JSON document, capturing their name.
+//
+//nolint:gochecknoglobals
+var jsonStringField = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// urlUserinfo matches the userinfo of the URLs in a body, e.g. the credentials of a remote
+// mirror URL, capturing the scheme. Slashes may be escaped in JSON.
+//
+//nolint:gochecknoglobals
+var urlUserinfo = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*:(?:\\?/){2})(?:[^/@\s"\\]|\\.)+@`)
+
+// debugHTTP is the destination of the dumps set through WithDebugHTTP.
+type debugHTTP struct {
+	redactSecrets bool
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WithDebugHTTP dumps every request sent to the Git provider and its response to w, to
+// troubleshoot the behavior of a provider. If redactSecrets is set, the credentials in headers,
+// query parameters and bodies, e.g. tokens, CI/CD variable values and the userinfo of URLs, are
+// replaced with REDACTED. Bodies are dumped whole, so this isn't meant for production use.
+func WithDebugHTTP(w io.Writer, redactSecrets bool) ClientOption {
+	if w == nil {
+		return optionError(fmt.Errorf("the debug writer cannot be nil: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{debugHTTP: &debugHTTP{w: w, redactSecrets: redactSecrets}}
+}
+
+// debugTransport dumps the requests sent through it, and their responses.
+type debugTransport struct {
+	debug *debugHTTP
+	base  http.RoundTripper
+}
+
+// debugChainTransport returns the ChainableRoundTripperFunc of debugTransport.
+func (opts *ClientOptions) debugChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &debugTransport{debug: opts.debugHTTP, base: in}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		// Send the request with the body read for the dump
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- request\n%s %s %s\n", req.Method, t.debug.redactURL(req.URL), req.Proto)
+	t.debug.writeMessage(&buf, req.Header, reqBody)
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		fmt.Fprintf(&buf, "--- error after %s\n%v\n\n", time.Since(start), err)
+		t.debug.write(buf.Bytes())
+		return nil, err
+	}
+	resBody, err := readBody(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	fmt.Fprintf(&buf, "--- response after %s\n%s %s\n", time.Since(start), res.Proto, res.Status)
+	t.debug.writeMessage(&buf, res.Header, resBody)
+	t.debug.write(buf.Bytes())
+	return res, nil
+}
+
+// readBody reads and closes body, which may be nil.
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil || body == http.NoBody {
+		return nil, nil
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// write writes a dump to the writer. Dumps are written whole, so that the dumps of concurrent
+// requests don't interleave.
+func (d *debugHTTP) write(dump []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.w.Write(dump)
+}
+
+// writeMessage writes the headers and body of a request or response to buf.
+func (d *debugHTTP) writeMessage(buf *bytes.Buffer, header http.Header, body []byte) {
+	if d.redactSecrets {
+		header = redactHeader(header)
+		body = redactBody(header.Get("Content-Type"), body)
+	}
+	_ = header.Write(buf)
+	buf.WriteString("\n")
+	if len(body) != 0 {
+		buf.Write(body)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+}
+
+// redactURL returns u as a string, without its secrets if they are redacted.
+func (d *debugHTTP) redactURL(u *url.URL) string {
+	if !d.redactSecrets {
+		return u.String()
+	}
+	redactedURL := *u
+	if u.User != nil {
+		redactedURL.User = url.User(u.User.Username())
+	}
+	redactedURL.RawQuery = redactValues(u.Query()).Encode()
+	return redactedURL.String()
+}
+
+// redactHeader returns a copy of header without its secrets.
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for name := range header {
+		if isSecretName(name) {
+			header[name] = []string{redacted}
+		}
+	}
+	return header
+}
+
+// redactValues returns values without their secrets.
+func redactValues(values url.Values) url.Values {
+	for name := range values {
+		if isSecretName(name) {
+			values[name] = []string{redacted}
+		}
+	}
+	return values
+}
+
+// redactBody returns body without the secrets in its JSON string fields, or form values, and
+// without the credentials of the URLs it contains.
+func redactBody(contentType string, body []byte) []byte {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return []byte(redacted)
+		}
+		for _, vals := range redactValues(values) {
+			for i, val := range vals {
+				vals[i] = urlUserinfo.ReplaceAllString(val, "${1}"+redacted+"@")
+			}
+		}
+		return []byte(values.Encode())
+	}
+	body = jsonStringField.ReplaceAllFunc(body, func(field []byte) []byte {
+		match := jsonStringField.FindSubmatch(field)
+		if !isSecretName(string(match[1])) {
+			return field
+		}
+		return []byte(fmt.Sprintf("%q%s%q", match[1], match[2], redacted))
+	})
+	return urlUserinfo.ReplaceAll(body, []byte("${1}"+redacted+"@"))
+}
+
+// isSecretName returns whether the header, query parameter or JSON field name holds a secret.
+func isSecretName(name string) bool {
+	name = strings.ToLower(name)
+	for _, secretName := range secretNames {
+		if name == secretName {
+			return true
+		}
+	}
+	for _, part := range secretNameParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
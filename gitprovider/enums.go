@@ -165,6 +165,126 @@ func LicenseTemplateVar(t LicenseTemplate) *LicenseTemplate {
 	return &t
 }
 
+// DeployTokenScope is an enum specifying what a deploy token is allowed to access.
+type DeployTokenScope string
+
+const (
+	// DeployTokenScopeReadRepository allows the token to clone (read) the repository.
+	DeployTokenScopeReadRepository = DeployTokenScope("read_repository")
+	// DeployTokenScopeReadRegistry allows the token to pull images from the container registry.
+	DeployTokenScopeReadRegistry = DeployTokenScope("read_registry")
+	// DeployTokenScopeWriteRegistry allows the token to push images to the container registry.
+	DeployTokenScopeWriteRegistry = DeployTokenScope("write_registry")
+	// DeployTokenScopeReadPackageRegistry allows the token to read from the package registry.
+	DeployTokenScopeReadPackageRegistry = DeployTokenScope("read_package_registry")
+	// DeployTokenScopeWritePackageRegistry allows the token to write to the package registry.
+	DeployTokenScopeWritePackageRegistry = DeployTokenScope("write_package_registry")
+)
+
+// knownDeployTokenScopeValues is a map of known DeployTokenScope values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownDeployTokenScopeValues = map[DeployTokenScope]struct{}{
+	DeployTokenScopeReadRepository:       {},
+	DeployTokenScopeReadRegistry:         {},
+	DeployTokenScopeWriteRegistry:        {},
+	DeployTokenScopeReadPackageRegistry:  {},
+	DeployTokenScopeWritePackageRegistry: {},
+}
+
+// ValidateDeployTokenScope validates a given DeployTokenScope.
+// Use as errs.Append(ValidateDeployTokenScope(scope), scope, "FieldName").
+func ValidateDeployTokenScope(s DeployTokenScope) error {
+	_, ok := knownDeployTokenScopeValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// EnvironmentReviewerType is an enum specifying what kind of identity is a required
+// reviewer of a deployment environment.
+type EnvironmentReviewerType string
+
+const (
+	// EnvironmentReviewerTypeUser specifies that the reviewer is a user, identified by its login.
+	EnvironmentReviewerTypeUser = EnvironmentReviewerType("user")
+	// EnvironmentReviewerTypeTeam specifies that the reviewer is a team (or GitLab group),
+	// identified by its slug (or full path).
+	EnvironmentReviewerTypeTeam = EnvironmentReviewerType("team")
+)
+
+// knownEnvironmentReviewerTypeValues is a map of known EnvironmentReviewerType values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownEnvironmentReviewerTypeValues = map[EnvironmentReviewerType]struct{}{
+	EnvironmentReviewerTypeUser: {},
+	EnvironmentReviewerTypeTeam: {},
+}
+
+// ValidateEnvironmentReviewerType validates a given EnvironmentReviewerType.
+// Use as errs.Append(ValidateEnvironmentReviewerType(t), t, "FieldName").
+func ValidateEnvironmentReviewerType(t EnvironmentReviewerType) error {
+	_, ok := knownEnvironmentReviewerTypeValues[t]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// MirrorDirection is an enum specifying in which direction a repository mirror is synced.
+type MirrorDirection string
+
+const (
+	// MirrorDirectionPush specifies that the repository is pushed to the mirror.
+	MirrorDirectionPush = MirrorDirection("push")
+	// MirrorDirectionPull specifies that the repository is pulled from the mirror, i.e. that
+	// the repository itself is a mirror.
+	MirrorDirectionPull = MirrorDirection("pull")
+)
+
+// knownMirrorDirectionValues is a map of known MirrorDirection values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownMirrorDirectionValues = map[MirrorDirection]struct{}{
+	MirrorDirectionPush: {},
+	MirrorDirectionPull: {},
+}
+
+// ValidateMirrorDirection validates a given MirrorDirection.
+// Use as errs.Append(ValidateMirrorDirection(d), d, "FieldName").
+func ValidateMirrorDirection(d MirrorDirection) error {
+	_, ok := knownMirrorDirectionValues[d]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// CISystem is an enum specifying a CI/CD system, as detected by Repository.DetectCI.
+type CISystem string
+
+const (
+	// CISystemGitHubActions is configured through workflows in .github/workflows.
+	CISystemGitHubActions = CISystem("github-actions")
+	// CISystemGiteaActions is configured through workflows in .gitea/workflows or .forgejo/workflows.
+	CISystemGiteaActions = CISystem("gitea-actions")
+	// CISystemGitLabCI is configured through .gitlab-ci.yml.
+	CISystemGitLabCI = CISystem("gitlab-ci")
+	// CISystemJenkins is configured through a Jenkinsfile.
+	CISystemJenkins = CISystem("jenkins")
+	// CISystemCircleCI is configured through .circleci/config.yml.
+	CISystemCircleCI = CISystem("circleci")
+	// CISystemTravisCI is configured through .travis.yml.
+	CISystemTravisCI = CISystem("travis-ci")
+	// CISystemAzurePipelines is configured through azure-pipelines.yml.
+	CISystemAzurePipelines = CISystem("azure-pipelines")
+	// CISystemBitbucketPipelines is configured through bitbucket-pipelines.yml.
+	CISystemBitbucketPipelines = CISystem("bitbucket-pipelines")
+	// CISystemDrone is configured through .drone.yml.
+	CISystemDrone = CISystem("drone")
+)
+
 // TokenPermission is an enum specifying the permissions for a token.
 type TokenPermission int
 
@@ -183,3 +303,417 @@ const (
 	// MergeMethodSquash causes a pull request merge to first squash commits
 	MergeMethodSquash = MergeMethod("squash")
 )
+
+// CommitFileEncoding is an enum specifying how the Content of a CommitFile is encoded.
+type CommitFileEncoding string
+
+const (
+	// CommitFileEncodingText ("text") means that Content is the file content itself. Only
+	// use it for UTF-8 text, as other data may be corrupted.
+	CommitFileEncodingText = CommitFileEncoding("text")
+	// CommitFileEncodingBase64 ("base64") means that Content is the standard base64 encoding
+	// of the file content. Use it for binary files such as images or tarballs.
+	CommitFileEncodingBase64 = CommitFileEncoding("base64")
+)
+
+// knownCommitFileEncodingValues is a map of known CommitFileEncoding values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownCommitFileEncodingValues = map[CommitFileEncoding]struct{}{
+	CommitFileEncodingText:   {},
+	CommitFileEncodingBase64: {},
+}
+
+// ValidateCommitFileEncoding validates a given CommitFileEncoding.
+// Use as errs.Append(ValidateCommitFileEncoding(encoding), encoding, "FieldName").
+func ValidateCommitFileEncoding(e CommitFileEncoding) error {
+	_, ok := knownCommitFileEncodingValues[e]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// CommitFileEncodingVar returns a pointer to a CommitFileEncoding.
+func CommitFileEncodingVar(e CommitFileEncoding) *CommitFileEncoding {
+	return &e
+}
+
+// DeploymentState is an enum specifying the state of a deployment.
+type DeploymentState string
+
+const (
+	// DeploymentStatePending ("pending") means that the deployment was created, but hasn't started.
+	DeploymentStatePending = DeploymentState("pending")
+	// DeploymentStateQueued ("queued") means that the deployment is waiting to be started.
+	DeploymentStateQueued = DeploymentState("queued")
+	// DeploymentStateInProgress ("in_progress") means that the deployment is rolling out.
+	DeploymentStateInProgress = DeploymentState("in_progress")
+	// DeploymentStateSuccess ("success") means that the deployment finished successfully.
+	DeploymentStateSuccess = DeploymentState("success")
+	// DeploymentStateFailure ("failure") means that the deployment failed.
+	DeploymentStateFailure = DeploymentState("failure")
+	// DeploymentStateError ("error") means that the deployment couldn't be carried out.
+	DeploymentStateError = DeploymentState("error")
+	// DeploymentStateInactive ("inactive") means that the deployment was superseded or canceled.
+	DeploymentStateInactive = DeploymentState("inactive")
+)
+
+// knownDeploymentStateValues is a map of known DeploymentState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownDeploymentStateValues = map[DeploymentState]struct{}{
+	DeploymentStatePending:    {},
+	DeploymentStateQueued:     {},
+	DeploymentStateInProgress: {},
+	DeploymentStateSuccess:    {},
+	DeploymentStateFailure:    {},
+	DeploymentStateError:      {},
+	DeploymentStateInactive:   {},
+}
+
+// ValidateDeploymentState validates a given DeploymentState.
+// Use as errs.Append(ValidateDeploymentState(state), state, "FieldName").
+func ValidateDeploymentState(s DeploymentState) error {
+	_, ok := knownDeploymentStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// IssueState is an enum specifying whether an issue is open or closed.
+type IssueState string
+
+const (
+	// IssueStateOpen ("open") means that the issue is open.
+	IssueStateOpen = IssueState("open")
+	// IssueStateClosed ("closed") means that the issue is closed.
+	IssueStateClosed = IssueState("closed")
+)
+
+// knownIssueStateValues is a map of known IssueState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownIssueStateValues = map[IssueState]struct{}{
+	IssueStateOpen:   {},
+	IssueStateClosed: {},
+}
+
+// ValidateIssueState validates a given IssueState.
+// Use as errs.Append(ValidateIssueState(state), state, "FieldName").
+func ValidateIssueState(s IssueState) error {
+	_, ok := knownIssueStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// MilestoneState is an enum specifying whether a milestone is open or closed.
+type MilestoneState string
+
+const (
+	// MilestoneStateOpen ("open") means that the milestone is active.
+	MilestoneStateOpen = MilestoneState("open")
+	// MilestoneStateClosed ("closed") means that the milestone is closed.
+	MilestoneStateClosed = MilestoneState("closed")
+)
+
+// knownMilestoneStateValues is a map of known MilestoneState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownMilestoneStateValues = map[MilestoneState]struct{}{
+	MilestoneStateOpen:   {},
+	MilestoneStateClosed: {},
+}
+
+// ValidateMilestoneState validates a given MilestoneState.
+// Use as errs.Append(ValidateMilestoneState(state), state, "FieldName").
+func ValidateMilestoneState(s MilestoneState) error {
+	_, ok := knownMilestoneStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// SignatureType is an enum specifying the kind of signature of a commit or tag.
+type SignatureType string
+
+const (
+	// SignatureTypeGPG is an OpenPGP signature.
+	SignatureTypeGPG = SignatureType("gpg")
+	// SignatureTypeSSH is a signature made with an SSH key.
+	SignatureTypeSSH = SignatureType("ssh")
+	// SignatureTypeX509 is an S/MIME signature made with an X.509 certificate.
+	SignatureTypeX509 = SignatureType("x509")
+)
+
+// SignatureTypeVar returns a pointer to a SignatureType.
+func SignatureTypeVar(t SignatureType) *SignatureType {
+	return &t
+}
+
+// PackageType is an enum specifying the format of a package. Types the providers
+// report that aren't listed here are passed through as-is.
+type PackageType string
+
+const (
+	// PackageTypeContainer is a container image (OCI or Docker).
+	PackageTypeContainer = PackageType("container")
+	// PackageTypeNPM is a Node.js package.
+	PackageTypeNPM = PackageType("npm")
+	// PackageTypeMaven is a Maven (Java) package.
+	PackageTypeMaven = PackageType("maven")
+	// PackageTypeNuGet is a NuGet (.NET) package.
+	PackageTypeNuGet = PackageType("nuget")
+	// PackageTypeRubyGems is a Ruby gem.
+	PackageTypeRubyGems = PackageType("rubygems")
+	// PackageTypePyPI is a Python package.
+	PackageTypePyPI = PackageType("pypi")
+	// PackageTypeHelm is a Helm chart.
+	PackageTypeHelm = PackageType("helm")
+	// PackageTypeGeneric is a set of files without a specific format.
+	PackageTypeGeneric = PackageType("generic")
+)
+
+// PipelineStatus is an enum specifying the progress of a pipeline or job.
+type PipelineStatus string
+
+const (
+	// PipelineStatusQueued ("queued") means that the pipeline is waiting to run.
+	PipelineStatusQueued = PipelineStatus("queued")
+	// PipelineStatusInProgress ("in_progress") means that the pipeline is running.
+	PipelineStatusInProgress = PipelineStatus("in_progress")
+	// PipelineStatusCompleted ("completed") means that the pipeline has finished,
+	// and has a conclusion.
+	PipelineStatusCompleted = PipelineStatus("completed")
+)
+
+// PipelineConclusion is an enum specifying the outcome of a completed pipeline or job.
+type PipelineConclusion string
+
+const (
+	// PipelineConclusionSuccess ("success") means that the pipeline succeeded.
+	PipelineConclusionSuccess = PipelineConclusion("success")
+	// PipelineConclusionFailure ("failure") means that the pipeline failed or timed out.
+	PipelineConclusionFailure = PipelineConclusion("failure")
+	// PipelineConclusionCancelled ("cancelled") means that the pipeline was cancelled.
+	PipelineConclusionCancelled = PipelineConclusion("cancelled")
+	// PipelineConclusionSkipped ("skipped") means that the pipeline didn't run.
+	PipelineConclusionSkipped = PipelineConclusion("skipped")
+	// PipelineConclusionActionRequired ("action_required") means that the pipeline is
+	// blocked until someone approves it or starts a manual job.
+	PipelineConclusionActionRequired = PipelineConclusion("action_required")
+)
+
+// PipelineConclusionVar returns a pointer to a PipelineConclusion.
+func PipelineConclusionVar(c PipelineConclusion) *PipelineConclusion {
+	return &c
+}
+
+// WatchLevel is an enum specifying which notifications the authenticated user
+// receives for a repository.
+type WatchLevel string
+
+const (
+	// WatchLevelWatching ("watching") means that the user is notified of all activity.
+	WatchLevelWatching = WatchLevel("watching")
+	// WatchLevelParticipating ("participating") means that the user is only notified of
+	// threads they participate in or are mentioned in. This is the default.
+	WatchLevelParticipating = WatchLevel("participating")
+	// WatchLevelIgnoring ("ignoring") means that the user isn't notified at all.
+	WatchLevelIgnoring = WatchLevel("ignoring")
+)
+
+// knownWatchLevelValues is a map of known WatchLevel values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownWatchLevelValues = map[WatchLevel]struct{}{
+	WatchLevelWatching:      {},
+	WatchLevelParticipating: {},
+	WatchLevelIgnoring:      {},
+}
+
+// ValidateWatchLevel validates a given WatchLevel.
+// Use as errs.Append(ValidateWatchLevel(level), level, "FieldName").
+func ValidateWatchLevel(l WatchLevel) error {
+	_, ok := knownWatchLevelValues[l]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// TeamRole is an enum specifying the role of a user within a team.
+type TeamRole string
+
+const (
+	// TeamRoleMember ("member") is a regular member of the team. This is the default.
+	// This is called "developer" in GitLab.
+	TeamRoleMember = TeamRole("member")
+	// TeamRoleMaintainer ("maintainer") can additionally manage the team and its members.
+	TeamRoleMaintainer = TeamRole("maintainer")
+)
+
+// knownTeamRoleValues is a map of known TeamRole values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownTeamRoleValues = map[TeamRole]struct{}{
+	TeamRoleMember:     {},
+	TeamRoleMaintainer: {},
+}
+
+// ValidateTeamRole validates a given TeamRole.
+// Use as errs.Append(ValidateTeamRole(role), role, "FieldName").
+func ValidateTeamRole(r TeamRole) error {
+	_, ok := knownTeamRoleValues[r]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// TeamRoleVar returns a pointer to a TeamRole.
+func TeamRoleVar(r TeamRole) *TeamRole {
+	return &r
+}
+
+// OrganizationRole is an enum specifying the role of a user within an organization.
+type OrganizationRole string
+
+const (
+	// OrganizationRoleMember ("member") is a regular member of the organization. This is the default.
+	// This is called "developer" in GitLab.
+	OrganizationRoleMember = OrganizationRole("member")
+	// OrganizationRoleOwner ("owner") has full administrative rights to the organization.
+	OrganizationRoleOwner = OrganizationRole("owner")
+	// OrganizationRoleBilling ("billing") can manage the billing settings of the organization.
+	// This is called "billing_manager" in GitHub.
+	OrganizationRoleBilling = OrganizationRole("billing")
+)
+
+// knownOrganizationRoleValues is a map of known OrganizationRole values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownOrganizationRoleValues = map[OrganizationRole]struct{}{
+	OrganizationRoleMember:  {},
+	OrganizationRoleOwner:   {},
+	OrganizationRoleBilling: {},
+}
+
+// ValidateOrganizationRole validates a given OrganizationRole.
+// Use as errs.Append(ValidateOrganizationRole(role), role, "FieldName").
+func ValidateOrganizationRole(r OrganizationRole) error {
+	_, ok := knownOrganizationRoleValues[r]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// OrganizationRoleVar returns a pointer to an OrganizationRole.
+func OrganizationRoleVar(r OrganizationRole) *OrganizationRole {
+	return &r
+}
+
+// VariableVisibility is an enum specifying which repositories of an organization can access
+// an organization-level variable.
+type VariableVisibility string
+
+const (
+	// VariableVisibilityAll ("all") makes the variable available to all repositories of the
+	// organization. This is the default.
+	VariableVisibilityAll = VariableVisibility("all")
+	// VariableVisibilityPrivate ("private") makes the variable available to the private and
+	// internal repositories of the organization.
+	VariableVisibilityPrivate = VariableVisibility("private")
+	// VariableVisibilitySelected ("selected") makes the variable available to the repositories
+	// listed in OrganizationVariableInfo.Repositories only.
+	VariableVisibilitySelected = VariableVisibility("selected")
+)
+
+// knownVariableVisibilityValues is a map of known VariableVisibility values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownVariableVisibilityValues = map[VariableVisibility]struct{}{
+	VariableVisibilityAll:      {},
+	VariableVisibilityPrivate:  {},
+	VariableVisibilitySelected: {},
+}
+
+// ValidateVariableVisibility validates a given VariableVisibility.
+// Use as errs.Append(ValidateVariableVisibility(visibility), visibility, "FieldName").
+func ValidateVariableVisibility(v VariableVisibility) error {
+	_, ok := knownVariableVisibilityValues[v]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// VariableVisibilityVar returns a pointer to a VariableVisibility.
+func VariableVisibilityVar(v VariableVisibility) *VariableVisibility {
+	return &v
+}
+
+// RepositorySortField is an enum specifying the field listed repositories are sorted by.
+type RepositorySortField string
+
+const (
+	// RepositorySortFieldName ("name") sorts repositories by name.
+	RepositorySortFieldName = RepositorySortField("name")
+	// RepositorySortFieldCreated ("created") sorts repositories by their time of creation.
+	RepositorySortFieldCreated = RepositorySortField("created")
+	// RepositorySortFieldPushed ("pushed") sorts repositories by their last activity, which is
+	// the last push on GitHub, the last activity on GitLab, and the last update on Gitea.
+	RepositorySortFieldPushed = RepositorySortField("pushed")
+)
+
+// knownRepositorySortFieldValues is a map of known RepositorySortField values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownRepositorySortFieldValues = map[RepositorySortField]struct{}{
+	RepositorySortFieldName:    {},
+	RepositorySortFieldCreated: {},
+	RepositorySortFieldPushed:  {},
+}
+
+// ValidateRepositorySortField validates a given RepositorySortField.
+// Use as errs.Append(ValidateRepositorySortField(field), field, "FieldName").
+func ValidateRepositorySortField(f RepositorySortField) error {
+	_, ok := knownRepositorySortFieldValues[f]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// RepositorySortFieldVar returns a pointer to a RepositorySortField.
+func RepositorySortFieldVar(f RepositorySortField) *RepositorySortField {
+	return &f
+}
+
+// Capability is an enum specifying a group of operations which the provider supports, but which
+// the credentials of a Client may not allow, see Client.CheckCapability.
+type Capability string
+
+const (
+	// CapabilityGPGKeys ("gpg-keys") covers Client.GPGKeys.
+	CapabilityGPGKeys = Capability("gpg-keys")
+	// CapabilitySSHKeys ("ssh-keys") covers Client.SSHKeys.
+	CapabilitySSHKeys = Capability("ssh-keys")
+	// CapabilityRunners ("runners") covers Client.Runners.
+	CapabilityRunners = Capability("runners")
+	// CapabilityInstanceUsers ("instance-users") covers Client.Instance().Users().
+	CapabilityInstanceUsers = Capability("instance-users")
+	// CapabilityCurrentUser ("current-user") covers Client.CurrentUser.
+	CapabilityCurrentUser = Capability("current-user")
+	// CapabilityAuditLog ("audit-log") covers Organization.AuditLog.
+	CapabilityAuditLog = Capability("audit-log")
+	// CapabilitySCIM ("scim") covers Organization.SCIM.
+	CapabilitySCIM = Capability("scim")
+	// CapabilityPackages ("packages") covers Repository.Packages.
+	CapabilityPackages = Capability("packages")
+)
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -71,8 +72,44 @@ var (
 	ErrMissingHeader = errors.New("header is missing")
 	// ErrGroupNotFound is returned when the gitlab group does not exist
 	ErrGroupNotFound = errors.New("404 Group Not Found")
+	// ErrInvalidPath is returned if a file path is rejected before being sent to the provider.
+	// The returned error is an *InvalidPathError, containing the path and the reason.
+	ErrInvalidPath = errors.New("invalid file path")
+	// ErrTransferPending is returned by Transfer() if the repository transfer has been requested, but
+	// has to be accepted by the new owner before it's carried out.
+	ErrTransferPending = errors.New("the repository transfer has to be accepted by the new owner")
+	// ErrRepositoryMoved is returned by Get() if the requested repository has been renamed or
+	// transferred, and following redirects has been disabled. The returned error is a
+	// *RepositoryMovedError.
+	ErrRepositoryMoved = errors.New("the repository has been renamed or transferred")
+	// ErrMissingScopes is returned by NewClient if WithScopeCheck is used, and the token lacks
+	// some of the required scopes. The returned error is a *MissingScopesError.
+	ErrMissingScopes = errors.New("the token is missing required scopes")
+	// ErrProviderUnavailable is returned for requests failed fast by WithCircuitBreaker, as the
+	// provider failed repeatedly.
+	ErrProviderUnavailable = errors.New("the provider is unavailable, the circuit breaker is open")
 )
 
+// CapabilityError is returned for operations which the provider supports, but the credentials
+// of the Client don't allow, e.g. because of the type of the token. It matches
+// ErrNoProviderSupport with errors.Is.
+type CapabilityError struct {
+	// Capability is the unavailable group of operations.
+	Capability Capability `json:"capability"`
+	// Reason explains why the capability is unavailable, and which credentials allow it.
+	Reason string `json:"reason"`
+}
+
+// Error implements the error interface.
+func (e *CapabilityError) Error() string {
+	return fmt.Sprintf("the %s capability is not available: %s", e.Capability, e.Reason)
+}
+
+// Is implements the errors.Is interface.
+func (e *CapabilityError) Is(target error) bool {
+	return target == ErrNoProviderSupport
+}
+
 // HTTPError is an error that contains context about the HTTP request/response that failed.
 type HTTPError struct {
 	// HTTP response that caused this error.
@@ -149,3 +186,91 @@ func NewErrIncorrectUser(user string) *ErrIncorrectUser {
 func (e *ErrIncorrectUser) Error() string {
 	return fmt.Sprintf("incorrect user '%s' provided", e.user)
 }
+
+// CommitTooLargeError is returned by CommitClient.Create before anything is pushed, if
+// the files to commit exceed the size limits of the provider, or those set with
+// WithCommitSizeLimits or CallCommitSizeLimits.
+type CommitTooLargeError struct {
+	// Limits are the size limits the commit was checked against.
+	Limits SizeLimits `json:"limits"`
+	// OversizedFiles lists the files exceeding Limits.MaxFileSize.
+	OversizedFiles []FileSize `json:"oversizedFiles"`
+	// TotalSize is the combined size of all files in the commit, in bytes.
+	TotalSize int64 `json:"totalSize"`
+}
+
+// Error implements the error interface.
+func (e *CommitTooLargeError) Error() string {
+	msgs := make([]string, 0, len(e.OversizedFiles)+1)
+	for _, f := range e.OversizedFiles {
+		msgs = append(msgs, fmt.Sprintf("file %q is %d bytes, maximum is %d", f.Path, f.Size, e.Limits.MaxFileSize))
+	}
+	if e.Limits.MaxPushSize > 0 && e.TotalSize > e.Limits.MaxPushSize {
+		msgs = append(msgs, fmt.Sprintf("commit is %d bytes, maximum is %d", e.TotalSize, e.Limits.MaxPushSize))
+	}
+	return fmt.Sprintf("commit exceeds the provider size limits: %s", strings.Join(msgs, "; "))
+}
+
+// Is makes errors.Is(err, ErrInvalidArgument) return true for a CommitTooLargeError.
+func (e *CommitTooLargeError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// InvalidPathError describes why a file path was rejected by NormalizePath, e.g. because it
+// escapes the repository root. errors.Is(err, ErrInvalidPath) and
+// errors.Is(err, ErrInvalidArgument) both return true for it.
+type InvalidPathError struct {
+	// Path is the rejected path.
+	Path string `json:"path"`
+	// Reason describes why the path was rejected.
+	Reason string `json:"reason"`
+}
+
+// Error implements the error interface.
+func (e *InvalidPathError) Error() string {
+	return fmt.Sprintf("%s %q: %s", ErrInvalidPath, e.Path, e.Reason)
+}
+
+// Is implements the errors.Is interface.
+func (e *InvalidPathError) Is(target error) bool {
+	return target == ErrInvalidPath || target == ErrInvalidArgument
+}
+
+// RepositoryMovedError is returned by Get() if the requested repository has been renamed or
+// transferred, and following redirects has been disabled (see WithFollowRedirects). It contains the
+// canonical location of the repository, so that the move can be recorded.
+type RepositoryMovedError struct {
+	// Ref is the reference that was requested.
+	Ref RepositoryRef `json:"ref"`
+	// CanonicalRef is the current location of the repository.
+	CanonicalRef RepositoryRef `json:"canonicalRef"`
+}
+
+// Error implements the error interface.
+func (e *RepositoryMovedError) Error() string {
+	return fmt.Sprintf("%s: %s is now %s", ErrRepositoryMoved, e.Ref, e.CanonicalRef)
+}
+
+// Is implements the errors.Is interface.
+func (e *RepositoryMovedError) Is(target error) bool {
+	return target == ErrRepositoryMoved
+}
+
+// MissingScopesError is returned by NewClient if WithScopeCheck is used, and the token lacks
+// some of the required scopes.
+type MissingScopesError struct {
+	// Missing are the required scopes which the token doesn't have.
+	Missing []string `json:"missing"`
+	// Granted are the scopes of the token, as reported by the provider.
+	Granted []string `json:"granted"`
+}
+
+// Error implements the error interface.
+func (e *MissingScopesError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrMissingScopes, strings.Join(e.Missing, ", "))
+}
+
+// Is implements the errors.Is interface.
+func (e *MissingScopesError) Is(target error) bool {
+	return target == ErrMissingScopes
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package experimental contains clients for subsystems that aren't part of the stable
+// gitprovider API yet. Everything in this package may change or be removed in any minor
+// release. Once a client is considered stable, it moves to the gitprovider package, and the
+// declarations here are kept as deprecated aliases until the next gitprovider.APIVersion.
+//
+// Experimental clients are accessed through functions of this package which take a stable
+// object, e.g. Releases(repo). These return gitprovider.ErrNoProviderSupport if the provider
+// doesn't implement the client (yet).
+package experimental
+
+import "github.com/fluxcd/go-git-providers/gitprovider"
+
+// Stability is the stability of all APIs in this package.
+const Stability = gitprovider.StabilityExperimental
+
+// API is embedded in all client interfaces of this package. Providers have to implement
+// ExperimentalAPI() for each experimental client, which makes depending on an experimental
+// API visible at compile time. Consumers can type-assert a client against API to find out
+// whether it's experimental.
+type API interface {
+	// ExperimentalAPI marks the implementation as experimental. It does nothing.
+	ExperimentalAPI()
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// FreezePeriodClient operates on the deploy freeze periods of a specific repository. While a
+// freeze period is active, CI jobs can check for it and skip deployments. Only GitLab has
+// deploy freezes; GitHub and Gitea model this through environment protection rules.
+// This client can be accessed through FreezePeriods(repo).
+type FreezePeriodClient interface {
+	API
+
+	// Get returns the freeze period with the given ID, see FreezePeriodInfo.ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int) (FreezePeriod, error)
+
+	// List all freeze periods of the repository.
+	//
+	// List returns all available freeze periods, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]FreezePeriod, error)
+
+	// ListIter returns an iterator over the freeze periods List returns.
+	ListIter(ctx context.Context) *gitprovider.Iterator[FreezePeriod]
+
+	// Create a freeze period with the given specifications.
+	Create(ctx context.Context, req FreezePeriodInfo) (FreezePeriod, error)
+}
+
+// FreezePeriod represents a deploy freeze period of a repository.
+type FreezePeriod interface {
+	// FreezePeriod implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	gitprovider.Object
+	// The freeze period can be updated.
+	gitprovider.Updatable
+	// The freeze period can be deleted.
+	gitprovider.Deletable
+	// RepositoryBound returns repository reference details.
+	gitprovider.RepositoryBound
+
+	// Get returns high-level information about this freeze period.
+	Get() FreezePeriodInfo
+	// Set sets high-level desired state for this freeze period. In order to apply these changes
+	// in the Git provider, run .Update().
+	Set(FreezePeriodInfo) error
+}
+
+// FreezePeriodInfo implements InfoRequest.
+var _ gitprovider.InfoRequest = FreezePeriodInfo{}
+
+// FreezePeriodInfo contains high-level information about a deploy freeze period, which
+// recurs from each FreezeStart to the following FreezeEnd.
+type FreezePeriodInfo struct {
+	// ID is the provider-assigned identifier of the freeze period.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int `json:"id,omitempty"`
+
+	// FreezeStart is the cron expression for the start of the freeze period, e.g. "0 23 * * 5".
+	// +required
+	FreezeStart string `json:"freezeStart"`
+
+	// FreezeEnd is the cron expression for the end of the freeze period, e.g. "0 7 * * 1".
+	// +required
+	FreezeEnd string `json:"freezeEnd"`
+
+	// CronTimezone is the IANA time zone of the cron expressions, e.g. "Europe/Berlin".
+	// Default: "UTC".
+	// +optional
+	CronTimezone string `json:"cronTimezone,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (f FreezePeriodInfo) ValidateInfo() error {
+	validator := validation.New("FreezePeriod")
+	if len(f.FreezeStart) == 0 {
+		validator.Required("FreezeStart")
+	} else if len(strings.Fields(f.FreezeStart)) != 5 {
+		validator.Invalid(f.FreezeStart, "FreezeStart")
+	}
+	if len(f.FreezeEnd) == 0 {
+		validator.Required("FreezeEnd")
+	} else if len(strings.Fields(f.FreezeEnd)) != 5 {
+		validator.Invalid(f.FreezeEnd, "FreezeEnd")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID is ignored, and an empty CronTimezone equals "UTC".
+func (f FreezePeriodInfo) Equals(actual gitprovider.InfoRequest) bool {
+	a, ok := actual.(FreezePeriodInfo)
+	if !ok {
+		return false
+	}
+	f.ID, a.ID = 0, 0
+	if f.CronTimezone == "" {
+		f.CronTimezone = "UTC"
+	}
+	if a.CronTimezone == "" {
+		a.CronTimezone = "UTC"
+	}
+	return reflect.DeepEqual(f, a)
+}
+
+// FreezePeriods returns the FreezePeriodClient of the repository.
+//
+// ErrNoProviderSupport is returned if the provider doesn't support deploy freezes.
+func FreezePeriods(repo gitprovider.UserRepository) (FreezePeriodClient, error) {
+	r, ok := repo.(interface {
+		FreezePeriods() (FreezePeriodClient, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("freeze periods: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return r.FreezePeriods()
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func TestFreezePeriods(t *testing.T) {
+	if _, err := FreezePeriods(fakeRepository{}); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("FreezePeriods() error = %v, want ErrNoProviderSupport", err)
+	}
+}
+
+func TestFreezePeriod_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		period       FreezePeriodInfo
+		expectedErrs []error
+	}{
+		{
+			name:   "valid",
+			period: FreezePeriodInfo{FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "Europe/Berlin"},
+		},
+		{
+			name:         "invalid, missing fields",
+			period:       FreezePeriodInfo{CronTimezone: "UTC"},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name:         "invalid, cron expression",
+			period:       FreezePeriodInfo{FreezeStart: "@weekly", FreezeEnd: "0 7 * * 1"},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.period.ValidateInfo()
+			if (err != nil) != (len(tt.expectedErrs) != 0) {
+				t.Errorf("FreezePeriod.ValidateInfo() error = %v, want %v", err, tt.expectedErrs)
+			}
+			validation.TestExpectErrors(t, "FreezePeriod.ValidateInfo", err, tt.expectedErrs...)
+		})
+	}
+}
+
+func TestFreezePeriodInfo_Equals(t *testing.T) {
+	desired := FreezePeriodInfo{FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1"}
+	actual := FreezePeriodInfo{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "UTC"}
+	if !desired.Equals(actual) {
+		t.Errorf("Equals() = false, want true")
+	}
+	desired.CronTimezone = "Europe/Berlin"
+	if desired.Equals(actual) {
+		t.Errorf("Equals() = true, want false")
+	}
+}
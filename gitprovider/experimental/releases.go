@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// ReleaseClient operates on the releases of a specific repository.
+// This client can be accessed through Releases(repo).
+type ReleaseClient interface {
+	API
+
+	// Get returns the release for the given tag.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, tag string) (Release, error)
+
+	// List all releases of the repository, newest first.
+	//
+	// List returns all available releases, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Release, error)
+
+	// ListIter returns an iterator over the releases List returns.
+	ListIter(ctx context.Context) *gitprovider.Iterator[Release]
+
+	// Create a release with the given specifications. If the tag doesn't exist yet, it's
+	// created from ReleaseInfo.Target.
+	//
+	// ErrAlreadyExists will be returned if a release for the tag already exists.
+	Create(ctx context.Context, req ReleaseInfo) (Release, error)
+}
+
+// Release represents a release of a repository.
+type Release interface {
+	// Release implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	gitprovider.Object
+	// The release can be deleted. The tag is kept.
+	gitprovider.Deletable
+	// RepositoryBound returns repository reference details.
+	gitprovider.RepositoryBound
+
+	// Get returns high-level information about this release.
+	Get() ReleaseInfo
+}
+
+// ReleaseInfo implements InfoRequest.
+var _ gitprovider.InfoRequest = ReleaseInfo{}
+
+// ReleaseInfo contains high-level information about a release.
+type ReleaseInfo struct {
+	// TagName is the name of the tag the release is for.
+	// +required
+	TagName string `json:"tagName"`
+
+	// Target is the branch or commit SHA the tag is created from, if it doesn't exist yet.
+	// Default: the default branch of the repository.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// Name is the title of the release.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Description contains the release notes, in Markdown.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Draft specifies whether the release is unpublished. Not supported on GitLab.
+	// +optional
+	Draft bool `json:"draft,omitempty"`
+
+	// Prerelease specifies whether the release is marked as not production-ready.
+	// Not supported on GitLab.
+	// +optional
+	Prerelease bool `json:"prerelease,omitempty"`
+
+	// WebURL is the URL of the release in the web UI.
+	// This field is read-only and set by the server.
+	// +optional
+	WebURL string `json:"webURL,omitempty"`
+}
+
+// ValidateInfo validates the object at Create() time.
+func (r ReleaseInfo) ValidateInfo() error {
+	validator := validation.New("Release")
+	if len(r.TagName) == 0 {
+		validator.Required("TagName")
+	}
+	if r.Target != nil && len(*r.Target) == 0 {
+		validator.Invalid(*r.Target, "Target")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only WebURL, and Target if unset, are ignored.
+func (r ReleaseInfo) Equals(actual gitprovider.InfoRequest) bool {
+	a, ok := actual.(ReleaseInfo)
+	if !ok {
+		return false
+	}
+	r.WebURL, a.WebURL = "", ""
+	if r.Target == nil {
+		a.Target = nil
+	}
+	return reflect.DeepEqual(r, a)
+}
+
+// Releases returns the ReleaseClient of the repository.
+//
+// ErrNoProviderSupport is returned if the provider doesn't support releases.
+func Releases(repo gitprovider.UserRepository) (ReleaseClient, error) {
+	r, ok := repo.(interface {
+		Releases() (ReleaseClient, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("releases: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return r.Releases()
+}
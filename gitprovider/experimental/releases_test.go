@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+type fakeRepository struct {
+	gitprovider.UserRepository
+}
+
+func TestReleases(t *testing.T) {
+	if _, err := Releases(fakeRepository{}); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Releases() error = %v, want ErrNoProviderSupport", err)
+	}
+}
+
+func TestRelease_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		release      ReleaseInfo
+		expectedErrs []error
+	}{
+		{
+			name:    "valid",
+			release: ReleaseInfo{TagName: "v1.0.0", Target: gitprovider.StringVar("main")},
+		},
+		{
+			name:         "invalid, missing tag",
+			release:      ReleaseInfo{Name: "v1.0.0"},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name:         "invalid, empty target",
+			release:      ReleaseInfo{TagName: "v1.0.0", Target: gitprovider.StringVar("")},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.release.ValidateInfo()
+			if (err != nil) != (len(tt.expectedErrs) != 0) {
+				t.Errorf("Release.ValidateInfo() error = %v, want %v", err, tt.expectedErrs)
+			}
+			validation.TestExpectErrors(t, "Release.ValidateInfo", err, tt.expectedErrs...)
+		})
+	}
+}
+
+func TestReleaseInfo_Equals(t *testing.T) {
+	desired := ReleaseInfo{TagName: "v1.0.0", Name: "First release"}
+	actual := ReleaseInfo{TagName: "v1.0.0", Name: "First release", Target: gitprovider.StringVar("main"), WebURL: "https://example.com"}
+	if !desired.Equals(actual) {
+		t.Errorf("Equals() = false, want true")
+	}
+	desired.Prerelease = true
+	if desired.Equals(actual) {
+		t.Errorf("Equals() = true, want false")
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// SnippetClient operates on the snippets of a specific repository, which are single files
+// versioned separately from the repository, e.g. shared scripts. Only GitLab has repository
+// snippets; GitHub gists and Gitea don't belong to repositories.
+// This client can be accessed through Snippets(repo).
+type SnippetClient interface {
+	API
+
+	// Get returns the snippet with the given ID, see SnippetInfo.ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int) (Snippet, error)
+
+	// List all snippets of the repository.
+	//
+	// List returns all available snippets, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Snippet, error)
+
+	// ListIter returns an iterator over the snippets List returns.
+	ListIter(ctx context.Context) *gitprovider.Iterator[Snippet]
+
+	// Create a snippet with the given specifications. Content is required.
+	Create(ctx context.Context, req SnippetInfo) (Snippet, error)
+}
+
+// Snippet represents a snippet of a repository.
+type Snippet interface {
+	// Snippet implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	gitprovider.Object
+	// The snippet can be updated.
+	gitprovider.Updatable
+	// The snippet can be deleted.
+	gitprovider.Deletable
+	// RepositoryBound returns repository reference details.
+	gitprovider.RepositoryBound
+
+	// Get returns high-level information about this snippet. Content isn't included, use
+	// Content to download it.
+	Get() SnippetInfo
+	// Set sets high-level desired state for this snippet. In order to apply these changes in
+	// the Git provider, run .Update(). The content is only changed if Content is set.
+	Set(SnippetInfo) error
+
+	// Content downloads the content of the snippet.
+	Content(ctx context.Context) ([]byte, error)
+}
+
+// SnippetInfo implements InfoRequest.
+var _ gitprovider.InfoRequest = SnippetInfo{}
+
+// SnippetInfo contains high-level information about a snippet.
+type SnippetInfo struct {
+	// ID is the provider-assigned identifier of the snippet.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int `json:"id,omitempty"`
+
+	// Title is the title of the snippet.
+	// +required
+	Title string `json:"title"`
+
+	// FileName is the name of the file, which determines the syntax highlighting.
+	// +required
+	FileName string `json:"fileName"`
+
+	// Description describes the snippet.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Visibility is the visibility of the snippet.
+	// Default: the provider default, which is private on GitLab.
+	// +optional
+	Visibility *gitprovider.RepositoryVisibility `json:"visibility,omitempty"`
+
+	// Content is the content of the file. It's required when creating a snippet, and only
+	// changes the content when updating if set.
+	// +optional
+	Content string `json:"content,omitempty"`
+
+	// WebURL is the URL of the snippet in the web UI.
+	// This field is read-only and set by the server.
+	// +optional
+	WebURL string `json:"webURL,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (s SnippetInfo) ValidateInfo() error {
+	validator := validation.New("Snippet")
+	if len(s.Title) == 0 {
+		validator.Required("Title")
+	}
+	if len(s.FileName) == 0 {
+		validator.Required("FileName")
+	}
+	if s.Visibility != nil {
+		validator.Append(gitprovider.ValidateRepositoryVisibility(*s.Visibility), *s.Visibility, "Visibility")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only fields, and Content and Visibility if unset, are
+// ignored.
+func (s SnippetInfo) Equals(actual gitprovider.InfoRequest) bool {
+	a, ok := actual.(SnippetInfo)
+	if !ok {
+		return false
+	}
+	s.ID, a.ID = 0, 0
+	s.WebURL, a.WebURL = "", ""
+	if s.Content == "" {
+		a.Content = ""
+	}
+	if s.Visibility == nil {
+		a.Visibility = nil
+	}
+	return reflect.DeepEqual(s, a)
+}
+
+// Snippets returns the SnippetClient of the repository.
+//
+// ErrNoProviderSupport is returned if the provider doesn't support repository snippets.
+func Snippets(repo gitprovider.UserRepository) (SnippetClient, error) {
+	r, ok := repo.(interface {
+		Snippets() (SnippetClient, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("snippets: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return r.Snippets()
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func TestSnippets(t *testing.T) {
+	if _, err := Snippets(fakeRepository{}); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Snippets() error = %v, want ErrNoProviderSupport", err)
+	}
+}
+
+func TestSnippet_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		snippet      SnippetInfo
+		expectedErrs []error
+	}{
+		{
+			name:    "valid",
+			snippet: SnippetInfo{Title: "Cleanup", FileName: "cleanup.sh", Visibility: gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityInternal)},
+		},
+		{
+			name:         "invalid, missing fields",
+			snippet:      SnippetInfo{Content: "#!/bin/sh"},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name:         "invalid, unknown visibility",
+			snippet:      SnippetInfo{Title: "Cleanup", FileName: "cleanup.sh", Visibility: gitprovider.RepositoryVisibilityVar("secret")},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.snippet.ValidateInfo()
+			if (err != nil) != (len(tt.expectedErrs) != 0) {
+				t.Errorf("Snippet.ValidateInfo() error = %v, want %v", err, tt.expectedErrs)
+			}
+			validation.TestExpectErrors(t, "Snippet.ValidateInfo", err, tt.expectedErrs...)
+		})
+	}
+}
+
+func TestSnippetInfo_Equals(t *testing.T) {
+	desired := SnippetInfo{Title: "Cleanup", FileName: "cleanup.sh"}
+	actual := SnippetInfo{ID: 1, Title: "Cleanup", FileName: "cleanup.sh", Visibility: gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate), WebURL: "https://example.com"}
+	if !desired.Equals(actual) {
+		t.Errorf("Equals() = false, want true")
+	}
+	desired.Content = "#!/bin/sh"
+	if desired.Equals(actual) {
+		t.Errorf("Equals() = true, want false")
+	}
+}
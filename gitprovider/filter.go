@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// RepositoryFilter narrows down the repositories returned when listing or searching repositories.
+// Fields that are unset don't filter. Providers apply as much of the filter as possible on the
+// server side, and the rest using Matches. Whether archived repositories are returned is
+// controlled by the CallIncludeArchived call option instead.
+type RepositoryFilter struct {
+	// Name only matches repositories whose name contains the given string, ignoring case.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Visibility only matches repositories with the given visibility.
+	// +optional
+	Visibility *RepositoryVisibility `json:"visibility,omitempty"`
+
+	// Topic only matches repositories tagged with the given topic.
+	// +optional
+	Topic string `json:"topic,omitempty"`
+
+	// ActiveSince only matches repositories that have been active at or after the given time.
+	// Activity is the last push on GitHub, the last activity on GitLab, and the last update
+	// on Gitea.
+	// +optional
+	ActiveSince *time.Time `json:"activeSince,omitempty"`
+}
+
+// IsZero returns whether the filter matches all repositories.
+func (f RepositoryFilter) IsZero() bool {
+	return f.Name == "" && f.Visibility == nil && f.Topic == "" && f.ActiveSince == nil
+}
+
+// Matches returns whether the repository with the given name, info and time of last activity
+// matches the filter.
+func (f RepositoryFilter) Matches(name string, info RepositoryInfo, lastActivity time.Time) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(name), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.Visibility != nil && (info.Visibility == nil || *info.Visibility != *f.Visibility) {
+		return false
+	}
+	if f.Topic != "" && !containsString(info.Topics, f.Topic) {
+		return false
+	}
+	if f.ActiveSince != nil && lastActivity.Before(*f.ActiveSince) {
+		return false
+	}
+	return true
+}
+
+// RepositorySort orders the repositories returned when listing the repositories of an
+// organization. Providers sort on the server side where possible.
+type RepositorySort struct {
+	// By is the field to sort by.
+	// Available options: See the RepositorySortField enum.
+	// +required
+	By RepositorySortField `json:"by"`
+
+	// Descending sorts the repositories in descending instead of ascending order.
+	// +optional
+	Descending bool `json:"descending,omitempty"`
+}
+
+// ValidateOptions validates the sort order.
+func (s RepositorySort) ValidateOptions() error {
+	errs := validation.New("RepositorySort")
+	errs.Append(ValidateRepositorySortField(s.By), s.By, "By")
+	return errs.Error()
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRepositoryFilter_Matches(t *testing.T) {
+	lastActivity := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	before := lastActivity.Add(-time.Hour)
+	after := lastActivity.Add(time.Hour)
+	info := RepositoryInfo{
+		Visibility: RepositoryVisibilityVar(RepositoryVisibilityPrivate),
+		Topics:     []string{"flux", "gitops"},
+	}
+	tests := []struct {
+		name   string
+		filter RepositoryFilter
+		want   bool
+	}{
+		{
+			name: "zero filter",
+			want: true,
+		},
+		{
+			name:   "name substring, ignoring case",
+			filter: RepositoryFilter{Name: "Infra"},
+			want:   true,
+		},
+		{
+			name:   "other name",
+			filter: RepositoryFilter{Name: "apps"},
+			want:   false,
+		},
+		{
+			name:   "other visibility",
+			filter: RepositoryFilter{Visibility: RepositoryVisibilityVar(RepositoryVisibilityPublic)},
+			want:   false,
+		},
+		{
+			name:   "topic and visibility",
+			filter: RepositoryFilter{Topic: "gitops", Visibility: RepositoryVisibilityVar(RepositoryVisibilityPrivate)},
+			want:   true,
+		},
+		{
+			name:   "missing topic",
+			filter: RepositoryFilter{Topic: "helm"},
+			want:   false,
+		},
+		{
+			name:   "active since before",
+			filter: RepositoryFilter{ActiveSince: &before},
+			want:   true,
+		},
+		{
+			name:   "inactive since",
+			filter: RepositoryFilter{ActiveSince: &after},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches("fleet-infra", info, lastActivity); got != tt.want {
+				t.Errorf("RepositoryFilter.Matches() = %t, want %t", got, tt.want)
+			}
+			if tt.filter.IsZero() != (tt.name == "zero filter") {
+				t.Errorf("RepositoryFilter.IsZero() = %t", tt.filter.IsZero())
+			}
+		})
+	}
+}
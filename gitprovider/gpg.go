@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+// ParseGPGPublicKey returns the ID of the primary key, in upper-case hexadecimal, and the sorted
+// email addresses of the identities of the given ASCII-armored public key. This allows filling
+// in GPGKeyInfo for providers that only return the armored key.
+func ParseGPGPublicKey(armored string) (string, []string, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armored))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(entities) != 1 {
+		return "", nil, errors.New("expected exactly one public key")
+	}
+	var emails []string
+	for _, identity := range entities[0].Identities {
+		if identity.UserId != nil && identity.UserId.Email != "" {
+			emails = append(emails, identity.UserId.Email)
+		}
+	}
+	sort.Strings(emails)
+	return entities[0].PrimaryKey.KeyIdString(), emails, nil
+}
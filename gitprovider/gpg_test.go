@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+// newArmoredGPGKey generates a public key with an identity for the given email address, and
+// returns it ASCII-armored along with its key ID.
+func newArmoredGPGKey(t *testing.T, email string) (string, string) {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Bot", "", email, &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String(), entity.PrimaryKey.KeyIdString()
+}
+
+func TestParseGPGPublicKey(t *testing.T) {
+	armored, keyID := newArmoredGPGKey(t, "bot@example.com")
+
+	gotKeyID, gotEmails, err := ParseGPGPublicKey(armored)
+	if err != nil {
+		t.Fatalf("ParseGPGPublicKey() error = %v", err)
+	}
+	if gotKeyID != keyID {
+		t.Errorf("ParseGPGPublicKey() keyID = %q, want %q", gotKeyID, keyID)
+	}
+	if want := []string{"bot@example.com"}; !reflect.DeepEqual(gotEmails, want) {
+		t.Errorf("ParseGPGPublicKey() emails = %v, want %v", gotEmails, want)
+	}
+
+	if _, _, err := ParseGPGPublicKey("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIA"); err == nil {
+		t.Error("ParseGPGPublicKey() error = nil for an SSH key, want an error")
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+)
+
+// PageInfo describes a page returned by a PageFunc.
+type PageInfo struct {
+	// NextPage is the number of the page following this one, or 0 if this is the last page.
+	NextPage int
+	// Total is the total number of items across all pages, if the provider reports it.
+	Total *int
+}
+
+// PageFunc fetches the given page (starting from 1) of a paginated list.
+type PageFunc[T any] func(ctx context.Context, page int) ([]T, PageInfo, error)
+
+// Iterator walks a paginated list one item at a time, fetching a page from the
+// provider only when the previous one has been consumed. Use it as:
+//
+//	it := c.OrgRepositories().ListIter(ctx, orgRef)
+//	for it.Next(ctx) {
+//		repo := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	fetch    PageFunc[T]
+	callOpts CallOptions
+
+	items   []T
+	index   int
+	count   int
+	page    int
+	next    int
+	total   *int
+	newPage bool
+	done    bool
+	err     error
+}
+
+// NewIterator returns an Iterator fetching its pages with fetch, starting from the first page
+// of the ListOptions of ctx. fetch is called with the context passed to Next, carrying the call
+// options of ctx, including their deadline. A timeout of the call options starts now, and bounds
+// all pages. The iterator stops once it returned the MaxItems of the ListOptions.
+func NewIterator[T any](ctx context.Context, fetch PageFunc[T]) *Iterator[T] {
+	callOpts := CallOptionsFromContext(ctx).startTimeout()
+	return &Iterator[T]{fetch: fetch, callOpts: callOpts, next: callOpts.ListOptions().FirstPage(), index: -1}
+}
+
+// ListIterator returns an Iterator over the result of a single call to list. It is used for
+// lists the provider can't fetch incrementally, so that they can be iterated all the same.
+// list handles the ListOptions of ctx itself.
+// list is called with the context passed to Next, carrying the call options of ctx.
+func ListIterator[T any](ctx context.Context, list func(ctx context.Context) ([]T, error)) *Iterator[T] {
+	return NewIterator(ctx, func(ctx context.Context, _ int) ([]T, PageInfo, error) {
+		items, err := list(ctx)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		total := len(items)
+		return items, PageInfo{Total: &total}, nil
+	})
+}
+
+// ConcatPages returns a PageFunc fetching all pages of each of fetches in turn, so that a list
+// spread over several paginated endpoints can be iterated as one. The pages are numbered one
+// after the other across the lists, and must be fetched in order. The total of a single list
+// isn't reported, as it isn't the total of all of them.
+func ConcatPages[T any](fetches ...PageFunc[T]) PageFunc[T] {
+	current, offset := 0, 0
+	return func(ctx context.Context, page int) ([]T, PageInfo, error) {
+		items, info, err := fetches[current](ctx, page-offset)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		if info.NextPage != 0 {
+			return items, PageInfo{NextPage: info.NextPage + offset}, nil
+		}
+		if current == len(fetches)-1 {
+			return items, PageInfo{}, nil
+		}
+		// Continue with the first page of the next list
+		current++
+		offset = page
+		return items, PageInfo{NextPage: page + 1}, nil
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if needed. It returns
+// false once all items have been returned, or when an error occurred; see Err.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	it.newPage = false
+	if it.err != nil || it.callOpts.ListOptions().Reached(it.count) {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.items) {
+		if it.done || it.next == 0 {
+			it.done = true
+			it.items = nil
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		fetchCtx, cancel := CallContext(withCallOptions(ctx, it.callOpts))
+		items, info, err := it.fetch(fetchCtx, it.next)
+		cancel()
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = it.next
+		// Guard against providers returning the same page over and over.
+		if info.NextPage <= it.page {
+			info.NextPage = 0
+		}
+		it.next = info.NextPage
+		if info.Total != nil {
+			it.total = info.Total
+		}
+		it.items = items
+		it.index = 0
+		it.newPage = true
+	}
+	it.count++
+	return true
+}
+
+// Value returns the current item. It must only be called after Next returned true.
+func (it *Iterator[T]) Value() T {
+	return it.items[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Page returns the number of the page the current item belongs to, starting from 1.
+func (it *Iterator[T]) Page() int {
+	return it.page
+}
+
+// NewPage reports whether the last call to Next fetched a new page, i.e. the current item is
+// the first one of its page.
+func (it *Iterator[T]) NewPage() bool {
+	return it.newPage
+}
+
+// Total returns the total number of items, and whether the provider reported it. The total
+// may include items the client filters out afterwards, e.g. archived repositories.
+func (it *Iterator[T]) Total() (int, bool) {
+	if it.total == nil {
+		return 0, false
+	}
+	return *it.total, true
+}
+
+// Collect consumes the remaining items of the iterator and returns them.
+func (it *Iterator[T]) Collect(ctx context.Context) ([]T, error) {
+	var items []T
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
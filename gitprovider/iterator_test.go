@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestIterator(t *testing.T) {
+	pages := map[int][]int{1: {1, 2}, 2: {}, 3: {3}}
+	var fetched []int
+	it := NewIterator(context.Background(), func(_ context.Context, page int) ([]int, PageInfo, error) {
+		fetched = append(fetched, page)
+		next := page + 1
+		if next > len(pages) {
+			next = 0
+		}
+		total := 3
+		return pages[page], PageInfo{NextPage: next, Total: &total}, nil
+	})
+	if _, ok := it.Total(); ok {
+		t.Error("Total() reported before the first page was fetched")
+	}
+
+	ctx := context.Background()
+	var values, pageOf []int
+	var newPages []bool
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+		pageOf = append(pageOf, it.Page())
+		newPages = append(newPages, it.NewPage())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(values, want) {
+		t.Errorf("values = %v, want %v", values, want)
+	}
+	if want := []int{1, 1, 3}; !reflect.DeepEqual(pageOf, want) {
+		t.Errorf("pages = %v, want %v", pageOf, want)
+	}
+	if want := []bool{true, false, true}; !reflect.DeepEqual(newPages, want) {
+		t.Errorf("new pages = %v, want %v", newPages, want)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("fetched pages = %v, want %v", fetched, want)
+	}
+	if total, ok := it.Total(); !ok || total != 3 {
+		t.Errorf("Total() = %d, %t, want 3, true", total, ok)
+	}
+	if it.Next(ctx) {
+		t.Error("Next() returned true after the last page")
+	}
+}
+
+func TestIterator_Error(t *testing.T) {
+	wantErr := errors.New("boom")
+	it := NewIterator(context.Background(), func(_ context.Context, page int) ([]string, PageInfo, error) {
+		if page == 2 {
+			return nil, PageInfo{}, wantErr
+		}
+		return []string{"a"}, PageInfo{NextPage: page + 1}, nil
+	})
+	got, err := it.Collect(context.Background())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Collect() error = %v, want %v", err, wantErr)
+	}
+	if !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("Collect() = %v", got)
+	}
+	if _, ok := it.Total(); ok {
+		t.Error("Total() reported although the provider didn't")
+	}
+}
+
+func TestIterator_Cancelled(t *testing.T) {
+	it := NewIterator(context.Background(), func(_ context.Context, page int) ([]int, PageInfo, error) {
+		return []int{page}, PageInfo{NextPage: page + 1}, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	if !it.Next(ctx) {
+		t.Fatalf("Next() = false, err %v", it.Err())
+	}
+	cancel()
+	if it.Next(ctx) {
+		t.Fatal("Next() returned true after the context was cancelled")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Err() = %v, want %v", it.Err(), context.Canceled)
+	}
+}
+
+func TestIterator_CallTimeout(t *testing.T) {
+	ctx := WithCallOption(context.Background(), CallTimeout(time.Hour))
+	var want time.Time
+	var fetchCtx context.Context
+	it := NewIterator(ctx, func(ctx context.Context, page int) ([]int, PageInfo, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok || time.Until(deadline) > time.Hour {
+			t.Errorf("fetch Deadline() = %v, %v, want within an hour", deadline, ok)
+		}
+		// The timeout started with the iterator, and bounds all pages
+		if page == 1 {
+			want = deadline
+		} else if !deadline.Equal(want) {
+			t.Errorf("fetch Deadline() = %v of page %d, want %v", deadline, page, want)
+		}
+		fetchCtx = ctx
+		if page == 1 {
+			return []int{page}, PageInfo{NextPage: 2}, nil
+		}
+		return []int{page}, PageInfo{}, nil
+	})
+	for i := 0; i < 2; i++ {
+		if !it.Next(context.Background()) {
+			t.Fatalf("Next() = false, err %v", it.Err())
+		}
+	}
+	// The deadline is released once the page is fetched
+	if err := fetchCtx.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("fetch Err() = %v after Next, want %v", err, context.Canceled)
+	}
+}
+
+func TestListIterator(t *testing.T) {
+	calls := 0
+	ctx := WithCallOption(context.Background(), CallIncludeArchived(false))
+	it := ListIterator(ctx, func(ctx context.Context) ([]string, error) {
+		calls++
+		if CallOptionsFromContext(ctx).ShouldIncludeArchived() {
+			t.Error("the call options weren't passed to list")
+		}
+		return []string{"a", "b"}, nil
+	})
+	got, err := it.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) || calls != 1 {
+		t.Errorf("Collect() = %v after %d calls", got, calls)
+	}
+	if total, ok := it.Total(); !ok || total != 2 {
+		t.Errorf("Total() = %d, %t, want 2, true", total, ok)
+	}
+}
+
+func TestIterator_ListOptions(t *testing.T) {
+	ctx := WithCallOption(context.Background(), CallListOptions(ListOptions{Page: 2, MaxItems: 3}))
+	var fetched []int
+	it := NewIterator(ctx, func(_ context.Context, page int) ([]int, PageInfo, error) {
+		fetched = append(fetched, page)
+		return []int{page * 10, page*10 + 1}, PageInfo{NextPage: page + 1}, nil
+	})
+	got, err := it.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{20, 21, 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect() = %v, want %v", got, want)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("fetched pages = %v, want %v", fetched, want)
+	}
+}
+
+func TestConcatPages(t *testing.T) {
+	var fetched []string
+	list := func(name string, pages ...[]string) PageFunc[string] {
+		return func(_ context.Context, page int) ([]string, PageInfo, error) {
+			fetched = append(fetched, name+strconv.Itoa(page))
+			var info PageInfo
+			if page < len(pages) {
+				info.NextPage = page + 1
+			}
+			total := 10
+			info.Total = &total
+			return pages[page-1], info, nil
+		}
+	}
+	it := NewIterator(context.Background(), ConcatPages(
+		list("a", []string{"a1", "a2"}, []string{"a3"}),
+		list("b", []string{}),
+		list("c", []string{"c1"}, []string{"c2"}),
+	))
+	got, err := it.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a1", "a2", "a3", "c1", "c2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect() = %v, want %v", got, want)
+	}
+	if want := []string{"a1", "a2", "b1", "c1", "c2"}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("fetched pages = %v, want %v", fetched, want)
+	}
+	if _, ok := it.Total(); ok {
+		t.Error("Total() reported the total of a single list")
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ReconcileLabels makes sure all the given labels exist with the given specifications, which
+// allows standardizing labels across many repositories. If prune is set, all other labels are
+// deleted.
+//
+// actionTaken is true if any label was created, updated or deleted.
+func ReconcileLabels(ctx context.Context, c LabelClient, labels []LabelInfo, prune bool) (actionTaken bool, err error) {
+	desired := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		if _, ok := desired[label.Name]; ok {
+			return false, fmt.Errorf("label %q is specified more than once: %w", label.Name, ErrInvalidArgument)
+		}
+		desired[label.Name] = struct{}{}
+	}
+
+	for _, label := range labels {
+		_, changed, err := c.Reconcile(ctx, label)
+		if err != nil {
+			return actionTaken, fmt.Errorf("failed to reconcile label %q: %w", label.Name, err)
+		}
+		actionTaken = actionTaken || changed
+	}
+	if !prune {
+		return actionTaken, nil
+	}
+
+	actual, err := c.List(WithoutListOptions(ctx))
+	if err != nil {
+		return actionTaken, err
+	}
+	for _, label := range actual {
+		name := label.Get().Name
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if err := label.Delete(ctx); err != nil && !errors.Is(err, ErrNotFound) {
+			return actionTaken, fmt.Errorf("failed to delete label %q: %w", name, err)
+		}
+		actionTaken = true
+	}
+	return actionTaken, nil
+}
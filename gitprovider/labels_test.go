@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type fakeLabel struct {
+	info LabelInfo
+	c    *fakeLabelClient
+}
+
+func (l *fakeLabel) APIObject() interface{}                    { return &l.info }
+func (l *fakeLabel) Get() LabelInfo                            { return l.info }
+func (l *fakeLabel) Set(info LabelInfo) error                  { l.info = info; return nil }
+func (l *fakeLabel) Update(_ context.Context) error            { return nil }
+func (l *fakeLabel) Reconcile(_ context.Context) (bool, error) { return false, nil }
+func (l *fakeLabel) Delete(_ context.Context) error {
+	delete(l.c.labels, l.info.Name)
+	return nil
+}
+
+type fakeLabelClient struct {
+	labels map[string]LabelInfo
+}
+
+func (c *fakeLabelClient) Get(_ context.Context, name string) (Label, error) {
+	info, ok := c.labels[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &fakeLabel{info: info, c: c}, nil
+}
+
+func (c *fakeLabelClient) List(ctx context.Context) ([]Label, error) {
+	labels := []Label{}
+	for _, info := range c.labels {
+		labels = append(labels, &fakeLabel{info: info, c: c})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Get().Name < labels[j].Get().Name })
+	return LimitItems(ctx, labels), nil
+}
+
+func (c *fakeLabelClient) ListIter(ctx context.Context) *Iterator[Label] {
+	return ListIterator(ctx, c.List)
+}
+
+func (c *fakeLabelClient) Create(_ context.Context, req LabelInfo) (Label, error) {
+	c.labels[req.Name] = req
+	return &fakeLabel{info: req, c: c}, nil
+}
+
+func (c *fakeLabelClient) Reconcile(ctx context.Context, req LabelInfo) (Label, bool, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	if actual, ok := c.labels[req.Name]; ok && req.Equals(actual) {
+		return &fakeLabel{info: actual, c: c}, false, nil
+	}
+	resp, err := c.Create(ctx, req)
+	return resp, true, err
+}
+
+func TestReconcileLabels(t *testing.T) {
+	existing := map[string]LabelInfo{
+		"bug":      {Name: "bug", Color: StringVar("D73A4A")},
+		"wontfix":  {Name: "wontfix", Color: StringVar("ffffff")},
+		"question": {Name: "question", Color: StringVar("d876e3")},
+	}
+	desired := []LabelInfo{
+		{Name: "bug", Color: StringVar("d73a4a")},
+		{Name: "question", Color: StringVar("d876e3"), Description: "Further information is requested"},
+		{Name: "drift"},
+	}
+	tests := []struct {
+		name            string
+		labels          []LabelInfo
+		prune           bool
+		listOptions     *ListOptions
+		wantActionTaken bool
+		wantNames       []string
+		wantErr         error
+	}{
+		{
+			name:            "no changes",
+			labels:          desired[:1],
+			wantActionTaken: false,
+			wantNames:       []string{"bug", "question", "wontfix"},
+		},
+		{
+			name:            "create and update",
+			labels:          desired,
+			wantActionTaken: true,
+			wantNames:       []string{"bug", "drift", "question", "wontfix"},
+		},
+		{
+			name:            "prune",
+			labels:          desired[:1],
+			prune:           true,
+			wantActionTaken: true,
+			wantNames:       []string{"bug"},
+		},
+		{
+			name:            "prune ignores the list options of the call",
+			labels:          desired[:1],
+			prune:           true,
+			listOptions:     &ListOptions{MaxItems: 1},
+			wantActionTaken: true,
+			wantNames:       []string{"bug"},
+		},
+		{
+			name:    "duplicate name",
+			labels:  []LabelInfo{{Name: "bug"}, {Name: "bug"}},
+			wantErr: ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &fakeLabelClient{labels: map[string]LabelInfo{}}
+			for name, info := range existing {
+				c.labels[name] = info
+			}
+			ctx := context.Background()
+			if tt.listOptions != nil {
+				ctx = WithCallOption(ctx, CallListOptions(*tt.listOptions))
+			}
+			actionTaken, err := ReconcileLabels(ctx, c, tt.labels, tt.prune)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ReconcileLabels() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReconcileLabels() error = %v", err)
+			}
+			if actionTaken != tt.wantActionTaken {
+				t.Errorf("ReconcileLabels() actionTaken = %v, want %v", actionTaken, tt.wantActionTaken)
+			}
+			names := []string{}
+			for name := range c.labels {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("labels = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+)
+
+// ListOptions controls the pagination of List calls. It is passed to List methods using the
+// CallListOptions call option. Zero or negative fields are ignored.
+type ListOptions struct {
+	// PerPage is the number of items requested per page, capped to the provider maximum.
+	// Default: the provider default
+	PerPage int
+
+	// Page is the page listing starts from, starting from 1. The pages are PerPage items long,
+	// so the same PerPage should be used to list the following pages.
+	// Default: 1
+	Page int
+
+	// MaxItems is the maximum number of items List returns. List stops requesting pages once
+	// it fetched enough items, so when the provider filters or sorts the items on the client
+	// side, only the fetched items are filtered or sorted.
+	// Default: no limit
+	MaxItems int
+}
+
+// PageSize returns the number of items to request per page, given the default and maximum
+// page sizes of the provider.
+func (opts ListOptions) PageSize(defaultSize, maxSize int) int {
+	if opts.PerPage <= 0 {
+		return defaultSize
+	}
+	return min(opts.PerPage, maxSize)
+}
+
+// FirstPage returns the page listing starts from.
+func (opts ListOptions) FirstPage() int {
+	return max(opts.Page, 1)
+}
+
+// Reached returns whether n listed items reach MaxItems.
+func (opts ListOptions) Reached(n int) bool {
+	return opts.MaxItems > 0 && n >= opts.MaxItems
+}
+
+// LimitItems truncates items to the MaxItems of the ListOptions ctx carries. Providers use this
+// to honor MaxItems when the pages they fetched hold more items.
+func LimitItems[T any](ctx context.Context, items []T) []T {
+	if opts := CallOptionsFromContext(ctx).ListOptions(); opts.Reached(len(items)) {
+		return items[:opts.MaxItems]
+	}
+	return items
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestListOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      ListOptions
+		pageSize  int
+		firstPage int
+		reached   int
+	}{
+		{
+			name:      "unset",
+			pageSize:  30,
+			firstPage: 1,
+		},
+		{
+			name:      "set",
+			opts:      ListOptions{PerPage: 50, Page: 3, MaxItems: 10},
+			pageSize:  50,
+			firstPage: 3,
+			reached:   10,
+		},
+		{
+			name:      "capped",
+			opts:      ListOptions{PerPage: 500},
+			pageSize:  100,
+			firstPage: 1,
+		},
+		{
+			name:      "negative",
+			opts:      ListOptions{PerPage: -1, Page: -1, MaxItems: -1},
+			pageSize:  30,
+			firstPage: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.PageSize(30, 100); got != tt.pageSize {
+				t.Errorf("PageSize() = %d, want %d", got, tt.pageSize)
+			}
+			if got := tt.opts.FirstPage(); got != tt.firstPage {
+				t.Errorf("FirstPage() = %d, want %d", got, tt.firstPage)
+			}
+			if tt.reached > 0 && (tt.opts.Reached(tt.reached-1) || !tt.opts.Reached(tt.reached)) {
+				t.Errorf("Reached() doesn't switch at %d", tt.reached)
+			}
+			if tt.reached == 0 && tt.opts.Reached(1000) {
+				t.Error("Reached() = true without MaxItems")
+			}
+		})
+	}
+}
+
+func TestLimitItems(t *testing.T) {
+	items := []int{1, 2, 3}
+	if got := LimitItems(context.Background(), items); !reflect.DeepEqual(got, items) {
+		t.Errorf("LimitItems() without options = %v", got)
+	}
+	ctx := WithCallOption(context.Background(), CallListOptions(ListOptions{MaxItems: 2}))
+	if got := LimitItems(ctx, items); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("LimitItems() = %v, want [1 2]", got)
+	}
+	ctx = WithCallOption(context.Background(), CallListOptions(ListOptions{MaxItems: 5}))
+	if got := LimitItems(ctx, items); !reflect.DeepEqual(got, items) {
+		t.Errorf("LimitItems() under the limit = %v", got)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// GetLogger returns the logger set with WithLogger, or a logger discarding all messages.
+func (opts *ClientOptions) GetLogger() logr.Logger {
+	if opts.Logger == nil {
+		return logr.Discard()
+	}
+	return *opts.Logger
+}
+
+// warningOutput is where warnings are written to if no Logger is set.
+//
+//nolint:gochecknoglobals
+var warningOutput io.Writer = os.Stderr
+
+// warn logs a warning the user must not miss to the Logger, or to stderr if no Logger is set,
+// as the default logger discards all messages.
+func (opts *ClientOptions) warn(msg string) {
+	if opts.Logger == nil {
+		fmt.Fprintf(warningOutput, "WARNING: %s\n", msg)
+		return
+	}
+	opts.Logger.Info("WARNING: " + msg)
+}
+
+// loggingTransport logs every request sent to the Git provider, with its outcome.
+type loggingTransport struct {
+	log  logr.Logger
+	base http.RoundTripper
+}
+
+// loggingChainTransport returns the ChainableRoundTripperFunc of loggingTransport.
+func (opts *ClientOptions) loggingChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &loggingTransport{log: opts.GetLogger(), base: in}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	log := t.log.V(2)
+	if !log.Enabled() {
+		return t.base.RoundTrip(req)
+	}
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		log.Info("request failed", "method", req.Method, "url", req.URL.Redacted(), "duration", time.Since(start), "error", err)
+		return res, err
+	}
+	log.Info("response", "method", req.Method, "url", req.URL.Redacted(), "status", res.StatusCode, "duration", time.Since(start))
+	return res, nil
+}
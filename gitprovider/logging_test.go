@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+)
+
+func TestWithLogger(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	var lines []string
+	log := funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: 2})
+	opts, err := MakeClientOptions(WithLogger(&log), WithRetryPolicy(RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// Every attempt is logged, along with the retry between them.
+	want := []string{`"msg"="response"`, `"msg"="retrying request"`, `"msg"="response"`}
+	if len(lines) != len(want) {
+		t.Fatalf("logged %d lines, want %d: %q", len(lines), len(want), lines)
+	}
+	for i, line := range lines {
+		if !strings.Contains(line, want[i]) {
+			t.Errorf("line %d = %s, want %s", i, line, want[i])
+		}
+	}
+	if !strings.Contains(lines[0], `"status"=503`) || !strings.Contains(lines[2], `"status"=200`) {
+		t.Errorf("logged responses %q, want status 503 then 200", lines)
+	}
+}
+
+func TestClientOptions_GetLogger(t *testing.T) {
+	opts, err := MakeClientOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log := opts.GetLogger(); log.Enabled() {
+		t.Error("GetLogger() without WithLogger is enabled, want it to discard")
+	}
+}
+
+func TestInsecureSkipTLSVerifyWarning(t *testing.T) {
+	// Without a Logger, the warning is written to stderr, and nothing to the standard logger
+	// of the application
+	var std, stderr bytes.Buffer
+	log.SetOutput(&std)
+	defer log.SetOutput(os.Stderr)
+	defer func(w io.Writer) { warningOutput = w }(warningOutput)
+	warningOutput = &stderr
+	opts, err := MakeClientOptions(WithInsecureSkipTLSVerify(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BuildClientFromTransportChain(opts.GetTransportChain()); err != nil {
+		t.Fatal(err)
+	}
+	if std.Len() != 0 {
+		t.Errorf("the standard logger got %q, want nothing", std.String())
+	}
+	if !strings.Contains(stderr.String(), "WARNING: TLS certificate verification is disabled") {
+		t.Errorf("stderr got %q, want the warning", stderr.String())
+	}
+	stderr.Reset()
+
+	var lines []string
+	logger := funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{})
+	opts, err = MakeClientOptions(WithInsecureSkipTLSVerify(true), WithLogger(&logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BuildClientFromTransportChain(opts.GetTransportChain()); err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || !strings.Contains(lines[0], "TLS certificate verification is disabled") {
+		t.Errorf("got log lines %q, want the warning", lines)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("stderr got %q, want nothing when a Logger is set", stderr.String())
+	}
+}
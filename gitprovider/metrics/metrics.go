@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exposes the requests of Git provider clients as Prometheus metrics. It's a
+// separate Go module, so that only the users of the metrics depend on the Prometheus client.
+//
+// Register a Metrics collector, and add its transport to the clients to observe, e.g.:
+//
+//	m := metrics.New()
+//	prometheus.MustRegister(m)
+//	c, err := github.NewClient(gitprovider.WithPreChainTransportHook(m.Transport("github")))
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// operationKey is the context key of the operation of a request.
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx labeling the requests made with it as operation, instead
+// of the resource they act on. Pass the returned context to a client method, e.g.:
+//
+//	ctx = metrics.WithOperation(ctx, "reconcile-repository")
+//	_, _, err := c.OrgRepositories().Reconcile(ctx, ref, info)
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// Metrics collects the requests made through its transports. It implements
+// prometheus.Collector, and is safe for concurrent use.
+type Metrics struct {
+	requests           *prometheus.CounterVec
+	duration           *prometheus.HistogramVec
+	rateLimitRemaining *prometheus.GaugeVec
+}
+
+// Metrics implements prometheus.Collector.
+var _ prometheus.Collector = &Metrics{}
+
+// New returns a Metrics collector with the following metrics:
+//
+//   - gitprovider_requests_total, the number of requests by provider, operation and response
+//     code, or "error" if no response was received.
+//   - gitprovider_request_duration_seconds, the latency of requests by provider and operation.
+//   - gitprovider_rate_limit_remaining, the number of requests left in the current rate limit
+//     window by provider, as last reported by the provider.
+//
+// The operation of a request is set with WithOperation, and defaults to the HTTP method and the
+// top-level resource of the request, e.g. "GET repos" or "POST projects".
+func New() *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitprovider_requests_total",
+			Help: "Number of requests sent to Git providers, by response code.",
+		}, []string{"provider", "operation", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitprovider_request_duration_seconds",
+			Help:    "Latency of the requests sent to Git providers.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"provider", "operation"}),
+		rateLimitRemaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gitprovider_rate_limit_remaining",
+			Help: "Number of requests left in the current rate limit window of Git providers.",
+		}, []string{"provider"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+	m.rateLimitRemaining.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+	m.rateLimitRemaining.Collect(ch)
+}
+
+// Transport returns a ChainableRoundTripperFunc observing the requests of a client into m,
+// labeled with provider, e.g. "github". Add it with WithPreChainTransportHook to observe calls
+// as the client makes them, or with WithPostChainTransportHook to observe every attempt,
+// including retries. As a PostChainTransportHook it can't be combined with the TLS, proxy and
+// transport options, e.g. WithCABundle or WithProxy, which need the hook to return an
+// *http.Transport: building the client fails with ErrInvalidTransportChainReturn then.
+func (m *Metrics) Transport(provider string) gitprovider.ChainableRoundTripperFunc {
+	return func(in http.RoundTripper) http.RoundTripper {
+		if in == nil {
+			in = http.DefaultTransport
+		}
+		return &transport{metrics: m, provider: provider, base: in}
+	}
+}
+
+// transport observes the requests sent through it.
+type transport struct {
+	metrics  *Metrics
+	provider string
+	base     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation := requestOperation(req)
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	t.metrics.duration.WithLabelValues(t.provider, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		t.metrics.requests.WithLabelValues(t.provider, operation, "error").Inc()
+		return res, err
+	}
+	t.metrics.requests.WithLabelValues(t.provider, operation, strconv.Itoa(res.StatusCode)).Inc()
+	if remaining, ok := rateLimitRemaining(res.Header); ok {
+		t.metrics.rateLimitRemaining.WithLabelValues(t.provider).Set(remaining)
+	}
+	return res, nil
+}
+
+// requestOperation returns the operation set on the context of req with WithOperation, or the
+// method and top-level resource of req. Only the resource is used, as deeper path segments
+// hold owner and repository names, which would make the label unbounded.
+func requestOperation(req *http.Request) string {
+	if operation, ok := req.Context().Value(operationKey{}).(string); ok {
+		return operation
+	}
+	for _, segment := range strings.Split(req.URL.Path, "/") {
+		// Skip the API prefixes, e.g. /api/v4 of GitLab or /rest/api/1.0 of Stash
+		if segment == "" || segment == "api" || segment == "rest" || isVersion(segment) {
+			continue
+		}
+		return req.Method + " " + segment
+	}
+	return req.Method + " /"
+}
+
+// isVersion returns whether segment is an API version, e.g. v3 or 1.0.
+func isVersion(segment string) bool {
+	segment = strings.TrimPrefix(segment, "v")
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		if (r < '0' || r > '9') && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// rateLimitRemaining returns the number of requests left in the rate limit window, from the
+// X-RateLimit-Remaining header of GitHub and Gitea, or the RateLimit-Remaining header of GitLab.
+func rateLimitRemaining(header http.Header) (float64, bool) {
+	for _, name := range []string{"X-RateLimit-Remaining", "RateLimit-Remaining"} {
+		if remaining, err := strconv.ParseFloat(header.Get(name), 64); err == nil {
+			return remaining, true
+		}
+	}
+	return 0, false
+}
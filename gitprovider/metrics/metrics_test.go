@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "42")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer srv.Close()
+
+	m := New()
+	c, err := gitprovider.BuildClientFromTransportChain([]gitprovider.ChainableRoundTripperFunc{m.Transport("gitlab")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	do := func(ctx context.Context, method, path string) {
+		t.Helper()
+		req, _ := http.NewRequestWithContext(ctx, method, srv.URL+path, nil)
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	do(context.Background(), http.MethodGet, "/api/v4/projects/fluxcd%2Fflux2")
+	do(context.Background(), http.MethodGet, "/api/v4/projects/fluxcd%2Fflagger")
+	do(context.Background(), http.MethodPost, "/api/v4/projects")
+	do(WithOperation(context.Background(), "reconcile"), http.MethodGet, "/api/v4/user")
+
+	want := `
+# HELP gitprovider_requests_total Number of requests sent to Git providers, by response code.
+# TYPE gitprovider_requests_total counter
+gitprovider_requests_total{code="200",operation="GET projects",provider="gitlab"} 2
+gitprovider_requests_total{code="200",operation="reconcile",provider="gitlab"} 1
+gitprovider_requests_total{code="201",operation="POST projects",provider="gitlab"} 1
+# HELP gitprovider_rate_limit_remaining Number of requests left in the current rate limit window of Git providers.
+# TYPE gitprovider_rate_limit_remaining gauge
+gitprovider_rate_limit_remaining{provider="gitlab"} 42
+`
+	if err := testutil.CollectAndCompare(m, strings.NewReader(want), "gitprovider_requests_total", "gitprovider_rate_limit_remaining"); err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(m, "gitprovider_request_duration_seconds"); n != 3 {
+		t.Errorf("request duration series = %d, want 3", n)
+	}
+}
+
+func TestMetrics_TLSOptions(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	m := New()
+	opts, err := gitprovider.MakeClientOptions(gitprovider.WithCABundle(caBundle), gitprovider.WithPreChainTransportHook(m.Transport("github")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := gitprovider.BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if n := testutil.CollectAndCount(m, "gitprovider_requests_total"); n != 1 {
+		t.Errorf("request series = %d, want 1", n)
+	}
+
+	// The TLS options can't be applied to the transport as a PostChainTransportHook
+	opts, err = gitprovider.MakeClientOptions(gitprovider.WithCABundle(caBundle), gitprovider.WithPostChainTransportHook(m.Transport("github")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gitprovider.BuildClientFromTransportChain(opts.GetTransportChain()); !errors.Is(err, gitprovider.ErrInvalidTransportChainReturn) {
+		t.Errorf("BuildClientFromTransportChain() error = %v, want %v", err, gitprovider.ErrInvalidTransportChainReturn)
+	}
+}
+
+func Test_requestOperation(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{method: http.MethodGet, path: "/repos/fluxcd/flux2", want: "GET repos"},
+		{method: http.MethodGet, path: "/api/v3/orgs/fluxcd/repos", want: "GET orgs"},
+		{method: http.MethodPut, path: "/rest/api/1.0/projects/PRJ/repos/repo", want: "PUT projects"},
+		{method: http.MethodGet, path: "/api/v1/version", want: "GET version"},
+		{method: http.MethodGet, path: "/", want: "GET /"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if got := requestOperation(req); got != tt.want {
+				t.Errorf("requestOperation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
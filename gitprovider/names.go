@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CanonicalName returns the canonical form of a name, e.g. of a repository, organization, user
+// or team, which can be used as a map key. The name is converted to Unicode normalization form C,
+// so that differently composed characters match, and case folded if caseSensitive is false.
+func CanonicalName(name string, caseSensitive bool) string {
+	name = norm.NFC.String(name)
+	if !caseSensitive {
+		name = cases.Fold().String(name)
+	}
+	return name
+}
+
+// NamesEqual reports whether the names a and b refer to the same object, following the
+// case-sensitivity rules of the provider. See CanonicalName for how names are compared.
+func NamesEqual(a, b string, caseSensitive bool) bool {
+	return CanonicalName(a, caseSensitive) == CanonicalName(b, caseSensitive)
+}
+
+// RepositoryRefsEqual reports whether a and b refer to the same repository. Domains are always
+// compared using DomainsEqual, while identities and repository names are compared using NamesEqual.
+func RepositoryRefsEqual(a, b RepositoryRef, caseSensitive bool) bool {
+	return DomainsEqual(a.GetDomain(), b.GetDomain()) &&
+		NamesEqual(a.GetIdentity(), b.GetIdentity(), caseSensitive) &&
+		NamesEqual(a.GetRepository(), b.GetRepository(), caseSensitive)
+}
+
+// idnaProfile converts internationalized host names to their ASCII (punycode) form. Underscores
+// and other characters not allowed in DNS names are tolerated, as they might be used internally.
+//
+//nolint:gochecknoglobals
+var idnaProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.StrictDomainName(false))
+
+// NormalizeDomain converts the host of an internationalized domain to its ASCII (punycode) form,
+// e.g. "bücher.example" to "xn--bcher-kva.example", so that it can be used in URLs. domain may
+// contain a scheme, a port and a path, e.g. "https://bücher.example:8443/git", those are kept as-is.
+// Punycode labels are validated. ASCII-only domains are returned unchanged.
+func NormalizeDomain(domain string) (string, error) {
+	scheme, host := "", domain
+	if i := strings.Index(host, "://"); i >= 0 {
+		scheme, host = host[:i+3], host[i+3:]
+	}
+	rest := ""
+	if i := strings.Index(host, "/"); i >= 0 {
+		host, rest = host[:i], host[i:]
+	}
+	// IP literals, e.g. "[::1]:8080", are never internationalized
+	if strings.HasPrefix(host, "[") {
+		return domain, nil
+	}
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host, rest = host[:i], host[i:]+rest
+	}
+	if !isIDN(host) {
+		return domain, nil
+	}
+
+	ascii, err := idnaProfile.ToASCII(host)
+	if err != nil {
+		return "", fmt.Errorf("invalid domain %q: %v: %w", domain, err, ErrInvalidArgument)
+	}
+	return scheme + ascii + rest, nil
+}
+
+// DomainsEqual reports whether a and b refer to the same domain, regardless of case, of the scheme,
+// and of whether internationalized host names are in their Unicode or ASCII (punycode) form.
+// Ports and path prefixes, e.g. "tools.corp:8443/gitlab", must match.
+func DomainsEqual(a, b string) bool {
+	a, b = trimDomainScheme(a), trimDomainScheme(b)
+	if normalized, err := NormalizeDomain(a); err == nil {
+		a = normalized
+	}
+	if normalized, err := NormalizeDomain(b); err == nil {
+		b = normalized
+	}
+	return strings.EqualFold(a, b)
+}
+
+// isIDN returns true if host contains non-ASCII characters or punycode-encoded labels.
+func isIDN(host string) bool {
+	if !isASCII(host) {
+		return true
+	}
+	for _, label := range strings.Split(host, ".") {
+		if len(label) >= 4 && strings.EqualFold(label[:4], "xn--") {
+			return true
+		}
+	}
+	return false
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// trimDomainScheme removes the scheme and any trailing slash from domain.
+func trimDomainScheme(domain string) string {
+	if i := strings.Index(domain, "://"); i >= 0 {
+		domain = domain[i+3:]
+	}
+	return strings.TrimSuffix(domain, "/")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import "testing"
+
+func TestNamesEqual(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          string
+		caseSensitive bool
+		want          bool
+	}{
+		{name: "identical", a: "repo", b: "repo", caseSensitive: true, want: true},
+		{name: "different case, insensitive", a: "Repo", b: "repo", caseSensitive: false, want: true},
+		{name: "different case, sensitive", a: "Repo", b: "repo", caseSensitive: true, want: false},
+		{name: "NFC and NFD", a: "caf\u00e9", b: "cafe\u0301", caseSensitive: true, want: true},
+		{name: "full case folding", a: "Straße", b: "STRASSE", caseSensitive: false, want: true},
+		{name: "different names", a: "repo", b: "repo2", caseSensitive: false, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NamesEqual(tt.a, tt.b, tt.caseSensitive); got != tt.want {
+				t.Errorf("NamesEqual(%q, %q, %t) = %t, want %t", tt.a, tt.b, tt.caseSensitive, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryRefsEqual(t *testing.T) {
+	a := OrgRepositoryRef{
+		OrganizationRef: OrganizationRef{Domain: "GitHub.com", Organization: "FluxCD"},
+		RepositoryName:  "Flux2",
+	}
+	b := OrgRepositoryRef{
+		OrganizationRef: OrganizationRef{Domain: "github.com", Organization: "fluxcd"},
+		RepositoryName:  "flux2",
+	}
+	if !RepositoryRefsEqual(a, b, false) {
+		t.Errorf("expected %s and %s to be equal when case-insensitive", a, b)
+	}
+	if RepositoryRefsEqual(a, b, true) {
+		t.Errorf("expected %s and %s to differ when case-sensitive", a, b)
+	}
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		domain  string
+		want    string
+		wantErr bool
+	}{
+		{name: "ascii", domain: "GitHub.example.com", want: "GitHub.example.com"},
+		{name: "idn", domain: "bücher.example", want: "xn--bcher-kva.example"},
+		{name: "idn with port", domain: "bücher.example:8443", want: "xn--bcher-kva.example:8443"},
+		{name: "idn with scheme and path", domain: "https://Bücher.example/git", want: "https://xn--bcher-kva.example/git"},
+		{name: "punycode", domain: "xn--bcher-kva.example", want: "xn--bcher-kva.example"},
+		{name: "ip literal", domain: "[::1]:3000", want: "[::1]:3000"},
+		{name: "invalid punycode", domain: "xn--a.example", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeDomain(tt.domain)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeDomain(%q) error = %v, wantErr %t", tt.domain, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeDomain(%q) = %q, want %q", tt.domain, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainsEqual(t *testing.T) {
+	if !DomainsEqual("bücher.example", "XN--BCHER-KVA.example") {
+		t.Error("expected the Unicode and punycode forms of a domain to be equal")
+	}
+	if DomainsEqual("bücher.example", "bucher.example") {
+		t.Error("expected different domains not to be equal")
+	}
+	if !DomainsEqual("https://tools.corp/gitlab/", "tools.corp/gitlab") {
+		t.Error("expected domains differing only in scheme to be equal")
+	}
+	if DomainsEqual("tools.corp/gitlab", "tools.corp") {
+		t.Error("expected domains with different path prefixes not to be equal")
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+)
+
+// NegotiateTokenFunc returns a SPNEGO token for a request to host, e.g. the Kerberos ticket for
+// the "HTTP/<host>" service principal wrapped as the initial SPNEGO token. The Kerberos
+// implementation is left to the caller, e.g. github.com/jcmturner/gokrb5, so that this module
+// doesn't depend on one.
+type NegotiateTokenFunc func(ctx context.Context, host string) ([]byte, error)
+
+// WithNegotiateAuth authenticates requests with "Authorization: Negotiate" (RFC 4559) headers
+// holding tokens from the given function, as required by Bitbucket Server instances behind
+// Active Directory. A new token is requested per request, as servers may reject replayed tokens.
+// The stash provider also uses it for Git operations over HTTPS, see NewStashClient.
+func WithNegotiateAuth(negotiate NegotiateTokenFunc) ClientOption {
+	if negotiate == nil {
+		return optionError(fmt.Errorf("negotiate cannot be nil: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{
+		authTransport: func(in http.RoundTripper) http.RoundTripper {
+			if in == nil {
+				in = http.DefaultTransport
+			}
+			return &sameHostAuthTransport{auth: &negotiateTransport{negotiate: negotiate, base: in}, base: in}
+		},
+		negotiate: negotiate,
+	}
+}
+
+// Negotiate returns the NegotiateTokenFunc set through WithNegotiateAuth, or nil.
+func (opts *ClientOptions) Negotiate() NegotiateTokenFunc {
+	return opts.negotiate
+}
+
+// NegotiateHeader returns the value of the Authorization header for a request to host.
+func NegotiateHeader(ctx context.Context, negotiate NegotiateTokenFunc, host string) (string, error) {
+	token, err := negotiate(ctx, host)
+	if err != nil {
+		return "", fmt.Errorf("failed to get SPNEGO token for %q: %w", host, err)
+	}
+	return "Negotiate " + base64.StdEncoding.EncodeToString(token), nil
+}
+
+// negotiateTransport authenticates every request with a token returned by negotiate.
+type negotiateTransport struct {
+	negotiate NegotiateTokenFunc
+	base      http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *negotiateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header, err := NegotiateHeader(req.Context(), t.negotiate, req.URL.Hostname())
+	if err != nil {
+		// A RoundTripper must always close the body
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	// A RoundTripper must not modify the given request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", header)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithNegotiateAuth(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	var hosts []string
+	negotiate := func(_ context.Context, host string) ([]byte, error) {
+		hosts = append(hosts, host)
+		if len(hosts) > 2 {
+			return nil, errors.New("no ticket")
+		}
+		return []byte("token"), nil
+	}
+	opts, err := MakeClientOptions(WithNegotiateAuth(negotiate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.Negotiate() == nil {
+		t.Error("Negotiate() = nil")
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A new token is requested per request
+	for i := 0; i < 2; i++ {
+		resp, err := c.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if want := "Negotiate dG9rZW4="; got != want {
+			t.Errorf("Authorization = %q, want %q", got, want)
+		}
+	}
+	if len(hosts) != 2 || hosts[0] != "127.0.0.1" {
+		t.Errorf("requested tokens for %v, want 2 for 127.0.0.1", hosts)
+	}
+	if _, err := c.Get(srv.URL); err == nil {
+		t.Error("Get() without a token succeeded, want an error")
+	}
+
+	if _, err := MakeClientOptions(WithNegotiateAuth(nil)); !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("WithNegotiateAuth(nil) error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// netrcMachine is an entry of a netrc file. The "default" entry has an empty name.
+type netrcMachine struct {
+	name     string
+	login    string
+	password string
+}
+
+// NetrcCredentials returns the login and password of the given host in the netrc file, which
+// is the file in the NETRC environment variable, or ~/.netrc (~/_netrc on Windows). host may
+// include a port, which is ignored. The entry of the host is used, or the default entry if there
+// is none. ErrNotFound is returned if there is no netrc file, or neither entry.
+func NetrcCredentials(host string) (login, password string, err error) {
+	machines, err := readNetrc()
+	if err != nil {
+		return "", "", err
+	}
+	m := lookupNetrc(machines, host)
+	if m == nil {
+		return "", "", fmt.Errorf("no netrc entry for %q: %w", host, ErrNotFound)
+	}
+	return m.login, m.password, nil
+}
+
+// netrcTransport authenticates requests with the password of the netrc entry of their host
+// as a bearer token. Requests to hosts without an entry aren't authenticated.
+func netrcTransport(in http.RoundTripper) http.RoundTripper {
+	// A missing netrc file is treated as an empty one, as the client might not need
+	// authentication at all
+	machines, _ := readNetrc()
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &netrcRoundTripper{machines: machines, base: in}
+}
+
+type netrcRoundTripper struct {
+	machines []netrcMachine
+	base     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *netrcRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if m := lookupNetrc(t.machines, req.URL.Host); m != nil && m.password != "" {
+		// A RoundTripper must not modify the given request
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+m.password)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// readNetrc reads and parses the netrc file. ErrNotFound is returned if it doesn't exist.
+func readNetrc() ([]netrcMachine, error) {
+	path := os.Getenv("NETRC")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("no netrc file: %w", ErrNotFound)
+		}
+		path = filepath.Join(home, ".netrc")
+		if runtime.GOOS == "windows" {
+			path = filepath.Join(home, "_netrc")
+		}
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("netrc file %q: %w", path, ErrNotFound)
+	} else if err != nil {
+		return nil, err
+	}
+	return parseNetrc(string(data)), nil
+}
+
+// parseNetrc parses the entries of a netrc file. Comments and macro definitions are skipped.
+func parseNetrc(data string) []netrcMachine {
+	var machines []netrcMachine
+	lines := strings.Split(data, "\n")
+	for i := 0; i < len(lines); i++ {
+		fields := strings.Fields(lines[i])
+		for j := 0; j < len(fields); j++ {
+			if strings.HasPrefix(fields[j], "#") {
+				break
+			}
+			// The value of a keyword is the next field
+			value := ""
+			if j+1 < len(fields) {
+				value = fields[j+1]
+			}
+			switch fields[j] {
+			case "machine":
+				machines = append(machines, netrcMachine{name: value})
+				j++
+			case "default":
+				machines = append(machines, netrcMachine{})
+			case "login", "password", "account":
+				if len(machines) > 0 && fields[j] == "login" {
+					machines[len(machines)-1].login = value
+				} else if len(machines) > 0 && fields[j] == "password" {
+					machines[len(machines)-1].password = value
+				}
+				j++
+			case "macdef":
+				// A macro definition spans until the next empty line
+				for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+					i++
+				}
+				j = len(fields)
+			}
+		}
+	}
+	return machines
+}
+
+// lookupNetrc returns the entry of the given host, or the default entry. Like curl and Git, only
+// the exact host matches, so that the credentials of a domain aren't sent to its subdomains.
+// nil is returned if there is neither entry.
+func lookupNetrc(machines []netrcMachine, host string) *netrcMachine {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	var def *netrcMachine
+	for i := range machines {
+		m := &machines[i]
+		switch {
+		case m.name == host:
+			return m
+		case m.name == "" && def == nil:
+			def = m
+		}
+	}
+	return def
+}
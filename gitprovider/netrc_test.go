@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testNetrc = `# credentials
+machine github.com login octocat password gh-token
+machine gitlab.example.com
+	login root
+	password gl-token # inline comment
+macdef init
+machine ignored.com login x password y
+
+machine git.example.com login git password git-token
+default login anonymous password default-token
+`
+
+func Test_parseNetrc(t *testing.T) {
+	want := []netrcMachine{
+		{name: "github.com", login: "octocat", password: "gh-token"},
+		{name: "gitlab.example.com", login: "root", password: "gl-token"},
+		{name: "git.example.com", login: "git", password: "git-token"},
+		{login: "anonymous", password: "default-token"},
+	}
+	if got := parseNetrc(testNetrc); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseNetrc() = %v, want %v", got, want)
+	}
+}
+
+func Test_lookupNetrc(t *testing.T) {
+	machines := parseNetrc(testNetrc)
+	tests := []struct {
+		host     string
+		password string
+	}{
+		{host: "github.com", password: "gh-token"},
+		{host: "api.github.com", password: "default-token"},
+		{host: "gitlab.example.com:8443", password: "gl-token"},
+		{host: "git.example.com", password: "git-token"},
+		{host: "example.com", password: "default-token"},
+		{host: "notgithub.com", password: "default-token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := lookupNetrc(machines, tt.host); got == nil || got.password != tt.password {
+				t.Errorf("lookupNetrc() = %v, want password %q", got, tt.password)
+			}
+		})
+	}
+	if got := lookupNetrc(machines[:1], "example.com"); got != nil {
+		t.Errorf("lookupNetrc() = %v, want nil without default entry", got)
+	}
+}
+
+func writeTestNetrc(t *testing.T, data string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ".netrc")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("NETRC", path)
+}
+
+func TestNetrcCredentials(t *testing.T) {
+	writeTestNetrc(t, "machine github.com login octocat password gh-token\n")
+	login, password, err := NetrcCredentials("github.com:443")
+	if err != nil || login != "octocat" || password != "gh-token" {
+		t.Errorf("NetrcCredentials() = %q, %q, %v", login, password, err)
+	}
+	// The entries of parent domains don't match
+	if _, _, err := NetrcCredentials("api.github.com"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("NetrcCredentials() error = %v, want ErrNotFound", err)
+	}
+	if _, _, err := NetrcCredentials("gitlab.com"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("NetrcCredentials() error = %v, want ErrNotFound", err)
+	}
+
+	t.Setenv("NETRC", filepath.Join(t.TempDir(), "missing"))
+	if _, _, err := NetrcCredentials("github.com"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("NetrcCredentials() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestWithNetrc(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+	writeTestNetrc(t, "machine 127.0.0.1 login git password netrc-token\n")
+
+	tests := []struct {
+		name string
+		opts []ClientOption
+		want string
+	}{
+		{name: "netrc", opts: []ClientOption{WithNetrc(true)}, want: "Bearer netrc-token"},
+		{name: "disabled", opts: []ClientOption{WithNetrc(false)}, want: ""},
+		{
+			name: "explicit token",
+			opts: []ClientOption{WithNetrc(true), WithTokenSource(NewRotatableToken("explicit").Token)},
+			want: "Bearer explicit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := MakeClientOptions(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = ""
+			res, err := c.Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if got != tt.want {
+				t.Errorf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oidc exchanges the OIDC token of a workload, e.g. a projected Kubernetes service
+// account token or the ID token of a CI job, for a short-lived provider access token, so that no
+// long-lived credentials have to be stored in clusters. The exchange follows OAuth 2.0 Token
+// Exchange (RFC 8693), as implemented by token brokers minting GitHub App installation tokens or
+// GitLab access tokens for trusted identities:
+//
+//	cfg := oidc.Config{
+//		TokenURL: "https://sts.example.com/token",
+//		IDToken:  src.Token, // e.g. a secretfile.Source of the projected token
+//		Audience: "github.com/my-org",
+//	}
+//	c, err := github.NewClient(gitprovider.WithOAuth2TokenSource(cfg.TokenSource()))
+//
+// Exchanged tokens are reused until shortly before they expire.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// GrantTypeTokenExchange is the grant type of RFC 8693.
+	GrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	// TokenTypeJWT is the default type of the subject token.
+	TokenTypeJWT = "urn:ietf:params:oauth:token-type:jwt"
+
+	// earlyExpiry is how long before their expiry exchanged tokens are replaced.
+	earlyExpiry = time.Minute
+)
+
+// requestTimeout bounds the requests to the broker and to GitHub Actions, which are made outside
+// of the context of the API calls needing the token.
+var requestTimeout = 30 * time.Second
+
+// Config configures the token exchange.
+type Config struct {
+	// TokenURL is the token exchange endpoint of the broker.
+	TokenURL string
+
+	// IDToken returns the OIDC token of the workload, which is exchanged.
+	IDToken gitprovider.TokenSource
+
+	// SubjectTokenType is the type of the ID token. Default: TokenTypeJWT.
+	SubjectTokenType string
+
+	// Audience is the logical name of the target of the exchanged token, e.g. the organization
+	// or project it's restricted to. Optional.
+	Audience string
+
+	// Scopes requested for the exchanged token. Optional.
+	Scopes []string
+
+	// HTTPClient is used to talk to the broker. Default: a client with a 30 seconds timeout.
+	HTTPClient *http.Client
+}
+
+// TokenSource returns a source of exchanged tokens, which are reused until a minute before they
+// expire. Pass it to gitprovider.WithOAuth2TokenSource, which keeps that early expiry, or convert
+// it with gitprovider.OAuth2TokenSource.
+func (c Config) TokenSource() oauth2.TokenSource {
+	return oauth2.ReuseTokenSourceWithExpiry(nil, &exchangeSource{cfg: c}, earlyExpiry)
+}
+
+// exchangeSource exchanges the ID token for every token requested.
+type exchangeSource struct {
+	cfg Config
+}
+
+// Token implements oauth2.TokenSource.
+func (s *exchangeSource) Token() (*oauth2.Token, error) {
+	// oauth2.TokenSource has no context, bound the exchange so that a hanging broker doesn't
+	// block the API calls waiting for the token forever
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	if s.cfg.TokenURL == "" || s.cfg.IDToken == nil {
+		return nil, errors.New("the token URL and ID token are required")
+	}
+	idToken, err := s.cfg.IDToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ID token: %w", err)
+	}
+
+	subjectTokenType := s.cfg.SubjectTokenType
+	if subjectTokenType == "" {
+		subjectTokenType = TokenTypeJWT
+	}
+	form := url.Values{
+		"grant_type":         {GrantTypeTokenExchange},
+		"subject_token":      {idToken},
+		"subject_token_type": {subjectTokenType},
+	}
+	if s.cfg.Audience != "" {
+		form.Set("audience", s.cfg.Audience)
+	}
+	if len(s.cfg.Scopes) != 0 {
+		form.Set("scope", strings.Join(s.cfg.Scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var res struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := doJSON(s.cfg.HTTPClient, req, &res)
+	if err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	if res.Error != "" {
+		return nil, fmt.Errorf("token exchange failed: %s: %s", res.Error, res.ErrorDescription)
+	}
+	if status != http.StatusOK || res.AccessToken == "" {
+		return nil, fmt.Errorf("token exchange failed: the broker returned status %d without an access token", status)
+	}
+
+	token := &oauth2.Token{AccessToken: res.AccessToken, TokenType: res.TokenType}
+	if res.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// GitHubActionsIDToken returns the OIDC token of the running GitHub Actions job, for the given
+// audience. The job needs the "id-token: write" permission.
+func GitHubActionsIDToken(audience string) gitprovider.TokenSource {
+	return func(ctx context.Context) (string, error) {
+		requestURL, requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"), os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+		if requestURL == "" || requestToken == "" {
+			return "", errors.New("no GitHub Actions ID token available, is the id-token: write permission granted?")
+		}
+		u, err := url.Parse(requestURL)
+		if err != nil {
+			return "", err
+		}
+		if audience != "" {
+			query := u.Query()
+			query.Set("audience", audience)
+			u.RawQuery = query.Encode()
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Authorization", "Bearer "+requestToken)
+
+		var res struct {
+			Value string `json:"value"`
+		}
+		status, err := doJSON(nil, req, &res)
+		if err != nil {
+			return "", fmt.Errorf("failed to get GitHub Actions ID token: %w", err)
+		}
+		if status != http.StatusOK || res.Value == "" {
+			return "", fmt.Errorf("failed to get GitHub Actions ID token: status %d", status)
+		}
+		return res.Value, nil
+	}
+}
+
+// doJSON sends the request, and decodes the JSON response into v. The status code is returned.
+func doJSON(client *http.Client, req *http.Request, v interface{}) (int, error) {
+	if client == nil {
+		client = &http.Client{Timeout: requestTimeout}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res.StatusCode, err
+	}
+	if err := json.Unmarshal(body, v); err != nil && res.StatusCode == http.StatusOK {
+		return res.StatusCode, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestTokenSource(t *testing.T) {
+	var exchanges int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("grant_type") != GrantTypeTokenExchange || r.PostForm.Get("subject_token_type") != TokenTypeJWT {
+			http.Error(w, `{"error":"unsupported_grant_type"}`, http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("subject_token") != "id-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"untrusted issuer"}`))
+			return
+		}
+		if r.PostForm.Get("audience") != "github.com/org" || r.PostForm.Get("scope") != "contents:read metadata:read" {
+			http.Error(w, `{"error":"invalid_target"}`, http.StatusBadRequest)
+			return
+		}
+		exchanges++
+		_, _ = w.Write([]byte(`{"access_token":"ghs_exchanged","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	cfg := Config{
+		TokenURL: server.URL,
+		IDToken:  func(context.Context) (string, error) { return "id-token", nil },
+		Audience: "github.com/org",
+		Scopes:   []string{"contents:read", "metadata:read"},
+	}
+	src := cfg.TokenSource()
+	for i := 0; i < 2; i++ {
+		token, err := src.Token()
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if token.AccessToken != "ghs_exchanged" || token.Expiry.IsZero() {
+			t.Errorf("Token() = %+v", token)
+		}
+	}
+	if exchanges != 1 {
+		t.Errorf("exchanged %d times, want the token to be reused", exchanges)
+	}
+
+	cfg.IDToken = func(context.Context) (string, error) { return "other", nil }
+	if _, err := cfg.TokenSource().Token(); err == nil || !strings.Contains(err.Error(), "untrusted issuer") {
+		t.Errorf("Token() error = %v, want the broker's error", err)
+	}
+}
+
+func TestTokenSource_WithOAuth2TokenSource(t *testing.T) {
+	var exchanges int
+	broker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges++
+		// The token expires within the early expiry, and is exchanged again on every request
+		_, _ = w.Write([]byte(`{"access_token":"ghs_exchanged","token_type":"Bearer","expires_in":30}`))
+	}))
+	defer broker.Close()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer api.Close()
+
+	cfg := Config{
+		TokenURL: broker.URL,
+		IDToken:  func(context.Context) (string, error) { return "id-token", nil },
+	}
+	opts, err := gitprovider.MakeClientOptions(gitprovider.WithOAuth2TokenSource(cfg.TokenSource()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := gitprovider.BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		resp, err := c.Get(api.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if exchanges != 2 {
+		t.Errorf("exchanged %d times, want once per request", exchanges)
+	}
+}
+
+func TestTokenSource_Timeout(t *testing.T) {
+	defer func(timeout time.Duration) { requestTimeout = timeout }(requestTimeout)
+	requestTimeout = 50 * time.Millisecond
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	cfg := Config{
+		TokenURL: server.URL,
+		IDToken:  func(context.Context) (string, error) { return "id-token", nil },
+	}
+	if _, err := cfg.TokenSource().Token(); err == nil {
+		t.Error("Token() succeeded, want the hanging exchange to time out")
+	}
+}
+
+func TestGitHubActionsIDToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" || r.URL.Query().Get("audience") != "sts" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"value":"id-token"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "")
+	if _, err := GitHubActionsIDToken("sts")(context.Background()); err == nil {
+		t.Error("expected an error outside of GitHub Actions")
+	}
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/?api-version=2.0")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+	token, err := GitHubActionsIDToken("sts")(context.Background())
+	if err != nil || token != "id-token" {
+		t.Errorf("GitHubActionsIDToken() = %q, %v", token, err)
+	}
+}
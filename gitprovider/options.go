@@ -17,6 +17,8 @@ limitations under the License.
 package gitprovider
 
 import (
+	"time"
+
 	"github.com/fluxcd/go-git-providers/validation"
 )
 
@@ -55,6 +57,17 @@ type RepositoryCreateOptions struct {
 	// Default: nil.
 	// Available options: See the LicenseTemplate enum.
 	LicenseTemplate *LicenseTemplate
+
+	// Template lets the user specify a template repository to generate the repository from,
+	// instead of creating an empty one. Can't be combined with AutoInit or LicenseTemplate.
+	// On GitLab, the template has to be a custom project template (GitLab Premium).
+	// Default: nil.
+	Template RepositoryRef
+
+	// TemplateIncludeAllBranches can be set to true to copy all branches of the template,
+	// instead of only the default branch. Only supported by GitHub.
+	// Default: nil (which means "false, only the default branch")
+	TemplateIncludeAllBranches *bool
 }
 
 // ApplyToRepositoryCreateOptions applies the options defined in the options struct to the
@@ -67,6 +80,12 @@ func (opts *RepositoryCreateOptions) ApplyToRepositoryCreateOptions(target *Repo
 	if opts.LicenseTemplate != nil {
 		target.LicenseTemplate = opts.LicenseTemplate
 	}
+	if opts.Template != nil {
+		target.Template = opts.Template
+	}
+	if opts.TemplateIncludeAllBranches != nil {
+		target.TemplateIncludeAllBranches = opts.TemplateIncludeAllBranches
+	}
 }
 
 // ValidateOptions validates that the options are valid.
@@ -75,6 +94,18 @@ func (opts *RepositoryCreateOptions) ValidateOptions() error {
 	if opts.LicenseTemplate != nil {
 		errs.Append(ValidateLicenseTemplate(*opts.LicenseTemplate), *opts.LicenseTemplate, "LicenseTemplate")
 	}
+	if opts.Template != nil {
+		// A generated repository already has the contents of the template
+		if opts.AutoInit != nil && *opts.AutoInit {
+			errs.Invalid(*opts.AutoInit, "AutoInit")
+		}
+		if opts.LicenseTemplate != nil {
+			errs.Invalid(*opts.LicenseTemplate, "LicenseTemplate")
+		}
+		opts.Template.ValidateFields(errs)
+	} else if opts.TemplateIncludeAllBranches != nil {
+		errs.Required("Template")
+	}
 	return errs.Error()
 }
 
@@ -94,3 +125,23 @@ func (opts *FilesGetOptions) ApplyFilesGetOptions(target *FilesGetOptions) {
 	target.Recursive = opts.Recursive
 
 }
+
+// AuditLogOptions specifies the time range of the audit events to read.
+type AuditLogOptions struct {
+	// Since only selects events which occurred at or after the given time.
+	// Default: nil (which means "as far back as the provider keeps events")
+	Since *time.Time
+
+	// Until only selects events which occurred before the given time.
+	// Default: nil (which means "until now")
+	Until *time.Time
+}
+
+// ValidateOptions validates that the options are valid.
+func (opts *AuditLogOptions) ValidateOptions() error {
+	errs := validation.New("AuditLogOptions")
+	if opts.Since != nil && opts.Until != nil && !opts.Since.Before(*opts.Until) {
+		errs.Invalid(*opts.Until, "Until")
+	}
+	return errs.Error()
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/fluxcd/go-git-providers/validation"
 )
@@ -31,6 +32,8 @@ var (
 	partialCreateOpts1     = &RepositoryCreateOptions{AutoInit: BoolVar(false)}
 	partialCreateOpts2     = &RepositoryCreateOptions{LicenseTemplate: LicenseTemplateVar(LicenseTemplateApache2)}
 	invalidRepoCreateOpts  = &RepositoryCreateOptions{LicenseTemplate: &unknownLicenseTemplate}
+	templateRef            = OrgRepositoryRef{OrganizationRef: OrganizationRef{Domain: "github.com", Organization: "fluxcd"}, RepositoryName: "template"}
+	templateCreateOpts     = &RepositoryCreateOptions{Template: templateRef, TemplateIncludeAllBranches: BoolVar(true)}
 )
 
 func TestMakeRepositoryCreateOptions(t *testing.T) {
@@ -72,6 +75,23 @@ func TestMakeRepositoryCreateOptions(t *testing.T) {
 			},
 			want: *repoCreateOpts2,
 		},
+		{
+			name: "template",
+			opts: []RepositoryCreateOption{partialCreateOpts1, templateCreateOpts},
+			want: RepositoryCreateOptions{AutoInit: BoolVar(false), Template: templateRef, TemplateIncludeAllBranches: BoolVar(true)},
+		},
+		{
+			name:        "template with auto init",
+			opts:        []RepositoryCreateOption{repoCreateOpts1, templateCreateOpts},
+			want:        RepositoryCreateOptions{AutoInit: BoolVar(true), LicenseTemplate: LicenseTemplateVar(LicenseTemplateMIT), Template: templateRef, TemplateIncludeAllBranches: BoolVar(true)},
+			expectedErr: validation.ErrFieldInvalid,
+		},
+		{
+			name:        "include all branches without template",
+			opts:        []RepositoryCreateOption{&RepositoryCreateOptions{TemplateIncludeAllBranches: BoolVar(true)}},
+			want:        RepositoryCreateOptions{TemplateIncludeAllBranches: BoolVar(true)},
+			expectedErr: validation.ErrFieldRequired,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -93,3 +113,47 @@ func TestMakeRepositoryCreateOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestAuditLogOptions_ValidateOptions(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+	tests := []struct {
+		name        string
+		opts        AuditLogOptions
+		expectedErr error
+	}{
+		{
+			name: "no time range",
+			opts: AuditLogOptions{},
+		},
+		{
+			name: "only since",
+			opts: AuditLogOptions{Since: &since},
+		},
+		{
+			name: "since before until",
+			opts: AuditLogOptions{Since: &since, Until: &until},
+		},
+		{
+			name:        "since after until",
+			opts:        AuditLogOptions{Since: &until, Until: &since},
+			expectedErr: validation.ErrFieldInvalid,
+		},
+		{
+			name:        "empty time range",
+			opts:        AuditLogOptions{Since: &since, Until: &since},
+			expectedErr: validation.ErrFieldInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.ValidateOptions()
+			if tt.expectedErr == nil && err != nil {
+				t.Errorf("AuditLogOptions.ValidateOptions() error = %v, wanted nil", err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("AuditLogOptions.ValidateOptions() error = %v, wanted %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultRateLimitMaxWait    = 15 * time.Minute
+	defaultRateLimitMaxRetries = 3
+	// defaultRateLimitWait is how long to wait for rate limits without reset information, as
+	// GitHub recommends for its secondary rate limits.
+	defaultRateLimitWait = time.Minute
+	// epochThreshold tells Unix timestamps apart from delays in seconds in RateLimit-Reset
+	// headers, which GitLab sets to a timestamp.
+	epochThreshold = 1_000_000_000
+)
+
+// RateLimitPolicy configures how a Client handles exhausted rate limits. Without it, or with
+// Wait unset, the rate limit error of the provider is returned right away.
+type RateLimitPolicy struct {
+	// Wait makes requests which exhausted the rate limit sleep until it resets, and retry them.
+	// The wait is interrupted when the context of the request is done.
+	Wait bool
+	// MaxWait is the longest a request sleeps before a retry, rate limits resetting later are
+	// returned as errors. Default: 15 minutes.
+	MaxWait time.Duration
+	// MaxRetries is how often a request is retried. Default: 3.
+	MaxRetries int
+}
+
+// WithRateLimitPolicy configures how the Client handles exhausted rate limits, which are
+// detected through the status code, and the Retry-After, X-RateLimit-* (GitHub, Gitea) and
+// RateLimit-* (GitLab, Bitbucket Server) headers. Requests with a body are only retried if it
+// can be replayed, which is the case for all requests of the providers.
+func WithRateLimitPolicy(policy RateLimitPolicy) ClientOption {
+	if policy.MaxWait < 0 || policy.MaxRetries < 0 {
+		return optionError(fmt.Errorf("MaxWait and MaxRetries cannot be negative: %w", ErrInvalidClientOptions))
+	}
+	if policy.MaxWait == 0 {
+		policy.MaxWait = defaultRateLimitMaxWait
+	}
+	if policy.MaxRetries == 0 {
+		policy.MaxRetries = defaultRateLimitMaxRetries
+	}
+	return &ClientOptions{rateLimitPolicy: &policy}
+}
+
+// rateLimitTransport retries rate limited requests according to policy.
+type rateLimitTransport struct {
+	policy RateLimitPolicy
+	log    logr.Logger
+	base   http.RoundTripper
+}
+
+// rateLimitChainTransport returns the ChainableRoundTripperFunc of rateLimitTransport.
+func (opts *ClientOptions) rateLimitChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &rateLimitTransport{policy: *opts.rateLimitPolicy, log: opts.GetLogger(), base: in}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	canRetry := t.policy.Wait && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+	for attempt := 0; ; attempt++ {
+		res, err := t.base.RoundTrip(req)
+		if err != nil || !canRetry || attempt >= t.policy.MaxRetries {
+			return res, err
+		}
+		wait, limited := rateLimitWait(res, time.Now())
+		if !limited || wait > t.policy.MaxWait {
+			return res, nil
+		}
+
+		retry := req.Clone(req.Context())
+		if req.GetBody != nil {
+			if retry.Body, err = req.GetBody(); err != nil {
+				return res, nil
+			}
+		}
+		// Drain the body, so that the connection can be reused
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+
+		t.log.Info("rate limit exhausted, waiting before retrying", "url", req.URL.String(), "wait", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = retry
+	}
+}
+
+// rateLimitWait returns how long to wait before retrying res, and whether it was rate limited.
+// GitHub responds to exhausted rate limits with 403 Forbidden, the other providers with
+// 429 Too Many Requests.
+func rateLimitWait(res *http.Response, now time.Time) (time.Duration, bool) {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusForbidden:
+		if res.Header.Get("X-RateLimit-Remaining") != "0" && res.Header.Get("Retry-After") == "" {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if wait, ok := retryAfter(res, now); ok {
+		return wait, true
+	}
+	for _, header := range []string{"X-RateLimit-Reset", "RateLimit-Reset"} {
+		reset, err := strconv.ParseInt(res.Header.Get(header), 10, 64)
+		if err != nil {
+			continue
+		}
+		if reset < epochThreshold {
+			return time.Duration(reset) * time.Second, true
+		}
+		return maxDuration(time.Unix(reset, 0).Sub(now), 0), true
+	}
+	return defaultRateLimitWait, true
+}
+
+// retryAfter returns the delay of the Retry-After header of res, given in seconds or as a date.
+func retryAfter(res *http.Response, now time.Time) (time.Duration, bool) {
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return maxDuration(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name        string
+		status      int
+		header      map[string]string
+		wantWait    time.Duration
+		wantLimited bool
+	}{
+		{
+			name:        "github primary rate limit",
+			status:      http.StatusForbidden,
+			header:      map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1700000030"},
+			wantWait:    30 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "github secondary rate limit",
+			status:      http.StatusForbidden,
+			header:      map[string]string{"Retry-After": "60"},
+			wantWait:    time.Minute,
+			wantLimited: true,
+		},
+		{
+			name:   "forbidden",
+			status: http.StatusForbidden,
+			header: map[string]string{"X-RateLimit-Remaining": "4999"},
+		},
+		{
+			name:        "gitlab rate limit",
+			status:      http.StatusTooManyRequests,
+			header:      map[string]string{"RateLimit-Remaining": "0", "RateLimit-Reset": "1700000010"},
+			wantWait:    10 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "delay in seconds",
+			status:      http.StatusTooManyRequests,
+			header:      map[string]string{"RateLimit-Reset": "5"},
+			wantWait:    5 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "http date",
+			status:      http.StatusTooManyRequests,
+			header:      map[string]string{"Retry-After": now.Add(20 * time.Second).UTC().Format(http.TimeFormat)},
+			wantWait:    20 * time.Second,
+			wantLimited: true,
+		},
+		{
+			name:        "no headers",
+			status:      http.StatusTooManyRequests,
+			wantWait:    defaultRateLimitWait,
+			wantLimited: true,
+		},
+		{
+			name:   "ok",
+			status: http.StatusOK,
+			header: map[string]string{"X-RateLimit-Remaining": "0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.header {
+				res.Header.Set(k, v)
+			}
+			wait, limited := rateLimitWait(res, now)
+			if wait != tt.wantWait || limited != tt.wantLimited {
+				t.Errorf("rateLimitWait() = %v, %v, want %v, %v", wait, limited, tt.wantWait, tt.wantLimited)
+			}
+		})
+	}
+}
+
+func TestWithRateLimitPolicy(t *testing.T) {
+	var requests int
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		// Always rate limit requests for a given Retry-After, and the first one otherwise
+		if retryAfter := r.URL.Query().Get("retry-after"); retryAfter != "" || requests == 1 {
+			if retryAfter == "" {
+				retryAfter = "0"
+			}
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+	}))
+	defer srv.Close()
+
+	newClient := func(policy RateLimitPolicy) *http.Client {
+		opts, err := MakeClientOptions(WithRateLimitPolicy(policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	// The request is retried with its body once the rate limit resets
+	resp, err := newClient(RateLimitPolicy{Wait: true}).Post(srv.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || requests != 2 || bodies[1] != "payload" {
+		t.Errorf("got status %d after %d requests with bodies %q, want a retry with the body", resp.StatusCode, requests, bodies)
+	}
+
+	// Without Wait, the rate limit is returned
+	requests = 0
+	resp, err = newClient(RateLimitPolicy{}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || requests != 1 {
+		t.Errorf("got status %d after %d requests, want no retry", resp.StatusCode, requests)
+	}
+
+	// Rate limits resetting after MaxWait are returned
+	requests = 0
+	resp, err = newClient(RateLimitPolicy{Wait: true, MaxWait: time.Minute}).Get(srv.URL + "?retry-after=3600")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || requests != 1 {
+		t.Errorf("got status %d after %d requests, want no retry", resp.StatusCode, requests)
+	}
+
+	// Requests are authenticated again after the wait
+	var tokens int
+	var auth []string
+	authSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if len(auth) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer authSrv.Close()
+	opts, err := MakeClientOptions(WithRateLimitPolicy(RateLimitPolicy{Wait: true}),
+		WithAuthHeader("Authorization", func(*http.Request) (string, error) {
+			tokens++
+			return fmt.Sprintf("token-%d", tokens), nil
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = c.Get(authSrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if want := []string{"token-1", "token-2"}; !reflect.DeepEqual(auth, want) {
+		t.Errorf("Authorization headers = %q, want %q", auth, want)
+	}
+
+	// Waiting is interrupted by the context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?retry-after=60", nil)
+	if _, err := newClient(RateLimitPolicy{Wait: true}).Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if _, err := MakeClientOptions(WithRateLimitPolicy(RateLimitPolicy{MaxRetries: -1})); !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("WithRateLimitPolicy() error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+}
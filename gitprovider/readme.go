@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+)
+
+// readmeExtensions lists the extensions of README files, most preferred first, the way
+// Git providers pick the README to render when a repository has several.
+//
+//nolint:gochecknoglobals
+var readmeExtensions = []string{".md", ".markdown", ".rst", ".adoc", ".asciidoc", ".org", ".txt", ""}
+
+// DetectReadme returns the path of the README file in the root of a repository, using listDir
+// to list the root directory. An empty path is returned if there's no README.
+// Providers use this to fill in RepositoryMetadata.ReadmePath.
+func DetectReadme(ctx context.Context, listDir DirLister) (string, error) {
+	entries, err := listDirIfExists(ctx, listDir, "")
+	if err != nil {
+		return "", err
+	}
+
+	candidates := []string{}
+	for _, p := range entries {
+		name := strings.ToLower(path.Base(p))
+		if name == "readme" || strings.HasPrefix(name, "readme.") {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return readmePriority(candidates[i]) < readmePriority(candidates[j])
+	})
+	return candidates[0], nil
+}
+
+// readmePriority returns the index of the extension of p in readmeExtensions, or its length
+// for unknown extensions.
+func readmePriority(p string) int {
+	ext := strings.ToLower(path.Ext(p))
+	for i, e := range readmeExtensions {
+		if ext == e {
+			return i
+		}
+	}
+	return len(readmeExtensions)
+}
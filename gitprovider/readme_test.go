@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDetectReadme(t *testing.T) {
+	tests := []struct {
+		name string
+		root []string
+		want string
+	}{
+		{
+			name: "empty repository",
+		},
+		{
+			name: "no readme",
+			root: []string{"LICENSE", "main.go", "docs"},
+		},
+		{
+			name: "markdown preferred",
+			root: []string{"README", "README.txt", "README.md", "readme.rst"},
+			want: "README.md",
+		},
+		{
+			name: "lowercase name",
+			root: []string{"go.mod", "readme.rst"},
+			want: "readme.rst",
+		},
+		{
+			name: "unknown extension last",
+			root: []string{"README.zh-CN", "README"},
+			want: "README",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listDir := func(_ context.Context, dir string) ([]string, error) {
+				if dir != "" {
+					t.Errorf("listDir(%q), want only the root to be listed", dir)
+				}
+				if tt.root == nil {
+					return nil, ErrNotFound
+				}
+				return tt.root, nil
+			}
+			got, err := DetectReadme(context.Background(), listDir)
+			if err != nil {
+				t.Fatalf("DetectReadme() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectReadme() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -318,33 +318,84 @@ func GetCloneURL(rs RepositoryRef, transport TransportType) string {
 	return ""
 }
 
+// GetWikiCloneURL returns the URL to clone the wiki of a repository for a given transport type.
+// GitHub, GitLab and Gitea all store the wiki in a "{repository}.wiki" repository next to it.
+// If the given TransportType isn't known an empty string is returned.
+func GetWikiCloneURL(rs RepositoryRef, transport TransportType) string {
+	return GetCloneURL(wikiRef{rs}, transport)
+}
+
+// wikiRef is a RepositoryRef pointing to the wiki of a repository.
+type wikiRef struct {
+	RepositoryRef
+}
+
+func (r wikiRef) GetRepository() string {
+	return r.RepositoryRef.GetRepository() + ".wiki"
+}
+
+func (r wikiRef) String() string {
+	return r.RepositoryRef.String() + ".wiki"
+}
+
 // ParseTypeHTTPS returns the HTTPS URL to clone a repository.
 func ParseTypeHTTPS(url string) string {
 	return fmt.Sprintf("%s.git", url)
 }
 
-// ParseTypeGit returns the URL to clone a repository using the Git protocol.
+// ParseTypeGit returns the URL to clone a repository using the Git protocol, i.e. the scp-like
+// syntax. As that syntax can't express a port, the equivalent ssh:// URL is returned if domain has one.
+// Any scheme or path prefix in domain is dropped.
 func ParseTypeGit(domain, identity, repository string) string {
-	return fmt.Sprintf("git@%s:%s/%s.git", domain, identity, repository)
+	host, port, _ := splitDomain(domain)
+	if port != "" {
+		return fmt.Sprintf("ssh://git@%s:%s/%s/%s.git", host, port, identity, repository)
+	}
+	return fmt.Sprintf("git@%s:%s/%s.git", host, identity, repository)
 }
 
 // ParseTypeSSH returns the URL to clone a repository using the SSH protocol.
+// Any scheme or path prefix in domain is dropped, the port is kept.
 func ParseTypeSSH(domain, identity, repository string) string {
-	trimmedDomain := domain
-	trimmedDomain = strings.Replace(trimmedDomain, "https://", "", -1)
-	trimmedDomain = strings.Replace(trimmedDomain, "http://", "", -1)
-	return fmt.Sprintf("ssh://git@%s/%s/%s", trimmedDomain, identity, repository)
+	host, port, _ := splitDomain(domain)
+	if port != "" {
+		host = fmt.Sprintf("%s:%s", host, port)
+	}
+	return fmt.Sprintf("ssh://git@%s/%s/%s", host, identity, repository)
+}
+
+// splitDomain splits a domain, e.g. "https://my-gitlab.com:6443/gitlab", into its host, e.g.
+// "my-gitlab.com", port, e.g. "6443", and path prefix, e.g. "/gitlab". The scheme is dropped.
+func splitDomain(domain string) (host, port, pathPrefix string) {
+	if i := strings.Index(domain, "://"); i >= 0 {
+		domain = domain[i+3:]
+	}
+	if i := strings.Index(domain, "/"); i >= 0 {
+		domain, pathPrefix = domain[:i], strings.TrimSuffix(domain[i:], "/")
+	}
+	// Don't mistake the colons of an IPv6 literal, e.g. "[::1]:8080", for a port
+	if i := strings.LastIndex(domain, ":"); i >= 0 && i > strings.LastIndex(domain, "]") {
+		domain, port = domain[:i], domain[i+1:]
+	}
+	return domain, port, pathPrefix
 }
 
 // ParseOrganizationURL parses an URL to an organization into a OrganizationRef object.
 func ParseOrganizationURL(o string) (*OrganizationRef, error) {
-	u, parts, err := parseURL(o)
+	return ParseOrganizationURLWithDomain(o, "")
+}
+
+// ParseOrganizationURLWithDomain is like ParseOrganizationURL, but supports providers installed under
+// a path prefix, e.g. domain "tools.corp/gitlab". The URL must point to domain, which is used as the
+// Domain of the returned ref, so that it matches the client's. If domain is empty, the URL host is used.
+func ParseOrganizationURLWithDomain(o, domain string) (*OrganizationRef, error) {
+	domain, parts, err := parseURL(o, domain)
 	if err != nil {
 		return nil, err
 	}
 	// Create the IdentityInfo object
 	info := &OrganizationRef{
-		Domain:           u.Host,
+		Domain:           domain,
 		Organization:     parts[0],
 		SubOrganizations: []string{},
 	}
@@ -357,8 +408,14 @@ func ParseOrganizationURL(o string) (*OrganizationRef, error) {
 
 // ParseUserURL parses an URL to an organization into a UserRef object.
 func ParseUserURL(u string) (*UserRef, error) {
+	return ParseUserURLWithDomain(u, "")
+}
+
+// ParseUserURLWithDomain is like ParseUserURL, but supports providers installed under a path prefix.
+// See ParseOrganizationURLWithDomain for how domain is used.
+func ParseUserURLWithDomain(u, domain string) (*UserRef, error) {
 	// Use the same logic as for parsing organization URLs, but return an UserRef object
-	orgInfoPtr, err := ParseOrganizationURL(u)
+	orgInfoPtr, err := ParseOrganizationURLWithDomain(u, domain)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +428,13 @@ func ParseUserURL(u string) (*UserRef, error) {
 
 // ParseUserRepositoryURL parses a HTTPS clone URL into a UserRepositoryRef object.
 func ParseUserRepositoryURL(r string) (*UserRepositoryRef, error) {
-	orgInfoPtr, repoName, err := parseRepositoryURL(r)
+	return ParseUserRepositoryURLWithDomain(r, "")
+}
+
+// ParseUserRepositoryURLWithDomain is like ParseUserRepositoryURL, but supports providers installed
+// under a path prefix. See ParseOrganizationURLWithDomain for how domain is used.
+func ParseUserRepositoryURLWithDomain(r, domain string) (*UserRepositoryRef, error) {
+	orgInfoPtr, repoName, err := parseRepositoryURL(r, domain)
 	if err != nil {
 		return nil, err
 	}
@@ -389,7 +452,13 @@ func ParseUserRepositoryURL(r string) (*UserRepositoryRef, error) {
 
 // ParseOrgRepositoryURL parses a HTTPS clone URL into a OrgRepositoryRef object.
 func ParseOrgRepositoryURL(r string) (*OrgRepositoryRef, error) {
-	orgInfoPtr, repoName, err := parseRepositoryURL(r)
+	return ParseOrgRepositoryURLWithDomain(r, "")
+}
+
+// ParseOrgRepositoryURLWithDomain is like ParseOrgRepositoryURL, but supports providers installed
+// under a path prefix. See ParseOrganizationURLWithDomain for how domain is used.
+func ParseOrgRepositoryURLWithDomain(r, domain string) (*OrgRepositoryRef, error) {
+	orgInfoPtr, repoName, err := parseRepositoryURL(r, domain)
 	if err != nil {
 		return nil, err
 	}
@@ -400,9 +469,9 @@ func ParseOrgRepositoryURL(r string) (*OrgRepositoryRef, error) {
 	}, nil
 }
 
-func parseRepositoryURL(r string) (orgInfoPtr *OrganizationRef, repoName string, err error) {
+func parseRepositoryURL(r, domain string) (orgInfoPtr *OrganizationRef, repoName string, err error) {
 	// First, parse the URL as an organization
-	orgInfoPtr, err = ParseOrganizationURL(r)
+	orgInfoPtr, err = ParseOrganizationURLWithDomain(r, domain)
 	if err != nil {
 		return nil, "", err
 	}
@@ -422,27 +491,55 @@ func parseRepositoryURL(r string) (orgInfoPtr *OrganizationRef, repoName string,
 	return
 }
 
-func parseURL(str string) (*url.URL, []string, error) {
+// parseURL parses str, returning its domain and path parts. If domain is set, str must point to it,
+// and the path prefix of domain is stripped.
+func parseURL(str, domain string) (string, []string, error) {
 	// Fail-fast if the URL is empty
 	if len(str) == 0 {
-		return nil, nil, fmt.Errorf("url cannot be empty: %w", ErrURLInvalid)
+		return "", nil, fmt.Errorf("url cannot be empty: %w", ErrURLInvalid)
 	}
 	u, err := url.Parse(str)
 	if err != nil {
-		return nil, nil, err
+		return "", nil, err
 	}
-	// Only allow explicit https URLs
-	if u.Scheme != "https" {
-		return nil, nil, fmt.Errorf("%w: %s", ErrURLUnsupportedScheme, str)
+	// Only allow explicit https URLs, unless the domain is explicitly served over http
+	if u.Scheme != "https" && (u.Scheme != "http" || !strings.HasPrefix(domain, "http://")) {
+		return "", nil, fmt.Errorf("%w: %s", ErrURLUnsupportedScheme, str)
 	}
 	// Don't allow any extra things in the URL, in order to be able to do a successful
 	// round-trip of parsing the URL and encoding it back to a string
 	if len(u.Fragment) != 0 || len(u.RawQuery) != 0 || len(u.User.String()) != 0 {
-		return nil, nil, fmt.Errorf("%w: %s", ErrURLUnsupportedParts, str)
+		return "", nil, fmt.Errorf("%w: %s", ErrURLUnsupportedParts, str)
+	}
+
+	// Always use the ASCII form of internationalized domains
+	host, err := NormalizeDomain(u.Host)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrURLInvalid, str)
+	}
+
+	path := u.Path
+	if domain == "" {
+		domain = host
+	} else {
+		domainHost, domainPort, pathPrefix := splitDomain(domain)
+		if domainPort != "" {
+			domainHost = fmt.Sprintf("%s:%s", domainHost, domainPort)
+		}
+		if !DomainsEqual(host, domainHost) {
+			return "", nil, fmt.Errorf("%w: %s is not on domain %s", ErrURLInvalid, str, domain)
+		}
+		// Strip the path prefix of the installation, e.g. "/gitlab"
+		if pathPrefix != "" {
+			if path != pathPrefix && !strings.HasPrefix(path, pathPrefix+"/") {
+				return "", nil, fmt.Errorf("%w: %s is not on domain %s", ErrURLInvalid, str, domain)
+			}
+			path = strings.TrimPrefix(path, pathPrefix)
+		}
 	}
 
 	// Strip any leading and trailing slash to be able to split the string cleanly
-	path := strings.TrimSuffix(strings.TrimPrefix(u.Path, "/"), "/")
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/")
 	// Split the path by slash
 	parts := strings.Split(path, "/")
 	// Make sure there aren't any "empty" string splits
@@ -451,10 +548,10 @@ func parseURL(str string) (*url.URL, []string, error) {
 	for _, p := range parts {
 		// Make sure any path part is not empty
 		if len(p) == 0 {
-			return nil, nil, fmt.Errorf("%w: %s", ErrURLInvalid, str)
+			return "", nil, fmt.Errorf("%w: %s", ErrURLInvalid, str)
 		}
 	}
-	return u, parts, nil
+	return domain, parts, nil
 }
 
 func orgInfoPtrToUserRef(orgInfoPtr *OrganizationRef) (*UserRef, error) {
@@ -104,6 +104,11 @@ func TestParseOrganizationURL(t *testing.T) {
 			url:  "https://my-gitlab.com:6443/my-org/sub-org/2/3",
 			want: newOrgRefPtr("my-gitlab.com:6443", "my-org", []string{"sub-org", "2", "3"}),
 		},
+		{
+			name: "internationalized domain",
+			url:  "https://gitlab.bücher.example:6443/my-org",
+			want: newOrgRefPtr("gitlab.xn--bcher-kva.example:6443", "my-org", nil),
+		},
 		{
 			name: "no org specified",
 			url:  "https://github.com",
@@ -171,8 +176,8 @@ func TestParseOrganizationURL(t *testing.T) {
 			}
 			// Ensure that roundtrip data is preserved
 			if got != nil {
-				// expect the round-trip to remove any trailing slashes
-				expectedURL := strings.TrimSuffix(tt.url, "/")
+				// expect the round-trip to remove any trailing slashes, and to convert the domain to punycode
+				expectedURL, _ := NormalizeDomain(strings.TrimSuffix(tt.url, "/"))
 				if got.String() != expectedURL {
 					t.Errorf("ParseOrganizationURL(): got.String() = %q, want %q", got.String(), expectedURL)
 				}
@@ -464,6 +469,68 @@ func TestParseRepositoryURL(t *testing.T) {
 	}
 }
 
+func TestParseRepositoryURLWithDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		domain  string
+		wantOrg *OrgRepositoryRef
+		err     error
+	}{
+		{
+			name:    "path prefix",
+			url:     "https://tools.corp/gitlab/group/subgroup/repo",
+			domain:  "tools.corp/gitlab",
+			wantOrg: newOrgRepoRefPtr("tools.corp/gitlab", "group", []string{"subgroup"}, "repo"),
+		},
+		{
+			name:    "path prefix with scheme and port",
+			url:     "http://tools.corp:8080/bitbucket/PRJ/repo.git",
+			domain:  "http://tools.corp:8080/bitbucket/",
+			wantOrg: newOrgRepoRefPtr("http://tools.corp:8080/bitbucket/", "PRJ", nil, "repo"),
+		},
+		{
+			name:    "no path prefix",
+			url:     "https://tools.corp/group/repo",
+			domain:  "tools.corp",
+			wantOrg: newOrgRepoRefPtr("tools.corp", "group", nil, "repo"),
+		},
+		{
+			name:   "http without http domain",
+			url:    "http://tools.corp/gitlab/group/repo",
+			domain: "tools.corp/gitlab",
+			err:    ErrURLUnsupportedScheme,
+		},
+		{
+			name:   "other host",
+			url:    "https://other.corp/gitlab/group/repo",
+			domain: "tools.corp/gitlab",
+			err:    ErrURLInvalid,
+		},
+		{
+			name:   "other path prefix",
+			url:    "https://tools.corp/gitlabs/group/repo",
+			domain: "tools.corp/gitlab",
+			err:    ErrURLInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ParseOrgRepositoryURLWithDomain(tt.url, tt.domain)
+			validation.TestExpectErrors(t, "ParseOrgRepositoryURLWithDomain", err, tt.err)
+			if !reflect.DeepEqual(res, tt.wantOrg) {
+				t.Errorf("ParseOrgRepositoryURLWithDomain() = %v, want %v", res, tt.wantOrg)
+			}
+			if res != nil {
+				want := strings.TrimSuffix(strings.TrimSuffix(tt.url, "/"), ".git")
+				if got := res.String(); got != want {
+					t.Errorf("ParseOrgRepositoryURLWithDomain().String() = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestGetCloneURL(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -525,6 +592,48 @@ func TestGetCloneURL(t *testing.T) {
 			transport: TransportTypeSSH,
 			want:      "ssh://git@my-gitlab.com:6443/luxas/test-org/other/foo-bar",
 		},
+		{
+			name:      "org: https, http scheme and port",
+			repoinfo:  newOrgRepoRef("http://my-gitlab.com:8080", "luxas", []string{"test-org"}, "foo-bar"),
+			transport: TransportTypeHTTPS,
+			want:      "http://my-gitlab.com:8080/luxas/test-org/foo-bar.git",
+		},
+		{
+			name:      "org: https, path prefix",
+			repoinfo:  newOrgRepoRef("https://my-host.com/gitlab", "luxas", []string{"test-org"}, "foo-bar"),
+			transport: TransportTypeHTTPS,
+			want:      "https://my-host.com/gitlab/luxas/test-org/foo-bar.git",
+		},
+		{
+			name:      "org: ssh, path prefix",
+			repoinfo:  newOrgRepoRef("https://my-host.com:8443/gitlab/", "luxas", []string{"test-org"}, "foo-bar"),
+			transport: TransportTypeSSH,
+			want:      "ssh://git@my-host.com:8443/luxas/test-org/foo-bar",
+		},
+		{
+			name:      "org: git, scheme and path prefix",
+			repoinfo:  newOrgRepoRef("https://my-host.com/gitlab", "luxas", []string{"test-org"}, "foo-bar"),
+			transport: TransportTypeGit,
+			want:      "git@my-host.com:luxas/test-org/foo-bar.git",
+		},
+		{
+			name:      "org: git, port",
+			repoinfo:  newOrgRepoRef("my-gitlab.com:6443", "luxas", []string{"test-org"}, "foo-bar"),
+			transport: TransportTypeGit,
+			want:      "ssh://git@my-gitlab.com:6443/luxas/test-org/foo-bar.git",
+		},
+		{
+			name:      "user: https, ipv6 and port",
+			repoinfo:  newUserRepoRef("[::1]:3000", "luxas", "foo-bar"),
+			transport: TransportTypeHTTPS,
+			want:      "https://[::1]:3000/luxas/foo-bar.git",
+		},
+		{
+			name:      "user: ssh, ipv6",
+			repoinfo:  newUserRepoRef("[::1]", "luxas", "foo-bar"),
+			transport: TransportTypeSSH,
+			want:      "ssh://git@[::1]/luxas/foo-bar",
+		},
 		{
 			name:      "user: git",
 			repoinfo:  newUserRepoRef("gitlab.com", "luxas", "foo-bar"),
@@ -561,6 +670,41 @@ func TestGetCloneURL(t *testing.T) {
 	}
 }
 
+func TestGetWikiCloneURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		repoinfo  RepositoryRef
+		transport TransportType
+		want      string
+	}{
+		{
+			name:      "org: https",
+			repoinfo:  newOrgRepoRef("gitlab.com", "luxas", []string{"test-org"}, "foo-bar"),
+			transport: TransportTypeHTTPS,
+			want:      "https://gitlab.com/luxas/test-org/foo-bar.wiki.git",
+		},
+		{
+			name:      "user: git",
+			repoinfo:  newUserRepoRef("github.com", "luxas", "foo-bar"),
+			transport: TransportTypeGit,
+			want:      "git@github.com:luxas/foo-bar.wiki.git",
+		},
+		{
+			name:      "user: ssh",
+			repoinfo:  newUserRepoRef("my-gitea.com:2222", "luxas", "foo-bar"),
+			transport: TransportTypeSSH,
+			want:      "ssh://git@my-gitea.com:2222/luxas/foo-bar.wiki",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetWikiCloneURL(tt.repoinfo, tt.transport); got != tt.want {
+				t.Errorf("GetWikiCloneURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIdentityRef_GetType(t *testing.T) {
 	tests := []struct {
 		name string
@@ -672,6 +816,16 @@ func TestGetDomainURL(t *testing.T) {
 			domain: "github.com",
 			want:   "https://github.com",
 		},
+		{
+			name:   "ip address and port",
+			domain: "127.0.0.1:3000",
+			want:   "https://127.0.0.1:3000",
+		},
+		{
+			name:   "path prefix, trailing slash",
+			domain: "http://my-host.com:8080/gitlab/",
+			want:   "http://my-host.com:8080/gitlab",
+		},
 		{
 			name:   "github.com, with https",
 			domain: "https://github.com",
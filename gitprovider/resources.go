@@ -16,33 +16,114 @@ limitations under the License.
 
 package gitprovider
 
+import "context"
+
 // Organization represents an organization in a Git provider.
-// For now, the organization is read-only, i.e. there aren't set/update methods.
 type Organization interface {
 	// Organization implements the Object interface,
 	// allowing access to the underlying object returned from the API.
 	Object
 	// OrganizationBound returns organization reference details.
 	OrganizationBound
+	// The organization can be updated.
+	Updatable
+	// The organization can be reconciled.
+	Reconcilable
 
 	// Get returns high-level information about the organization.
 	Get() OrganizationInfo
+	// Set sets high-level desired state for this organization. In order to apply these changes
+	// in the Git provider, run .Update() or .Reconcile().
+	Set(OrganizationInfo) error
 
 	// Teams gives access to the TeamsClient for this specific organization
 	Teams() TeamsClient
+
+	// Runners gives access to enrolling self-hosted CI runners for all repositories of this
+	// organization. Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Runners() (RunnerClient, error)
+
+	// Bots gives access to managing the bot users of this organization.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Bots() (BotClient, error)
+
+	// AccessTokens gives access to managing the access tokens of this organization.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	AccessTokens() (AccessTokenClient, error)
+
+	// Labels gives access to the issue labels shared by all repositories of this organization.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Labels() (LabelClient, error)
+
+	// Milestones gives access to the milestones shared by all repositories of this organization.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Milestones() (MilestoneClient, error)
+
+	// Members gives access to the members of this organization, and their role.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Members() (OrganizationMemberClient, error)
+
+	// Variables gives access to the CI/CD variables shared by the repositories of this
+	// organization. Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Variables() (OrganizationVariableClient, error)
+
+	// AuditLog gives access to the audit log of this organization. Depending on the provider,
+	// this needs a paid plan and owner permissions, which are only checked when streaming.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it, or a *CapabilityError
+	// if the token can't access it. Checking the token may need to request it, so providers
+	// doing so return the *CapabilityError from Stream instead.
+	AuditLog() (AuditLogClient, error)
+
+	// SCIM gives access to the users provisioned for this organization through SCIM. This
+	// needs SAML single sign-on to be set up for the organization, which is only checked when
+	// using the client. Returns "ErrNoProviderSupport" if the provider doesn't support it, or a
+	// *CapabilityError if the token can't access it. Checking the token may need to request it,
+	// so providers doing so return the *CapabilityError from the methods of the client instead.
+	SCIM() (SCIMClient, error)
+
+	// SSHCertificateAuthorities gives access to the SSH certificate authorities of this
+	// organization. Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	SSHCertificateAuthorities() (SSHCertificateAuthorityClient, error)
+
+	// IPAllowList gives access to the IP allow list of this organization. Depending on the
+	// provider, this needs a paid plan, which is only checked when using the client.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	IPAllowList() (IPAllowListClient, error)
 }
 
 // Team represents a team in an organization in a Git provider.
-// For now, the team is read-only, i.e. there aren't set/update methods.
 type Team interface {
 	// Team implements the Object interface,
 	// allowing access to the underlying object returned from the API.
 	Object
 	// OrganizationBound returns organization reference details.
 	OrganizationBound
+	// The team can be updated.
+	Updatable
+	// The team can be reconciled.
+	Reconcilable
+	// The team can be deleted.
+	Deletable
 
 	// Get returns high-level information about this team.
 	Get() TeamInfo
+	// Set sets high-level desired state for this team. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile(). Members are ignored.
+	Set(TeamInfo) error
+
+	// ListMembers lists the direct members of this team, including their role.
+	//
+	// ListMembers returns all members, using multiple paginated requests if needed.
+	ListMembers(ctx context.Context) ([]TeamMemberInfo, error)
+	// AddMember adds the user to this team with the given role (default: member),
+	// or changes the role if the user is already a member.
+	//
+	// ErrNoProviderSupport is returned if the provider doesn't support the role.
+	AddMember(ctx context.Context, req TeamMemberInfo) error
+	// RemoveMember removes the user from this team.
+	//
+	// ErrNotFound is returned if the user isn't a member of this team.
+	RemoveMember(ctx context.Context, username string) error
 }
 
 // UserRepository describes a repository owned by an user.
@@ -65,13 +146,140 @@ type UserRepository interface {
 	// the Git provider, run .Update() or .Reconcile().
 	Set(RepositoryInfo) error
 
+	// SSHEndpoint returns the SSH endpoint to clone this repository from, as reported by the
+	// provider, including a custom SSH port and user name. Returns "ErrNoProviderSupport" if the
+	// instance has SSH access disabled.
+	SSHEndpoint() (SSHEndpoint, error)
+
 	// DeployKeys gives access to manipulating deploy keys to access this specific repository.
 	DeployKeys() DeployKeyClient
 
 	// DeployTokens gives access to manipulating deploy tokens to access this specific repository.
+	// Deploy tokens complement deploy keys for HTTPS-based access, e.g. for cloning the
+	// repository or pulling from its registries in environments where SSH can't be used.
 	// Returns "ErrNoProviderSupport" if the provider doesn't support deploy tokens.
 	DeployTokens() (DeployTokenClient, error)
 
+	// Variables gives access to manipulating the CI/CD variables of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support repository-level variables.
+	Variables() (VariableClient, error)
+
+	// Artifacts gives access to storing binary build artifacts alongside this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support artifact storage.
+	Artifacts() (ArtifactClient, error)
+
+	// Packages gives access to the packages and container images published from this specific
+	// repository. Returns "ErrNoProviderSupport" if the provider doesn't have a package registry,
+	// or a *CapabilityError if the token can't access it. Checking the token may need to request
+	// it, so providers doing so return the *CapabilityError from List instead.
+	Packages() (PackageClient, error)
+
+	// Environments gives access to manipulating the deployment environments of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support deployment environments.
+	Environments() (EnvironmentClient, error)
+
+	// Deployments gives access to creating deployments of this specific repository, and reporting
+	// their state. Returns "ErrNoProviderSupport" if the provider doesn't support deployments.
+	Deployments() (DeploymentClient, error)
+
+	// Mirrors gives access to configuring the push and pull mirrors of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support mirroring.
+	Mirrors() (MirrorClient, error)
+
+	// Runners gives access to enrolling self-hosted CI runners for this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Runners() (RunnerClient, error)
+
+	// Issues gives access to filing and managing the issues of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't have issues.
+	Issues() (IssueClient, error)
+
+	// Labels gives access to the issue labels of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't have issues.
+	Labels() (LabelClient, error)
+
+	// Milestones gives access to the milestones of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't have milestones.
+	Milestones() (MilestoneClient, error)
+
+	// Autolinks gives access to linking references to external issue trackers, like Jira.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Autolinks() (AutolinkClient, error)
+
+	// Collaborators gives access to the individual users that have access to this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Collaborators() (CollaboratorClient, error)
+
+	// Bots gives access to managing the bot users of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Bots() (BotClient, error)
+
+	// AccessTokens gives access to managing the access tokens of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	AccessTokens() (AccessTokenClient, error)
+
+	// Schedules gives access to the scheduled pipelines of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support scheduled pipelines.
+	Schedules() (ScheduleClient, error)
+
+	// Pipelines gives access to running the CI/CD pipelines of this specific repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	Pipelines() (PipelineClient, error)
+
+	// LFSUsage returns the storage used by Git LFS objects of this repository, in bytes.
+	// Returns "ErrNoProviderSupport" if the provider doesn't report it.
+	LFSUsage(ctx context.Context) (int64, error)
+
+	// Languages returns the share of each programming language in the code of this repository,
+	// in percent, e.g. {"Go": 97.5, "Makefile": 2.5}, as computed by the provider.
+	// Returns "ErrNoProviderSupport" if the provider doesn't detect languages.
+	Languages(ctx context.Context) (map[string]float64, error)
+
+	// GetUserPermission returns the effective permission level of the given user on this repository,
+	// taking into account access granted through teams, groups and the owning organization.
+	// A nil permission is returned if the user doesn't have access.
+	GetUserPermission(ctx context.Context, username string) (*RepositoryPermission, error)
+
+	// GetWatchLevel returns which notifications the authenticated user receives for this repository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support it.
+	GetWatchLevel(ctx context.Context) (WatchLevel, error)
+
+	// SetWatchLevel sets which notifications the authenticated user receives for this repository,
+	// e.g. to unsubscribe a bot account from the notifications of repositories it pushes to.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support the given level.
+	SetWatchLevel(ctx context.Context, level WatchLevel) error
+
+	// DetectCI inspects the default branch of this repository for the configuration files of
+	// known CI/CD systems. Returns "ErrNoProviderSupport" if the provider can't list files.
+	DetectCI(ctx context.Context) (*CIInfo, error)
+
+	// Fork forks this repository into the given user account or organization, and returns the
+	// fork. If target is nil, the repository is forked into the account of the authenticated user.
+	// Forks owned by an organization can be cast to an OrgRepository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support forking.
+	Fork(ctx context.Context, target IdentityRef) (UserRepository, error)
+
+	// ListForks lists the forks of this repository the authenticated user has access to.
+	// Forks owned by an organization can be cast to an OrgRepository.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support forking.
+	ListForks(ctx context.Context) ([]UserRepository, error)
+
+	// Transfer transfers this repository to the given user account or organization, and returns
+	// the repository at its new location. This object refers to the old location afterwards.
+	// If the new owner has to accept the transfer first, ErrTransferPending is returned.
+	// As the repository leaves the current owner, ErrDestructiveCallDisallowed is returned unless
+	// the client was created with WithDestructiveAPICalls(true).
+	// Returns "ErrNoProviderSupport" if the provider doesn't support transfers.
+	Transfer(ctx context.Context, newOwner IdentityRef) (UserRepository, error)
+
+	// Archive archives this repository, making it read-only. This is a no-op if it's already archived.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support archiving.
+	Archive(ctx context.Context) error
+
+	// Unarchive unarchives this repository, making it writable again. This is a no-op if it isn't archived.
+	// Returns "ErrNoProviderSupport" if the provider doesn't support archiving.
+	Unarchive(ctx context.Context) error
+
 	// Commits gives access to this specific repository commits
 	Commits() CommitClient
 
@@ -144,6 +352,108 @@ type DeployToken interface {
 	Set(DeployTokenInfo) error
 }
 
+// Variable represents a CI/CD variable (or secret) defined on a repository.
+type Variable interface {
+	// Variable implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The variable can be updated.
+	Updatable
+	// The variable can be reconciled.
+	Reconcilable
+	// The variable can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this variable.
+	Get() VariableInfo
+	// Set sets high-level desired state for this variable. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(VariableInfo) error
+}
+
+// OrganizationVariable represents a CI/CD variable (or secret) defined on an organization.
+type OrganizationVariable interface {
+	// OrganizationVariable implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The variable can be updated.
+	Updatable
+	// The variable can be reconciled.
+	Reconcilable
+	// The variable can be deleted.
+	Deletable
+	// OrganizationBound returns organization reference details.
+	OrganizationBound
+
+	// Get returns high-level information about this variable.
+	Get() OrganizationVariableInfo
+	// Set sets high-level desired state for this variable. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(OrganizationVariableInfo) error
+}
+
+// Environment represents a deployment environment of a repository.
+type Environment interface {
+	// Environment implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The environment can be updated.
+	Updatable
+	// The environment can be reconciled.
+	Reconcilable
+	// The environment can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this environment.
+	Get() EnvironmentInfo
+	// Set sets high-level desired state for this environment. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(EnvironmentInfo) error
+}
+
+// Mirror represents a push or pull mirror of a repository.
+type Mirror interface {
+	// Mirror implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The mirror can be updated.
+	Updatable
+	// The mirror can be reconciled.
+	Reconcilable
+	// The mirror can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this mirror.
+	Get() MirrorInfo
+	// Set sets high-level desired state for this mirror. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(MirrorInfo) error
+}
+
+// Deployment represents a deployment of a ref of a repository to an environment.
+type Deployment interface {
+	// Deployment implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this deployment.
+	Get() DeploymentInfo
+
+	// CreateStatus reports the given state of the deployment back to the provider.
+	CreateStatus(ctx context.Context, req DeploymentStatusInfo) error
+	// ListStatuses lists the states reported for this deployment, most recent first.
+	// Providers that only keep the current state return a single status.
+	ListStatuses(ctx context.Context) ([]DeploymentStatusInfo, error)
+}
+
 // TeamAccess describes a binding between a repository and a team.
 type TeamAccess interface {
 	// TeamAccess implements the Object interface,
@@ -165,6 +475,28 @@ type TeamAccess interface {
 	Set(TeamAccessInfo) error
 }
 
+// Collaborator describes an individual user's access to a repository, or a pending
+// invitation to get that access.
+type Collaborator interface {
+	// Collaborator implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The collaborator can be updated.
+	Updatable
+	// The collaborator can be reconciled.
+	Reconcilable
+	// The collaborator can be removed from the repository, or the invitation withdrawn.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this collaborator.
+	Get() CollaboratorInfo
+	// Set sets high-level desired state for this collaborator. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(CollaboratorInfo) error
+}
+
 // Commit represents a git commit.
 type Commit interface {
 	// Object implements the Object interface,
@@ -196,3 +528,196 @@ type Tree interface {
 	// List files (blob) in a tree
 	List() TreeEntry
 }
+
+// Schedule represents a pipeline that periodically runs on a branch of a repository.
+type Schedule interface {
+	// Schedule implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The schedule can be updated.
+	Updatable
+	// The schedule can be reconciled.
+	Reconcilable
+	// The schedule can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this schedule.
+	Get() ScheduleInfo
+	// Set sets high-level desired state for this schedule. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(ScheduleInfo) error
+}
+
+// Bot represents a bot user of an organization or repository, which automation can
+// authenticate as.
+type Bot interface {
+	// Bot implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The bot can be deleted, which revokes its tokens.
+	Deletable
+
+	// Get returns high-level information about this bot.
+	Get() BotInfo
+}
+
+// Issue represents an issue of a repository.
+type Issue interface {
+	// Issue implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The issue can be updated.
+	Updatable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this issue.
+	Get() IssueInfo
+	// Set sets high-level desired state for this issue. In order to apply these changes in
+	// the Git provider, run .Update().
+	Set(IssueInfo) error
+
+	// Close closes the issue, if it's still open.
+	Close(ctx context.Context) error
+
+	// Comments gives access to the comments of this issue.
+	Comments() CommentClient
+}
+
+// Autolink represents an autolink reference of a repository.
+type Autolink interface {
+	// Autolink implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The autolink can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this autolink.
+	Get() AutolinkInfo
+}
+
+// Milestone represents a milestone of a repository or organization.
+type Milestone interface {
+	// Milestone implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The milestone can be updated.
+	Updatable
+
+	// Get returns high-level information about this milestone.
+	Get() MilestoneInfo
+	// Set sets high-level desired state for this milestone. In order to apply these changes in
+	// the Git provider, run .Update().
+	Set(MilestoneInfo) error
+
+	// Close closes the milestone, if it's still open.
+	Close(ctx context.Context) error
+}
+
+// Label represents an issue label of a repository or organization.
+type Label interface {
+	// Label implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The label can be updated.
+	Updatable
+	// The label can be reconciled.
+	Reconcilable
+	// The label can be deleted.
+	Deletable
+
+	// Get returns high-level information about this label.
+	Get() LabelInfo
+	// Set sets high-level desired state for this label. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile(). Changing the name and running .Update()
+	// renames the label.
+	Set(LabelInfo) error
+}
+
+// Comment represents a comment on an issue.
+type Comment interface {
+	// Comment implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The comment can be updated.
+	Updatable
+	// The comment can be deleted.
+	Deletable
+
+	// Get returns high-level information about this comment.
+	Get() CommentInfo
+	// Set sets high-level desired state for this comment. In order to apply these changes in
+	// the Git provider, run .Update().
+	Set(CommentInfo) error
+}
+
+// GPGKey represents a GPG key of the authenticated user.
+type GPGKey interface {
+	// GPGKey implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The GPG key can be deleted.
+	Deletable
+
+	// Get returns high-level information about this GPG key.
+	Get() GPGKeyInfo
+}
+
+// SSHKey represents an SSH key of the authenticated user.
+type SSHKey interface {
+	// SSHKey implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The SSH key can be deleted.
+	Deletable
+
+	// Get returns high-level information about this SSH key.
+	Get() SSHKeyInfo
+}
+
+// Package represents a package or container image published from a repository.
+type Package interface {
+	// Package implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this package.
+	Get() PackageInfo
+
+	// Versions lists the published versions of this package,
+	// using multiple paginated requests if needed.
+	Versions(ctx context.Context) ([]PackageVersion, error)
+}
+
+// PackageVersion represents a published version of a package.
+type PackageVersion interface {
+	// PackageVersion implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The package version can be deleted.
+	Deletable
+
+	// Get returns high-level information about this package version.
+	Get() PackageVersionInfo
+}
+
+// Pipeline represents a CI/CD pipeline run of a repository.
+type Pipeline interface {
+	// Pipeline implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this pipeline.
+	Get() PipelineInfo
+
+	// Jobs lists the jobs of this pipeline, using multiple paginated requests if needed.
+	Jobs(ctx context.Context) ([]PipelineJobInfo, error)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// defaultRetryableStatusCodes are the status codes of transient server errors.
+//
+//nolint:gochecknoglobals
+var defaultRetryableStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// RetryPolicy configures how a Client retries requests failing with transient errors, i.e.
+// connection errors and the RetryableStatusCodes.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of a request, including the first one. Default: 3.
+	MaxAttempts int
+	// MinBackoff is the backoff before the first retry, which doubles for every further retry.
+	// Default: 500ms.
+	MinBackoff time.Duration
+	// MaxBackoff caps the backoff between attempts. Default: 30s.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the status codes of responses which are retried.
+	// Default: 502, 503 and 504.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent enables retrying POST and PATCH requests, which may be applied twice if
+	// the server processed the failed attempt. Requests with an Idempotency-Key header are
+	// always retried.
+	RetryNonIdempotent bool
+}
+
+// WithRetryPolicy retries requests failing with transient errors with an exponential backoff and
+// jitter. Only idempotent requests are retried by default, see RetryPolicy.RetryNonIdempotent.
+// The Retry-After header of 503 Service Unavailable responses is honoured instead of the backoff,
+// and the response is returned if it asks to wait longer than MaxBackoff.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	if policy.MaxAttempts < 0 || policy.MinBackoff < 0 || policy.MaxBackoff < 0 {
+		return optionError(fmt.Errorf("MaxAttempts, MinBackoff and MaxBackoff cannot be negative: %w", ErrInvalidClientOptions))
+	}
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if policy.MinBackoff == 0 {
+		policy.MinBackoff = defaultRetryMinBackoff
+	}
+	if policy.MaxBackoff == 0 {
+		policy.MaxBackoff = defaultRetryMaxBackoff
+	}
+	if policy.RetryableStatusCodes == nil {
+		policy.RetryableStatusCodes = defaultRetryableStatusCodes
+	}
+	return &ClientOptions{retryPolicy: &policy}
+}
+
+// retryTransport retries requests according to policy.
+type retryTransport struct {
+	policy RetryPolicy
+	log    logr.Logger
+	base   http.RoundTripper
+}
+
+// retryChainTransport returns the ChainableRoundTripperFunc of retryTransport.
+func (opts *ClientOptions) retryChainTransport(in http.RoundTripper) http.RoundTripper {
+	if in == nil {
+		in = http.DefaultTransport
+	}
+	return &retryTransport{policy: *opts.retryPolicy, log: opts.GetLogger(), base: in}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	canRetry := t.retryable(req) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+	for attempt := 1; ; attempt++ {
+		res, err := t.base.RoundTrip(req)
+		if !canRetry || attempt >= t.policy.MaxAttempts || !t.shouldRetry(req.Context(), res, err) {
+			return res, err
+		}
+		backoff := t.backoff(attempt)
+		if res != nil && res.StatusCode == http.StatusServiceUnavailable {
+			if wait, ok := retryAfter(res, time.Now()); ok {
+				if wait > t.policy.MaxBackoff {
+					return res, err
+				}
+				backoff = wait
+			}
+		}
+
+		retry := req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return res, err
+			}
+			retry.Body = body
+		}
+		if res != nil {
+			// Drain the body, so that the connection can be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		t.log.V(1).Info("retrying request", "url", req.URL.String(), "attempt", attempt, "backoff", backoff, "error", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = retry
+	}
+}
+
+// retryable returns whether req may be sent again.
+func (t *retryTransport) retryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch:
+		return t.policy.RetryNonIdempotent || req.Header.Get("Idempotency-Key") != ""
+	}
+	return true
+}
+
+// shouldRetry returns whether the attempt failed with a transient error.
+func (t *retryTransport) shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if err != nil {
+		// Don't retry requests which were canceled, or timed out as a whole
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	for _, code := range t.policy.RetryableStatusCodes {
+		if res.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the backoff after the given attempt: MinBackoff doubled for every attempt,
+// capped at MaxBackoff, of which up to a half is randomized to spread out the retries of clients.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	backoff := t.policy.MaxBackoff
+	if attempt < 32 {
+		if exp := t.policy.MinBackoff << (attempt - 1); exp > 0 && exp < backoff {
+			backoff = exp
+		}
+	}
+	half := backoff / 2
+	//nolint:gosec // The jitter doesn't need a cryptographically secure source
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithRetryPolicy(t *testing.T) {
+	var requests int
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		// Fail the first two attempts
+		if requests <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	newClient := func(policy RetryPolicy) *http.Client {
+		opts, err := MakeClientOptions(WithRetryPolicy(policy))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	do := func(c *http.Client, method string, header http.Header) int {
+		t.Helper()
+		requests, bodies = 0, nil
+		req, _ := http.NewRequest(method, srv.URL, strings.NewReader("payload"))
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	fast := RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	if status := do(newClient(fast), http.MethodPut, nil); status != http.StatusOK || requests != 3 {
+		t.Errorf("PUT got status %d after %d requests, want 200 after 3", status, requests)
+	}
+	if bodies[2] != "payload" {
+		t.Errorf("retried body = %q, want the replayed body", bodies[2])
+	}
+	if status := do(newClient(fast), http.MethodPost, nil); status != http.StatusServiceUnavailable || requests != 1 {
+		t.Errorf("POST got status %d after %d requests, want no retry", status, requests)
+	}
+	if status := do(newClient(fast), http.MethodPost, http.Header{"Idempotency-Key": {"key"}}); status != http.StatusOK || requests != 3 {
+		t.Errorf("POST with Idempotency-Key got status %d after %d requests, want 200 after 3", status, requests)
+	}
+	nonIdempotent := fast
+	nonIdempotent.RetryNonIdempotent = true
+	if status := do(newClient(nonIdempotent), http.MethodPost, nil); status != http.StatusOK || requests != 3 {
+		t.Errorf("POST got status %d after %d requests, want 200 after 3", status, requests)
+	}
+	limited := fast
+	limited.MaxAttempts = 2
+	if status := do(newClient(limited), http.MethodGet, nil); status != http.StatusServiceUnavailable || requests != 2 {
+		t.Errorf("GET got status %d after %d requests, want 503 after 2", status, requests)
+	}
+	other := fast
+	other.RetryableStatusCodes = []int{http.StatusBadGateway}
+	if status := do(newClient(other), http.MethodGet, nil); status != http.StatusServiceUnavailable || requests != 1 {
+		t.Errorf("GET got status %d after %d requests, want no retry", status, requests)
+	}
+
+	if _, err := MakeClientOptions(WithRetryPolicy(RetryPolicy{MaxAttempts: -1})); !errors.Is(err, ErrInvalidClientOptions) {
+		t.Errorf("WithRetryPolicy() error = %v, want %v", err, ErrInvalidClientOptions)
+	}
+}
+
+func TestWithRetryPolicy_RetryAfter(t *testing.T) {
+	var auth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if len(auth) == 1 {
+			w.Header().Set("Retry-After", r.URL.Query().Get("retry-after"))
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	var tokens int
+	opts, err := MakeClientOptions(
+		// The backoff would exceed the test timeout, if Retry-After wasn't honoured
+		WithRetryPolicy(RetryPolicy{MinBackoff: time.Hour, MaxBackoff: time.Hour}),
+		WithAuthHeader("Authorization", func(*http.Request) (string, error) {
+			tokens++
+			return fmt.Sprintf("token-%d", tokens), nil
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every attempt is authenticated again
+	resp, err := c.Get(srv.URL + "?retry-after=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if want := []string{"token-1", "token-2"}; resp.StatusCode != http.StatusOK || !reflect.DeepEqual(auth, want) {
+		t.Errorf("got status %d with Authorization headers %q, want 200 with %q", resp.StatusCode, auth, want)
+	}
+
+	// Responses asking to wait longer than MaxBackoff are returned
+	auth = nil
+	resp, err = c.Get(srv.URL + "?retry-after=7200")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || len(auth) != 1 {
+		t.Errorf("got status %d after %d requests, want no retry", resp.StatusCode, len(auth))
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tr := &retryTransport{policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}}
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 5: 10 * time.Second, 64: 10 * time.Second} {
+		if got := tr.backoff(attempt); got < want/2 || got > want {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, got, want/2, want)
+		}
+	}
+}
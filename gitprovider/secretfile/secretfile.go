@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretfile provides a gitprovider.TokenSource reading the token from a file, e.g. a
+// key of a Kubernetes Secret mounted as a volume. The file is re-read periodically, so a
+// long-running controller picks up the token once the kubelet updates the mounted Secret:
+//
+//	src, err := secretfile.New("/etc/git-credentials/token", 0)
+//	if err != nil {
+//		return err
+//	}
+//	c, err := github.NewClient(gitprovider.WithTokenSource(src.Token))
+//
+// Controllers watching Secrets through the Kubernetes API can pass the token of the updated
+// Secret to a gitprovider.RotatableToken instead.
+package secretfile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// DefaultInterval is how often the file is re-read by default. The kubelet updates mounted
+// Secrets within about a minute.
+const DefaultInterval = 10 * time.Second
+
+// Source reads the token from a file, and re-reads it at most once per interval, when the
+// token is requested. It's safe for concurrent use.
+type Source struct {
+	path     string
+	interval time.Duration
+	// now returns the current time, and can be replaced in tests
+	now func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	checkedAt time.Time
+}
+
+// Source.Token implements gitprovider.TokenSource.
+var _ gitprovider.TokenSource = (&Source{}).Token
+
+// New reads the token from the file at path, and returns a Source re-reading it every
+// interval, or every DefaultInterval if interval is zero. Whitespace around the token, e.g. a
+// trailing newline, is trimmed. An error is returned if the file can't be read or is empty.
+func New(path string, interval time.Duration) (*Source, error) {
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	token, err := readToken(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Source{
+		path:      path,
+		interval:  interval,
+		now:       time.Now,
+		token:     token,
+		checkedAt: time.Now(),
+	}, nil
+}
+
+// Token returns the current token, implementing gitprovider.TokenSource. If the file can't be
+// read, e.g. while it's being replaced, or is empty, the last token is returned.
+func (s *Source) Token(_ context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now := s.now(); now.Sub(s.checkedAt) >= s.interval {
+		s.checkedAt = now
+		if token, err := readToken(s.path); err == nil {
+			s.token = token
+		}
+	}
+	return s.token, nil
+}
+
+// readToken reads the token from the file at path.
+func readToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %q is empty", path)
+	}
+	return token, nil
+}
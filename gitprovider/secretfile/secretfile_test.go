@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretfile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	writeToken := func(token string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(token), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertToken := func(s *Source, want string) {
+		t.Helper()
+		got, err := s.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("Token() = %q, want %q", got, want)
+		}
+	}
+
+	if _, err := New(path, 0); err == nil {
+		t.Error("New() with a missing file succeeded, want an error")
+	}
+	writeToken("\n")
+	if _, err := New(path, 0); err == nil {
+		t.Error("New() with an empty file succeeded, want an error")
+	}
+
+	writeToken("first\n")
+	s, err := New(path, time.Minute)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	assertToken(s, "first")
+
+	// The file isn't re-read before the interval passed
+	writeToken("second")
+	assertToken(s, "first")
+	now = now.Add(time.Minute)
+	assertToken(s, "second")
+
+	// The last token is kept while the file is missing, e.g. while the kubelet replaces it
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Minute)
+	assertToken(s, "second")
+	writeToken("third")
+	now = now.Add(time.Minute)
+	assertToken(s, "third")
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import "strings"
+
+// signatureArmorTypes maps the armor header lines of signatures to their type.
+//
+//nolint:gochecknoglobals
+var signatureArmorTypes = map[string]SignatureType{
+	"-----BEGIN PGP SIGNATURE-----":  SignatureTypeGPG,
+	"-----BEGIN SSH SIGNATURE-----":  SignatureTypeSSH,
+	"-----BEGIN SIGNED MESSAGE-----": SignatureTypeX509,
+}
+
+// DetectSignatureType returns the type of the given ASCII-armored signature, as returned by
+// providers that don't tell the type, or nil if it's unknown.
+func DetectSignatureType(signature string) *SignatureType {
+	header, _, _ := strings.Cut(strings.TrimSpace(signature), "\n")
+	if t, ok := signatureArmorTypes[strings.TrimSpace(header)]; ok {
+		return SignatureTypeVar(t)
+	}
+	return nil
+}
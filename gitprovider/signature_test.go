@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import "testing"
+
+func TestDetectSignatureType(t *testing.T) {
+	tests := []struct {
+		name      string
+		signature string
+		want      *SignatureType
+	}{
+		{
+			name:      "gpg",
+			signature: "-----BEGIN PGP SIGNATURE-----\n\niQEzBAABCAAdFiEE\n-----END PGP SIGNATURE-----\n",
+			want:      SignatureTypeVar(SignatureTypeGPG),
+		},
+		{
+			name:      "ssh, with CRLF",
+			signature: "-----BEGIN SSH SIGNATURE-----\r\nU1NIU0lH\r\n-----END SSH SIGNATURE-----",
+			want:      SignatureTypeVar(SignatureTypeSSH),
+		},
+		{
+			name:      "x509",
+			signature: "\n-----BEGIN SIGNED MESSAGE-----\nMIAGCSqGSIb3\n-----END SIGNED MESSAGE-----",
+			want:      SignatureTypeVar(SignatureTypeX509),
+		},
+		{
+			name: "unsigned",
+		},
+		{
+			name:      "unknown",
+			signature: "-----BEGIN SOMETHING-----",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectSignatureType(tt.signature)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("DetectSignatureType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat = "20060102T150405Z"
+)
+
+// AWSCredentials are the credentials used for AWS Signature Version 4 signing.
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set for temporary credentials, e.g. of an assumed role.
+	SessionToken string
+}
+
+// AWSCredentialsProvider returns the current AWS credentials. It's called for every request, so
+// implementations should cache temporary credentials until they expire, e.g. by adapting the
+// Retrieve method of an aws.CredentialsCache of the AWS SDK.
+type AWSCredentialsProvider func(ctx context.Context) (AWSCredentials, error)
+
+// WithSigV4 signs requests with AWS Signature Version 4 for the given region and service, e.g.
+// "execute-api" for API gateways with IAM authorization, instead of authenticating them with a
+// token.
+func WithSigV4(region, service string, credentials AWSCredentialsProvider) ClientOption {
+	if region == "" || service == "" {
+		return optionError(fmt.Errorf("region and service cannot be empty: %w", ErrInvalidClientOptions))
+	}
+	if credentials == nil {
+		return optionError(fmt.Errorf("credentials cannot be nil: %w", ErrInvalidClientOptions))
+	}
+	return &ClientOptions{authTransport: func(in http.RoundTripper) http.RoundTripper {
+		if in == nil {
+			in = http.DefaultTransport
+		}
+		return &sameHostAuthTransport{auth: &sigV4Transport{region: region, service: service, credentials: credentials, base: in, now: time.Now}, base: in}
+	}}
+}
+
+// sigV4Transport signs every request with the credentials returned by credentials.
+type sigV4Transport struct {
+	region      string
+	service     string
+	credentials AWSCredentialsProvider
+	base        http.RoundTripper
+	now         func() time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *sigV4Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	creds, err := t.credentials(req.Context())
+	var body []byte
+	if err == nil && req.Body != nil && req.Body != http.NoBody {
+		// The payload is part of the signature
+		body, err = io.ReadAll(req.Body)
+	}
+	if req.Body != nil {
+		// A RoundTripper must always close the body
+		_ = req.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	// A RoundTripper must not modify the given request
+	req = req.Clone(req.Context())
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	}
+	t.sign(req, body, creds, t.now().UTC())
+	return t.base.RoundTrip(req)
+}
+
+// sign sets the X-Amz-Date, X-Amz-Security-Token and Authorization headers of req.
+func (t *sigV4Transport) sign(req *http.Request, body []byte, creds AWSCredentials, now time.Time) {
+	amzDate := now.Format(sigV4TimeFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	canonicalHeaders, signedHeaders := sigV4CanonicalHeaders(req)
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		sigV4CanonicalURI(req.URL.EscapedPath(), t.service),
+		sigV4CanonicalQuery(req),
+		canonicalHeaders,
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := strings.Join([]string{amzDate[:8], t.region, t.service, "aws4_request"}, "/")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := []byte("AWS4" + creds.SecretAccessKey)
+	for _, part := range []string{amzDate[:8], t.region, t.service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// sigV4CanonicalHeaders returns the canonical headers and the list of signed headers. The host,
+// content type and all X-Amz-* headers are signed.
+func sigV4CanonicalHeaders(req *http.Request) (canonical, signed string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name != "content-type" && !strings.HasPrefix(name, "x-amz-") {
+			continue
+		}
+		trimmed := make([]string, 0, len(values))
+		for _, v := range values {
+			trimmed = append(trimmed, strings.Join(strings.Fields(v), " "))
+		}
+		headers[name] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return b.String(), strings.Join(names, ";")
+}
+
+// sigV4CanonicalURI returns the canonical URI of the escaped path. Path segments are encoded
+// twice for all services but S3.
+func sigV4CanonicalURI(path, service string) string {
+	if path == "" {
+		return "/"
+	}
+	if service == "s3" {
+		return path
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = sigV4Escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// sigV4CanonicalQuery returns the canonical query string, sorted by key and value.
+func sigV4CanonicalQuery(req *http.Request) string {
+	var params [][2]string
+	for key, values := range req.URL.Query() {
+		for _, v := range values {
+			params = append(params, [2]string{sigV4Escape(key), sigV4Escape(v)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+	pairs := make([]string, 0, len(params))
+	for _, p := range params {
+		pairs = append(pairs, p[0]+"="+p[1])
+	}
+	return strings.Join(pairs, "&")
+}
+
+// sigV4Escape percent-encodes all but the unreserved characters of RFC 3986.
+func sigV4Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-_.~", c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultSSHPort is the port of SSH endpoints which don't specify one.
+const defaultSSHPort = 22
+
+// SSHEndpoint is the SSH endpoint to clone a repository from, as reported by the provider.
+// Unlike the URLs of GetCloneURL, it includes the SSH port and user name the instance is
+// configured with, which may differ from the domain of the API.
+type SSHEndpoint struct {
+	// User is the SSH user name, e.g. "git".
+	User string `json:"user"`
+	// Host is the SSH host name.
+	Host string `json:"host"`
+	// Port is the SSH port, 22 unless the instance uses a custom one.
+	Port int `json:"port"`
+	// Path is the path of the repository on the host, e.g. "org/repo.git".
+	Path string `json:"path"`
+}
+
+// URL returns the ssh:// URL of the endpoint, e.g. "ssh://git@example.com:7999/org/repo.git".
+// The port is omitted if it's the default one.
+func (e SSHEndpoint) URL() string {
+	host := e.Host
+	if e.Port != 0 && e.Port != defaultSSHPort {
+		host = net.JoinHostPort(e.Host, strconv.Itoa(e.Port))
+	}
+	if e.User != "" {
+		host = e.User + "@" + host
+	}
+	return fmt.Sprintf("ssh://%s/%s", host, e.Path)
+}
+
+// KnownHostsHost returns the host as it's written in known_hosts files, i.e. "host" for the
+// default port, and "[host]:port" otherwise.
+func (e SSHEndpoint) KnownHostsHost() string {
+	return knownHostsHost(e.Host, e.Port)
+}
+
+// ParseSSHEndpoint parses an SSH clone URL, either of the form
+// ssh://[<user>@]<host>[:<port>]/<path>, or the scp-like form [<user>@]<host>:<path>.
+func ParseSSHEndpoint(sshURL string) (SSHEndpoint, error) {
+	if strings.HasPrefix(sshURL, "ssh://") {
+		u, err := url.Parse(sshURL)
+		if err != nil || u.Hostname() == "" {
+			return SSHEndpoint{}, fmt.Errorf("%q: %w", sshURL, ErrURLInvalid)
+		}
+		e := SSHEndpoint{Host: u.Hostname(), Port: defaultSSHPort, Path: strings.TrimPrefix(u.Path, "/")}
+		if u.User != nil {
+			e.User = u.User.Username()
+		}
+		if p := u.Port(); p != "" {
+			if e.Port, err = strconv.Atoi(p); err != nil {
+				return SSHEndpoint{}, fmt.Errorf("%q: %w", sshURL, ErrURLInvalid)
+			}
+		}
+		return e, nil
+	}
+
+	userHost, path, ok := strings.Cut(sshURL, ":")
+	if !ok || strings.Contains(userHost, "/") || path == "" || strings.HasPrefix(path, "//") {
+		return SSHEndpoint{}, fmt.Errorf("%q: %w", sshURL, ErrURLInvalid)
+	}
+	e := SSHEndpoint{Host: userHost, Port: defaultSSHPort, Path: strings.TrimPrefix(path, "/")}
+	if user, host, ok := strings.Cut(userHost, "@"); ok {
+		e.User, e.Host = user, host
+	}
+	if e.Host == "" {
+		return SSHEndpoint{}, fmt.Errorf("%q: %w", sshURL, ErrURLInvalid)
+	}
+	return e, nil
+}
+
+// GetSSHEndpoint parses the SSH clone URL reported by the provider, or falls back to the SSH
+// clone URL of ref if the provider didn't report one.
+func GetSSHEndpoint(sshURL string, ref RepositoryRef) (SSHEndpoint, error) {
+	if sshURL == "" {
+		sshURL = ref.GetCloneURL(TransportTypeSSH)
+	}
+	return ParseSSHEndpoint(sshURL)
+}
+
+// SSHHostKey is a public SSH host key of an instance, which can be added to known_hosts files.
+type SSHHostKey struct {
+	// Host is the host as written in known_hosts files, e.g. "github.com" or "[example.com]:7999".
+	Host string `json:"host"`
+	// Key is the public key in the authorized_keys format, e.g. "ssh-ed25519 AAAAC3Nza...".
+	Key string `json:"key"`
+	// Fingerprint is the SHA256 fingerprint of the key, e.g. "SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU".
+	Fingerprint string `json:"fingerprint"`
+}
+
+// KnownHostsLine returns the line for known_hosts files.
+func (k SSHHostKey) KnownHostsLine() string {
+	return k.Host + " " + k.Key
+}
+
+// NewSSHHostKey returns the SSHHostKey of a public key in the authorized_keys format for the
+// given host and port, computing its fingerprint.
+func NewSSHHostKey(host string, port int, key string) (SSHHostKey, error) {
+	fingerprint, err := SSHFingerprint(key)
+	if err != nil {
+		return SSHHostKey{}, err
+	}
+	return SSHHostKey{Host: knownHostsHost(host, port), Key: strings.TrimSpace(key), Fingerprint: fingerprint}, nil
+}
+
+// SSHFingerprint returns the SHA256 fingerprint of a public key in the authorized_keys format,
+// as printed by ssh-keygen -l.
+func SSHFingerprint(key string) (string, error) {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("invalid public key %q: %w", key, ErrInvalidArgument)
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid public key %q: %w", key, ErrInvalidArgument)
+	}
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+func knownHostsHost(host string, port int) string {
+	if port == 0 || port == defaultSSHPort {
+		return host
+	}
+	return fmt.Sprintf("[%s]:%d", host, port)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseSSHEndpoint(t *testing.T) {
+	tests := []struct {
+		name          string
+		sshURL        string
+		want          SSHEndpoint
+		wantURL       string
+		wantKnownHost string
+		expectedErr   error
+	}{
+		{
+			name:          "scp-like",
+			sshURL:        "git@github.com:fluxcd/flux2.git",
+			want:          SSHEndpoint{User: "git", Host: "github.com", Port: 22, Path: "fluxcd/flux2.git"},
+			wantURL:       "ssh://git@github.com/fluxcd/flux2.git",
+			wantKnownHost: "github.com",
+		},
+		{
+			name:          "custom port and user",
+			sshURL:        "ssh://stash@stash.example.com:7999/prj/repo.git",
+			want:          SSHEndpoint{User: "stash", Host: "stash.example.com", Port: 7999, Path: "prj/repo.git"},
+			wantURL:       "ssh://stash@stash.example.com:7999/prj/repo.git",
+			wantKnownHost: "[stash.example.com]:7999",
+		},
+		{
+			name:          "no user",
+			sshURL:        "ssh://gitlab.example.com/group/sub/project.git",
+			want:          SSHEndpoint{Host: "gitlab.example.com", Port: 22, Path: "group/sub/project.git"},
+			wantURL:       "ssh://gitlab.example.com/group/sub/project.git",
+			wantKnownHost: "gitlab.example.com",
+		},
+		{
+			name:        "https",
+			sshURL:      "https://github.com/fluxcd/flux2.git",
+			expectedErr: ErrURLInvalid,
+		},
+		{
+			name:        "no host",
+			sshURL:      "git@:fluxcd/flux2.git",
+			expectedErr: ErrURLInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSSHEndpoint(tt.sshURL)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("ParseSSHEndpoint() error = %v, want %v", err, tt.expectedErr)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseSSHEndpoint() = %+v, want %+v", got, tt.want)
+			}
+			if got.URL() != tt.wantURL {
+				t.Errorf("URL() = %q, want %q", got.URL(), tt.wantURL)
+			}
+			if got.KnownHostsHost() != tt.wantKnownHost {
+				t.Errorf("KnownHostsHost() = %q, want %q", got.KnownHostsHost(), tt.wantKnownHost)
+			}
+		})
+	}
+}
+
+func TestGetSSHEndpoint(t *testing.T) {
+	ref := newOrgRepoRef("my-gitlab.com", "luxas", []string{"test-org"}, "foo-bar")
+	got, err := GetSSHEndpoint("", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "ssh://git@my-gitlab.com/luxas/test-org/foo-bar"; got.URL() != want {
+		t.Errorf("GetSSHEndpoint() = %q, want the clone URL %q", got.URL(), want)
+	}
+	got, err = GetSSHEndpoint("ssh://git@ssh.my-gitlab.com:2222/luxas/test-org/foo-bar.git", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Host != "ssh.my-gitlab.com" || got.Port != 2222 {
+		t.Errorf("GetSSHEndpoint() = %+v, want the reported endpoint", got)
+	}
+}
+
+func TestNewSSHHostKey(t *testing.T) {
+	// The ed25519 host key of github.com, and its published fingerprint
+	key := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+	got, err := NewSSHHostKey("github.com", 22, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU"; got.Fingerprint != want {
+		t.Errorf("Fingerprint = %q, want %q", got.Fingerprint, want)
+	}
+	if want := "github.com " + key; got.KnownHostsLine() != want {
+		t.Errorf("KnownHostsLine() = %q, want %q", got.KnownHostsLine(), want)
+	}
+	got, err = NewSSHHostKey("stash.example.com", 7999, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Host != "[stash.example.com]:7999" {
+		t.Errorf("Host = %q, want the bracketed host and port", got.Host)
+	}
+	if _, err := NewSSHHostKey("github.com", 22, "ssh-ed25519 !!!"); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("NewSSHHostKey() error = %v, want %v", err, ErrInvalidArgument)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+// APIVersion is the version of the stable interfaces in this package. Within an API version,
+// interfaces and types only change in backwards-compatible ways: methods and fields are only
+// removed after having been marked with a "Deprecated:" paragraph in their doc comment for at
+// least one minor release, pointing to their replacement.
+//
+// Clients for new subsystems can start out in the experimental package, see Stability.
+const APIVersion = "v1"
+
+// Stability describes the compatibility guarantees of a part of the API.
+type Stability string
+
+const (
+	// StabilityStable means that the API follows the compatibility guarantees of APIVersion.
+	StabilityStable = Stability("stable")
+
+	// StabilityExperimental means that the API may change or be removed in any minor release.
+	// Experimental client interfaces embed experimental.API, and are accessed through functions
+	// in the experimental package instead of methods of the stable interfaces, so that adding
+	// or changing them never breaks implementations of the stable interfaces.
+	StabilityExperimental = Stability("experimental")
+
+	// StabilityDeprecated means that the API is scheduled for removal in the next APIVersion.
+	StabilityDeprecated = Stability("deprecated")
+)
@@ -56,6 +56,91 @@ func TestDefaulting(t *testing.T) {
 				ReadOnly: BoolVar(false),
 			},
 		},
+		{
+			name:       "DeployToken: empty",
+			structName: "DeployToken",
+			object:     &DeployTokenInfo{},
+			expected: &DeployTokenInfo{
+				Scopes: []DeployTokenScope{DeployTokenScopeReadRepository},
+			},
+		},
+		{
+			name:       "DeployToken: don't set if non-empty",
+			structName: "DeployToken",
+			object: &DeployTokenInfo{
+				Scopes: []DeployTokenScope{DeployTokenScopeReadRegistry},
+			},
+			expected: &DeployTokenInfo{
+				Scopes: []DeployTokenScope{DeployTokenScopeReadRegistry},
+			},
+		},
+		{
+			name:       "Variable: empty",
+			structName: "Variable",
+			object:     &VariableInfo{},
+			expected: &VariableInfo{
+				Masked:    BoolVar(false),
+				Protected: BoolVar(false),
+			},
+		},
+		{
+			name:       "Variable: don't set if non-nil (non-default)",
+			structName: "Variable",
+			object: &VariableInfo{
+				Masked:    BoolVar(true),
+				Protected: BoolVar(true),
+			},
+			expected: &VariableInfo{
+				Masked:    BoolVar(true),
+				Protected: BoolVar(true),
+			},
+		},
+		{
+			name:       "OrganizationVariable: empty",
+			structName: "OrganizationVariable",
+			object:     &OrganizationVariableInfo{},
+			expected: &OrganizationVariableInfo{
+				Masked:     BoolVar(false),
+				Protected:  BoolVar(false),
+				Visibility: VariableVisibilityVar(VariableVisibilityAll),
+			},
+		},
+		{
+			name:       "AccessToken: empty",
+			structName: "AccessToken",
+			object:     &AccessTokenInfo{},
+			expected: &AccessTokenInfo{
+				Scopes: []string{"read_repository"},
+			},
+		},
+		{
+			name:       "IPAllowListEntry: empty",
+			structName: "IPAllowListEntry",
+			object:     &IPAllowListEntryInfo{},
+			expected: &IPAllowListEntryInfo{
+				Active: BoolVar(true),
+			},
+		},
+		{
+			name:       "User: empty",
+			structName: "User",
+			object: &UserInfo{
+				Username: "ci-bot",
+			},
+			expected: &UserInfo{
+				Username: "ci-bot",
+				Name:     StringVar("ci-bot"),
+				Admin:    BoolVar(false),
+			},
+		},
+		{
+			name:       "ImpersonationToken: empty",
+			structName: "ImpersonationToken",
+			object:     &ImpersonationTokenInfo{},
+			expected: &ImpersonationTokenInfo{
+				Scopes: []string{"api"},
+			},
+		},
 		{
 			name:       "Repository: empty",
 			structName: "Repository",
@@ -117,6 +202,25 @@ func TestDefaulting(t *testing.T) {
 				Permission: RepositoryPermissionVar(RepositoryPermissionPush),
 			},
 		},
+		{
+			name:       "Collaborator: empty",
+			structName: "Collaborator",
+			object:     &CollaboratorInfo{Username: "jdoe"},
+			expected: &CollaboratorInfo{
+				Username:   "jdoe",
+				Permission: RepositoryPermissionVar(RepositoryPermissionPull),
+			},
+		},
+		{
+			name:       "Collaborator: don't set if non-nil",
+			structName: "Collaborator",
+			object: &CollaboratorInfo{
+				Permission: RepositoryPermissionVar(RepositoryPermissionMaintain),
+			},
+			expected: &CollaboratorInfo{
+				Permission: RepositoryPermissionVar(RepositoryPermissionMaintain),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -16,20 +16,516 @@ limitations under the License.
 
 package gitprovider
 
+import (
+	"net/netip"
+	"reflect"
+	"sort"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// OrganizationInfo implements InfoRequest.
+var _ InfoRequest = OrganizationInfo{}
+
 // OrganizationInfo represents an (top-level- or sub-) organization.
 type OrganizationInfo struct {
 	// Name is the human-friendly name of this organization, e.g. "Flux" or "Kubernetes SIGs".
+	// When creating an organization, it defaults to the last element of the OrganizationRef.
+	// +optional
 	Name *string `json:"name"`
 
 	// Description returns a description for the organization.
+	// +optional
 	Description *string `json:"description"`
+
+	// Visibility controls who can see the organization. GitHub organizations are always
+	// public, and Stash projects can't be internal.
+	// +optional
+	Visibility *RepositoryVisibility `json:"visibility"`
+
+	// DefaultRepositoryPermission is the base permission all members of the organization
+	// get on its repositories. Only GitHub supports this, with the pull, push and admin
+	// permissions.
+	// +optional
+	DefaultRepositoryPermission *RepositoryPermission `json:"defaultRepositoryPermission"`
+
+	// RequireTwoFactor requires all members of the organization to enable two-factor
+	// authentication. This can only be changed in GitLab, and is read-only in GitHub.
+	// +optional
+	RequireTwoFactor *bool `json:"requireTwoFactor"`
 }
 
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (o OrganizationInfo) ValidateInfo() error {
+	validator := validation.New("Organization")
+	if o.Name != nil && len(*o.Name) == 0 {
+		validator.Required("Name")
+	}
+	// Validate the Visibility enum
+	if o.Visibility != nil {
+		validator.Append(ValidateRepositoryVisibility(*o.Visibility), *o.Visibility, "Visibility")
+	}
+	// Validate the DefaultRepositoryPermission enum
+	if o.DefaultRepositoryPermission != nil {
+		validator.Append(ValidateRepositoryPermission(*o.DefaultRepositoryPermission), *o.DefaultRepositoryPermission, "DefaultRepositoryPermission")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. Fields which are unset in the desired state are not compared.
+func (o OrganizationInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(OrganizationInfo)
+	if !ok {
+		return false
+	}
+	if o.Name == nil {
+		a.Name = nil
+	}
+	if o.Description == nil {
+		a.Description = nil
+	}
+	if o.Visibility == nil {
+		a.Visibility = nil
+	}
+	if o.DefaultRepositoryPermission == nil {
+		a.DefaultRepositoryPermission = nil
+	}
+	if o.RequireTwoFactor == nil {
+		a.RequireTwoFactor = nil
+	}
+	return reflect.DeepEqual(o, a)
+}
+
+// TeamInfo implements InfoRequest.
+var _ InfoRequest = TeamInfo{}
+
 // TeamInfo is a representation for a team of users inside of an organization.
 type TeamInfo struct {
 	// Name describes the name of the team. The team name may contain slashes.
+	// +required
 	Name string `json:"name"`
 
+	// Description describes the purpose of the team.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
 	// Members points to a set of user names (logins) of the members of this team.
+	// This field is read-only, and is ignored when creating or updating a team.
+	// +optional
 	Members []string `json:"members"`
 }
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (t TeamInfo) ValidateInfo() error {
+	validator := validation.New("Team")
+	if len(t.Name) == 0 {
+		validator.Required("Name")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. Members are read-only and not compared, and a nil Description
+// in the desired state matches any actual Description.
+func (t TeamInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(TeamInfo)
+	if !ok {
+		return false
+	}
+	t.Members, a.Members = nil, nil
+	if t.Description == nil {
+		a.Description = nil
+	}
+	return reflect.DeepEqual(t, a)
+}
+
+// TeamMemberInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = TeamMemberInfo{}
+var _ DefaultedInfoRequest = &TeamMemberInfo{}
+
+// TeamMemberInfo contains high-level information about a user's membership of a team.
+type TeamMemberInfo struct {
+	// Username is the login of the user.
+	// +required
+	Username string `json:"username"`
+
+	// Role describes the role of the user within the team.
+	// Default: member.
+	// Available options: See the TeamRole enum.
+	// +optional
+	Role *TeamRole `json:"role,omitempty"`
+}
+
+// Default defaults the TeamMember fields.
+func (m *TeamMemberInfo) Default() {
+	if m.Role == nil {
+		m.Role = TeamRoleVar(TeamRoleMember)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (m TeamMemberInfo) ValidateInfo() error {
+	validator := validation.New("TeamMember")
+	if len(m.Username) == 0 {
+		validator.Required("Username")
+	}
+	if m.Role != nil {
+		validator.Append(ValidateTeamRole(*m.Role), *m.Role, "Role")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (m TeamMemberInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(m, actual)
+}
+
+// OrganizationMemberInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = OrganizationMemberInfo{}
+var _ DefaultedInfoRequest = &OrganizationMemberInfo{}
+
+// OrganizationMemberInfo contains high-level information about a user's membership of an organization.
+type OrganizationMemberInfo struct {
+	// Username is the login of the user.
+	// +required
+	Username string `json:"username"`
+
+	// Role describes the role of the user within the organization.
+	// Default: member.
+	// Available options: See the OrganizationRole enum.
+	// +optional
+	Role *OrganizationRole `json:"role,omitempty"`
+
+	// Pending is true if the user has been invited, but hasn't accepted the invitation yet.
+	// This field is read-only and set by the server.
+	// +optional
+	Pending bool `json:"pending,omitempty"`
+}
+
+// Default defaults the OrganizationMember fields.
+func (m *OrganizationMemberInfo) Default() {
+	if m.Role == nil {
+		m.Role = OrganizationRoleVar(OrganizationRoleMember)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (m OrganizationMemberInfo) ValidateInfo() error {
+	validator := validation.New("OrganizationMember")
+	if len(m.Username) == 0 {
+		validator.Required("Username")
+	}
+	if m.Role != nil {
+		validator.Append(ValidateOrganizationRole(*m.Role), *m.Role, "Role")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only Pending field is ignored.
+func (m OrganizationMemberInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(OrganizationMemberInfo)
+	if !ok {
+		return false
+	}
+	m.Pending, a.Pending = false, false
+	return reflect.DeepEqual(m, a)
+}
+
+// AuditEventInfo describes an event in the audit log of an organization. Audit events are
+// read-only.
+type AuditEventInfo struct {
+	// ID identifies the event within the audit log.
+	ID string `json:"id"`
+
+	// Action is the provider-specific kind of event, e.g. "repo.create" in GitHub or
+	// "member_created" in GitLab.
+	Action string `json:"action"`
+
+	// Actor is the user who performed the action, if known. This is the login of the user in
+	// GitHub, and the full name in GitLab.
+	// +optional
+	Actor string `json:"actor,omitempty"`
+
+	// Target describes the entity the action was performed on, if known.
+	// +optional
+	Target string `json:"target,omitempty"`
+
+	// CreatedAt is the time the event occurred.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SCIMUserInfo implements InfoRequest.
+var _ InfoRequest = SCIMUserInfo{}
+
+// SCIMUserInfo contains high-level information about a user provisioned through SCIM.
+type SCIMUserInfo struct {
+	// ID identifies the SCIM identity of the user.
+	// This field is read-only and set by the server.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// ExternalID is the ID of the user in the identity provider.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// UserName is the name of the user in the identity provider, e.g. an email address.
+	// +required
+	UserName string `json:"userName"`
+
+	// GivenName is the first name of the user.
+	// +optional
+	GivenName *string `json:"givenName,omitempty"`
+
+	// FamilyName is the last name of the user.
+	// +optional
+	FamilyName *string `json:"familyName,omitempty"`
+
+	// Emails are the email addresses of the user, the first one being the primary address.
+	// +optional
+	Emails []string `json:"emails,omitempty"`
+
+	// Active is false if the user has been deactivated in the identity provider.
+	// This field is read-only and set by the server.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (u SCIMUserInfo) ValidateInfo() error {
+	validator := validation.New("SCIMUser")
+	if len(u.UserName) == 0 {
+		validator.Required("UserName")
+	}
+	for _, email := range u.Emails {
+		if len(email) == 0 {
+			validator.Invalid(email, "Emails")
+		}
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID and Active fields are ignored.
+func (u SCIMUserInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(SCIMUserInfo)
+	if !ok {
+		return false
+	}
+	u.ID, a.ID = "", ""
+	u.Active, a.Active = nil, nil
+	return reflect.DeepEqual(u, a)
+}
+
+// SSHCertificateAuthorityInfo implements InfoRequest.
+var _ InfoRequest = SSHCertificateAuthorityInfo{}
+
+// SSHCertificateAuthorityInfo contains high-level information about an SSH certificate
+// authority of an organization. Members can authenticate with SSH certificates signed by it.
+type SSHCertificateAuthorityInfo struct {
+	// ID identifies the certificate authority within the organization.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id,omitempty"`
+
+	// Title is the human-friendly name of the certificate authority.
+	// +required
+	Title string `json:"title"`
+
+	// Key is the public key of the certificate authority in the authorized_keys format,
+	// e.g. "ssh-ed25519 AAAA...".
+	// +required
+	Key string `json:"key"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (c SSHCertificateAuthorityInfo) ValidateInfo() error {
+	validator := validation.New("SSHCertificateAuthority")
+	if len(c.Title) == 0 {
+		validator.Required("Title")
+	}
+	if len(c.Key) == 0 {
+		validator.Required("Key")
+	} else if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(c.Key)); err != nil {
+		validator.Invalid(c.Key, "Key")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID field is ignored.
+func (c SSHCertificateAuthorityInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(SSHCertificateAuthorityInfo)
+	if !ok {
+		return false
+	}
+	c.ID, a.ID = 0, 0
+	return reflect.DeepEqual(c, a)
+}
+
+// IPAllowListEntryInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = IPAllowListEntryInfo{}
+var _ DefaultedInfoRequest = &IPAllowListEntryInfo{}
+
+// IPAllowListEntryInfo contains high-level information about an entry of the IP allow list
+// of an organization. Once the list isn't empty, the organization can only be accessed from
+// the listed addresses.
+type IPAllowListEntryInfo struct {
+	// ID identifies the entry within the allow list. In GitLab, this is the Value.
+	// This field is read-only and set by the server.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Value is the allowed IP address or CIDR range, e.g. "192.0.2.0/24".
+	// +required
+	Value string `json:"value"`
+
+	// Name is a human-friendly description of the entry. Only supported by GitHub.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Active is false if the entry is kept in the list without being enforced. Only
+	// supported by GitHub.
+	// Default: true.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// Default defaults the IP allow list entry, implementing the DefaultedInfoRequest interface.
+func (e *IPAllowListEntryInfo) Default() {
+	if e.Active == nil {
+		e.Active = BoolVar(true)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (e IPAllowListEntryInfo) ValidateInfo() error {
+	validator := validation.New("IPAllowListEntry")
+	if len(e.Value) == 0 {
+		validator.Required("Value")
+	} else if !isIPOrPrefix(e.Value) {
+		validator.Invalid(e.Value, "Value")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID field is ignored.
+func (e IPAllowListEntryInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(IPAllowListEntryInfo)
+	if !ok {
+		return false
+	}
+	e.ID, a.ID = "", ""
+	return reflect.DeepEqual(e, a)
+}
+
+// isIPOrPrefix returns true if s is an IP address or a CIDR range.
+func isIPOrPrefix(s string) bool {
+	if _, err := netip.ParseAddr(s); err == nil {
+		return true
+	}
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
+
+// OrganizationVariableInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = OrganizationVariableInfo{}
+var _ DefaultedInfoRequest = &OrganizationVariableInfo{}
+
+// OrganizationVariableInfo contains high-level information about an organization-level CI/CD
+// variable, which is shared by the repositories of the organization.
+type OrganizationVariableInfo struct {
+	// Key is the name of the variable, e.g. "REGISTRY_PASSWORD".
+	// +required
+	Key string `json:"key"`
+
+	// Value is the value of the variable. Providers that store variables as write-only
+	// secrets never return the value, in which case this field is empty when read.
+	// +optional
+	Value string `json:"value"`
+
+	// Masked specifies whether the value should be hidden in CI job logs.
+	// Default value at POST-time: false.
+	// +optional
+	Masked *bool `json:"masked,omitempty"`
+
+	// Protected specifies whether the variable is only exposed to protected branches and tags.
+	// Default value at POST-time: false.
+	// +optional
+	Protected *bool `json:"protected,omitempty"`
+
+	// Environment limits the variable to the given environment scope. Only GitLab supports
+	// this, empty means all environments.
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// Visibility specifies which repositories of the organization can access the variable.
+	// GitLab group variables are always available to all projects of the group.
+	// Default value at POST-time: all.
+	// Available options: See the VariableVisibility enum.
+	// +optional
+	Visibility *VariableVisibility `json:"visibility,omitempty"`
+
+	// Repositories are the names of the repositories which can access the variable, if
+	// Visibility is "selected". The order doesn't matter.
+	// +optional
+	Repositories []string `json:"repositories,omitempty"`
+}
+
+// Default defaults the OrganizationVariable fields.
+func (v *OrganizationVariableInfo) Default() {
+	if v.Masked == nil {
+		v.Masked = BoolVar(defaultVariableMasked)
+	}
+	if v.Protected == nil {
+		v.Protected = BoolVar(defaultVariableProtected)
+	}
+	if v.Visibility == nil {
+		v.Visibility = VariableVisibilityVar(VariableVisibilityAll)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (v OrganizationVariableInfo) ValidateInfo() error {
+	validator := validation.New("OrganizationVariable")
+	// Make sure we've set the key of the variable
+	if len(v.Key) == 0 {
+		validator.Required("Key")
+	}
+	// Validate the Visibility enum
+	if v.Visibility != nil {
+		validator.Append(ValidateVariableVisibility(*v.Visibility), *v.Visibility, "Visibility")
+	}
+	// Repositories can only be selected explicitly
+	if len(v.Repositories) != 0 && (v.Visibility == nil || *v.Visibility != VariableVisibilitySelected) {
+		validator.Invalid(v.Repositories, "Repositories")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The order of the repositories isn't compared.
+func (v OrganizationVariableInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(OrganizationVariableInfo)
+	if !ok {
+		return false
+	}
+	v.Repositories, a.Repositories = sortedOrNil(v.Repositories), sortedOrNil(a.Repositories)
+	return reflect.DeepEqual(v, a)
+}
+
+// sortedOrNil returns a sorted copy of s, or nil if s is empty.
+func sortedOrNil(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sorted := append([]string(nil), s...)
+	sort.Strings(sorted)
+	return sorted
+}
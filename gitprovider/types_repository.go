@@ -17,7 +17,11 @@ limitations under the License.
 package gitprovider
 
 import (
+	"encoding/base64"
+	"net/url"
 	"reflect"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/fluxcd/go-git-providers/validation"
@@ -34,6 +38,13 @@ const (
 	defaultBranchName = "main"
 	// by default, deploy keys are read-only.
 	defaultDeployKeyReadOnly = true
+	// by default, deploy tokens can only read the repository.
+	defaultDeployTokenScope = DeployTokenScopeReadRepository
+	// by default, variables are neither masked nor protected.
+	defaultVariableMasked    = false
+	defaultVariableProtected = false
+	// the default label color is light grey.
+	defaultLabelColor = "ededed"
 )
 
 // RepositoryInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
@@ -58,6 +69,76 @@ type RepositoryInfo struct {
 	// Default value at POST-time: RepositoryVisibilityPrivate.
 	// +optional
 	Visibility *RepositoryVisibility `json:"visibility"`
+
+	// Topics lists the topics (called tags in some providers) the repository is tagged with, for
+	// discovery. GitHub only allows lowercase letters, numbers and hyphens. A nil value leaves the
+	// topics untouched, while an empty, non-nil value removes all topics.
+	// Only supported by GitHub and GitLab.
+	// +optional
+	Topics []string `json:"topics,omitempty"`
+
+	// Archived describes whether the repository is archived, i.e. read-only. A nil value leaves
+	// the archival state untouched. Ignored at POST-time, new repositories are never archived.
+	// Not supported by Stash.
+	// +optional
+	Archived *bool `json:"archived,omitempty"`
+
+	// LFSEnabled describes whether Git LFS is enabled for the repository. A nil value leaves
+	// the provider default untouched. GitHub doesn't report the LFS state, so it's only
+	// applied when creating or updating the repository there.
+	// Supported by GitHub and GitLab.
+	// +optional
+	LFSEnabled *bool `json:"lfsEnabled,omitempty"`
+
+	// WikiEnabled describes whether the wiki of the repository is enabled. A nil value leaves
+	// the wiki setting untouched. Use GetWikiCloneURL to clone the wiki.
+	// Not supported by Stash.
+	// +optional
+	WikiEnabled *bool `json:"wikiEnabled,omitempty"`
+
+	// Statistics contains statistics about the repository, which are only fetched when getting
+	// the repository with the CallIncludeStatistics call option.
+	// This field is read-only and set by the server.
+	// +optional
+	Statistics *RepositoryStatistics `json:"statistics,omitempty"`
+
+	// Metadata contains the license and README detected in the repository, which are only
+	// fetched when getting the repository with the CallIncludeMetadata call option.
+	// This field is read-only and set by the server.
+	// +optional
+	Metadata *RepositoryMetadata `json:"metadata,omitempty"`
+}
+
+// RepositoryStatistics contains statistics about a repository, e.g. for inventory dashboards.
+// Counts the provider doesn't report are nil.
+type RepositoryStatistics struct {
+	// Size is the size of the Git repository in bytes. GitHub and Gitea only report it in kilobytes.
+	Size int64 `json:"size"`
+
+	// Languages is the share of each programming language in the code, in percent.
+	Languages map[string]float64 `json:"languages,omitempty"`
+
+	// CommitCount is the number of commits on the default branch.
+	CommitCount *int `json:"commitCount,omitempty"`
+
+	// OpenIssues is the number of open issues, not counting pull requests.
+	OpenIssues *int `json:"openIssues,omitempty"`
+
+	// OpenPullRequests is the number of open pull requests.
+	OpenPullRequests *int `json:"openPullRequests,omitempty"`
+}
+
+// RepositoryMetadata contains metadata detected from the files of a repository, e.g. for
+// compliance scanning.
+type RepositoryMetadata struct {
+	// LicenseSPDXID is the SPDX identifier of the license detected by the provider, e.g.
+	// "Apache-2.0", or empty if no license was detected. GitHub reports "NOASSERTION" for
+	// licenses it doesn't recognize. Not reported by Gitea.
+	LicenseSPDXID string `json:"licenseSPDXID,omitempty"`
+
+	// ReadmePath is the path of the README file in the root of the default branch, or
+	// empty if the repository doesn't have one.
+	ReadmePath string `json:"readmePath,omitempty"`
 }
 
 // Default defaults the Repository, implementing the InfoRequest interface.
@@ -77,12 +158,37 @@ func (r RepositoryInfo) ValidateInfo() error {
 	if r.Visibility != nil {
 		validator.Append(ValidateRepositoryVisibility(*r.Visibility), *r.Visibility, "Visibility")
 	}
+	for _, topic := range r.Topics {
+		if len(topic) == 0 {
+			validator.Invalid(topic, "Topics")
+		}
+	}
 	return validator.Error()
 }
 
 // Equals can be used to check if this *Info request (the desired state) matches the actual
 // passed in as the argument.
 func (r RepositoryInfo) Equals(actual InfoRequest) bool {
+	// Topics, Archived, LFSEnabled and WikiEnabled are only managed if set in the desired state
+	if a, ok := actual.(RepositoryInfo); ok {
+		// Statistics and Metadata are read-only
+		r.Statistics, a.Statistics = nil, nil
+		r.Metadata, a.Metadata = nil, nil
+		if r.Topics == nil || (len(r.Topics) == 0 && len(a.Topics) == 0) {
+			a.Topics = r.Topics
+		}
+		if r.Archived == nil {
+			a.Archived = nil
+		}
+		if r.WikiEnabled == nil {
+			a.WikiEnabled = nil
+		}
+		// An unknown LFS state can't be compared
+		if r.LFSEnabled == nil || a.LFSEnabled == nil {
+			a.LFSEnabled = r.LFSEnabled
+		}
+		actual = a
+	}
 	return reflect.DeepEqual(r, actual)
 }
 
@@ -130,6 +236,58 @@ func (ta TeamAccessInfo) Equals(actual InfoRequest) bool {
 	return reflect.DeepEqual(ta, actual)
 }
 
+// CollaboratorInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = CollaboratorInfo{}
+var _ DefaultedInfoRequest = &CollaboratorInfo{}
+
+// CollaboratorInfo contains high-level information about an individual user's access to a repository.
+type CollaboratorInfo struct {
+	// Username is the login of the user.
+	// +required
+	Username string `json:"username"`
+
+	// Permission describes the permission level the user has on the repository.
+	// Default: pull.
+	// Available options: See the RepositoryPermission enum.
+	// +optional
+	Permission *RepositoryPermission `json:"permission,omitempty"`
+
+	// Pending is true if the user has been invited, but hasn't accepted the invitation yet.
+	// This field is read-only and set by the server.
+	// +optional
+	Pending bool `json:"pending,omitempty"`
+}
+
+// Default defaults the Collaborator fields.
+func (c *CollaboratorInfo) Default() {
+	if c.Permission == nil {
+		c.Permission = RepositoryPermissionVar(defaultRepoPermission)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (c CollaboratorInfo) ValidateInfo() error {
+	validator := validation.New("Collaborator")
+	if len(c.Username) == 0 {
+		validator.Required("Username")
+	}
+	if c.Permission != nil {
+		validator.Append(ValidateRepositoryPermission(*c.Permission), *c.Permission, "Permission")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only Pending field is ignored.
+func (c CollaboratorInfo) Equals(actual InfoRequest) bool {
+	b, ok := actual.(CollaboratorInfo)
+	if !ok {
+		return false
+	}
+	c.Pending, b.Pending = false, false
+	return reflect.DeepEqual(c, b)
+}
+
 // DeployKeyInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
 var _ InfoRequest = DeployKeyInfo{}
 var _ DefaultedInfoRequest = &DeployKeyInfo{}
@@ -196,10 +354,23 @@ type DeployTokenInfo struct {
 	// Token is the generated Deploy Token by the API
 	// +optional
 	Token string `json:"token"`
+
+	// Scopes specifies what the deploy token is allowed to access.
+	// Default value at POST-time: [read_repository].
+	// Available options: See the DeployTokenScope enum.
+	// +optional
+	Scopes []DeployTokenScope `json:"scopes,omitempty"`
+
+	// ExpiresAt specifies when the deploy token expires. A nil value means it never expires.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 }
 
 // Default defaults the DeployToken fields.
 func (dk *DeployTokenInfo) Default() {
+	if len(dk.Scopes) == 0 {
+		dk.Scopes = []DeployTokenScope{defaultDeployTokenScope}
+	}
 }
 
 // ValidateInfo validates the object at {Object}.Set() and POST-time.
@@ -209,6 +380,10 @@ func (dk DeployTokenInfo) ValidateInfo() error {
 	if len(dk.Name) == 0 {
 		validator.Required("Name")
 	}
+	// Validate the Scopes enum
+	for _, scope := range dk.Scopes {
+		validator.Append(ValidateDeployTokenScope(scope), scope, "Scopes")
+	}
 	// Don't care about the RepositoryRef, as that information is coming from
 	// the RepositoryClient. In the client, we make sure that they equal.
 	return validator.Error()
@@ -220,6 +395,309 @@ func (dk DeployTokenInfo) Equals(actual InfoRequest) bool {
 	return reflect.DeepEqual(dk, actual)
 }
 
+// VariableInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = VariableInfo{}
+var _ DefaultedInfoRequest = &VariableInfo{}
+
+// VariableInfo contains high-level information about a repository-level CI/CD variable.
+type VariableInfo struct {
+	// Key is the name of the variable, e.g. "DEPLOY_TOKEN".
+	// +required
+	Key string `json:"key"`
+
+	// Value is the value of the variable. Providers that store variables as write-only
+	// secrets never return the value, in which case this field is empty when read.
+	// +optional
+	Value string `json:"value"`
+
+	// Masked specifies whether the value should be hidden in CI job logs.
+	// Default value at POST-time: false.
+	// +optional
+	Masked *bool `json:"masked,omitempty"`
+
+	// Protected specifies whether the variable is only exposed to protected branches and tags.
+	// Default value at POST-time: false.
+	// +optional
+	Protected *bool `json:"protected,omitempty"`
+
+	// Environment limits the variable to the given deployment environment, e.g. GitLab's
+	// environment scope or a GitHub deployment environment. Empty means repository-wide.
+	// +optional
+	Environment string `json:"environment,omitempty"`
+}
+
+// Default defaults the Variable fields.
+func (v *VariableInfo) Default() {
+	if v.Masked == nil {
+		v.Masked = BoolVar(defaultVariableMasked)
+	}
+	if v.Protected == nil {
+		v.Protected = BoolVar(defaultVariableProtected)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (v VariableInfo) ValidateInfo() error {
+	validator := validation.New("Variable")
+	// Make sure we've set the key of the variable
+	if len(v.Key) == 0 {
+		validator.Required("Key")
+	}
+	// Don't care about the RepositoryRef, as that information is coming from
+	// the RepositoryClient. In the client, we make sure that they equal.
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (v VariableInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(v, actual)
+}
+
+// EnvironmentInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = EnvironmentInfo{}
+var _ DefaultedInfoRequest = &EnvironmentInfo{}
+
+// EnvironmentInfo contains high-level information about a deployment environment.
+type EnvironmentInfo struct {
+	// Name is the name of the environment, e.g. "production".
+	// +required
+	Name string `json:"name"`
+
+	// ExternalURL is the URL the environment is reachable at.
+	// Only supported by GitLab.
+	// +optional
+	ExternalURL *string `json:"externalURL,omitempty"`
+
+	// WaitTimer is the number of minutes to wait before a deployment to this environment may proceed.
+	// Only supported by GitHub.
+	// +optional
+	WaitTimer *int `json:"waitTimer,omitempty"`
+
+	// Reviewers lists the users and teams that have to approve deployments to this environment.
+	// Setting this protects the environment.
+	// +optional
+	Reviewers []EnvironmentReviewer `json:"reviewers,omitempty"`
+}
+
+// EnvironmentReviewer is a required reviewer of deployments to an environment.
+type EnvironmentReviewer struct {
+	// Type specifies whether Name refers to a user or a team.
+	// +required
+	Type EnvironmentReviewerType `json:"type"`
+
+	// Name is the login of the user, or the slug of the team (full path of the group on GitLab).
+	// +required
+	Name string `json:"name"`
+}
+
+// Default defaults the Environment fields.
+func (e *EnvironmentInfo) Default() {
+	// A zero wait timer is the same as no wait timer
+	if e.WaitTimer != nil && *e.WaitTimer == 0 {
+		e.WaitTimer = nil
+	}
+	if len(e.Reviewers) == 0 {
+		e.Reviewers = nil
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (e EnvironmentInfo) ValidateInfo() error {
+	validator := validation.New("Environment")
+	// Make sure we've set the name of the environment
+	if len(e.Name) == 0 {
+		validator.Required("Name")
+	}
+	if e.WaitTimer != nil && *e.WaitTimer < 0 {
+		validator.Invalid(*e.WaitTimer, "WaitTimer")
+	}
+	for _, reviewer := range e.Reviewers {
+		validator.Append(ValidateEnvironmentReviewerType(reviewer.Type), reviewer.Type, "Reviewers.Type")
+		if len(reviewer.Name) == 0 {
+			validator.Required("Reviewers.Name")
+		}
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (e EnvironmentInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(e, actual)
+}
+
+// DeploymentInfo implements InfoRequest.
+var _ InfoRequest = DeploymentInfo{}
+
+// DeploymentInfo contains high-level information about a deployment.
+type DeploymentInfo struct {
+	// ID is the provider-assigned identifier of the deployment.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id"`
+
+	// Ref is the branch, tag or commit SHA that is deployed.
+	// +required
+	Ref string `json:"ref"`
+
+	// SHA is the commit SHA the Ref resolved to when the deployment was created.
+	// This field is read-only and set by the server.
+	// +optional
+	SHA string `json:"sha,omitempty"`
+
+	// Environment is the name of the environment that is deployed to, e.g. "production".
+	// +required
+	Environment string `json:"environment"`
+
+	// Description is a short description of the deployment.
+	// Only supported by GitHub.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (d DeploymentInfo) ValidateInfo() error {
+	validator := validation.New("Deployment")
+	if len(d.Ref) == 0 {
+		validator.Required("Ref")
+	}
+	if len(d.Environment) == 0 {
+		validator.Required("Environment")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (d DeploymentInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(d, actual)
+}
+
+// DeploymentStatusInfo implements InfoRequest.
+var _ InfoRequest = DeploymentStatusInfo{}
+
+// DeploymentStatusInfo contains high-level information about the state of a deployment.
+type DeploymentStatusInfo struct {
+	// State is the state of the deployment.
+	// +required
+	State DeploymentState `json:"state"`
+
+	// Description is a short description of the state.
+	// Only supported by GitHub.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// EnvironmentURL is the URL the deployed environment is reachable at.
+	// Only supported by GitHub.
+	// +optional
+	EnvironmentURL *string `json:"environmentURL,omitempty"`
+
+	// LogURL is the URL of the deployment output, e.g. the logs of the rollout.
+	// Only supported by GitHub.
+	// +optional
+	LogURL *string `json:"logURL,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (d DeploymentStatusInfo) ValidateInfo() error {
+	validator := validation.New("DeploymentStatus")
+	if len(d.State) == 0 {
+		validator.Required("State")
+	} else {
+		validator.Append(ValidateDeploymentState(d.State), d.State, "State")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (d DeploymentStatusInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(d, actual)
+}
+
+// ArtifactInfo contains high-level information about a binary artifact.
+type ArtifactInfo struct {
+	// Name is the file name of the artifact.
+	Name string `json:"name"`
+	// Version is the version the artifact is stored under, e.g. a release tag.
+	Version string `json:"version"`
+	// Size is the size of the artifact in bytes.
+	Size int64 `json:"size"`
+	// DownloadURL is the URL the artifact can be downloaded from, if known.
+	DownloadURL string `json:"downloadURL,omitempty"`
+}
+
+// PipelineInfo contains high-level information about a CI/CD pipeline run.
+type PipelineInfo struct {
+	// ID is the ID of the pipeline.
+	ID int64 `json:"id"`
+	// Name is the name of the pipeline, e.g. the name of the GitHub Actions workflow.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Ref is the branch or tag the pipeline ran for.
+	Ref string `json:"ref"`
+	// SHA is the commit the pipeline ran for.
+	SHA string `json:"sha"`
+	// Status tells whether the pipeline is queued, running or completed.
+	Status PipelineStatus `json:"status"`
+	// Conclusion is the outcome of the pipeline, set once its status is completed.
+	// +optional
+	Conclusion *PipelineConclusion `json:"conclusion,omitempty"`
+	// WebURL is the URL of the pipeline in the web UI.
+	WebURL string `json:"webURL"`
+	// CreatedAt is when the pipeline was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt is when the pipeline was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PipelineJobInfo is a summary of a job of a pipeline.
+type PipelineJobInfo struct {
+	// ID is the ID of the job.
+	ID int64 `json:"id"`
+	// Name is the name of the job.
+	Name string `json:"name"`
+	// Stage is the stage the job belongs to. Only GitLab has stages.
+	// +optional
+	Stage string `json:"stage,omitempty"`
+	// Status tells whether the job is queued, running or completed.
+	Status PipelineStatus `json:"status"`
+	// Conclusion is the outcome of the job, set once its status is completed.
+	// +optional
+	Conclusion *PipelineConclusion `json:"conclusion,omitempty"`
+	// StartedAt is when the job started running.
+	// +optional
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	// FinishedAt is when the job completed.
+	// +optional
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// WebURL is the URL of the job in the web UI.
+	WebURL string `json:"webURL"`
+}
+
+// PackageInfo contains high-level information about a package or container image
+// published from a repository.
+type PackageInfo struct {
+	// Name is the name of the package, e.g. the path of a container image.
+	Name string `json:"name"`
+	// Type is the package format, e.g. "container" or "npm".
+	Type PackageType `json:"type"`
+}
+
+// PackageVersionInfo contains high-level information about a published version of a package.
+type PackageVersionInfo struct {
+	// Version is the version of the package. For container images, this is the
+	// manifest digest on GitHub, and the tag on GitLab.
+	Version string `json:"version"`
+	// Tags are the tags pointing to this version of a container image.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+	// CreatedAt is when the version was published, if known.
+	// +optional
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
 // CommitInfo contains high-level information about a deploy key.
 type CommitInfo struct {
 	// Sha is the git sha for this commit.
@@ -241,6 +719,37 @@ type CommitInfo struct {
 
 	// URL is the link for the commit
 	URL string `json:"url"`
+
+	// Signature is the signature verification of the commit, if the provider returns it when
+	// listing commits. Use CommitClient.GetSignature to get it otherwise.
+	Signature *SignatureVerification `json:"signature,omitempty"`
+}
+
+// SignatureVerificationReasonUnsigned is the reason of the SignatureVerification of an unsigned
+// commit or tag, for all providers.
+const SignatureVerificationReasonUnsigned = "unsigned"
+
+// SignatureVerification contains the result of the provider verifying the signature of a commit or tag.
+type SignatureVerification struct {
+	// Verified is true if the signature is valid, and made by a key of the signer's account.
+	Verified bool `json:"verified"`
+
+	// Reason is the provider-specific verification status, e.g. "valid", "unknown_key" or
+	// "unverified_email". Unsigned commits and tags have SignatureVerificationReasonUnsigned.
+	Reason string `json:"reason"`
+
+	// Type is the kind of signature, if known.
+	Type *SignatureType `json:"type,omitempty"`
+
+	// SignerName is the name of the signer, if known. GitHub and Gitea only verify signatures of
+	// the committer or tagger, which is what's returned.
+	SignerName string `json:"signerName,omitempty"`
+
+	// SignerEmail is the email address of the signer, if known.
+	SignerEmail string `json:"signerEmail,omitempty"`
+
+	// KeyID is the ID of the signing key, or the fingerprint of the certificate, if known.
+	KeyID string `json:"keyID,omitempty"`
 }
 
 // CommitFile contains high-level information about a file added to a commit.
@@ -249,9 +758,64 @@ type CommitFile struct {
 	// +required
 	Path *string `json:"path"`
 
-	// Content is the content of the file.
+	// Content is the content of the file, encoded as specified by Encoding.
+	// A nil Content deletes the file.
 	// +required
 	Content *string `json:"content"`
+
+	// Encoding specifies how Content is encoded. Binary files must be base64 encoded, as
+	// non-UTF-8 data is corrupted otherwise. Use NewBinaryCommitFile to create such a file.
+	// Defaults to CommitFileEncodingText, except for Gitea, which historically expects
+	// base64 encoded Content if Encoding isn't set.
+	// +optional
+	Encoding *CommitFileEncoding `json:"encoding,omitempty"`
+}
+
+// IsBase64 returns true if Content is base64 encoded.
+func (f CommitFile) IsBase64() bool {
+	return f.Encoding != nil && *f.Encoding == CommitFileEncodingBase64
+}
+
+// Bytes returns the decoded content of the file, or nil if Content is nil.
+func (f CommitFile) Bytes() ([]byte, error) {
+	if f.Content == nil {
+		return nil, nil
+	}
+	if f.IsBase64() {
+		return base64.StdEncoding.DecodeString(*f.Content)
+	}
+	return []byte(*f.Content), nil
+}
+
+// size returns the decoded size of the content in bytes, without decoding it.
+func (f CommitFile) size() int64 {
+	if f.Content == nil {
+		return 0
+	}
+	if !f.IsBase64() {
+		return int64(len(*f.Content))
+	}
+	content := strings.TrimRight(*f.Content, "=")
+	return int64(base64.RawStdEncoding.DecodedLen(len(content)))
+}
+
+// SizeLimits describes the maximum sizes a provider accepts when creating a commit.
+// A zero value means that there is no limit.
+type SizeLimits struct {
+	// MaxFileSize is the maximum size of a single file, in bytes.
+	MaxFileSize int64 `json:"maxFileSize"`
+
+	// MaxPushSize is the maximum combined size of all files in a commit, in bytes.
+	MaxPushSize int64 `json:"maxPushSize"`
+}
+
+// FileSize is the size of a file in a commit, in bytes.
+type FileSize struct {
+	// Path is the path of the file.
+	Path string `json:"path"`
+
+	// Size is the size of the file, in bytes.
+	Size int64 `json:"size"`
 }
 
 // PullRequestInfo contains high-level information about a pull request.
@@ -307,3 +871,679 @@ type TreeInfo struct {
 	// If truncated is true in the response when fetching a tree, then the number of items in the tree array exceeded the maximum limit
 	Truncated bool `json:"truncated"`
 }
+
+// MirrorInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = MirrorInfo{}
+var _ DefaultedInfoRequest = &MirrorInfo{}
+
+// MirrorInfo contains high-level information about a push or pull mirror of a repository.
+type MirrorInfo struct {
+	// ID is the provider-assigned identifier of the mirror.
+	// This field is read-only and set by the server.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Direction specifies whether the repository is pushed to, or pulled from the mirror.
+	// +required
+	Direction MirrorDirection `json:"direction"`
+
+	// URL is the clone URL of the mirror, without any credentials.
+	// +required
+	URL string `json:"url"`
+
+	// Username is the user to authenticate to the mirror as.
+	// Credentials are write-only, and not compared by Equals. Update the mirror to rotate them.
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// Password is the password or access token to authenticate to the mirror with.
+	// Credentials are write-only, and not compared by Equals. Update the mirror to rotate them.
+	// +optional
+	Password *string `json:"password,omitempty"`
+
+	// Interval is how often the mirror is synced.
+	// Only supported by Gitea.
+	// +optional
+	Interval *time.Duration `json:"interval,omitempty"`
+
+	// Enabled specifies whether the mirror is synced. Mirrors are enabled by default.
+	// Only supported by GitLab push mirrors.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// OnlyProtectedBranches limits mirroring to protected branches.
+	// Only supported by GitLab.
+	// +optional
+	OnlyProtectedBranches *bool `json:"onlyProtectedBranches,omitempty"`
+}
+
+// Default defaults the Mirror fields.
+func (m *MirrorInfo) Default() {
+	m.URL = strings.TrimSuffix(m.URL, "/")
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (m MirrorInfo) ValidateInfo() error {
+	validator := validation.New("Mirror")
+	validator.Append(ValidateMirrorDirection(m.Direction), m.Direction, "Direction")
+	if len(m.URL) == 0 {
+		validator.Required("URL")
+	} else if u, err := url.Parse(m.URL); err != nil || u.Host == "" || u.User != nil {
+		// Credentials have to be passed separately, so that they aren't leaked in the URL
+		validator.Invalid(m.URL, "URL")
+	}
+	if m.Password != nil && m.Username == nil {
+		validator.Required("Username")
+	}
+	if m.Interval != nil && *m.Interval <= 0 {
+		validator.Invalid(*m.Interval, "Interval")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID and the write-only credentials are ignored, as
+// are optional fields that aren't set in the desired state.
+func (m MirrorInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(MirrorInfo)
+	if !ok {
+		return false
+	}
+	a.ID = ""
+	a.Username, a.Password = m.Username, m.Password
+	if m.Interval == nil {
+		a.Interval = nil
+	}
+	if m.Enabled == nil {
+		a.Enabled = nil
+	}
+	if m.OnlyProtectedBranches == nil {
+		a.OnlyProtectedBranches = nil
+	}
+	m.ID = ""
+	return reflect.DeepEqual(m, a)
+}
+
+// ScheduleInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = ScheduleInfo{}
+var _ DefaultedInfoRequest = &ScheduleInfo{}
+
+// ScheduleInfo contains high-level information about a scheduled pipeline of a repository.
+type ScheduleInfo struct {
+	// ID is the provider-assigned identifier of the schedule.
+	// This field is read-only and set by the server.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Description identifies the schedule in the repository.
+	// +required
+	Description string `json:"description"`
+
+	// Ref is the branch or tag the pipeline runs on.
+	// +required
+	Ref string `json:"ref"`
+
+	// Cron is the schedule in five-field cron syntax, e.g. "0 4 * * 1".
+	// +required
+	Cron string `json:"cron"`
+
+	// CronTimezone is the IANA time zone the schedule is evaluated in, e.g. "Europe/Berlin".
+	// Default: "UTC"
+	// +optional
+	CronTimezone *string `json:"cronTimezone,omitempty"`
+
+	// Active specifies whether pipelines are triggered by the schedule. Default: true
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// Default defaults the Schedule fields.
+func (s *ScheduleInfo) Default() {
+	if s.CronTimezone == nil {
+		s.CronTimezone = StringVar("UTC")
+	}
+	if s.Active == nil {
+		s.Active = BoolVar(true)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (s ScheduleInfo) ValidateInfo() error {
+	validator := validation.New("Schedule")
+	if len(s.Description) == 0 {
+		validator.Required("Description")
+	}
+	if len(s.Ref) == 0 {
+		validator.Required("Ref")
+	}
+	if len(s.Cron) == 0 {
+		validator.Required("Cron")
+	} else if len(strings.Fields(s.Cron)) != 5 {
+		validator.Invalid(s.Cron, "Cron")
+	}
+	if s.CronTimezone != nil && len(*s.CronTimezone) == 0 {
+		validator.Invalid(*s.CronTimezone, "CronTimezone")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID is ignored.
+func (s ScheduleInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(ScheduleInfo)
+	if !ok {
+		return false
+	}
+	s.ID, a.ID = "", ""
+	return reflect.DeepEqual(s, a)
+}
+
+// IssueInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = IssueInfo{}
+var _ DefaultedInfoRequest = &IssueInfo{}
+
+// IssueInfo contains high-level information about an issue.
+type IssueInfo struct {
+	// Number is the number of the issue in the repository.
+	// This field is read-only and set by the server.
+	// +optional
+	Number int `json:"number,omitempty"`
+
+	// Title is the title of the issue.
+	// +required
+	Title string `json:"title"`
+
+	// Description is the body of the issue, in Markdown.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Labels lists the names of the labels of the issue. The labels must exist in the
+	// repository on Gitea. A nil value leaves the labels untouched when updating.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Assignees lists the logins of the users the issue is assigned to. A nil value leaves
+	// the assignees untouched when updating.
+	// +optional
+	Assignees []string `json:"assignees,omitempty"`
+
+	// State specifies whether the issue is open or closed.
+	// Default value at POST-time: IssueStateOpen.
+	// +optional
+	State IssueState `json:"state,omitempty"`
+
+	// WebURL is the URL of the issue in the web interface of the Git provider.
+	// This field is read-only and set by the server.
+	// +optional
+	WebURL string `json:"webURL,omitempty"`
+}
+
+// Default defaults the Issue fields.
+func (i *IssueInfo) Default() {
+	if i.State == "" {
+		i.State = IssueStateOpen
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (i IssueInfo) ValidateInfo() error {
+	validator := validation.New("Issue")
+	if len(i.Title) == 0 {
+		validator.Required("Title")
+	}
+	if i.State != "" {
+		validator.Append(ValidateIssueState(i.State), i.State, "State")
+	}
+	for _, label := range i.Labels {
+		if len(label) == 0 {
+			validator.Invalid(label, "Labels")
+		}
+	}
+	for _, assignee := range i.Assignees {
+		if len(assignee) == 0 {
+			validator.Invalid(assignee, "Assignees")
+		}
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only Number and WebURL are ignored, as are the labels
+// and assignees if they aren't set in the desired state.
+func (i IssueInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(IssueInfo)
+	if !ok {
+		return false
+	}
+	i.Number, a.Number = 0, 0
+	i.WebURL, a.WebURL = "", ""
+	if i.Labels == nil {
+		a.Labels = nil
+	}
+	if i.Assignees == nil {
+		a.Assignees = nil
+	}
+	return reflect.DeepEqual(i, a)
+}
+
+// MilestoneInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = MilestoneInfo{}
+var _ DefaultedInfoRequest = &MilestoneInfo{}
+
+// MilestoneInfo contains high-level information about a milestone of a repository or organization.
+type MilestoneInfo struct {
+	// ID identifies the milestone: the number of the milestone on GitHub, and the
+	// provider-assigned ID otherwise.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int `json:"id,omitempty"`
+
+	// Title is the title of the milestone.
+	// +required
+	Title string `json:"title"`
+
+	// Description describes the milestone, in Markdown.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// DueDate is the date the milestone is due. Only the date is used, in UTC.
+	// +optional
+	DueDate *time.Time `json:"dueDate,omitempty"`
+
+	// State specifies whether the milestone is open or closed. Default: open
+	// +optional
+	State MilestoneState `json:"state,omitempty"`
+
+	// WebURL is the URL of the milestone in the web UI, if the provider returns it.
+	// This field is read-only and set by the server.
+	// +optional
+	WebURL string `json:"webURL,omitempty"`
+}
+
+// Default defaults the Milestone fields.
+func (m *MilestoneInfo) Default() {
+	if m.State == "" {
+		m.State = MilestoneStateOpen
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (m MilestoneInfo) ValidateInfo() error {
+	validator := validation.New("Milestone")
+	if len(m.Title) == 0 {
+		validator.Required("Title")
+	}
+	if m.State != "" {
+		validator.Append(ValidateMilestoneState(m.State), m.State, "State")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID and WebURL are ignored, and due dates are
+// compared by their date only.
+func (m MilestoneInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(MilestoneInfo)
+	if !ok {
+		return false
+	}
+	m.ID, a.ID = 0, 0
+	m.WebURL, a.WebURL = "", ""
+	if m.DueDate != nil && a.DueDate != nil {
+		if !sameDate(*m.DueDate, *a.DueDate) {
+			return false
+		}
+		m.DueDate, a.DueDate = nil, nil
+	}
+	return reflect.DeepEqual(m, a)
+}
+
+// sameDate returns whether a and b fall on the same day in UTC.
+func sameDate(a, b time.Time) bool {
+	return a.UTC().Format(time.DateOnly) == b.UTC().Format(time.DateOnly)
+}
+
+// AutolinkInfo implements InfoRequest.
+var _ InfoRequest = AutolinkInfo{}
+
+// AutolinkInfo contains high-level information about an autolink reference, which turns
+// references like "JIRA-123" in commit messages, issues and pull requests into links.
+type AutolinkInfo struct {
+	// ID is the provider-assigned identifier of the autolink.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id,omitempty"`
+
+	// KeyPrefix is the prefix of the references to link, e.g. "JIRA-". GitLab links
+	// references with any upper-case prefix, and always returns an empty KeyPrefix.
+	// +required
+	KeyPrefix string `json:"keyPrefix"`
+
+	// URLTemplate is the URL references link to, where "<num>" is replaced by the reference
+	// number, e.g. "https://jira.example.com/browse/JIRA-<num>".
+	// +required
+	URLTemplate string `json:"urlTemplate"`
+
+	// IsAlphanumeric specifies whether the reference number may contain letters in addition
+	// to digits. Only numeric references are supported by GitLab. Default: the provider default.
+	// +optional
+	IsAlphanumeric *bool `json:"isAlphanumeric,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (a AutolinkInfo) ValidateInfo() error {
+	validator := validation.New("Autolink")
+	if len(a.KeyPrefix) == 0 {
+		validator.Required("KeyPrefix")
+	}
+	if len(a.URLTemplate) == 0 {
+		validator.Required("URLTemplate")
+	} else if !strings.Contains(a.URLTemplate, AutolinkNumberPlaceholder) {
+		validator.Invalid(a.URLTemplate, "URLTemplate")
+	} else if _, err := url.Parse(a.URLTemplate); err != nil {
+		validator.Invalid(a.URLTemplate, "URLTemplate")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID, and IsAlphanumeric if unset, are ignored.
+func (a AutolinkInfo) Equals(actual InfoRequest) bool {
+	b, ok := actual.(AutolinkInfo)
+	if !ok {
+		return false
+	}
+	a.ID, b.ID = 0, 0
+	if a.IsAlphanumeric == nil {
+		b.IsAlphanumeric = nil
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// AutolinkNumberPlaceholder is replaced by the reference number in AutolinkInfo.URLTemplate.
+const AutolinkNumberPlaceholder = "<num>"
+
+// LabelInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = LabelInfo{}
+var _ DefaultedInfoRequest = &LabelInfo{}
+
+// LabelInfo contains high-level information about an issue label of a repository or organization.
+type LabelInfo struct {
+	// Name is the name of the label, which identifies it.
+	// +required
+	Name string `json:"name"`
+
+	// Color is the background color of the label as six hexadecimal digits, without a
+	// leading "#", e.g. "d73a4a". Default: "ededed".
+	// +optional
+	Color *string `json:"color,omitempty"`
+
+	// Description describes what the label is used for.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// Default defaults the Label fields.
+func (l *LabelInfo) Default() {
+	if l.Color == nil {
+		l.Color = StringVar(defaultLabelColor)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (l LabelInfo) ValidateInfo() error {
+	validator := validation.New("Label")
+	if len(l.Name) == 0 {
+		validator.Required("Name")
+	}
+	if l.Color != nil && !labelColorRegexp.MatchString(*l.Color) {
+		validator.Invalid(*l.Color, "Color")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. Colors are compared case-insensitively.
+func (l LabelInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(LabelInfo)
+	if !ok {
+		return false
+	}
+	if l.Color != nil && a.Color != nil {
+		l.Color, a.Color = StringVar(strings.ToLower(*l.Color)), StringVar(strings.ToLower(*a.Color))
+	}
+	return reflect.DeepEqual(l, a)
+}
+
+// labelColorRegexp matches label colors as accepted by LabelInfo.
+//
+//nolint:gochecknoglobals
+var labelColorRegexp = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// CommentInfo implements InfoRequest.
+var _ InfoRequest = CommentInfo{}
+
+// CommentInfo contains high-level information about a comment on an issue.
+type CommentInfo struct {
+	// ID is the provider-assigned identifier of the comment.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id,omitempty"`
+
+	// Body is the text of the comment, in Markdown.
+	// +required
+	Body string `json:"body"`
+
+	// Author is the login of the user who wrote the comment.
+	// This field is read-only and set by the server.
+	// +optional
+	Author string `json:"author,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (c CommentInfo) ValidateInfo() error {
+	validator := validation.New("Comment")
+	if len(c.Body) == 0 {
+		validator.Required("Body")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. Only the body is compared.
+func (c CommentInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(CommentInfo)
+	return ok && c.Body == a.Body
+}
+
+// BotInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = BotInfo{}
+var _ DefaultedInfoRequest = &BotInfo{}
+
+// BotInfo contains high-level information about a bot user and its token.
+type BotInfo struct {
+	// ID is the provider-assigned identifier of the bot.
+	// This field is read-only and set by the server.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Name is the display name of the bot.
+	// +required
+	Name string `json:"name"`
+
+	// Username is the name to authenticate as, together with the token. It is empty if the
+	// provider accepts any username with the token.
+	// This field is read-only and set by the server.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// Scopes lists the API scopes of the token of the bot, e.g. "read_repository" or "api".
+	// Default value at POST-time: [read_repository].
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Permission is the role the bot has in the organization or repository.
+	// Default: the provider default, which is the maintainer role on GitLab.
+	// +optional
+	Permission *RepositoryPermission `json:"permission,omitempty"`
+
+	// ExpiresAt specifies when the token expires. Providers may enforce a maximum lifetime.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Token is the secret to authenticate as the bot with.
+	// This field is read-only, and only set by the server right after creating the bot.
+	// +optional
+	Token string `json:"token,omitempty"`
+}
+
+// Default defaults the Bot fields.
+func (b *BotInfo) Default() {
+	if len(b.Scopes) == 0 {
+		b.Scopes = []string{"read_repository"}
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (b BotInfo) ValidateInfo() error {
+	validator := validation.New("Bot")
+	if len(b.Name) == 0 {
+		validator.Required("Name")
+	}
+	for _, scope := range b.Scopes {
+		if len(scope) == 0 {
+			validator.Invalid(scope, "Scopes")
+		}
+	}
+	if b.Permission != nil {
+		validator.Append(ValidateRepositoryPermission(*b.Permission), *b.Permission, "Permission")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (b BotInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(b, actual)
+}
+
+// AccessTokenInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = AccessTokenInfo{}
+var _ DefaultedInfoRequest = &AccessTokenInfo{}
+
+// AccessTokenInfo contains high-level information about an access token of an organization or
+// repository, which authenticates as a bot user that only has access to it.
+type AccessTokenInfo struct {
+	// ID is the provider-assigned identifier of the token.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id,omitempty"`
+
+	// Name is the human-friendly name of the token.
+	// +required
+	Name string `json:"name"`
+
+	// Scopes lists the API scopes of the token, e.g. "read_repository" or "api".
+	// Default value at POST-time: [read_repository].
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Permission is the role of the bot user of the token in the organization or repository.
+	// Default: the provider default, which is the maintainer role on GitLab.
+	// +optional
+	Permission *RepositoryPermission `json:"permission,omitempty"`
+
+	// ExpiresAt specifies when the token expires. Providers may enforce a maximum lifetime.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Active is false if the token has been revoked or has expired.
+	// This field is read-only and set by the server.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+
+	// Token is the secret to authenticate with.
+	// This field is read-only, and only set by the server right after creating or rotating
+	// the token.
+	// +optional
+	Token string `json:"token,omitempty"`
+}
+
+// Default defaults the AccessToken fields.
+func (t *AccessTokenInfo) Default() {
+	if len(t.Scopes) == 0 {
+		t.Scopes = []string{"read_repository"}
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (t AccessTokenInfo) ValidateInfo() error {
+	validator := validation.New("AccessToken")
+	if len(t.Name) == 0 {
+		validator.Required("Name")
+	}
+	for _, scope := range t.Scopes {
+		if len(scope) == 0 {
+			validator.Invalid(scope, "Scopes")
+		}
+	}
+	if t.Permission != nil {
+		validator.Append(ValidateRepositoryPermission(*t.Permission), *t.Permission, "Permission")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (t AccessTokenInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(t, actual)
+}
+
+// RunnerTokenInfo implements InfoRequest.
+var _ InfoRequest = RunnerTokenInfo{}
+
+// RunnerTokenInfo contains high-level information about a token for registering a CI runner.
+type RunnerTokenInfo struct {
+	// Description describes the runner.
+	// Only supported by GitLab.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags lists the job tags the runner picks up jobs for. GitHub runners are labeled when
+	// registering them instead.
+	// Only supported by GitLab.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Token is the token to register or authenticate the runner with.
+	// This field is read-only and set by the server.
+	// +optional
+	Token string `json:"token,omitempty"`
+
+	// RunnerID is the ID of the runner, if the provider creates the runner upfront.
+	// This field is read-only and set by the server.
+	// +optional
+	RunnerID int64 `json:"runnerID,omitempty"`
+
+	// ExpiresAt is the time the token expires at, if it expires.
+	// This field is read-only and set by the server.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (r RunnerTokenInfo) ValidateInfo() error {
+	validator := validation.New("RunnerToken")
+	for _, tag := range r.Tags {
+		if len(tag) == 0 {
+			validator.Invalid(tag, "Tags")
+		}
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (r RunnerTokenInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(r, actual)
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"reflect"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// GPGKeyInfo implements InfoRequest.
+var _ InfoRequest = GPGKeyInfo{}
+
+// GPGKeyInfo contains high-level information about a GPG key of the authenticated user.
+type GPGKeyInfo struct {
+	// ArmoredPublicKey is the ASCII-armored public key, as exported by "gpg --armor --export".
+	// Gitea doesn't return the armored key, so it's empty for existing keys.
+	// +required
+	ArmoredPublicKey string `json:"armoredPublicKey"`
+
+	// KeyID is the ID of the primary key in upper-case hexadecimal, e.g. "3262EFF25BA0D270".
+	// This field is read-only and set by the server, or parsed from ArmoredPublicKey.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Emails are the email addresses of the identities of the key.
+	// This field is read-only and set by the server, or parsed from ArmoredPublicKey.
+	// +optional
+	Emails []string `json:"emails,omitempty"`
+
+	// ExpiresAt is the time the key expires, if it does.
+	// This field is read-only and set by the server.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (k GPGKeyInfo) ValidateInfo() error {
+	validator := validation.New("GPGKey")
+	if len(k.ArmoredPublicKey) == 0 {
+		validator.Required("ArmoredPublicKey")
+	} else if _, _, err := ParseGPGPublicKey(k.ArmoredPublicKey); err != nil {
+		validator.Invalid(k.ArmoredPublicKey, "ArmoredPublicKey")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only fields are ignored.
+func (k GPGKeyInfo) Equals(actual InfoRequest) bool {
+	b, ok := actual.(GPGKeyInfo)
+	if !ok {
+		return false
+	}
+	k.KeyID, b.KeyID = "", ""
+	k.Emails, b.Emails = nil, nil
+	k.ExpiresAt, b.ExpiresAt = nil, nil
+	return reflect.DeepEqual(k, b)
+}
+
+// SSHKeyInfo implements InfoRequest.
+var _ InfoRequest = SSHKeyInfo{}
+
+// SSHKeyInfo contains high-level information about an SSH key of the authenticated user.
+type SSHKeyInfo struct {
+	// Name is the human-friendly title of the key.
+	// +required
+	Name string `json:"name"`
+
+	// Key is the public key in the authorized_keys format, e.g. "ssh-ed25519 AAAA...".
+	// +required
+	Key []byte `json:"key"`
+
+	// ExpiresAt is the time the key expires. Only supported by GitLab.
+	// Default: the key doesn't expire.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (k SSHKeyInfo) ValidateInfo() error {
+	validator := validation.New("SSHKey")
+	if len(k.Name) == 0 {
+		validator.Required("Name")
+	}
+	if len(k.Key) == 0 {
+		validator.Required("Key")
+	} else if _, _, _, _, err := ssh.ParseAuthorizedKey(k.Key); err != nil {
+		validator.Invalid(string(k.Key), "Key")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (k SSHKeyInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(k, actual)
+}
+
+// UserInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = UserInfo{}
+var _ DefaultedInfoRequest = &UserInfo{}
+
+// UserInfo contains high-level information about a user account of a self-hosted instance,
+// as managed by an administrator.
+type UserInfo struct {
+	// ID is the provider-assigned identifier of the user.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id,omitempty"`
+
+	// Username is the login of the user.
+	// +required
+	Username string `json:"username"`
+
+	// Name is the full name of the user.
+	// Default: the username.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Email is the primary email address of the user.
+	// +required
+	Email string `json:"email"`
+
+	// Password is the initial password of the user. It is never returned by the server.
+	// GitLab generates a random password if it isn't set, Gitea requires it.
+	// +optional
+	Password *string `json:"password,omitempty"`
+
+	// Admin specifies whether the user is an administrator of the instance.
+	// Default value at POST-time: false.
+	// +optional
+	Admin *bool `json:"admin,omitempty"`
+
+	// Blocked is true if the user is blocked from signing in.
+	// This field is read-only and set by the server.
+	// +optional
+	Blocked bool `json:"blocked,omitempty"`
+}
+
+// Default defaults the User fields.
+func (u *UserInfo) Default() {
+	if u.Name == nil {
+		u.Name = StringVar(u.Username)
+	}
+	if u.Admin == nil {
+		u.Admin = BoolVar(false)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (u UserInfo) ValidateInfo() error {
+	validator := validation.New("User")
+	if len(u.Username) == 0 {
+		validator.Required("Username")
+	}
+	if len(u.Email) == 0 {
+		validator.Required("Email")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The read-only ID and Blocked fields, and the Password, which is
+// never returned, are ignored.
+func (u UserInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(UserInfo)
+	if !ok {
+		return false
+	}
+	u.ID, a.ID = 0, 0
+	u.Blocked, a.Blocked = false, false
+	u.Password, a.Password = nil, nil
+	return reflect.DeepEqual(u, a)
+}
+
+// ImpersonationTokenInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = ImpersonationTokenInfo{}
+var _ DefaultedInfoRequest = &ImpersonationTokenInfo{}
+
+// ImpersonationTokenInfo contains high-level information about a token an administrator
+// created to act as another user.
+type ImpersonationTokenInfo struct {
+	// ID is the provider-assigned identifier of the token.
+	// This field is read-only and set by the server.
+	// +optional
+	ID int64 `json:"id,omitempty"`
+
+	// Name is the human-friendly name of the token.
+	// +required
+	Name string `json:"name"`
+
+	// Scopes lists the API scopes of the token, e.g. "read_repository" or "api".
+	// Default value at POST-time: [api].
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// ExpiresAt specifies when the token expires. Providers may enforce a maximum lifetime.
+	// +optional
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Token is the secret to authenticate as the user with.
+	// This field is read-only, and only set by the server right after creating the token.
+	// +optional
+	Token string `json:"token,omitempty"`
+}
+
+// Default defaults the ImpersonationToken fields.
+func (t *ImpersonationTokenInfo) Default() {
+	if len(t.Scopes) == 0 {
+		t.Scopes = []string{"api"}
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (t ImpersonationTokenInfo) ValidateInfo() error {
+	validator := validation.New("ImpersonationToken")
+	if len(t.Name) == 0 {
+		validator.Required("Name")
+	}
+	for _, scope := range t.Scopes {
+		if len(scope) == 0 {
+			validator.Invalid(scope, "Scopes")
+		}
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (t ImpersonationTokenInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(t, actual)
+}
+
+// CurrentUserInfo describes the identity a Client is authenticated as, see Client.CurrentUser.
+// All fields are read-only and set by the server.
+type CurrentUserInfo struct {
+	// ID is the provider-assigned identifier of the user.
+	ID int64 `json:"id"`
+
+	// Username is the login of the user.
+	Username string `json:"username"`
+
+	// Name is the full name of the user, if set.
+	Name *string `json:"name,omitempty"`
+
+	// Email is the email address of the user, if visible to the token.
+	Email *string `json:"email,omitempty"`
+
+	// Bot is true if the identity is a bot or service account, e.g. a GitHub App or a GitLab
+	// project access token.
+	Bot bool `json:"bot"`
+
+	// Scopes of the token, if the provider reports them. nil if unknown.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// TokenExpiresAt is when the token expires, if the provider reports it.
+	TokenExpiresAt *time.Time `json:"tokenExpiresAt,omitempty"`
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package gitprovider
 
 import (
+	"encoding/base64"
 	"fmt"
-	"net/url"
+	"path"
+	"strings"
 )
 
 // BoolVar returns a pointer to the given bool.
@@ -31,11 +33,132 @@ func StringVar(s string) *string {
 	return &s
 }
 
+// IntVar returns a pointer to the given int.
+func IntVar(i int) *int {
+	return &i
+}
+
+// IsCommitSHA returns whether s is a full SHA-1 or SHA-256 commit hash, as opposed
+// to the name of a branch or tag.
+func IsCommitSHA(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
 // GetDomainURL returns the domain URL prepended with https:// if a scheme is not set.
+// The port and any path prefix, e.g. of "my-gitlab.com:6443/gitlab", are preserved.
 func GetDomainURL(d string) string {
-	parsedURL, _ := url.Parse(d)
-	if parsedURL.Scheme != "https" && parsedURL.Scheme != "http" {
+	d = strings.TrimSuffix(d, "/")
+	if !strings.HasPrefix(d, "https://") && !strings.HasPrefix(d, "http://") {
 		d = fmt.Sprintf("https://%s", d)
 	}
 	return d
 }
+
+// NewBinaryCommitFile returns a CommitFile for the given path, with content base64 encoded,
+// so that binary data is committed as-is.
+func NewBinaryCommitFile(path string, content []byte) CommitFile {
+	return CommitFile{
+		Path:     &path,
+		Content:  StringVar(base64.StdEncoding.EncodeToString(content)),
+		Encoding: CommitFileEncodingVar(CommitFileEncodingBase64),
+	}
+}
+
+// CheckCommitSize checks the files of a commit against the given size limits, before
+// anything is sent to the provider. Sizes are those of the decoded content; deleted files,
+// i.e. with a nil Content, don't count.
+// A *CommitTooLargeError listing all the offending files is returned if a limit is exceeded.
+func CheckCommitSize(files []CommitFile, limits SizeLimits) error {
+	var total int64
+	var oversized []FileSize
+	for _, file := range files {
+		if file.Content == nil {
+			continue
+		}
+		size := file.size()
+		total += size
+		if limits.MaxFileSize > 0 && size > limits.MaxFileSize {
+			path := ""
+			if file.Path != nil {
+				path = *file.Path
+			}
+			oversized = append(oversized, FileSize{Path: path, Size: size})
+		}
+	}
+
+	if len(oversized) == 0 && (limits.MaxPushSize <= 0 || total <= limits.MaxPushSize) {
+		return nil
+	}
+	return &CommitTooLargeError{
+		Limits:         limits,
+		OversizedFiles: oversized,
+		TotalSize:      total,
+	}
+}
+
+// NormalizePath validates a file path within a repository, and returns it in its clean POSIX
+// form, e.g. "docs//./README.md" becomes "docs/README.md". An empty path refers to the root
+// of the repository, and is returned as-is.
+//
+// As these paths often come from user input, paths that are absolute, contain backslashes or
+// NUL bytes, or have ".." elements are rejected with an *InvalidPathError, instead of
+// being resolved.
+func NormalizePath(p string) (string, error) {
+	if p == "" {
+		return "", nil
+	}
+	switch {
+	case strings.HasPrefix(p, "/"):
+		return "", &InvalidPathError{Path: p, Reason: "must be relative to the repository root"}
+	case strings.Contains(p, "\\"):
+		return "", &InvalidPathError{Path: p, Reason: "must not contain backslashes"}
+	case strings.ContainsRune(p, 0):
+		return "", &InvalidPathError{Path: p, Reason: "must not contain NUL bytes"}
+	}
+	for _, elem := range strings.Split(p, "/") {
+		if elem == ".." {
+			return "", &InvalidPathError{Path: p, Reason: "must not contain \"..\" elements"}
+		}
+	}
+
+	cleaned := path.Clean(p)
+	if cleaned == "." {
+		return "", nil
+	}
+	return cleaned, nil
+}
+
+// NormalizeCommitFiles returns a copy of files with all paths normalized using NormalizePath.
+// An *InvalidPathError is returned for the first invalid or missing path, and an error wrapping
+// ErrInvalidArgument for the first unknown Encoding.
+func NormalizeCommitFiles(files []CommitFile) ([]CommitFile, error) {
+	normalized := make([]CommitFile, 0, len(files))
+	for _, file := range files {
+		if file.Path == nil || *file.Path == "" {
+			return nil, &InvalidPathError{Reason: "file path is required"}
+		}
+		p, err := NormalizePath(*file.Path)
+		if err != nil {
+			return nil, err
+		}
+		if p == "" {
+			return nil, &InvalidPathError{Path: *file.Path, Reason: "must refer to a file"}
+		}
+		if file.Encoding != nil {
+			if err := ValidateCommitFileEncoding(*file.Encoding); err != nil {
+				return nil, fmt.Errorf("file %q has encoding %q: %v: %w", p, *file.Encoding, err, ErrInvalidArgument)
+			}
+		}
+		file.Path = &p
+		normalized = append(normalized, file)
+	}
+	return normalized, nil
+}
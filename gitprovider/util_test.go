@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckCommitSize(t *testing.T) {
+	file := func(path string, size int) CommitFile {
+		return CommitFile{Path: StringVar(path), Content: StringVar(strings.Repeat("a", size))}
+	}
+	tests := []struct {
+		name         string
+		files        []CommitFile
+		limits       SizeLimits
+		wantOversize []FileSize
+		wantErr      bool
+	}{
+		{
+			name:   "no limits",
+			files:  []CommitFile{file("a", 100)},
+			limits: SizeLimits{},
+		},
+		{
+			name:   "within limits",
+			files:  []CommitFile{file("a", 10), file("b", 10)},
+			limits: SizeLimits{MaxFileSize: 10, MaxPushSize: 20},
+		},
+		{
+			name:         "oversized files are listed",
+			files:        []CommitFile{file("a", 11), file("b", 5), file("c", 12)},
+			limits:       SizeLimits{MaxFileSize: 10},
+			wantOversize: []FileSize{{Path: "a", Size: 11}, {Path: "c", Size: 12}},
+			wantErr:      true,
+		},
+		{
+			name:    "push too large",
+			files:   []CommitFile{file("a", 10), file("b", 10)},
+			limits:  SizeLimits{MaxFileSize: 10, MaxPushSize: 15},
+			wantErr: true,
+		},
+		{
+			name:         "decoded size of binary files",
+			files:        []CommitFile{NewBinaryCommitFile("a", make([]byte, 10)), NewBinaryCommitFile("b", make([]byte, 11))},
+			limits:       SizeLimits{MaxFileSize: 10},
+			wantOversize: []FileSize{{Path: "b", Size: 11}},
+			wantErr:      true,
+		},
+		{
+			name:   "deleted files don't count",
+			files:  []CommitFile{{Path: StringVar("a")}, file("b", 10)},
+			limits: SizeLimits{MaxFileSize: 10, MaxPushSize: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckCommitSize(tt.files, tt.limits)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckCommitSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			if !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("CheckCommitSize() error = %v, expected ErrInvalidArgument", err)
+			}
+			var sizeErr *CommitTooLargeError
+			if !errors.As(err, &sizeErr) {
+				t.Fatalf("CheckCommitSize() error = %v, expected *CommitTooLargeError", err)
+			}
+			if !reflect.DeepEqual(sizeErr.OversizedFiles, tt.wantOversize) {
+				t.Errorf("OversizedFiles = %v, want %v", sizeErr.OversizedFiles, tt.wantOversize)
+			}
+		})
+	}
+}
+
+func TestNewBinaryCommitFile(t *testing.T) {
+	content := []byte{0x00, 0xff, 0xfe, 'a', 0x80}
+	file := NewBinaryCommitFile("image.png", content)
+	if !file.IsBase64() {
+		t.Errorf("expected base64 encoding, got %v", file.Encoding)
+	}
+	got, err := file.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, content) {
+		t.Errorf("Bytes() = %v, want %v", got, content)
+	}
+	if file.size() != int64(len(content)) {
+		t.Errorf("size() = %d, want %d", file.size(), len(content))
+	}
+}
+
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "", want: ""},
+		{path: ".", want: ""},
+		{path: "README.md", want: "README.md"},
+		{path: "./docs//guide/./index.md", want: "docs/guide/index.md"},
+		{path: "clusters/prod/", want: "clusters/prod"},
+		{path: "/etc/passwd", wantErr: true},
+		{path: "../other-repo", wantErr: true},
+		{path: "docs/../../secret", wantErr: true},
+		{path: "docs/..", wantErr: true},
+		{path: `docs\guide.md`, wantErr: true},
+		{path: "docs\x00.md", wantErr: true},
+		{path: "docs/..hidden", want: "docs/..hidden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := NormalizePath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizePath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var pathErr *InvalidPathError
+				if !errors.As(err, &pathErr) || !errors.Is(err, ErrInvalidPath) || !errors.Is(err, ErrInvalidArgument) {
+					t.Errorf("NormalizePath() error = %v, expected *InvalidPathError", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeCommitFiles(t *testing.T) {
+	files := []CommitFile{
+		{Path: StringVar("./a//b.txt"), Content: StringVar("b")},
+		{Path: StringVar("c.txt")},
+	}
+	got, err := NormalizeCommitFiles(files)
+	if err != nil {
+		t.Fatalf("NormalizeCommitFiles() error = %v", err)
+	}
+	if *got[0].Path != "a/b.txt" || *got[1].Path != "c.txt" {
+		t.Errorf("NormalizeCommitFiles() paths = %q, %q", *got[0].Path, *got[1].Path)
+	}
+	if *files[0].Path != "./a//b.txt" {
+		t.Errorf("NormalizeCommitFiles() modified the input path to %q", *files[0].Path)
+	}
+
+	for _, invalid := range [][]CommitFile{
+		{{Content: StringVar("no path")}},
+		{{Path: StringVar(".")}},
+		{{Path: StringVar("../escape")}},
+	} {
+		if _, err := NormalizeCommitFiles(invalid); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("NormalizeCommitFiles(%v) error = %v, expected ErrInvalidPath", invalid, err)
+		}
+	}
+
+	unknown := []CommitFile{{Path: StringVar("a.bin"), Content: StringVar("YQ=="), Encoding: CommitFileEncodingVar("base32")}}
+	if _, err := NormalizeCommitFiles(unknown); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("NormalizeCommitFiles() with an unknown encoding error = %v, expected ErrInvalidArgument", err)
+	}
+}
+
+func TestIsCommitSHA(t *testing.T) {
+	for s, want := range map[string]bool{
+		"3f786850e387550fdab836ed7e6dc881de23001b":                         true,
+		"b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c": true,
+		"3F786850E387550FDAB836ED7E6DC881DE23001B":                         false,
+		"3f78685": false,
+		"main":    false,
+		"v1.0.0":  false,
+		"3f786850e387550fdab836ed7e6dc881de23001g": false,
+	} {
+		if got := IsCommitSHA(s); got != want {
+			t.Errorf("IsCommitSHA(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
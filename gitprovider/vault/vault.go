@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vault provides a gitprovider.TokenSource reading the token from HashiCorp Vault, for
+// platforms which forbid static tokens. The secret can be stored in a KV secrets engine
+// (version 1 or 2), or be issued by a secrets engine minting short-lived tokens, e.g. the GitHub
+// or GitLab secrets engine plugins:
+//
+//	src, err := vault.New(ctx, vault.Config{Path: "github/token"})
+//	if err != nil {
+//		return err
+//	}
+//	c, err := github.NewClient(gitprovider.WithTokenSource(src.Token))
+//
+// Leased tokens are requested again before their lease expires, KV secrets are re-read every
+// RefreshInterval. The Vault token itself can come from any TokenSource, e.g. a
+// secretfile.Source reading the sink file of the Vault Agent, which keeps it renewed.
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// DefaultField is the field of the secret data holding the token by default.
+	DefaultField = "token"
+	// DefaultRefreshInterval is how often secrets without a lease are re-read by default.
+	DefaultRefreshInterval = 5 * time.Minute
+)
+
+var (
+	// retryInterval is how long to wait before reading a secret again after it couldn't be read,
+	// so that an unavailable Vault isn't asked again on every API call.
+	retryInterval = 10 * time.Second
+	// requestTimeout bounds the requests of the default HTTP client.
+	requestTimeout = 30 * time.Second
+)
+
+// Config configures where the token is read from.
+type Config struct {
+	// Address of the Vault server, e.g. "https://vault.example.com:8200".
+	// Default: the VAULT_ADDR environment variable.
+	Address string
+
+	// VaultToken returns the token to authenticate to Vault with.
+	// Default: the VAULT_TOKEN environment variable.
+	VaultToken gitprovider.TokenSource
+
+	// Namespace is the Vault Enterprise namespace of the secret.
+	// Default: the VAULT_NAMESPACE environment variable.
+	Namespace string
+
+	// Path of the secret, including the mount, e.g. "secret/data/ci/github" for KV version 2,
+	// "github/token" for the GitHub secrets engine or "gitlab/token/ci" for the GitLab one.
+	Path string
+
+	// Field of the secret data holding the token. The data of KV version 2 secrets is unwrapped.
+	// Default: DefaultField.
+	Field string
+
+	// RefreshInterval is how often secrets without a lease, e.g. KV secrets, are re-read.
+	// Default: DefaultRefreshInterval.
+	RefreshInterval time.Duration
+
+	// HTTPClient is used to talk to Vault.
+	// Default: an *http.Client with a timeout of 30 seconds per request.
+	HTTPClient *http.Client
+}
+
+// Source reads the token from Vault when it's requested and the last one is due for a refresh.
+// It's safe for concurrent use.
+type Source struct {
+	cfg Config
+	// now returns the current time, and can be replaced in tests
+	now func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+	expiresAt time.Time
+	// err is the error of the last refresh
+	err error
+}
+
+// Source.Token implements gitprovider.TokenSource.
+var _ gitprovider.TokenSource = (&Source{}).Token
+
+// New reads the token from Vault, and returns a Source keeping it up to date. An error is
+// returned if the configuration is incomplete or the token can't be read.
+func New(ctx context.Context, cfg Config) (*Source, error) {
+	if cfg.Address == "" {
+		cfg.Address = os.Getenv("VAULT_ADDR")
+	}
+	if cfg.VaultToken == nil {
+		if token := os.Getenv("VAULT_TOKEN"); token != "" {
+			cfg.VaultToken = gitprovider.NewRotatableToken(token).Token
+		}
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = os.Getenv("VAULT_NAMESPACE")
+	}
+	if cfg.Field == "" {
+		cfg.Field = DefaultField
+	}
+	if cfg.RefreshInterval == 0 {
+		cfg.RefreshInterval = DefaultRefreshInterval
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: requestTimeout}
+	}
+	if cfg.Address == "" || cfg.VaultToken == nil || cfg.Path == "" {
+		return nil, errors.New("the Vault address, token and secret path are required")
+	}
+
+	s := &Source{cfg: cfg, now: time.Now}
+	if err := s.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Token returns the current token, implementing gitprovider.TokenSource. If it can't be read
+// again, e.g. because Vault is unavailable, the last token is returned until its lease expires,
+// and reading it is retried after a short delay.
+func (s *Source) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if !now.Before(s.refreshAt) {
+		if s.err = s.refresh(ctx); s.err != nil {
+			s.refreshAt = now.Add(retryInterval)
+		}
+	}
+	if s.err != nil && !s.expiresAt.IsZero() && !now.Before(s.expiresAt) {
+		return "", s.err
+	}
+	return s.token, nil
+}
+
+// refresh reads the secret, and sets when it's refreshed next. Leased secrets are refreshed
+// after two thirds of their lease, so that the token doesn't expire while in use.
+func (s *Source) refresh(ctx context.Context) error {
+	token, lease, err := s.read(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	s.token = token
+	if lease > 0 {
+		s.refreshAt = now.Add(lease * 2 / 3)
+		s.expiresAt = now.Add(lease)
+	} else {
+		s.refreshAt = now.Add(s.cfg.RefreshInterval)
+		s.expiresAt = time.Time{}
+	}
+	return nil
+}
+
+// read requests the secret from Vault, and returns the token and the lease duration.
+func (s *Source) read(ctx context.Context) (string, time.Duration, error) {
+	vaultToken, err := s.cfg.VaultToken(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get Vault token: %w", err)
+	}
+	url := strings.TrimSuffix(s.cfg.Address, "/") + "/v1/" + strings.TrimPrefix(s.cfg.Path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("X-Vault-Token", vaultToken)
+	req.Header.Set("X-Vault-Request", "true")
+	if s.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.cfg.Namespace)
+	}
+
+	res, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read secret %q: %w", s.cfg.Path, err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read secret %q: %w", s.cfg.Path, err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("failed to read secret %q: Vault returned %s: %s", s.cfg.Path, res.Status, strings.TrimSpace(string(body)))
+	}
+
+	var secret struct {
+		LeaseDuration int64                  `json:"lease_duration"`
+		Data          map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", 0, fmt.Errorf("failed to decode secret %q: %w", s.cfg.Path, err)
+	}
+	data := secret.Data
+	if _, ok := data[s.cfg.Field]; !ok {
+		// KV version 2 nests the data of the secret next to its metadata
+		if nested, ok := data["data"].(map[string]interface{}); ok {
+			data = nested
+		}
+	}
+	token, _ := data[s.cfg.Field].(string)
+	if token == "" {
+		return "", 0, fmt.Errorf("secret %q has no %q field", s.cfg.Path, s.cfg.Field)
+	}
+	return token, time.Duration(secret.LeaseDuration) * time.Second, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSource(t *testing.T) {
+	var (
+		response  string
+		status    = http.StatusOK
+		namespace string
+		requests  int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+			return
+		}
+		namespace = r.Header.Get("X-Vault-Namespace")
+		requests++
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "vault-token")
+
+	assertToken := func(s *Source, want string) {
+		t.Helper()
+		got, err := s.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("Token() = %q, want %q", got, want)
+		}
+	}
+
+	t.Run("kv version 2", func(t *testing.T) {
+		response = `{"lease_duration":0,"data":{"data":{"token":"first"},"metadata":{"version":1}}}`
+		s, err := New(context.Background(), Config{Path: "secret/data/ci/github", Namespace: "team"})
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if namespace != "team" {
+			t.Errorf("got namespace %q, want %q", namespace, "team")
+		}
+		now := time.Now()
+		s.now = func() time.Time { return now }
+		assertToken(s, "first")
+
+		// The secret isn't re-read before the refresh interval passed
+		response = `{"data":{"data":{"token":"second"}}}`
+		assertToken(s, "first")
+		now = now.Add(DefaultRefreshInterval)
+		assertToken(s, "second")
+
+		// Secrets without a lease are kept while Vault is unavailable
+		status = http.StatusServiceUnavailable
+		now = now.Add(DefaultRefreshInterval)
+		assertToken(s, "second")
+
+		// Reading the secret is retried after a delay, not on every call
+		before := requests
+		assertToken(s, "second")
+		if requests != before {
+			t.Errorf("got %d requests after a failed refresh, want none", requests-before)
+		}
+		status = http.StatusOK
+		now = now.Add(retryInterval)
+		response = `{"data":{"data":{"token":"third"}}}`
+		assertToken(s, "third")
+	})
+
+	t.Run("leased token", func(t *testing.T) {
+		response = `{"lease_duration":3600,"data":{"token":"ghs_first","expires_at":"..."}}`
+		s, err := New(context.Background(), Config{Path: "github/token"})
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		now := time.Now()
+		s.now = func() time.Time { return now }
+
+		// The token is requested again after two thirds of its lease
+		response = `{"lease_duration":3600,"data":{"token":"ghs_second"}}`
+		now = now.Add(30 * time.Minute)
+		assertToken(s, "ghs_first")
+		now = now.Add(15 * time.Minute)
+		assertToken(s, "ghs_second")
+
+		// The last token is used until it expires
+		status = http.StatusServiceUnavailable
+		now = now.Add(50 * time.Minute)
+		assertToken(s, "ghs_second")
+		now = now.Add(10 * time.Minute)
+		if _, err := s.Token(context.Background()); err == nil {
+			t.Error("Token() with an expired lease succeeded, want an error")
+		}
+		if _, err := s.Token(context.Background()); err == nil {
+			t.Error("Token() with an expired lease while backing off succeeded, want an error")
+		}
+		status = http.StatusOK
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		response = `{"data":{"password":"secret"}}`
+		if _, err := New(context.Background(), Config{Path: "secret/ci"}); err == nil {
+			t.Error("New() without a token field succeeded, want an error")
+		}
+		if s, err := New(context.Background(), Config{Path: "secret/ci", Field: "password"}); err != nil {
+			t.Errorf("New() error = %v", err)
+		} else {
+			assertToken(s, "secret")
+		}
+		if _, err := New(context.Background(), Config{Path: "secret/ci", VaultToken: func(context.Context) (string, error) { return "wrong", nil }}); err == nil {
+			t.Error("New() with a wrong Vault token succeeded, want an error")
+		}
+		if _, err := New(context.Background(), Config{}); err == nil {
+			t.Error("New() without a path succeeded, want an error")
+		}
+	})
+}
+
+func TestSource_Timeout(t *testing.T) {
+	defer func(timeout time.Duration) { requestTimeout = timeout }(requestTimeout)
+	requestTimeout = 50 * time.Millisecond
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	_, err := New(context.Background(), Config{
+		Address:    server.URL,
+		VaultToken: func(context.Context) (string, error) { return "vault-token", nil },
+		Path:       "github/token",
+	})
+	if err == nil {
+		t.Fatal("New() with a hanging Vault succeeded, want an error")
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package stash
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -27,11 +28,19 @@ import (
 
 // NewStashClient creates a new Client instance for Stash API endpoints.
 // The client accepts a username+token as an argument, which is used to authenticate.
-// The host name is used to construct the base URL for the Stash API.
+// To authenticate with tokens which change over time, pass an empty token together with
+// gitprovider.WithTokenSource or gitprovider.WithOAuth2TokenSource. The current token is
+// also used for Git operations.
+// For Bitbucket Server behind Active Directory, pass an empty token together with
+// gitprovider.WithNegotiateAuth to authenticate API calls and Git operations with SPNEGO.
+// With gitprovider.WithNetrc, pass an empty token to authenticate with the netrc entry of the
+// host; its login is used if the username is empty.
+// For read-only access to public repositories, pass an empty username and token together with
+// gitprovider.WithAnonymous.
+// The host name is used to construct the base URL for the Stash API. A host with the http:// scheme
+// requires gitprovider.WithAllowInsecureHTTP.
 // Variadic parameters gitprovider.ClientOption are used to pass additional options to the gitprovider.Client.
 func NewStashClient(username, token string, optFns ...gitprovider.ClientOption) (*ProviderClient, error) {
-	url := &url.URL{}
-
 	opts, err := gitprovider.MakeClientOptions(optFns...)
 	if err != nil {
 		return nil, fmt.Errorf("failed making client options: %w", err)
@@ -54,18 +63,54 @@ func NewStashClient(username, token string, optFns ...gitprovider.ClientOption)
 		logger = *opts.Logger
 	}
 
-	url, err = url.Parse(host)
+	// Validates the host, and that plain HTTP is allowed if it's used
+	baseURL, err := opts.DomainURL()
 	if err != nil {
-		return nil, fmt.Errorf("failed parsing host URL %q: %w", host, err)
+		return nil, err
+	}
+
+	if len(opts.RequiredScopes()) != 0 {
+		// Bitbucket Server has no endpoint to introspect the permissions of the current token
+		return nil, fmt.Errorf("token scopes can't be checked: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	var clientOpts []ClientOptionsFunc
+	switch {
+	case opts.Anonymous():
+		if username != "" || token != "" {
+			return nil, fmt.Errorf("anonymous client can't be given credentials: %w", gitprovider.ErrInvalidClientOptions)
+		}
+	case token == "" && opts.TokenSource() != nil:
+		clientOpts = append(clientOpts, WithTokenSource(username, opts.TokenSource()))
+	case token == "" && opts.Negotiate() != nil:
+		clientOpts = append(clientOpts, WithNegotiate(username, opts.Negotiate()))
+	case token == "" && opts.Netrc():
+		// API calls are authenticated by the netrc transport, the entry of the host is only looked
+		// up here for the user name and Git operations, if there is one.
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, err
+		}
+		if login, password, err := gitprovider.NetrcCredentials(u.Host); err == nil && password != "" {
+			if username == "" {
+				username = login
+			}
+			clientOpts = append(clientOpts, WithTokenSource(username, func(context.Context) (string, error) {
+				return password, nil
+			}))
+		}
+	default:
+		clientOpts = append(clientOpts, WithAuth(username, token))
 	}
 
-	var stashClient *Client
 	if len(opts.CABundle) != 0 {
-		stashClient, err = NewClient(client, host, nil, logger, WithAuth(username, token), WithCABundle(opts.CABundle))
-	} else {
-		stashClient, err = NewClient(client, host, nil, logger, WithAuth(username, token))
+		clientOpts = append(clientOpts, WithCABundle(opts.CABundle))
+	}
+	if opts.InsecureSkipTLSVerify != nil {
+		clientOpts = append(clientOpts, WithInsecureSkipTLS(*opts.InsecureSkipTLSVerify))
 	}
 
+	stashClient, err := NewClient(client, baseURL, nil, logger, clientOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating client: %w", err)
 	}
@@ -17,18 +17,27 @@ limitations under the License.
 package stash
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
 	"github.com/google/go-cmp/cmp"
 )
 
 func Test_DomainVariations(t *testing.T) {
 	tests := []struct {
-		name         string
-		opts         gitprovider.ClientOption
-		want         string
-		expectedErrs []error
+		name              string
+		opts              gitprovider.ClientOption
+		allowInsecureHTTP bool
+		want              string
+		expectedErrs      []error
 	}{
 		{
 			name: "custom domain without protocol",
@@ -46,17 +55,102 @@ func Test_DomainVariations(t *testing.T) {
 			want: "stash.testserver.link:8990",
 		},
 		{
-			name: "custom domain with http protocol",
-			opts: gitprovider.WithDomain("http://stash.testserver.link:8990"),
-			want: "stash.testserver.link:8990",
+			name:              "custom domain with http protocol",
+			opts:              gitprovider.WithDomain("http://stash.testserver.link:8990"),
+			allowInsecureHTTP: true,
+			want:              "stash.testserver.link:8990",
+		},
+		{
+			name:         "custom domain with http protocol, insecure HTTP not allowed",
+			opts:         gitprovider.WithDomain("http://stash.testserver.link:8990"),
+			expectedErrs: []error{gitprovider.ErrInvalidClientOptions},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c2, _ := NewStashClient("user1", "token", tt.opts)
+			c2, err := NewStashClient("user1", "token", tt.opts, gitprovider.WithAllowInsecureHTTP(tt.allowInsecureHTTP))
+			validation.TestExpectErrors(t, "NewStashClient", err, tt.expectedErrs...)
+			if err != nil {
+				return
+			}
 			if diff := cmp.Diff(tt.want, c2.SupportedDomain()); diff != "" {
 				t.Errorf("New Stash client returned domain (want -> got): %s", diff)
 			}
 		})
 	}
 }
+
+func Test_NewStashClientWithTokenSource(t *testing.T) {
+	ts := func(context.Context) (string, error) { return "rotated", nil }
+	if _, err := NewStashClient("user1", "", gitprovider.WithDomain("stash.testserver.link")); err == nil {
+		t.Error("NewStashClient() without a token succeeded, want an error")
+	}
+	c, err := NewStashClient("user1", "", gitprovider.WithDomain("stash.testserver.link"), gitprovider.WithTokenSource(ts))
+	if err != nil {
+		t.Fatalf("NewStashClient() error = %v", err)
+	}
+	auth, err := c.client.Git.(*GitService).basicAuth(context.Background())
+	if err != nil {
+		t.Fatalf("basicAuth() error = %v", err)
+	}
+	if auth.Username != "user1" || auth.Password != "rotated" {
+		t.Errorf("basicAuth() = %s:%s, want user1:rotated", auth.Username, auth.Password)
+	}
+}
+
+func Test_NewStashClientWithNegotiate(t *testing.T) {
+	negotiate := func(_ context.Context, host string) ([]byte, error) { return []byte("ticket-" + host), nil }
+	c, err := NewStashClient("user1", "", gitprovider.WithDomain("stash.testserver.link"), gitprovider.WithNegotiateAuth(negotiate))
+	if err != nil {
+		t.Fatalf("NewStashClient() error = %v", err)
+	}
+	auth, err := c.client.Git.(*GitService).auth(context.Background())
+	if err != nil {
+		t.Fatalf("auth() error = %v", err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://stash.testserver.link/scm/p/r.git/info/refs", nil)
+	auth.SetAuth(req)
+	want := "Negotiate " + base64.StdEncoding.EncodeToString([]byte("ticket-stash.testserver.link"))
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+}
+
+func Test_NewStashClientWithNetrc(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".netrc")
+	if err := os.WriteFile(path, []byte("machine stash.testserver.link login user1 password netrc-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("NETRC", path)
+
+	c, err := NewStashClient("", "", gitprovider.WithDomain("stash.testserver.link:8990"), gitprovider.WithNetrc(true))
+	if err != nil {
+		t.Fatalf("NewStashClient() error = %v", err)
+	}
+	auth, err := c.client.Git.(*GitService).basicAuth(context.Background())
+	if err != nil {
+		t.Fatalf("basicAuth() error = %v", err)
+	}
+	if auth.Username != "user1" || auth.Password != "netrc-token" {
+		t.Errorf("basicAuth() = %s:%s, want user1:netrc-token", auth.Username, auth.Password)
+	}
+
+	// Hosts without an entry aren't authenticated
+	if _, err := NewStashClient("user1", "", gitprovider.WithDomain("other.testserver.link"), gitprovider.WithNetrc(true)); err != nil {
+		t.Errorf("NewStashClient() error = %v", err)
+	}
+}
+
+func Test_NewStashClientAnonymous(t *testing.T) {
+	if _, err := NewStashClient("user1", "token", gitprovider.WithDomain("stash.testserver.link"), gitprovider.WithAnonymous(true)); !errors.Is(err, gitprovider.ErrInvalidClientOptions) {
+		t.Errorf("NewStashClient() error = %v, want %v", err, gitprovider.ErrInvalidClientOptions)
+	}
+	c, err := NewStashClient("", "", gitprovider.WithDomain("stash.testserver.link"), gitprovider.WithAnonymous(true))
+	if err != nil {
+		t.Fatalf("NewStashClient() error = %v", err)
+	}
+	auth, err := c.client.Git.(*GitService).auth(context.Background())
+	if err != nil || auth != nil {
+		t.Errorf("auth() = %v, %v, want no authentication", auth, err)
+	}
+}
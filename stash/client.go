@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-cleanhttp"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
@@ -94,14 +95,21 @@ type Client struct {
 	BaseURL *url.URL
 	//HeaderFields is the header fields for all requests.
 	HeaderFields *http.Header
-	// Logger is the logger used to log the request and response.
+	// Logger is the logger of the client. Requests are logged by the transport chain built from
+	// the gitprovider client options, see gitprovider.WithLogger.
 	Logger logr.Logger
 	// username is the username for WithAuth.
 	username string
 	// Token used to make authenticated API calls.
 	token string
+	// tokenSource returns the token instead, if set through WithTokenSource.
+	tokenSource gitprovider.TokenSource
+	// negotiate returns SPNEGO tokens instead, if set through WithNegotiate.
+	negotiate gitprovider.NegotiateTokenFunc
 	// caBundle is the CA bundle used to authenticate the server.
 	caBundle []byte
+	// insecureSkipTLS disables the verification of the server certificate in Git operations.
+	insecureSkipTLS bool
 
 	// Services are used to communicate with the different stash endpoints.
 	Users        Users
@@ -133,6 +141,15 @@ func WithCABundle(caBundle []byte) ClientOptionsFunc {
 	}
 }
 
+// WithInsecureSkipTLS disables the verification of the server certificate in Git operations.
+// The API calls use the TLS configuration of the given *http.Client.
+func WithInsecureSkipTLS(insecure bool) ClientOptionsFunc {
+	return func(c *Client) error {
+		c.insecureSkipTLS = insecure
+		return nil
+	}
+}
+
 // WithAuth is used to setup the client authentication.
 func WithAuth(username string, token string) ClientOptionsFunc {
 	return func(c *Client) error {
@@ -150,6 +167,44 @@ func WithAuth(username string, token string) ClientOptionsFunc {
 	}
 }
 
+// WithTokenSource is used to setup the client authentication with tokens which change over
+// time. API calls are authenticated by the transport of gitprovider.WithTokenSource, the
+// tokens are only resolved here for Git operations.
+func WithTokenSource(username string, ts gitprovider.TokenSource) ClientOptionsFunc {
+	return func(c *Client) error {
+		if username == "" {
+			return errors.New("user name is required")
+		}
+
+		if ts == nil {
+			return errors.New("token source is required")
+		}
+
+		c.username = username
+		c.tokenSource = ts
+		return nil
+	}
+}
+
+// WithNegotiate is used to setup the client authentication with SPNEGO, e.g. Kerberos. API calls
+// are authenticated by the transport of gitprovider.WithNegotiateAuth, the tokens are only
+// requested here for Git operations.
+func WithNegotiate(username string, negotiate gitprovider.NegotiateTokenFunc) ClientOptionsFunc {
+	return func(c *Client) error {
+		if username == "" {
+			return errors.New("user name is required")
+		}
+
+		if negotiate == nil {
+			return errors.New("negotiate function is required")
+		}
+
+		c.username = username
+		c.negotiate = negotiate
+		return nil
+	}
+}
+
 // NewClient returns a new Client given a host name an optional http.Client, a logger, http.Header and ClientOptionsFunc.
 // If the http.Client is nil, a default http.Client is used.
 // If the http.Header is nil, a default http.Header is used.
@@ -231,15 +286,15 @@ func NewClient(httpClient *http.Client, host string, header *http.Header, logger
 }
 
 func (c *Client) setBaseURL(host string) error {
-	h := host
-	if !strings.Contains(h, "http") && !strings.Contains(h, "https") {
-		h = fmt.Sprintf("https://%s", host)
-	}
+	h := gitprovider.GetDomainURL(host)
 
 	url, err := url.ParseRequestURI(h)
 	if err != nil {
 		return fmt.Errorf("failed to parse host %s to url, %w", h, err)
 	}
+	// Request paths are absolute, so a path prefix, e.g. of "https://tools.corp/bitbucket",
+	// mustn't end with a slash
+	url.Path = strings.TrimSuffix(url.Path, "/")
 
 	c.BaseURL = url
 
@@ -476,8 +531,6 @@ func (c *Client) Do(request *http.Request) ([]byte, *http.Response, error) {
 		return nil, nil, err
 	}
 
-	c.Logger.V(2).Info("request", "method", request.Method, "url", request.URL)
-
 	req, err := retryablehttp.FromRequest(request)
 	if err != nil {
 		return nil, nil, err
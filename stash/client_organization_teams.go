@@ -18,6 +18,7 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -47,8 +48,15 @@ func getGroupMemberSlugs(users []*User) []string {
 // teamName must not be an empty string.
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
-	users, err := c.client.Groups.AllGroupMembers(ctx, teamName)
+	return c.get(ctx, teamName)
+}
+
+func (c *TeamsClient) get(ctx context.Context, teamName string) (*Team, error) {
+	users, err := c.client.Groups.AllGroupMembers(gitprovider.WithoutListOptions(ctx), teamName)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
 		return nil, err
 	}
 
@@ -64,18 +72,20 @@ func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Tea
 		return nil, errs
 	}
 
-	team := &Team{
+	return newTeam(c, teamName, users), nil
+}
+
+func newTeam(c *TeamsClient, teamName string, users []*User) *Team {
+	return &Team{
+		c:     c,
 		ref:   c.ref,
 		users: users,
+		info: gitprovider.TeamInfo{
+			Name: teamName,
+			// We rely on slugs here as it is used for login
+			Members: getGroupMemberSlugs(users),
+		},
 	}
-
-	team.info = gitprovider.TeamInfo{
-		Name: teamName,
-		// We rely on slugs here as it is used for login
-		Members: getGroupMemberSlugs(team.users),
-	}
-
-	return team, nil
 }
 
 // List teams (stash groups).
@@ -116,7 +126,84 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 		return nil, errs
 	}
 
-	return teams, nil
+	return gitprovider.LimitItems(ctx, teams), nil
+}
+
+// ListIter returns an iterator over the teams List returns, fetching the groups with a
+// permission on the project a page at a time, and the detailed information about each team of
+// a page along with it.
+func (c *TeamsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Team] {
+	return gitprovider.NewIterator(ctx, offsetPages(func(ctx context.Context, opts *PagingOptions) ([]gitprovider.Team, *Paging, error) {
+		list, err := c.client.Projects.ListProjectGroupsPermission(ctx, c.ref.Key(), opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list groups for project %s: %w", c.ref.Key(), err)
+		}
+
+		teams := make([]gitprovider.Team, 0, len(list.GetGroups()))
+		for _, apiObj := range list.GetGroups() {
+			if err := validateProjectGroupPermissionAPI(apiObj); err != nil {
+				return nil, nil, err
+			}
+			team, err := c.Get(ctx, apiObj.Group.Name)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get team %s: %w", apiObj.Group.Name, err)
+			}
+			teams = append(teams, team)
+		}
+		return teams, &list.Paging, nil
+	}))
+}
+
+// Create a team (stash group).
+// Stash groups don't have a description, req.Description must be unset.
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.Description != nil {
+		return nil, fmt.Errorf("team description: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	apiObj, err := c.client.Groups.Create(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, ErrAlreadyExists) {
+			return nil, gitprovider.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create group %s: %w", req.Name, err)
+	}
+
+	return newTeam(c, apiObj.Name, nil), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// As stash groups can't be updated, ErrNoProviderSupport is returned if req doesn't equal the actual state.
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team, as it doesn't exist", "organization", c.ref.String(), "team", req.Name)
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, fmt.Errorf("unexpected error when reconciling team: %w", err)
+	}
+
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team is up to date", "organization", c.ref.String(), "team", req.Name)
+		return actual, false, nil
+	}
+
+	return actual, false, fmt.Errorf("cannot update team %s: %w", req.Name, gitprovider.ErrNoProviderSupport)
 }
 
 func validateProjectGroupPermissionAPI(apiObj *ProjectGroupPermission) error {
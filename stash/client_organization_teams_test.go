@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestTeamsClient_GetWithListOptions(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc(fmt.Sprintf("%s/%s", stashURIprefix, groupMembersURI), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "1" {
+			_, _ = w.Write([]byte(`{"isLastPage":true,"start":1,"values":[{"name":"bob","slug":"bob"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"isLastPage":false,"nextPageStart":1,"values":[{"name":"alice","slug":"alice"}]}`))
+	})
+
+	c := &TeamsClient{
+		clientContext: &clientContext{client: client},
+		ref:           gitprovider.OrganizationRef{Domain: "stash.example.com", Organization: "prj1"},
+	}
+	// The caller's list options must not truncate the members of the team.
+	ctx := gitprovider.WithCallOption(context.Background(), gitprovider.CallListOptions(gitprovider.ListOptions{PerPage: 1, MaxItems: 1}))
+	team, err := c.Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"alice", "bob"}, team.Get().Members); diff != "" {
+		t.Errorf("Get() members mismatch (-want +got):\n%s", diff)
+	}
+}
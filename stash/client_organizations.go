@@ -87,7 +87,31 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 		projects[i] = newOrganization(c.clientContext, apiObj, ref)
 	}
 
-	return projects, nil
+	return gitprovider.LimitItems(ctx, projects), nil
+}
+
+// ListIter returns an iterator over the organizations List returns, fetching them a page at a time.
+func (c *OrganizationsClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.Organization] {
+	return gitprovider.NewIterator(ctx, offsetPages(func(ctx context.Context, opts *PagingOptions) ([]gitprovider.Organization, *Paging, error) {
+		list, err := c.client.Projects.List(ctx, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+
+		projects := make([]gitprovider.Organization, 0, len(list.GetProjects()))
+		for _, apiObj := range list.GetProjects() {
+			if err := validateProjectAPI(apiObj); err != nil {
+				return nil, nil, err
+			}
+			ref := gitprovider.OrganizationRef{
+				Domain:       c.host,
+				Organization: apiObj.Name,
+			}
+			ref.SetKey(apiObj.Key)
+			projects = append(projects, newOrganization(c.clientContext, apiObj, ref))
+		}
+		return projects, &list.Paging, nil
+	}))
 }
 
 // Children returns the immediate child-organizations for the specific OrganizationRef o.
@@ -97,6 +121,49 @@ func (c *OrganizationsClient) Children(_ context.Context, _ gitprovider.Organiza
 	return nil, gitprovider.ErrNoProviderSupport
 }
 
+// Create returns ErrNoProviderSupport, as this provider doesn't implement creating projects yet.
+func (c *OrganizationsClient) Create(_ context.Context, _ gitprovider.OrganizationRef, _ gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// This provider doesn't implement creating projects yet, so an error is returned if the project
+// doesn't exist.
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	// First thing, validate the request
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("organization is up to date", "organization", ref.String())
+		return actual, false, nil
+	}
+
+	c.log.V(1).Info("updating organization to the desired state", "organization", ref.String())
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// Delete returns ErrNoProviderSupport, as this provider doesn't implement deleting projects yet.
+func (c *OrganizationsClient) Delete(_ context.Context, _ gitprovider.OrganizationRef) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
 // validateOrganizationRef makes sure the OrganizationRef is valid for stash usage.
 func validateOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain string) error {
 	// Make sure the OrganizationRef fields are valid
@@ -110,7 +177,7 @@ func validateOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain str
 // validateIdentityFields makes sure the type of the IdentityRef is supported, and the domain is as expected.
 func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string) error {
 	// Make sure the expected domain is used
-	if ref.GetDomain() != expectedDomain {
+	if !gitprovider.DomainsEqual(ref.GetDomain(), expectedDomain) {
 		return fmt.Errorf("domain %q not supported by this client: %w", ref.GetDomain(), gitprovider.ErrDomainUnsupported)
 	}
 	// Make sure the right type of identityref is used
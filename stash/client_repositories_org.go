@@ -83,6 +83,13 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 	if err := validateOrganizationRef(ref, c.host); err != nil {
 		return nil, err
 	}
+	callOpts := gitprovider.CallOptionsFromContext(ctx)
+	if !callOpts.RepositoryFilter().IsZero() {
+		return nil, fmt.Errorf("filtering repositories: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if callOpts.Sort != nil {
+		return nil, fmt.Errorf("sorting repositories: %w", gitprovider.ErrNoProviderSupport)
+	}
 
 	apiObjs, err := c.client.Repositories.All(ctx, ref.Key())
 	if err != nil {
@@ -100,7 +107,35 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 		return nil, errs
 	}
 
-	// Traverse the list, and return a list of OrgRepository objects
+	return gitprovider.LimitItems(ctx, c.orgRepositories(ref, apiObjs)), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+// Stash doesn't report the total number of repositories.
+func (c *OrgRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.OrganizationRef) *gitprovider.Iterator[gitprovider.OrgRepository] {
+	pages := repositoryPages(c.client, ref.Key())
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.OrgRepository, gitprovider.PageInfo, error) {
+		// Make sure the OrganizationRef is valid
+		if err := validateOrganizationRef(ref, c.host); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		callOpts := gitprovider.CallOptionsFromContext(ctx)
+		if !callOpts.RepositoryFilter().IsZero() {
+			return nil, gitprovider.PageInfo{}, fmt.Errorf("filtering repositories: %w", gitprovider.ErrNoProviderSupport)
+		}
+		if callOpts.Sort != nil {
+			return nil, gitprovider.PageInfo{}, fmt.Errorf("sorting repositories: %w", gitprovider.ErrNoProviderSupport)
+		}
+		apiObjs, info, err := pages(ctx, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.orgRepositories(ref, apiObjs), info, nil
+	})
+}
+
+// orgRepositories returns the OrgRepository objects for the repositories of the project.
+func (c *OrgRepositoriesClient) orgRepositories(ref gitprovider.OrganizationRef, apiObjs []*Repository) []gitprovider.OrgRepository {
 	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
 		repoRef := gitprovider.OrgRepositoryRef{
@@ -111,7 +146,34 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 
 		repos = append(repos, newOrgRepository(c.clientContext, apiObj, repoRef))
 	}
-	return repos, nil
+	return repos
+}
+
+// repositoryPages returns a PageFunc listing the repositories of the given project. The pages
+// must be fetched in order, as Stash pages from the start offset the previous page returned.
+func repositoryPages(client *Client, projectKey string) gitprovider.PageFunc[*Repository] {
+	return offsetPages(func(ctx context.Context, opts *PagingOptions) ([]*Repository, *Paging, error) {
+		list, err := client.Repositories.List(ctx, projectKey, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list repositories for %s: %w", projectKey, err)
+		}
+
+		var errs error
+		for _, apiObj := range list.GetRepositories() {
+			if err := validateRepositoryAPI(apiObj); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		if errs != nil {
+			return nil, nil, errs
+		}
+		return list.GetRepositories(), &list.Paging, nil
+	})
+}
+
+// Search is not supported by Stash.
+func (c *OrgRepositoriesClient) Search(_ context.Context, _ string) ([]gitprovider.OrgRepository, error) {
+	return nil, gitprovider.ErrNoProviderSupport
 }
 
 // Create creates a repository for the given organization, with the data and options.
@@ -147,6 +209,7 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -197,12 +260,24 @@ func createRepository(ctx context.Context, c *Client, orgKey string, ref gitprov
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
 		return nil, err
 	}
+	if req.Topics != nil {
+		return nil, fmt.Errorf("repository topics: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.LFSEnabled != nil {
+		return nil, fmt.Errorf("repository LFS settings: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.WikiEnabled != nil {
+		return nil, fmt.Errorf("repository wikis: %w", gitprovider.ErrNoProviderSupport)
+	}
 
 	// Assemble the options struct based on the given options
 	opt, err := gitprovider.MakeRepositoryCreateOptions(opts...)
 	if err != nil {
 		return nil, err
 	}
+	if opt.Template != nil {
+		return nil, fmt.Errorf("template repositories: %w", gitprovider.ErrNoProviderSupport)
+	}
 
 	// Convert to the API object and apply the options
 	data := repositoryToAPI(&req, ref)
@@ -349,8 +424,10 @@ func (c *OrgRepositoriesClient) reconcileRepository(ctx context.Context, actual
 	// If the desired matches the actual state, just return the actual state
 	new := actual.Get()
 	if req.Equals(new) {
+		c.log.V(1).Info("repository is up to date", "repository", actual.Repository().String())
 		return actionTaken, nil
 	}
+	c.log.V(1).Info("updating repository to the desired state", "repository", actual.Repository().String())
 	// Populate the desired state to the current-actual object
 	err := actual.Set(req)
 	if err != nil {
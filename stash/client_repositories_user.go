@@ -112,7 +112,28 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 		return nil, errs
 	}
 
-	// Traverse the list, and return a list of UserRepository objects
+	return gitprovider.LimitItems(ctx, c.userRepositories(ref, apiObjs)), nil
+}
+
+// ListIter returns an iterator over the repositories List returns, fetching them a page at a time.
+// Stash doesn't report the total number of repositories.
+func (c *UserRepositoriesClient) ListIter(ctx context.Context, ref gitprovider.UserRef) *gitprovider.Iterator[gitprovider.UserRepository] {
+	pages := repositoryPages(c.client, addTilde(ref.UserLogin))
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.UserRepository, gitprovider.PageInfo, error) {
+		// Make sure the UserRef is valid
+		if err := validateUserRef(ref, c.host); err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		apiObjs, info, err := pages(ctx, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		return c.userRepositories(ref, apiObjs), info, nil
+	})
+}
+
+// userRepositories returns the UserRepository objects for the repositories of the user.
+func (c *UserRepositoriesClient) userRepositories(ref gitprovider.UserRef, apiObjs []*Repository) []gitprovider.UserRepository {
 	repos := make([]gitprovider.UserRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
 		repoRef := gitprovider.UserRepositoryRef{
@@ -123,7 +144,7 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 
 		repos = append(repos, newUserRepository(c.clientContext, apiObj, repoRef))
 	}
-	return repos, nil
+	return repos
 }
 
 // Create creates a repository for the given organization, with the data and options
@@ -161,6 +182,7 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating repository, as it doesn't exist", "repository", ref.String())
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
 			return resp, true, err
 		}
@@ -180,8 +202,10 @@ func (c *UserRepositoriesClient) reconcileRepository(ctx context.Context, actual
 	// If the desired matches the actual state, just return the actual state
 	new := actual.Get()
 	if req.Equals(new) {
+		c.log.V(1).Info("repository is up to date", "repository", actual.Repository().String())
 		return actionTaken, nil
 	}
+	c.log.V(1).Info("updating repository to the desired state", "repository", actual.Repository().String())
 	// Populate the desired state to the current-actual object
 	err := actual.Set(req)
 	if err != nil {
@@ -46,6 +46,23 @@ func (c *CommitClient) ListPage(ctx context.Context, branch string, perPage, pag
 	return commits, nil
 }
 
+// ListIter returns an iterator over the commits of the given branch, fetching them a page at a
+// time with ListPage. A page holding fewer commits than the page size is the last one.
+func (c *CommitClient) ListIter(ctx context.Context, branch string) *gitprovider.Iterator[gitprovider.Commit] {
+	perPage := int(gitprovider.CallOptionsFromContext(ctx).ListOptions().PageSize(perPageLimit, maxPageLimit))
+	return gitprovider.NewIterator(ctx, func(ctx context.Context, page int) ([]gitprovider.Commit, gitprovider.PageInfo, error) {
+		commits, err := c.ListPage(ctx, branch, perPage, page)
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+		var info gitprovider.PageInfo
+		if len(commits) == perPage {
+			info.NextPage = page + 1
+		}
+		return commits, info, nil
+	})
+}
+
 func (c *CommitClient) listPage(ctx context.Context, branch string, perPage, page int) ([]*commitType, error) {
 	projectKey, repoSlug := getStashRefs(c.ref)
 
@@ -70,6 +87,11 @@ func (c *CommitClient) listPage(ctx context.Context, branch string, perPage, pag
 
 // Create creates a commit with the given specifications.
 func (c *CommitClient) Create(ctx context.Context, branch string, message string, files []gitprovider.CommitFile) (gitprovider.Commit, error) {
+	files, err := gitprovider.NormalizeCommitFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
 	projectKey, repoSlug := getStashRefs(c.ref)
 
 	// check if it is a user repository
@@ -96,7 +118,15 @@ func (c *CommitClient) Create(ctx context.Context, branch string, message string
 
 	f := make([]CommitFile, 0, len(files))
 	for _, file := range files {
-		f = append(f, CommitFile{Path: file.Path, Content: file.Content})
+		content := file.Content
+		if file.IsBase64() {
+			b, err := file.Bytes()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode file %s: %w", *file.Path, err)
+			}
+			content = gitprovider.StringVar(string(b))
+		}
+		f = append(f, CommitFile{Path: file.Path, Content: content})
 	}
 	commit, err := NewCommit(
 		WithAuthor(&CommitAuthor{
@@ -129,3 +159,13 @@ func (c *CommitClient) Create(ctx context.Context, branch string, message string
 
 	return newCommit(sha), nil
 }
+
+// GetSignature is not supported by Stash.
+func (c *CommitClient) GetSignature(_ context.Context, _ string) (*gitprovider.SignatureVerification, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// GetTagSignature is not supported by Stash.
+func (c *CommitClient) GetTagSignature(_ context.Context, _ string) (*gitprovider.SignatureVerification, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
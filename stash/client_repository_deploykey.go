@@ -47,7 +47,7 @@ func (c *DeployKeyClient) Get(ctx context.Context, name string) (gitprovider.Dep
 }
 
 func (c *DeployKeyClient) get(ctx context.Context, name string) (*DeployKey, error) {
-	deployKeys, err := c.list(ctx)
+	deployKeys, err := c.list(gitprovider.WithoutListOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +73,32 @@ func (c *DeployKeyClient) List(ctx context.Context) ([]gitprovider.DeployKey, er
 	for _, apiObj := range apiObjs {
 		keys = append(keys, newDeployKey(c, apiObj))
 	}
-	return keys, nil
+	return gitprovider.LimitItems(ctx, keys), nil
+}
+
+// ListIter returns an iterator over the deploy keys List returns, fetching them a page at a time.
+func (c *DeployKeyClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.DeployKey] {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	// check if it is a user repository
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
+
+	return gitprovider.NewIterator(ctx, offsetPages(func(ctx context.Context, opts *PagingOptions) ([]gitprovider.DeployKey, *Paging, error) {
+		list, err := c.client.DeployKeys.List(ctx, projectKey, repoSlug, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list deploy keys: %w", err)
+		}
+
+		keys := make([]gitprovider.DeployKey, 0, len(list.GetDeployKeys()))
+		for _, apiObj := range list.GetDeployKeys() {
+			if err := validateDeployKeyAPI(apiObj); err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, newDeployKey(c, apiObj))
+		}
+		return keys, &list.Paging, nil
+	}))
 }
 
 func (c *DeployKeyClient) list(ctx context.Context) ([]*DeployKey, error) {
@@ -152,6 +177,7 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating deploy key, as it doesn't exist", "repository", c.ref.String(), "deployKey", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -162,9 +188,11 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("deploy key is up to date", "repository", c.ref.String(), "deployKey", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating deploy key to the desired state", "repository", c.ref.String(), "deployKey", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
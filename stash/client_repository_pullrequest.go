@@ -72,10 +72,32 @@ func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest
 		prs = append(prs, newPullRequest(apiObj))
 	}
 
-	return prs, nil
+	return gitprovider.LimitItems(ctx, prs), nil
 
 }
 
+// ListIter returns an iterator over the pull requests List returns, fetching them a page at a time.
+func (c *PullRequestClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.PullRequest] {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	// check if it is a user repository
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
+
+	return gitprovider.NewIterator(ctx, offsetPages(func(ctx context.Context, opts *PagingOptions) ([]gitprovider.PullRequest, *Paging, error) {
+		list, err := c.client.PullRequests.List(ctx, projectKey, repoSlug, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list pull requests: %w", err)
+		}
+
+		prs := make([]gitprovider.PullRequest, 0, len(list.PullRequests))
+		for _, apiObj := range list.PullRequests {
+			prs = append(prs, newPullRequest(apiObj))
+		}
+		return prs, &list.Paging, nil
+	}))
+}
+
 // Merge merges the pull request.
 // Stash does not support message and merge strategy options for pull requests automatic merges.
 func (c *PullRequestClient) Merge(ctx context.Context, number int, _ gitprovider.MergeMethod, _ string) error {
@@ -185,3 +207,13 @@ func validatePullRequestsAPI(apiObj *PullRequest) error {
 		}
 	})
 }
+
+// EnableAutoMerge returns ErrNoProviderSupport, as auto-merge isn't implemented for Bitbucket Server.
+func (c *PullRequestClient) EnableAutoMerge(_ context.Context, _ int, _ gitprovider.MergeMethod) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+// DisableAutoMerge returns ErrNoProviderSupport, as auto-merge isn't implemented for Bitbucket Server.
+func (c *PullRequestClient) DisableAutoMerge(_ context.Context, _ int) error {
+	return gitprovider.ErrNoProviderSupport
+}
@@ -174,7 +174,14 @@ func (c *TeamAccessClient) List(ctx context.Context) ([]gitprovider.TeamAccess,
 
 	}
 
-	return teamsAccess, nil
+	return gitprovider.LimitItems(ctx, teamsAccess), nil
+}
+
+// ListIter returns an iterator over the team accesses List returns. The permissions a group has
+// on the repository and on its project are merged into a single team access, so they're all
+// fetched by a single call to List.
+func (c *TeamAccessClient) ListIter(ctx context.Context) *gitprovider.Iterator[gitprovider.TeamAccess] {
+	return gitprovider.ListIterator(ctx, c.List)
 }
 
 // Create adds a given team to the repo's team access control list.
@@ -231,6 +238,7 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
+			c.log.V(1).Info("creating team access, as it doesn't exist", "repository", c.ref.String(), "team", req.Name)
 			resp, err := c.Create(ctx, req)
 			return resp, true, err
 		}
@@ -241,9 +249,11 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 
 	// If the desired matches the actual state, just return the actual state
 	if req.Equals(actual.Get()) {
+		c.log.V(1).Info("team access is up to date", "repository", c.ref.String(), "team", req.Name)
 		return actual, false, nil
 	}
 
+	c.log.V(1).Info("updating team access to the desired state", "repository", c.ref.String(), "team", req.Name)
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
 		return actual, false, err
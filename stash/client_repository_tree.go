@@ -42,3 +42,11 @@ func (c *TreeClient) Get(ctx context.Context, sha string, recursive bool) (*gitp
 func (c *TreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
 	return nil, fmt.Errorf("error listing tree items %s. not implemented in stash yet", sha)
 }
+
+// ListIter returns an iterator over the tree entries List returns. As listing trees isn't
+// implemented in stash yet, it's a single call to List returning its error.
+func (c *TreeClient) ListIter(ctx context.Context, sha string, path string, recursive bool) *gitprovider.Iterator[*gitprovider.TreeEntry] {
+	return gitprovider.ListIterator(ctx, func(ctx context.Context) ([]*gitprovider.TreeEntry, error) {
+		return c.List(ctx, sha, path, recursive)
+	})
+}
@@ -82,6 +82,11 @@ func Test_NewClient(t *testing.T) {
 			transport: defaultTransport,
 			log:       initLogger(t),
 			output:    "https://my.host",
+		}, {
+			name:   "path prefixed host",
+			host:   "https://tools.corp/bitbucket/",
+			log:    logr.Discard(),
+			output: "https://tools.corp/bitbucket",
 		},
 	}
 
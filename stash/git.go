@@ -316,19 +316,25 @@ func (s *GitService) CloneRepository(ctx context.Context, URL string) (r *git.Re
 		return nil, "", err
 	}
 
+	auth, err := s.auth(ctx)
+	if err != nil {
+		return nil, "", err
+	}
 	r, err = git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{
-		URL:      URL,
-		Auth:     &githttp.BasicAuth{Username: s.Client.username, Password: s.Client.token},
-		CABundle: s.Client.caBundle,
+		URL:             URL,
+		Auth:            auth,
+		CABundle:        s.Client.caBundle,
+		InsecureSkipTLS: s.Client.insecureSkipTLS,
 	})
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to clone repository: %v", err)
 	}
 
 	err = r.Fetch(&git.FetchOptions{
-		RefSpecs: []config.RefSpec{"refs/*:refs/*", "HEAD:refs/heads/HEAD"},
-		Auth:     &githttp.BasicAuth{Username: s.Client.username, Password: s.Client.token},
-		CABundle: s.Client.caBundle,
+		RefSpecs:        []config.RefSpec{"refs/*:refs/*", "HEAD:refs/heads/HEAD"},
+		Auth:            auth,
+		CABundle:        s.Client.caBundle,
+		InsecureSkipTLS: s.Client.insecureSkipTLS,
 	})
 
 	if err != nil {
@@ -537,14 +543,19 @@ func (s *GitService) commit(w *git.Worktree, r *git.Repository, c *CreateCommit)
 
 // Push commits the current changes to the remote repository.
 func (s *GitService) Push(ctx context.Context, r *git.Repository) error {
+	auth, err := s.auth(ctx)
+	if err != nil {
+		return err
+	}
 
 	options := &git.PushOptions{
-		RemoteName: "origin",
-		Auth:       &githttp.BasicAuth{Username: s.Client.username, Password: s.Client.token},
-		CABundle:   s.Client.caBundle,
+		RemoteName:      "origin",
+		Auth:            auth,
+		CABundle:        s.Client.caBundle,
+		InsecureSkipTLS: s.Client.insecureSkipTLS,
 	}
 
-	err := r.PushContext(ctx, options)
+	err = r.PushContext(ctx, options)
 	if err != nil {
 		return fmt.Errorf("failed to push to remote: %w", err)
 	}
@@ -552,6 +563,57 @@ func (s *GitService) Push(ctx context.Context, r *git.Repository) error {
 	return nil
 }
 
+// auth returns the authentication method for Git operations.
+func (s *GitService) auth(ctx context.Context) (githttp.AuthMethod, error) {
+	if s.Client.negotiate != nil {
+		return &negotiateAuth{negotiate: s.Client.negotiate}, nil
+	}
+	if s.Client.username == "" {
+		// Anonymous clients don't authenticate
+		return nil, nil
+	}
+	return s.basicAuth(ctx)
+}
+
+// negotiateAuth implements githttp.AuthMethod with SPNEGO tokens. A new token is requested per
+// request, as servers may reject replayed tokens.
+type negotiateAuth struct {
+	negotiate gitprovider.NegotiateTokenFunc
+}
+
+// Name implements transport.AuthMethod.
+func (a *negotiateAuth) Name() string {
+	return "http-negotiate"
+}
+
+// String implements transport.AuthMethod.
+func (a *negotiateAuth) String() string {
+	return "http-negotiate"
+}
+
+// SetAuth implements githttp.AuthMethod. The request is sent unauthenticated if no token can be
+// obtained, as SetAuth can't return an error; the server then rejects it.
+func (a *negotiateAuth) SetAuth(r *http.Request) {
+	header, err := gitprovider.NegotiateHeader(r.Context(), a.negotiate, r.URL.Hostname())
+	if err != nil {
+		return
+	}
+	r.Header.Set("Authorization", header)
+}
+
+// basicAuth returns the credentials for Git operations, resolving the current token if the
+// client was set up WithTokenSource.
+func (s *GitService) basicAuth(ctx context.Context) (*githttp.BasicAuth, error) {
+	token := s.Client.token
+	if s.Client.tokenSource != nil {
+		var err error
+		if token, err = s.Client.tokenSource(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get token: %w", err)
+		}
+	}
+	return &githttp.BasicAuth{Username: s.Client.username, Password: token}, nil
+}
+
 func getLicense(license gitprovider.LicenseTemplate) (string, error) {
 
 	licenseURL, ok := licenseURLs[license]
@@ -25,17 +25,23 @@ import (
 )
 
 const (
-	groupsURI       = "admin/groups"
-	groupMembersURI = "admin/groups/more-members"
+	groupsURI          = "admin/groups"
+	groupMembersURI    = "admin/groups/more-members"
+	groupAddUsersURI   = "admin/groups/add-users"
+	groupRemoveUserURI = "admin/groups/remove-user"
 )
 
 // Groups interface defines the methods that can be used to
-// retrieve groups and members of a group.
+// manage groups and retrieve members of a group.
 type Groups interface {
 	List(ctx context.Context, opts *PagingOptions) (*GroupList, error)
 	Get(ctx context.Context, groupName string) (*Group, error)
+	Create(ctx context.Context, groupName string) (*Group, error)
+	Delete(ctx context.Context, groupName string) error
 	ListGroupMembers(ctx context.Context, groupName string, opts *PagingOptions) (*GroupMembers, error)
 	AllGroupMembers(ctx context.Context, groupName string) ([]*User, error)
+	AddUsers(ctx context.Context, groupName string, userNames ...string) error
+	RemoveUser(ctx context.Context, groupName, userName string) error
 }
 
 // GroupsService is a client for communicating with stash groups endpoint
@@ -135,6 +141,63 @@ func (s *GroupsService) Get(ctx context.Context, groupName string) (*Group, erro
 	return g, nil
 }
 
+// Create creates a stash group with the given name.
+// Create uses the endpoint "POST /rest/api/1.0/admin/groups?name".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) Create(ctx context.Context, groupName string) (*Group, error) {
+	query := url.Values{
+		"name": []string{groupName},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(groupsURI), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("create group request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("create group failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("create group failed: %s", resp.Status)
+	}
+
+	g := &Group{}
+	if err := json.Unmarshal(res, g); err != nil {
+		return nil, fmt.Errorf("create group failed, unable to unmarshal group json: %w", err)
+	}
+
+	g.Session.set(resp)
+	return g, nil
+}
+
+// Delete deletes the stash group with the given name.
+// Delete uses the endpoint "DELETE /rest/api/1.0/admin/groups?name".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) Delete(ctx context.Context, groupName string) error {
+	query := url.Values{
+		"name": []string{groupName},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newURI(groupsURI), WithQuery(query))
+	if err != nil {
+		return fmt.Errorf("delete group request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete group failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 // GroupMembers  is a list of stash groups members.
 type GroupMembers struct {
 	// Paging is the paging information.
@@ -194,7 +257,7 @@ func (s *GroupsService) ListGroupMembers(ctx context.Context, groupName string,
 func (s *GroupsService) AllGroupMembers(ctx context.Context, groupName string) ([]*User, error) {
 	p := []*User{}
 	opts := &PagingOptions{Limit: perPageLimit}
-	err := allPages(opts, func() (*Paging, error) {
+	err := allPages(ctx, opts, func() (*Paging, error) {
 		list, err := s.ListGroupMembers(ctx, groupName, opts)
 		if err != nil {
 			return nil, err
@@ -208,3 +271,61 @@ func (s *GroupsService) AllGroupMembers(ctx context.Context, groupName string) (
 
 	return p, nil
 }
+
+// AddUsers adds the given users to the stash group.
+// AddUsers uses the endpoint "POST /rest/api/1.0/admin/groups/add-users".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) AddUsers(ctx context.Context, groupName string, userNames ...string) error {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(struct {
+		Group string   `json:"group"`
+		Users []string `json:"users"`
+	}{groupName, userNames})
+	if err != nil {
+		return fmt.Errorf("failed to marshall group users: %w", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(groupAddUsersURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return fmt.Errorf("add group users request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("add group users failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RemoveUser removes the given user from the stash group.
+// RemoveUser uses the endpoint "POST /rest/api/1.0/admin/groups/remove-user".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) RemoveUser(ctx context.Context, groupName, userName string) error {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(struct {
+		Context  string `json:"context"`
+		ItemName string `json:"itemName"`
+	}{groupName, userName})
+	if err != nil {
+		return fmt.Errorf("failed to marshall group user: %w", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(groupRemoveUserURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return fmt.Errorf("remove group user request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remove group user failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
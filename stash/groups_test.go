@@ -19,6 +19,7 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -153,3 +154,133 @@ func TestListGroupMembers(t *testing.T) {
 		t.Errorf("Groups.ListGroupMembers returned diff (want -> got):\n%s", diff)
 	}
 }
+
+func TestCreateGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupName string
+		wantErr   error
+	}{
+		{
+			name:      "create a group",
+			groupName: "dev-2",
+		},
+		{
+			name:      "group already exists",
+			groupName: "dev-1",
+			wantErr:   ErrAlreadyExists,
+		},
+	}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Groups.Create used method %s, want %s", r.Method, http.MethodPost)
+		}
+		name := r.URL.Query().Get("name")
+		if name == "dev-1" {
+			http.Error(w, "The group already exists", http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Group{Name: name, Deleteable: true})
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, err := client.Groups.Create(context.Background(), tt.groupName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Groups.Create returned error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && group.Name != tt.groupName {
+				t.Errorf("Groups.Create returned group %s, want %s", group.Name, tt.groupName)
+			}
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Groups.Delete used method %s, want %s", r.Method, http.MethodDelete)
+		}
+		if r.URL.Query().Get("name") != "dev-1" {
+			http.Error(w, "The specified group does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Group{Name: "dev-1"})
+	})
+
+	ctx := context.Background()
+	if err := client.Groups.Delete(ctx, "dev-1"); err != nil {
+		t.Fatalf("Groups.Delete returned error: %v", err)
+	}
+	if err := client.Groups.Delete(ctx, "admin"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Groups.Delete returned error %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestAddGroupUsers(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupAddUsersURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			Group string   `json:"group"`
+			Users []string `json:"users"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Group != "dev-1" {
+			http.Error(w, "The specified group does not exist", http.StatusNotFound)
+			return
+		}
+		if diff := cmp.Diff([]string{"jcitizen", "tstark"}, req.Users); diff != "" {
+			t.Errorf("Groups.AddUsers sent diff (want -> got):\n%s", diff)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if err := client.Groups.AddUsers(ctx, "dev-1", "jcitizen", "tstark"); err != nil {
+		t.Fatalf("Groups.AddUsers returned error: %v", err)
+	}
+	if err := client.Groups.AddUsers(ctx, "admin", "jcitizen"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Groups.AddUsers returned error %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestRemoveGroupUser(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupRemoveUserURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			Context  string `json:"context"`
+			ItemName string `json:"itemName"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if req.Context != "dev-1" || req.ItemName != "jcitizen" {
+			http.Error(w, "The specified group or user does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if err := client.Groups.RemoveUser(ctx, "dev-1", "jcitizen"); err != nil {
+		t.Fatalf("Groups.RemoveUser returned error: %v", err)
+	}
+	if err := client.Groups.RemoveUser(ctx, "dev-1", "tstark"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Groups.RemoveUser returned error %v, want %v", err, ErrNotFound)
+	}
+}
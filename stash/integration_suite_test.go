@@ -213,6 +213,7 @@ var _ = Describe("Stash Provider", func() {
 			stashToken,
 			gitprovider.WithDomain(stashDomain),
 			gitprovider.WithDestructiveAPICalls(true),
+			gitprovider.WithAllowInsecureHTTP(true),
 			gitprovider.WithConditionalRequests(true),
 			gitprovider.WithPreChainTransportHook(customTransportFactory),
 			gitprovider.WithLogger(&log),
@@ -35,11 +35,13 @@ const (
 type Projects interface {
 	List(ctx context.Context, opts *PagingOptions) (*ProjectsList, error)
 	Get(ctx context.Context, projectName string) (*Project, error)
+	Update(ctx context.Context, project *Project) (*Project, error)
 	All(ctx context.Context) ([]*Project, error)
 	GetProjectGroupPermission(ctx context.Context, projectKey, groupName string) (*ProjectGroupPermission, error)
 	ListProjectGroupsPermission(ctx context.Context, projectKey string, opts *PagingOptions) (*ProjectGroups, error)
 	AllGroupsPermission(ctx context.Context, projectKey string) ([]*ProjectGroupPermission, error)
 	ListProjectUsersPermission(ctx context.Context, projectKey string, opts *PagingOptions) (*ProjectUsers, error)
+	AllUsersPermission(ctx context.Context, projectKey string) ([]*ProjectUserPermission, error)
 }
 
 // ProjectsService is a client for communicating with stash projects endpoint
@@ -125,7 +127,7 @@ func (s *ProjectsService) List(ctx context.Context, opts *PagingOptions) (*Proje
 func (s *ProjectsService) All(ctx context.Context) ([]*Project, error) {
 	p := []*Project{}
 	opts := &PagingOptions{Limit: perPageLimit}
-	err := allPages(opts, func() (*Paging, error) {
+	err := allPages(ctx, opts, func() (*Paging, error) {
 		list, err := s.List(ctx, opts)
 		if err != nil {
 			return nil, err
@@ -185,6 +187,55 @@ func (s *ProjectsService) Get(ctx context.Context, projectName string) (*Project
 
 }
 
+// Update updates the name, description and public flag of the project with the key of the
+// given project.
+// Update uses the endpoint "PUT /rest/api/1.0/projects/{projectKey}".
+// The authenticated user must have the PROJECT_ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *ProjectsService) Update(ctx context.Context, project *Project) (*Project, error) {
+	// Project omits a false public flag, which would make it impossible to make a project private
+	p := struct {
+		Key         string `json:"key"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Public      bool   `json:"public"`
+	}{
+		Key:         project.Key,
+		Name:        project.Name,
+		Description: project.Description,
+		Public:      project.Public,
+	}
+	body, err := marshallBody(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall project: %v", err)
+	}
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	req, err := s.Client.NewRequest(ctx, http.MethodPut, newURI(projectsURI, project.Key), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("update project request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update project failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("update project failed: %s", resp.Status)
+	}
+
+	updated := &Project{}
+	if err := json.Unmarshal(res, updated); err != nil {
+		return nil, fmt.Errorf("update project failed, unable to unmarshal project json: %w", err)
+	}
+
+	updated.Session.set(resp)
+	return updated, nil
+}
+
 // ProjectGroupPermission is a permission for a given group.
 // The permission is tied to a project.
 // The permission can be either read, write, or admin.
@@ -292,7 +343,7 @@ func (s *ProjectsService) ListProjectGroupsPermission(ctx context.Context, proje
 func (s *ProjectsService) AllGroupsPermission(ctx context.Context, projectKey string) ([]*ProjectGroupPermission, error) {
 	p := []*ProjectGroupPermission{}
 	opts := &PagingOptions{Limit: perPageLimit}
-	err := allPages(opts, func() (*Paging, error) {
+	err := allPages(ctx, opts, func() (*Paging, error) {
 		list, err := s.ListProjectGroupsPermission(ctx, projectKey, opts)
 		if err != nil {
 			return nil, err
@@ -373,3 +424,23 @@ func (s *ProjectsService) ListProjectUsersPermission(ctx context.Context, projec
 
 	return up, nil
 }
+
+// AllUsersPermission retrieves all projects users permission.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *ProjectsService) AllUsersPermission(ctx context.Context, projectKey string) ([]*ProjectUserPermission, error) {
+	p := []*ProjectUserPermission{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(ctx, opts, func() (*Paging, error) {
+		list, err := s.ListProjectUsersPermission(ctx, projectKey, opts)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, list.GetUsers()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -117,6 +117,44 @@ func TestListProjects(t *testing.T) {
 
 }
 
+func TestUpdateProject(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/%s", stashURIprefix, projectsURI, "PRJ")
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Projects.Update used method %s, want %s", r.Method, http.MethodPut)
+		}
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request body: %v", err)
+			return
+		}
+		// A false public flag must be sent, otherwise a project can't be made private
+		if public, ok := body["public"]; !ok || public != false {
+			t.Errorf("Projects.Update sent public %v, want false", public)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Project{
+			Key:         "PRJ",
+			Name:        body["name"].(string),
+			Description: body["description"].(string),
+		})
+	})
+
+	project, err := client.Projects.Update(context.Background(), &Project{
+		Key:         "PRJ",
+		Name:        "project 1",
+		Description: "updated",
+	})
+	if err != nil {
+		t.Fatalf("Projects.Update returned error: %v", err)
+	}
+	if project.Name != "project 1" || project.Description != "updated" {
+		t.Errorf("Projects.Update returned project %s (%s), want project 1 (updated)", project.Name, project.Description)
+	}
+}
+
 func TestListProjectGroupsPermission(t *testing.T) {
 
 	type group struct {
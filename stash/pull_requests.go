@@ -208,7 +208,7 @@ func (s *PullRequestsService) List(ctx context.Context, projectKey, repositorySl
 func (s *PullRequestsService) All(ctx context.Context, projectKey, repositorySlug string) ([]*PullRequest, error) {
 	pr := []*PullRequest{}
 	opts := &PagingOptions{Limit: perPageLimit}
-	err := allPages(opts, func() (*Paging, error) {
+	err := allPages(ctx, opts, func() (*Paging, error) {
 		list, err := s.List(ctx, projectKey, repositorySlug, opts)
 		if err != nil {
 			return nil, err
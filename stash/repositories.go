@@ -62,6 +62,7 @@ type RepositoryPermissionManager interface {
 	AllGroupsPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryGroupPermission, error)
 	UpdateRepositoryGroupPermission(ctx context.Context, projectKey, repositorySlug string, permission *RepositoryGroupPermission) error
 	ListRepositoryUsersPermission(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*RepositoryUsers, error)
+	AllUsersPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryUserPermission, error)
 }
 
 // RepositoriesService is a client for communicating with stash repositories endpoints
@@ -155,7 +156,7 @@ func (s *RepositoriesService) List(ctx context.Context, projectKey string, opts
 func (s *RepositoriesService) All(ctx context.Context, projectKey string) ([]*Repository, error) {
 	r := []*Repository{}
 	opts := &PagingOptions{Limit: perPageLimit}
-	err := allPages(opts, func() (*Paging, error) {
+	err := allPages(ctx, opts, func() (*Paging, error) {
 		list, err := s.List(ctx, projectKey, opts)
 		if err != nil {
 			return nil, err
@@ -398,7 +399,7 @@ func (s *RepositoriesService) ListRepositoryGroupsPermission(ctx context.Context
 func (s *RepositoriesService) AllGroupsPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryGroupPermission, error) {
 	p := []*RepositoryGroupPermission{}
 	opts := &PagingOptions{Limit: perPageLimit}
-	err := allPages(opts, func() (*Paging, error) {
+	err := allPages(ctx, opts, func() (*Paging, error) {
 		list, err := s.ListRepositoryGroupsPermission(ctx, projectKey, repositorySlug, opts)
 		if err != nil {
 			return nil, err
@@ -495,3 +496,23 @@ func (s *RepositoriesService) ListRepositoryUsersPermission(ctx context.Context,
 
 	return users, nil
 }
+
+// AllUsersPermission retrieves all repository users permission.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *RepositoriesService) AllUsersPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryUserPermission, error) {
+	p := []*RepositoryUserPermission{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(ctx, opts, func() (*Paging, error) {
+		list, err := s.ListRepositoryUsersPermission(ctx, projectKey, repositorySlug, opts)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, list.GetUsers()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package stash
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
@@ -28,6 +32,7 @@ type Organization struct {
 	p     Project
 	ref   gitprovider.OrganizationRef
 	teams *TeamsClient
+	c     *OrganizationsClient
 }
 
 // Get returns the organization's information, Name and description.
@@ -50,10 +55,136 @@ func (o *Organization) Teams() gitprovider.TeamsClient {
 	return o.teams
 }
 
+// Runners returns ErrNoProviderSupport, as Stash has no CI runners.
+func (o *Organization) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Bots returns ErrNoProviderSupport, as Stash has no bot users.
+func (o *Organization) Bots() (gitprovider.BotClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AccessTokens returns ErrNoProviderSupport, as managing the HTTP access tokens of Stash
+// projects isn't implemented.
+func (o *Organization) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Labels returns ErrNoProviderSupport, as Stash has no issues.
+func (o *Organization) Labels() (gitprovider.LabelClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Milestones returns ErrNoProviderSupport, as Stash has no milestones.
+func (o *Organization) Milestones() (gitprovider.MilestoneClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Members returns ErrNoProviderSupport, as Stash projects grant permissions to users
+// and groups instead of having members.
+func (o *Organization) Members() (gitprovider.OrganizationMemberClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Variables returns ErrNoProviderSupport, as Stash has no CI variables.
+func (o *Organization) Variables() (gitprovider.OrganizationVariableClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// AuditLog returns ErrNoProviderSupport, as the audit log of Stash isn't available through
+// the REST API.
+func (o *Organization) AuditLog() (gitprovider.AuditLogClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// SCIM returns ErrNoProviderSupport, as Stash has no SCIM API.
+func (o *Organization) SCIM() (gitprovider.SCIMClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// SSHCertificateAuthorities returns ErrNoProviderSupport, as Stash has no SSH certificate
+// authorities per project.
+func (o *Organization) SSHCertificateAuthorities() (gitprovider.SSHCertificateAuthorityClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// IPAllowList returns ErrNoProviderSupport, as Stash has no IP allow list per project.
+func (o *Organization) IPAllowList() (gitprovider.IPAllowListClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Set sets the desired state of the project. In order to apply these changes, run .Update()
+// or .Reconcile().
+//
+// ErrNoProviderSupport is returned when trying to make the project internal, or to set its
+// default repository permission or two-factor authentication requirement.
+func (o *Organization) Set(info gitprovider.OrganizationInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Visibility != nil && *info.Visibility == gitprovider.RepositoryVisibilityInternal {
+		return fmt.Errorf("projects are either public or private: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.DefaultRepositoryPermission != nil || info.RequireTwoFactor != nil {
+		return fmt.Errorf("cannot set project permissions: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.Name != nil {
+		o.p.Name = *info.Name
+	}
+	if info.Description != nil {
+		o.p.Description = *info.Description
+	}
+	if info.Visibility != nil {
+		o.p.Public = *info.Visibility == gitprovider.RepositoryVisibilityPublic
+	}
+	return nil
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a *Project and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (o *Organization) Update(ctx context.Context) error {
+	apiObj, err := o.c.client.Projects.Update(ctx, &o.p)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to update project %q: %w", o.p.Key, err)
+	}
+	o.p = *apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (o *Organization) Reconcile(ctx context.Context) (bool, error) {
+	resp, actionTaken, err := o.c.Reconcile(ctx, o.ref, o.Get())
+	if err != nil {
+		return actionTaken, err
+	}
+	*o = *resp.(*Organization)
+	return actionTaken, nil
+}
+
 func organizationFromAPI(apiObj *Project) gitprovider.OrganizationInfo {
+	visibility := gitprovider.RepositoryVisibilityPrivate
+	if apiObj.Public {
+		visibility = gitprovider.RepositoryVisibilityPublic
+	}
 	return gitprovider.OrganizationInfo{
 		Name:        &apiObj.Name,
 		Description: &apiObj.Description,
+		Visibility:  &visibility,
 	}
 }
 
@@ -65,5 +196,6 @@ func newOrganization(ctx *clientContext, apiObj *Project, ref gitprovider.Organi
 			clientContext: ctx,
 			ref:           ref,
 		},
+		c: &OrganizationsClient{clientContext: ctx},
 	}
 }
@@ -19,6 +19,7 @@ package stash
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -101,6 +102,18 @@ func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
 	if err := info.ValidateInfo(); err != nil {
 		return err
 	}
+	if info.Topics != nil {
+		return fmt.Errorf("repository topics: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.Archived != nil {
+		return fmt.Errorf("repository archival: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.LFSEnabled != nil {
+		return fmt.Errorf("repository LFS settings: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.WikiEnabled != nil {
+		return fmt.Errorf("repository wikis: %w", gitprovider.ErrNoProviderSupport)
+	}
 	repositoryInfoToAPIObj(&info, &r.repository)
 	return nil
 }
@@ -109,6 +122,17 @@ func (r *userRepository) APIObject() interface{} {
 	return &r.repository
 }
 
+// SSHEndpoint returns the endpoint of the "ssh" clone link reported by Bitbucket Server, e.g.
+// "ssh://git@stash.example.com:7999/prj/repo.git". There is none if SSH access is disabled.
+func (r *userRepository) SSHEndpoint() (gitprovider.SSHEndpoint, error) {
+	for _, link := range r.repository.Links.Clone {
+		if link.Name == "ssh" {
+			return gitprovider.ParseSSHEndpoint(link.Href)
+		}
+	}
+	return gitprovider.SSHEndpoint{}, fmt.Errorf("SSH access is disabled: %w", gitprovider.ErrNoProviderSupport)
+}
+
 func (r *userRepository) Repository() gitprovider.RepositoryRef {
 	return r.ref
 }
@@ -121,6 +145,128 @@ func (r *userRepository) DeployTokens() (gitprovider.DeployTokenClient, error) {
 	return nil, gitprovider.ErrNoProviderSupport
 }
 
+func (r *userRepository) Variables() (gitprovider.VariableClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Artifacts() (gitprovider.ArtifactClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Environments() (gitprovider.EnvironmentClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Deployments() (gitprovider.DeploymentClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Mirrors() (gitprovider.MirrorClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Issues() (gitprovider.IssueClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Labels() (gitprovider.LabelClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Milestones() (gitprovider.MilestoneClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Autolinks() (gitprovider.AutolinkClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Packages() (gitprovider.PackageClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Collaborators() (gitprovider.CollaboratorClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Bots() (gitprovider.BotClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) AccessTokens() (gitprovider.AccessTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Schedules() (gitprovider.ScheduleClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Pipelines() (gitprovider.PipelineClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) LFSUsage(_ context.Context) (int64, error) {
+	return 0, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Languages(_ context.Context) (map[string]float64, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// GetUserPermission returns the permission granted to the given user on the repository.
+// The owner of the personal repository always is an admin. Permissions granted through
+// groups aren't taken into account.
+func (r *userRepository) GetUserPermission(ctx context.Context, username string) (*gitprovider.RepositoryPermission, error) {
+	ref := r.ref.(gitprovider.UserRepositoryRef)
+	if strings.EqualFold(ref.UserLogin, username) {
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin), nil
+	}
+	level, err := getUserPermissionLevel(ctx, r.c.client, addTilde(ref.UserLogin), ref.Slug(), username)
+	if err != nil {
+		return nil, err
+	}
+	if level == 0 {
+		return nil, nil
+	}
+	return getGitProviderPermission(level)
+}
+
+func (r *userRepository) GetWatchLevel(_ context.Context) (gitprovider.WatchLevel, error) {
+	return "", gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) SetWatchLevel(_ context.Context, _ gitprovider.WatchLevel) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) DetectCI(_ context.Context) (*gitprovider.CIInfo, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Fork(_ context.Context, _ gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) ListForks(_ context.Context) ([]gitprovider.UserRepository, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Transfer(_ context.Context, _ gitprovider.IdentityRef) (gitprovider.UserRepository, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Archive(_ context.Context) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (r *userRepository) Unarchive(_ context.Context) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
 // The internal API object will be overridden with the received server data.
 func (r *userRepository) Update(ctx context.Context) error {
 	// update by calling client
@@ -213,6 +359,31 @@ func (r *orgRepository) TeamAccess() gitprovider.TeamAccessClient {
 	return r.teamAccess
 }
 
+// GetUserPermission returns the highest permission granted to the given user on the repository,
+// or on its project. Permissions granted through groups, and global permissions, aren't taken
+// into account.
+func (r *orgRepository) GetUserPermission(ctx context.Context, username string) (*gitprovider.RepositoryPermission, error) {
+	projectKey, repoSlug := getStashRefs(r.ref)
+	level, err := getUserPermissionLevel(ctx, r.c.client, projectKey, repoSlug, username)
+	if err != nil {
+		return nil, err
+	}
+	users, err := r.c.client.Projects.AllUsersPermission(gitprovider.WithoutListOptions(ctx), projectKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if isUser(u.User, username) {
+			// A project permission grants the same permission on all of its repositories
+			level = max(level, stashPriority[strings.Replace(u.Permission, "PROJECT_", "REPO_", 1)])
+		}
+	}
+	if level == 0 {
+		return nil, nil
+	}
+	return getGitProviderPermission(level)
+}
+
 // Reconcile makes sure the desired state in this object (called "req" here) becomes
 // the actual state in the backing Git provider.
 //
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestOrgRepository_GetCloneURL(t *testing.T) {
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{
+			Domain:       "https://stash.example.com:8443/bitbucket",
+			Organization: "project",
+		},
+		RepositoryName: "repo",
+	}
+	ref.SetKey("PRJ")
+	ref.SetSlug("repo")
+	r := &orgRepository{userRepository: userRepository{ref: ref}}
+
+	tests := []struct {
+		transport gitprovider.TransportType
+		want      string
+	}{
+		{transport: gitprovider.TransportTypeHTTPS, want: "https://stash.example.com:8443/bitbucket/scm/PRJ/repo.git"},
+		{transport: gitprovider.TransportTypeSSH, want: "ssh://git@stash.example.com:8443/PRJ/repo"},
+		{transport: gitprovider.TransportTypeGit, want: "ssh://git@stash.example.com:8443/PRJ/repo.git"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.transport), func(t *testing.T) {
+			if got := r.GetCloneURL("", tt.transport); got != tt.want {
+				t.Errorf("GetCloneURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserRepository_Fork(t *testing.T) {
+	r := &userRepository{}
+	if _, err := r.Fork(context.Background(), nil); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Fork() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+	if _, err := r.ListForks(context.Background()); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("ListForks() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+}
+
+func TestUserRepository_Transfer(t *testing.T) {
+	r := &userRepository{}
+	newOwner := gitprovider.OrganizationRef{Domain: "stash.example.com", Organization: "other"}
+	if _, err := r.Transfer(context.Background(), newOwner); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Transfer() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+}
+
+func TestOrgRepository_GetUserPermission(t *testing.T) {
+	tests := []struct {
+		name              string
+		repoPermission    string
+		projectPermission string
+		want              *gitprovider.RepositoryPermission
+	}{
+		{
+			name:           "repository read",
+			repoPermission: stashPermissionRead,
+			want:           gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull),
+		},
+		{
+			name:           "repository write",
+			repoPermission: stashPermissionWrite,
+			want:           gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush),
+		},
+		{
+			name:           "repository admin",
+			repoPermission: stashPermissionAdmin,
+			want:           gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin),
+		},
+		{
+			name:              "project permission above the repository permission",
+			repoPermission:    stashPermissionRead,
+			projectPermission: "PROJECT_WRITE",
+			want:              gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush),
+		},
+		{
+			name:              "project admin",
+			projectPermission: "PROJECT_ADMIN",
+			want:              gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin),
+		},
+		{
+			name: "not a collaborator",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, client := setup(t)
+			users := func(permission string) string {
+				if permission == "" {
+					return `{"isLastPage":true,"values":[{"user":{"name":"bob","slug":"bob"},"permission":"REPO_ADMIN"}]}`
+				}
+				return `{"isLastPage":true,"values":[{"user":{"name":"alice","slug":"alice"},"permission":"` + permission + `"}]}`
+			}
+			mux.HandleFunc(fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, userPermisionsURI), func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(users(tt.repoPermission)))
+			})
+			mux.HandleFunc(fmt.Sprintf("%s/%s/prj1/%s", stashURIprefix, projectsURI, userPermisionsURI), func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(users(tt.projectPermission)))
+			})
+			ref := gitprovider.OrgRepositoryRef{
+				OrganizationRef: gitprovider.OrganizationRef{Domain: "stash.example.com", Organization: "prj1"},
+				RepositoryName:  "repo1",
+			}
+			ref.SetKey("prj1")
+			ref.SetSlug("repo1")
+			r := newOrgRepository(&clientContext{client: client}, &Repository{}, ref)
+
+			got, err := r.GetUserPermission(context.Background(), "alice")
+			if err != nil {
+				t.Fatalf("GetUserPermission() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetUserPermission() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserRepository_WatchLevel(t *testing.T) {
+	r := &userRepository{}
+	if _, err := r.GetWatchLevel(context.Background()); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("GetWatchLevel() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+	if err := r.SetWatchLevel(context.Background(), gitprovider.WatchLevelWatching); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("SetWatchLevel() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+}
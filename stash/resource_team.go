@@ -17,6 +17,10 @@ limitations under the License.
 package stash
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
@@ -28,6 +32,7 @@ type Team struct {
 	users []*User
 	info  gitprovider.TeamInfo
 	ref   gitprovider.OrganizationRef
+	c     *TeamsClient
 }
 
 // Get returns the team's information, Name and members.
@@ -35,6 +40,17 @@ func (t *Team) Get() gitprovider.TeamInfo {
 	return t.info
 }
 
+// Set sets the desired state for the team.
+// As stash groups can't be renamed and have no description, applying a changed state
+// with Update or Reconcile returns ErrNoProviderSupport.
+func (t *Team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	t.info = info
+	return nil
+}
+
 // APIObject returns the Users that ware part of this team.
 func (t *Team) APIObject() interface{} {
 	return t.users
@@ -44,3 +60,91 @@ func (t *Team) APIObject() interface{} {
 func (t *Team) Organization() gitprovider.OrganizationRef {
 	return t.ref
 }
+
+// Update is not supported, as stash groups can't be renamed and have no description.
+// ErrNoProviderSupport is returned.
+func (t *Team) Update(_ context.Context) error {
+	return fmt.Errorf("cannot update team %s: %w", t.info.Name, gitprovider.ErrNoProviderSupport)
+}
+
+// Delete deletes the stash group, revoking all permissions granted through it.
+// ErrNotFound is returned if the resource does not exist.
+func (t *Team) Delete(ctx context.Context) error {
+	// Don't allow deleting teams if the user didn't explicitly allow dangerous API calls.
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	if err := t.c.client.Groups.Delete(ctx, t.info.Name); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete group %s: %w", t.info.Name, err)
+	}
+
+	return nil
+}
+
+// ListMembers lists the members of the stash group. Stash groups don't have roles,
+// all members are reported with the member role.
+func (t *Team) ListMembers(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	users, err := t.c.client.Groups.AllGroupMembers(ctx, t.info.Name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to list members of group %s: %w", t.info.Name, err)
+	}
+
+	members := make([]gitprovider.TeamMemberInfo, 0, len(users))
+	for _, slug := range getGroupMemberSlugs(users) {
+		members = append(members, gitprovider.TeamMemberInfo{
+			Username: slug,
+			Role:     gitprovider.TeamRoleVar(gitprovider.TeamRoleMember),
+		})
+	}
+	return members, nil
+}
+
+// AddMember adds the user to the stash group.
+// As stash groups don't have roles, ErrNoProviderSupport is returned for the maintainer role.
+func (t *Team) AddMember(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	if *req.Role != gitprovider.TeamRoleMember {
+		return fmt.Errorf("team role %q: %w", *req.Role, gitprovider.ErrNoProviderSupport)
+	}
+
+	if err := t.c.client.Groups.AddUsers(ctx, t.info.Name, req.Username); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to add user %s to group %s: %w", req.Username, t.info.Name, err)
+	}
+	return nil
+}
+
+// RemoveMember removes the user from the stash group.
+// ErrNotFound is returned if the group or user does not exist.
+func (t *Team) RemoveMember(ctx context.Context, username string) error {
+	if err := t.c.client.Groups.RemoveUser(ctx, t.info.Name, username); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to remove user %s from group %s: %w", username, t.info.Name, err)
+	}
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object becomes the actual state in the backing Git provider.
+// If the team doesn't exist, it is created (actionTaken == true).
+// If the team exists and differs from the desired state, ErrNoProviderSupport is returned.
+func (t *Team) Reconcile(ctx context.Context) (bool, error) {
+	resp, actionTaken, err := t.c.Reconcile(ctx, t.info)
+	if err != nil {
+		return actionTaken, err
+	}
+	*t = *resp.(*Team)
+	return actionTaken, nil
+}
@@ -18,6 +18,7 @@ package stash
 
 import (
 	"context"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -151,3 +152,23 @@ func getStashPermission(permission gitprovider.RepositoryPermission) (string, er
 	}
 	return "", gitprovider.ErrInvalidPermissionLevel
 }
+
+// getUserPermissionLevel returns the priority of the permission granted to the given user
+// on the repository itself, or 0 if there's none.
+func getUserPermissionLevel(ctx context.Context, client *Client, projectKey, repoSlug, username string) (int, error) {
+	users, err := client.Repositories.AllUsersPermission(gitprovider.WithoutListOptions(ctx), projectKey, repoSlug)
+	if err != nil {
+		return 0, err
+	}
+	level := 0
+	for _, u := range users {
+		if isUser(u.User, username) {
+			level = max(level, stashPriority[u.Permission])
+		}
+	}
+	return level, nil
+}
+
+func isUser(user User, username string) bool {
+	return strings.EqualFold(user.Name, username) || strings.EqualFold(user.Slug, username)
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package stash
 
 import (
+	"context"
 	"net/http"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
 const (
@@ -25,6 +28,8 @@ const (
 	filterKey      = "filter"
 	stashURIprefix = "/rest/api/1.0"
 	perPageLimit   = 25
+	// maxPageLimit is the default maximum page size of Bitbucket Server.
+	maxPageLimit = 1000
 )
 
 // Session keeps a record of a request for a given user.
@@ -104,13 +109,46 @@ type Links struct {
 	Clone []Clone `json:"clone,omitempty"`
 }
 
-func allPages(opts *PagingOptions, fn func() (*Paging, error)) error {
-	for {
+// offsetPages returns a PageFunc fetching the pages with list, mapping the page numbers of the
+// iterator to item offsets. The pages must be fetched in order, as Stash pages from the start
+// offset the previous page returned.
+func offsetPages[T any](list func(ctx context.Context, opts *PagingOptions) ([]T, *Paging, error)) gitprovider.PageFunc[T] {
+	start := int64(-1)
+	return func(ctx context.Context, page int) ([]T, gitprovider.PageInfo, error) {
+		limit := int64(gitprovider.CallOptionsFromContext(ctx).ListOptions().PageSize(perPageLimit, maxPageLimit))
+		if start < 0 {
+			start = int64(page-1) * limit
+		}
+		items, paging, err := list(ctx, &PagingOptions{Start: start, Limit: limit})
+		if err != nil {
+			return nil, gitprovider.PageInfo{}, err
+		}
+
+		var info gitprovider.PageInfo
+		if !paging.IsLast() {
+			info.NextPage = page + 1
+			start = paging.NextPageStart
+		}
+		return items, info, nil
+	}
+}
+
+// allPages calls fn for each page until the last one, honoring the ListOptions of ctx.
+// Stash pages by item offset, so the first page of the ListOptions is mapped to its offset.
+func allPages(ctx context.Context, opts *PagingOptions, fn func() (*Paging, error)) error {
+	listOpts := gitprovider.CallOptionsFromContext(ctx).ListOptions()
+	opts.Limit = int64(listOpts.PageSize(int(opts.Limit), maxPageLimit))
+	opts.Start = int64(listOpts.FirstPage()-1) * opts.Limit
+	for fetched := opts.Limit; ; fetched += opts.Limit {
+		// Stop between pages once the call is cancelled or past its deadline.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		resp, err := fn()
 		if err != nil {
 			return err
 		}
-		if resp.IsLast() {
+		if resp.IsLast() || listOpts.Reached(int(fetched)) {
 			return nil
 		}
 		// get Next start
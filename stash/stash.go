@@ -18,6 +18,9 @@ package stash
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/fluxcd/go-git-providers/validation"
@@ -50,6 +53,7 @@ func newClient(c *Client, host, token string, destructiveActions bool, logger lo
 		userRepos: &UserRepositoriesClient{
 			clientContext: ctx,
 		},
+		instance: &InstanceClient{},
 	}
 }
 
@@ -71,13 +75,15 @@ type ProviderClient struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+	instance  *InstanceClient
 }
 
-// SupportedDomain returns the host endpoint for this client, e.g. "mystash.com:7990"
+// SupportedDomain returns the host endpoint for this client, e.g. "mystash.com:7990", including
+// the path prefix if Stash is installed under one, e.g. "tools.corp/bitbucket".
 // This allows a higher-level user to know what Client to use for what endpoints.
 // This field is set at client creation time, and can't be changed.
 func (p *ProviderClient) SupportedDomain() string {
-	return p.client.BaseURL.Host
+	return p.client.BaseURL.Host + p.client.BaseURL.Path
 }
 
 // ProviderID returns the provider ID "gostash..
@@ -107,11 +113,76 @@ func (p *ProviderClient) UserRepositories() gitprovider.UserRepositoriesClient {
 	return p.userRepos
 }
 
+// GPGKeys is not supported by Stash.
+func (p *ProviderClient) GPGKeys() (gitprovider.GPGKeyClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// SSHKeys is not supported by Stash.
+func (p *ProviderClient) SSHKeys() (gitprovider.SSHKeyClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Runners is not supported by Stash.
+func (p *ProviderClient) Runners() (gitprovider.RunnerClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Instance returns the InstanceClient handling the administration of the instance.
+func (p *ProviderClient) Instance() gitprovider.InstanceClient {
+	return p.instance
+}
+
 // HasTokenPermission returns a boolean indicating whether the supplied token has the requested permission.
 func (p *ProviderClient) HasTokenPermission(_ context.Context, _ gitprovider.TokenPermission) (bool, error) {
 	return false, gitprovider.ErrNoProviderSupport
 }
 
+// CheckCapability returns nil, Bitbucket Server tokens don't restrict which APIs they can access
+// beyond their permissions.
+func (p *ProviderClient) CheckCapability(_ context.Context, _ gitprovider.Capability) error {
+	return nil
+}
+
+// SSHHostKeys returns ErrNoProviderSupport, Bitbucket Server doesn't publish its SSH host keys
+// through the API.
+func (p *ProviderClient) SSHHostKeys(_ context.Context) ([]gitprovider.SSHHostKey, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// CurrentUser returns the identity the client is authenticated as. Bitbucket Server has no
+// endpoint for it, but reports the authenticated user in the X-AUSERNAME header of every
+// response. The permissions of tokens can't be introspected.
+func (p *ProviderClient) CurrentUser(ctx context.Context) (gitprovider.CurrentUserInfo, error) {
+	if p.client.username == "" {
+		return gitprovider.CurrentUserInfo{}, fmt.Errorf("anonymous client has no user: %w", gitprovider.ErrNotFound)
+	}
+	user, err := p.client.Users.Get(ctx, p.client.username)
+	if err == nil {
+		// The given username might not be the one of the token, e.g. for HTTP access tokens
+		if name := user.Session.UserName; name != "" && !strings.EqualFold(name, user.Name) {
+			user, err = p.client.Users.Get(ctx, name)
+		}
+	}
+	if errors.Is(err, ErrNotFound) {
+		return gitprovider.CurrentUserInfo{}, gitprovider.ErrNotFound
+	} else if err != nil {
+		return gitprovider.CurrentUserInfo{}, fmt.Errorf("failed to get current user: %w", err)
+	}
+	info := gitprovider.CurrentUserInfo{
+		ID:       user.ID,
+		Username: user.Name,
+		Bot:      user.Type == "SERVICE",
+	}
+	if user.DisplayName != "" {
+		info.Name = &user.DisplayName
+	}
+	if user.EmailAddress != "" {
+		info.Email = &user.EmailAddress
+	}
+	return info, nil
+}
+
 // validateAPIObject creates a Validatior with the specified name, gives it to fn, and
 // depending on if any error was registered with it; either returns nil, or a MultiError
 // with both the validation error and ErrInvalidServerData, to mark that the server data
@@ -146,3 +146,23 @@ func TestUserList(t *testing.T) {
 		t.Errorf("Users.List returned diff (want -> got):\n%s", diff)
 	}
 }
+
+func TestCurrentUser(t *testing.T) {
+	mux, client := setup(t)
+	mux.HandleFunc(stashURIprefix+"/users/", func(w http.ResponseWriter, r *http.Request) {
+		// The token belongs to a service user, not to the given username
+		w.Header().Set("X-AUSERNAME", "ci-bot")
+		slug := strings.TrimPrefix(r.URL.Path, stashURIprefix+"/users/")
+		json.NewEncoder(w).Encode(&User{ID: int64(len(slug)), Name: slug, Slug: slug, DisplayName: "CI", Type: "SERVICE"})
+	})
+	client.username = "jcitizen"
+	p := newClient(client, "stash.example.com", "token", false, initLogger(t))
+
+	info, err := p.CurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentUser() error = %v", err)
+	}
+	if info.Username != "ci-bot" || info.ID != 6 || !info.Bot || info.Name == nil || *info.Name != "CI" {
+		t.Errorf("CurrentUser() = %+v, want the ci-bot service user", info)
+	}
+}